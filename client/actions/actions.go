@@ -16,6 +16,7 @@ import (
 	"github.com/ethereum/go-ethereum/core/types"
 
 	"github.com/figment-networks/skale-indexer/client/standard"
+	"github.com/figment-networks/skale-indexer/client/stream"
 	"github.com/figment-networks/skale-indexer/scraper/structs"
 	"github.com/figment-networks/skale-indexer/scraper/transport"
 	"github.com/figment-networks/skale-indexer/scraper/transport/eth/contract"
@@ -24,7 +25,7 @@ import (
 	"github.com/golang/groupcache/lru"
 )
 
-var implementedContractNames = []string{"skale_token", "delegation_controller", "validator_service", "nodes", "distributor", "punisher", "skale_manager", "bounty", "bounty_v2"}
+var implementedContractNames = []string{"skale_token", "delegation_controller", "validator_service", "nodes", "distributor", "punisher", "skale_manager", "bounty", "bounty_v2", "schains"}
 
 type Call interface {
 	// Validator
@@ -57,19 +58,33 @@ type BCGetter interface {
 }
 
 type Caches struct {
-	Account        *lru.Cache
-	AccountLock    sync.RWMutex
-	Delegation     *lru.Cache
-	DelegationLock sync.RWMutex
+	Account         *lru.Cache
+	AccountLock     sync.RWMutex
+	Delegation      *lru.Cache
+	DelegationLock  sync.RWMutex
+	Transaction     *lru.Cache
+	TransactionLock sync.RWMutex
 }
 
 func NewCaches() *Caches {
 	return &Caches{
-		Account:    lru.New(1000),
-		Delegation: lru.New(9000),
+		Account:     lru.New(1000),
+		Delegation:  lru.New(9000),
+		Transaction: lru.New(1000),
 	}
 }
 
+// dropStored clears caches of entities already persisted in the store
+func (c *Caches) dropStored() {
+	c.AccountLock.Lock()
+	c.Account.Clear()
+	c.AccountLock.Unlock()
+
+	c.TransactionLock.Lock()
+	c.Transaction.Clear()
+	c.TransactionLock.Unlock()
+}
+
 type Manager struct {
 	dataStore store.DataStore
 	c         Call
@@ -77,6 +92,13 @@ type Manager struct {
 	cm        *contract.Manager
 	l         *zap.Logger
 	caches    *Caches
+	publisher Publisher
+	outbox    bool
+}
+
+// Publisher receives system and contract events once they are committed to the store
+type Publisher interface {
+	Publish(events []stream.Event)
 }
 
 func NewManager(c Call, dataStore store.DataStore, tr transport.EthereumTransport, cm *contract.Manager, l *zap.Logger) *Manager {
@@ -90,16 +112,227 @@ func NewManager(c Call, dataStore store.DataStore, tr transport.EthereumTranspor
 	}
 }
 
+// SetPublisher sets the publisher of persisted events
+func (m *Manager) SetPublisher(p Publisher) {
+	m.publisher = p
+}
+
+type pendingKey struct{}
+
+// pending collects events saved within unit of work, until it's committed, and its outbox messages
+type pending struct {
+	mu       sync.Mutex
+	events   []stream.Event
+	outbox   []structs.OutboxMessage
+	webhooks *webhookSet
+}
+
+// webhookSet holds active webhooks, loaded once per outermost unit of work and shared by the nested ones
+type webhookSet struct {
+	mu       sync.Mutex
+	loaded   bool
+	webhooks []structs.Webhook
+}
+
+func (p *pending) add(events ...stream.Event) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.events = append(p.events, events...)
+}
+
+var errOutsideUnit = errors.New("record saved outside of unit of work")
+
+// Atomic runs fn as a single unit of work in the store.
+// Caches of persisted entities are dropped when it fails, as they may point at rolled back records.
+// Events saved within it are published once the outermost unit of work commits, or Bulk it's run within ends. Outbox messages are saved
+// in its transaction, after fn, so they're committed together with the records (bulk buffered ones included)
+func (m *Manager) Atomic(ctx context.Context, fn func(ctx context.Context) error) error {
+	parent, _ := ctx.Value(pendingKey{}).(*pending)
+	p := &pending{webhooks: &webhookSet{}}
+	if parent != nil {
+		p.webhooks = parent.webhooks
+	}
+	err := m.dataStore.Atomic(context.WithValue(ctx, pendingKey{}, p), func(ctx context.Context) error {
+		p.events, p.outbox = nil, nil
+		if err := fn(ctx); err != nil {
+			return err
+		}
+		if len(p.outbox) == 0 {
+			return nil
+		}
+		return m.dataStore.SaveOutboxMessages(ctx, p.outbox)
+	})
+	if err != nil {
+		m.caches.dropStored()
+		return err
+	}
+
+	if parent != nil {
+		parent.add(p.events...)
+	} else if b, ok := ctx.Value(bulkKey{}).(*pending); ok {
+		b.add(p.events...)
+	} else if m.publisher != nil && len(p.events) > 0 {
+		m.publisher.Publish(p.events)
+	}
+	return nil
+}
+
+type bulkKey struct{}
+
+// Bulk runs fn with bulk writes of the store. Units of work run within it may be committed only when it ends,
+// so their events are published then, and caches are dropped when committing fails
+func (m *Manager) Bulk(ctx context.Context, fn func(ctx context.Context) error) error {
+	b := &pending{}
+	var fnErr error
+	err := m.dataStore.Bulk(context.WithValue(ctx, bulkKey{}, b), func(ctx context.Context) error {
+		fnErr = fn(ctx)
+		return fnErr
+	})
+	if err != fnErr {
+		m.caches.dropStored()
+		return err
+	}
+
+	if m.publisher != nil && len(b.events) > 0 {
+		m.publisher.Publish(b.events)
+	}
+	return err
+}
+
+// inUnit runs fn within unit of work, the current one or the new one when there's none.
+// Records carrying outbox messages or published events are saved this way, so neither is let out before the record is committed
+func (m *Manager) inUnit(ctx context.Context, fn func(ctx context.Context) error) error {
+	if _, ok := ctx.Value(pendingKey{}).(*pending); ok {
+		return fn(ctx)
+	}
+	return m.Atomic(ctx, fn)
+}
+
+// publish queues event for publishing once the outermost unit of work commits
+func (m *Manager) publish(ctx context.Context, e stream.Event) error {
+	p, ok := ctx.Value(pendingKey{}).(*pending)
+	if !ok {
+		return errOutsideUnit
+	}
+	p.add(e)
+	return nil
+}
+
+func (m *Manager) saveSystemEvent(ctx context.Context, se structs.SystemEvent) error {
+	return m.inUnit(ctx, func(ctx context.Context) error {
+		if err := m.dataStore.SaveSystemEvent(ctx, se); err != nil {
+			return err
+		}
+		if err := m.publish(ctx, stream.Event{SystemEvent: &se}); err != nil {
+			return err
+		}
+		if err := m.enqueue(ctx, structs.OutboxTopicSystemEvent, se.Key(), se.Height, &se); err != nil {
+			return err
+		}
+		return m.queueWebhookDeliveries(ctx, se)
+	})
+}
+
+// activeWebhooks gets active webhooks. Within unit of work they're read once, on the first system event saved in it
+func (m *Manager) activeWebhooks(ctx context.Context) ([]structs.Webhook, error) {
+	p, ok := ctx.Value(pendingKey{}).(*pending)
+	if !ok {
+		return nil, errOutsideUnit
+	}
+
+	ws := p.webhooks
+	ws.mu.Lock()
+	defer ws.mu.Unlock()
+	if !ws.loaded {
+		webhooks, err := m.dataStore.GetWebhooks(ctx, structs.WebhookParams{Active: true})
+		if err != nil {
+			return nil, err
+		}
+		ws.webhooks, ws.loaded = webhooks, true
+	}
+	return ws.webhooks, nil
+}
+
+// queueWebhookDeliveries queues event for delivery to active webhooks it matches, in the same unit of work it's saved in.
+// Events older than webhook are skipped, so registering webhook during resync doesn't replay the history
+func (m *Manager) queueWebhookDeliveries(ctx context.Context, se structs.SystemEvent) error {
+	webhooks, err := m.activeWebhooks(ctx)
+	if err != nil {
+		return fmt.Errorf("error getting webhooks %w", err)
+	}
+
+	for _, wh := range webhooks {
+		if se.Time.Before(wh.CreatedAt) || !wh.Match(se) {
+			continue
+		}
+		if err := m.dataStore.CreateWebhookDelivery(ctx, structs.WebhookDelivery{
+			WebhookID: wh.ID,
+			Event:     se,
+			Status:    structs.WebhookDeliveryPending,
+			NextRetry: time.Now(),
+		}); err != nil {
+			return fmt.Errorf("error queueing webhook delivery %w", err)
+		}
+	}
+	return nil
+}
+
+func (m *Manager) saveContractEvent(ctx context.Context, ce structs.ContractEvent) error {
+	return m.inUnit(ctx, func(ctx context.Context) error {
+		if err := m.dataStore.SaveContractEvent(ctx, ce); err != nil {
+			return err
+		}
+		if err := m.publish(ctx, stream.Event{ContractEvent: &ce}); err != nil {
+			return err
+		}
+		return m.enqueue(ctx, structs.OutboxTopicContractEvent, ce.Key(), ce.BlockHeight, &ce)
+	})
+}
+
 func (m *Manager) GetImplementedContractNames() []string {
 	return implementedContractNames
 }
 
-func (m *Manager) GetBlockHeader(ctx context.Context, height big.Int) (h *types.Header, err error) {
-	h, err = m.tr.GetBlockHeader(ctx, &height)
-	return h, err
+// GetBlock gets block from the store, fetching its header and persisting it when it's not there yet
+func (m *Manager) GetBlock(ctx context.Context, height uint64) (b structs.Block, err error) {
+	b, err = m.dataStore.GetBlock(ctx, height)
+	if err == nil {
+		return b, nil
+	}
+	if err != structs.ErrNotFound {
+		m.l.Warn("error getting block from store", zap.Uint64("height", height), zap.Error(err))
+	}
+
+	h, err := m.tr.GetBlockHeader(ctx, new(big.Int).SetUint64(height))
+	if err != nil {
+		return b, err
+	}
+
+	b = structs.Block{
+		Number:     h.Number,
+		Hash:       h.Hash,
+		ParentHash: h.ParentHash,
+		Time:       time.Unix(int64(h.Time), 0),
+	}
+	if err = m.dataStore.SaveBlock(ctx, b); err != nil {
+		m.l.Warn("error saving block", zap.Uint64("height", height), zap.Error(err))
+	}
+
+	return b, nil
+}
+
+// GetBlockBefore gets the highest stored block below given height
+func (m *Manager) GetBlockBefore(ctx context.Context, height uint64) (b structs.Block, err error) {
+	return m.dataStore.GetBlockBefore(ctx, height)
 }
 
 func (m *Manager) AfterEventLog(ctx context.Context, c contract.ContractsContents, ce structs.ContractEvent) (err error) {
+	// outbox messages of records it changes are identified by the event
+	ctx = context.WithValue(ctx, originKey{}, ce.Key())
+
+	if err = m.saveTransaction(ctx, ce); err != nil {
+		return fmt.Errorf("error storing transaction %w", err)
+	}
 
 	bc := m.tr.GetBoundContractCaller(ctx, c.Addr, c.Abi)
 
@@ -108,7 +341,7 @@ func (m *Manager) AfterEventLog(ctx context.Context, c contract.ContractsContent
 		ce.EventName == "Upgraded" ||
 		ce.EventName == "AdminChanged" {
 		ce.BoundType = "none"
-		return m.dataStore.SaveContractEvent(ctx, ce)
+		return m.saveContractEvent(ctx, ce)
 	}
 	switch ce.ContractName {
 	case "validator_service":
@@ -126,7 +359,7 @@ func (m *Manager) AfterEventLog(ctx context.Context, c contract.ContractsContent
 			return fmt.Errorf("error running validatorChanged  %w", err)
 		}
 		v.BlockHeight = ce.BlockHeight
-		if err = m.dataStore.SaveValidator(ctx, v); err != nil {
+		if err = m.saveValidator(ctx, v); err != nil {
 			return fmt.Errorf("error storing validator %w", err)
 		}
 
@@ -134,6 +367,10 @@ func (m *Manager) AfterEventLog(ctx context.Context, c contract.ContractsContent
 			return fmt.Errorf("error storing changes %w", err)
 		}
 
+		if err = m.saveValidatorAddress(ctx, v, ce.BlockHeight, ce.Time, ce.TransactionHash, ce.EventName); err != nil {
+			return fmt.Errorf("error storing validator address %w", err)
+		}
+
 		if ce.EventName == "NodeAddressWasAdded" || ce.EventName == "NodeAddressWasRemoved" {
 			cV, ok := m.cm.GetContractByNameVersion("nodes", c.Version)
 			if !ok {
@@ -165,17 +402,17 @@ func (m *Manager) AfterEventLog(ctx context.Context, c contract.ContractsContent
 				}
 			}
 
-			if err := m.dataStore.SaveNodes(ctx, nodes, removedNodeAddr); err != nil {
+			if err := m.saveNodes(ctx, nodes, removedNodeAddr); err != nil {
 				return fmt.Errorf("error storing validator nodes %w", err)
 			}
 
-			err = m.dataStore.SaveValidatorStatistic(ctx, vID, ce.BlockHeight, ce.Time, structs.ValidatorStatisticsTypeActiveNodes, new(big.Int).SetUint64(activeNodes))
+			err = m.saveValidatorStatistic(ctx, vID, ce.BlockHeight, ce.Time, structs.ValidatorStatisticsTypeActiveNodes, new(big.Int).SetUint64(activeNodes))
 			if err != nil {
 				m.l.Error("error saving SaveValidatorStatistic for ValidatorStatisticsTypeActiveNodes ", zap.Error(err))
 				return err
 			}
 
-			err = m.dataStore.SaveValidatorStatistic(ctx, vID, ce.BlockHeight, ce.Time, structs.ValidatorStatisticsTypeLinkedNodes, new(big.Int).SetUint64(linkedNodes))
+			err = m.saveValidatorStatistic(ctx, vID, ce.BlockHeight, ce.Time, structs.ValidatorStatisticsTypeLinkedNodes, new(big.Int).SetUint64(linkedNodes))
 			if err != nil {
 				m.l.Error("error saving SaveValidatorStatistic for ValidatorStatisticsTypeLinkedNodes ", zap.Error(err))
 				break
@@ -189,14 +426,14 @@ func (m *Manager) AfterEventLog(ctx context.Context, c contract.ContractsContent
 
 		} else if ce.EventName == "ValidatorRegistered" {
 
-			if err = m.dataStore.SaveAccount(ctx, structs.Account{
+			if err = m.saveAccount(ctx, structs.Account{
 				Address: v.ValidatorAddress,
 				Type:    structs.AccountTypeValidator,
 			}); err != nil {
 				return fmt.Errorf("error storing account %w", err)
 			}
 
-			if err = m.dataStore.SaveSystemEvent(ctx, structs.SystemEvent{
+			if err = m.saveSystemEvent(ctx, structs.SystemEvent{
 				Height: ce.BlockHeight,
 				Time:   ce.Time,
 				Kind:   structs.SysEvtTypeFeeChanged,
@@ -205,7 +442,7 @@ func (m *Manager) AfterEventLog(ctx context.Context, c contract.ContractsContent
 				return fmt.Errorf("error storing system event %w", err)
 			}
 
-			if err = m.dataStore.SaveSystemEvent(ctx, structs.SystemEvent{
+			if err = m.saveSystemEvent(ctx, structs.SystemEvent{
 				Height: ce.BlockHeight,
 				Time:   ce.Time,
 				Kind:   structs.SysEvtTypeMDRChanged,
@@ -224,14 +461,14 @@ func (m *Manager) AfterEventLog(ctx context.Context, c contract.ContractsContent
 				return errors.New("structure is not for ValidatorAddressChanged, it does not have newAddress")
 			}
 
-			if err := m.dataStore.SaveAccount(ctx, structs.Account{
+			if err := m.saveAccount(ctx, structs.Account{
 				Address: addr,
 				Type:    structs.AccountTypeValidator,
 			}); err != nil {
 				return fmt.Errorf("error storing account %w", err)
 			}
 		} else if ce.EventName == "ValidatorWasEnabled" {
-			if err := m.dataStore.SaveSystemEvent(ctx, structs.SystemEvent{
+			if err := m.saveSystemEvent(ctx, structs.SystemEvent{
 				Height:      ce.BlockHeight,
 				Time:        ce.Time,
 				Kind:        structs.SysEvtTypeJoinedActiveSet,
@@ -239,7 +476,7 @@ func (m *Manager) AfterEventLog(ctx context.Context, c contract.ContractsContent
 				return fmt.Errorf("error storing system event %w", err)
 			}
 		} else if ce.EventName == "ValidatorWasDisabled" {
-			if err := m.dataStore.SaveSystemEvent(ctx, structs.SystemEvent{
+			if err := m.saveSystemEvent(ctx, structs.SystemEvent{
 				Height:      ce.BlockHeight,
 				Time:        ce.Time,
 				Kind:        structs.SysEvtTypeLeftActiveSet,
@@ -266,7 +503,7 @@ func (m *Manager) AfterEventLog(ctx context.Context, c contract.ContractsContent
 		}
 
 		if ce.EventName == "ExitCompleted" {
-			err = m.dataStore.SaveNodes(ctx, []structs.Node{n}, common.Address{})
+			err = m.saveNodes(ctx, []structs.Node{n}, common.Address{})
 			if err != nil {
 				m.l.Error("error saving exiting/exited node", zap.Error(err))
 				return err
@@ -287,17 +524,17 @@ func (m *Manager) AfterEventLog(ctx context.Context, c contract.ContractsContent
 			linkedNodes++
 		}
 
-		if err = m.dataStore.SaveNodes(ctx, nodes, common.Address{}); err != nil {
+		if err = m.saveNodes(ctx, nodes, common.Address{}); err != nil {
 			return fmt.Errorf("error storing nodes %w", err)
 		}
 
-		err = m.dataStore.SaveValidatorStatistic(ctx, n.ValidatorID, ce.BlockHeight, ce.Time, structs.ValidatorStatisticsTypeActiveNodes, new(big.Int).SetUint64(activeNodes))
+		err = m.saveValidatorStatistic(ctx, n.ValidatorID, ce.BlockHeight, ce.Time, structs.ValidatorStatisticsTypeActiveNodes, new(big.Int).SetUint64(activeNodes))
 		if err != nil {
 			m.l.Error("error saving SaveValidatorStatistic for ValidatorStatisticsTypeActiveNodes ", zap.Error(err))
 			return err
 		}
 
-		err = m.dataStore.SaveValidatorStatistic(ctx, n.ValidatorID, ce.BlockHeight, ce.Time, structs.ValidatorStatisticsTypeLinkedNodes, new(big.Int).SetUint64(linkedNodes))
+		err = m.saveValidatorStatistic(ctx, n.ValidatorID, ce.BlockHeight, ce.Time, structs.ValidatorStatisticsTypeLinkedNodes, new(big.Int).SetUint64(linkedNodes))
 		if err != nil {
 			m.l.Error("error saving SaveValidatorStatistic for ValidatorStatisticsTypeLinkedNodes ", zap.Error(err))
 			break
@@ -334,7 +571,7 @@ func (m *Manager) AfterEventLog(ctx context.Context, c contract.ContractsContent
 				return errors.New("structure is not a slash")
 			}
 
-			if err = m.dataStore.SaveSystemEvent(ctx, structs.SystemEvent{
+			if err = m.saveSystemEvent(ctx, structs.SystemEvent{
 				Height: ce.BlockHeight,
 				Time:   ce.Time,
 				Kind:   structs.SysEvtTypeSlashed,
@@ -365,7 +602,7 @@ func (m *Manager) AfterEventLog(ctx context.Context, c contract.ContractsContent
 				return errors.New("structure is not a forgive")
 			}
 
-			if err = m.dataStore.SaveSystemEvent(ctx, structs.SystemEvent{
+			if err = m.saveSystemEvent(ctx, structs.SystemEvent{
 				Height:    ce.BlockHeight,
 				Time:      ce.Time,
 				Kind:      structs.SysEvtTypeForgiven,
@@ -443,11 +680,11 @@ func (m *Manager) AfterEventLog(ctx context.Context, c contract.ContractsContent
 		m.caches.DelegationLock.Lock()
 		m.caches.Delegation.Add(dID, d)
 		m.caches.DelegationLock.Unlock()
-		if err := m.dataStore.SaveDelegation(ctx, d); err != nil {
+		if err := m.saveDelegation(ctx, d); err != nil {
 			return fmt.Errorf("error storing delegation %w", err)
 		}
 
-		if err := m.dataStore.SaveAccount(ctx, structs.Account{
+		if err := m.saveAccount(ctx, structs.Account{
 			Address: d.Holder,
 			Type:    structs.AccountTypeDelegator,
 		}); err != nil {
@@ -478,7 +715,7 @@ func (m *Manager) AfterEventLog(ctx context.Context, c contract.ContractsContent
 			sysEvt.RecipientID = *d.ValidatorID
 		}
 
-		if err = m.dataStore.SaveSystemEvent(ctx, sysEvt); err != nil {
+		if err = m.saveSystemEvent(ctx, sysEvt); err != nil {
 			return fmt.Errorf("error storing system event %w", err)
 		}
 
@@ -506,7 +743,7 @@ func (m *Manager) AfterEventLog(ctx context.Context, c contract.ContractsContent
 		}
 
 		n.BlockHeight = ce.BlockHeight
-		if err = m.dataStore.SaveNodes(ctx, []structs.Node{n}, common.Address{}); err != nil {
+		if err = m.saveNodes(ctx, []structs.Node{n}, common.Address{}); err != nil {
 			return fmt.Errorf("error storing node %w", err)
 		}
 
@@ -523,7 +760,7 @@ func (m *Manager) AfterEventLog(ctx context.Context, c contract.ContractsContent
 				_, ok := m.caches.Account.Get(ad)
 				m.caches.AccountLock.RUnlock()
 				if !ok {
-					if err := m.dataStore.SaveAccount(ctx, structs.Account{Address: ad}); err != nil {
+					if err := m.saveAccount(ctx, structs.Account{Address: ad}); err != nil {
 						return err
 					}
 					m.caches.AccountLock.Lock()
@@ -534,43 +771,194 @@ func (m *Manager) AfterEventLog(ctx context.Context, c contract.ContractsContent
 		}
 		ce.BoundType = "token"
 
+	case "schains":
+		// SchainCreated and SchainDeleted are stored to count skale chains
+		ce.BoundType = "schain"
+
 	default:
 		m.l.Debug("Unknown event type", zap.String("type", ce.ContractName), zap.Any("event", ce))
 	}
 
-	return m.dataStore.SaveContractEvent(ctx, ce)
+	return m.saveContractEvent(ctx, ce)
 
 }
 
-func (m *Manager) saveValidatorStatChanges(ctx context.Context, validator structs.Validator, blockNumber uint64, blockTime time.Time) error {
+// StoreFailedEvent puts event log which failed processing into failed events, to be retried later
+func (m *Manager) StoreFailedEvent(ctx context.Context, l types.Log, ce structs.ContractEvent, processErr error) error {
+	return m.dataStore.SaveFailedEvent(ctx, structs.FailedEvent{
+		BlockHeight:     l.BlockNumber,
+		TransactionHash: l.TxHash,
+		LogIndex:        l.Index,
+		ContractName:    ce.ContractName,
+		EventName:       ce.EventName,
+		Log:             l,
+		Error:           processErr.Error(),
+		Attempts:        1,
+		NextRetry:       time.Now().Add(structs.FailedEventRetryDelay(1)),
+	})
+}
 
-	err := m.dataStore.SaveValidatorStatistic(ctx, validator.ValidatorID, blockNumber, blockTime, structs.ValidatorStatisticsTypeFee, validator.FeeRate)
-	if err != nil {
-		return fmt.Errorf("error calling SaveValidatorStatistic (ValidatorStatisticsTypeFee) %w", err)
+// DeleteFailedEvent removes failed event once it's processed
+func (m *Manager) DeleteFailedEvent(ctx context.Context, id string) error {
+	return m.dataStore.DeleteFailedEvent(ctx, id)
+}
+
+// DeleteFailedEventOfLog removes failed event of the log, once it's processed when its block is re-scraped
+func (m *Manager) DeleteFailedEventOfLog(ctx context.Context, txHash common.Hash, logIndex uint) error {
+	return m.dataStore.DeleteFailedEventOfLog(ctx, txHash, logIndex)
+}
+
+type transactionsKey struct{}
+
+// PrefetchTransactions fetches transactions of logs which aren't stored yet, with their receipts.
+// They're carried by the returned context for AfterEventLog to store, so the node isn't called within unit of work
+func (m *Manager) PrefetchTransactions(ctx context.Context, logs []types.Log, blockTime time.Time) (context.Context, error) {
+	fetched := make(map[common.Hash]structs.Transaction)
+	seen := make(map[common.Hash]bool)
+	for _, l := range logs {
+		if seen[l.TxHash] {
+			continue
+		}
+		seen[l.TxHash] = true
+
+		stored, err := m.transactionStored(ctx, l.TxHash)
+		if err != nil {
+			return ctx, err
+		}
+		if stored {
+			continue
+		}
+
+		t, err := m.fetchTransaction(ctx, l.TxHash, blockTime)
+		if err != nil {
+			return ctx, err
+		}
+		fetched[l.TxHash] = t
+	}
+	return context.WithValue(ctx, transactionsKey{}, fetched), nil
+}
+
+// transactionStored reports whether transaction is already in the store
+func (m *Manager) transactionStored(ctx context.Context, hash common.Hash) (bool, error) {
+	m.caches.TransactionLock.RLock()
+	_, ok := m.caches.Transaction.Get(hash)
+	m.caches.TransactionLock.RUnlock()
+	if ok {
+		return true, nil
 	}
 
-	err = m.dataStore.SaveValidatorStatistic(ctx, validator.ValidatorID, blockNumber, blockTime, structs.ValidatorStatisticsTypeMDR, validator.MinimumDelegationAmount)
+	_, err := m.dataStore.GetTransaction(ctx, hash)
+	switch err {
+	case nil:
+		return true, nil
+	case structs.ErrNotFound:
+		return false, nil
+	}
+	return false, err
+}
+
+// fetchTransaction gets transaction with its receipt from the node
+func (m *Manager) fetchTransaction(ctx context.Context, hash common.Hash, blockTime time.Time) (t structs.Transaction, err error) {
+	tx, err := m.tr.GetTransaction(ctx, hash)
 	if err != nil {
-		return fmt.Errorf("error calling SaveValidatorStatistic (ValidatorStatisticsTypeMDR) %w", err)
+		return t, fmt.Errorf("error getting transaction %w", err)
 	}
 
-	err = m.dataStore.SaveValidatorStatistic(ctx, validator.ValidatorID, blockNumber, blockTime, structs.ValidatorStatisticsTypeAuthorized, boolToBigInt(validator.Authorized))
+	receipt, err := m.tr.GetTransactionReceipt(ctx, hash)
 	if err != nil {
-		return fmt.Errorf("error calling SaveValidatorStatistic (ValidatorStatisticsTypeAuthorized) %w", err)
+		return t, fmt.Errorf("error getting transaction receipt %w", err)
 	}
 
-	if validator.ValidatorAddress.String() != "" {
-		err = m.dataStore.SaveValidatorStatistic(ctx, validator.ValidatorID, blockNumber, blockTime, structs.ValidatorStatisticsTypeValidatorAddress, validator.ValidatorAddress.Hash().Big())
+	var baseFee *big.Int
+	if tx.Type == transport.DynamicFeeTxType {
+		h, err := m.tr.GetBlockHeader(ctx, receipt.BlockNumber)
 		if err != nil {
-			return fmt.Errorf("error calling SaveValidatorStatistic (ValidatorStatisticsTypeAuthorized) %w", err)
+			return t, fmt.Errorf("error getting block header %w", err)
 		}
+		baseFee = h.BaseFee
+	}
+	gasPrice := tx.EffectiveGasPrice(baseFee)
+
+	t = structs.Transaction{
+		Hash:        hash,
+		BlockHeight: receipt.BlockNumber.Uint64(),
+		BlockHash:   receipt.BlockHash,
+		Index:       receipt.TransactionIndex,
+		Time:        blockTime,
+		From:        tx.From,
+		Value:       tx.Value,
+		Nonce:       tx.Nonce,
+		Gas:         tx.Gas,
+		GasPrice:    gasPrice,
+		GasUsed:     receipt.GasUsed,
+		Fee:         new(big.Int).Mul(gasPrice, new(big.Int).SetUint64(receipt.GasUsed)),
+		Status:      receipt.Status,
 	}
+	if tx.To != nil {
+		t.To = *tx.To
+	}
+	return t, nil
+}
 
-	if validator.RequestedAddress.String() != "" {
-		err = m.dataStore.SaveValidatorStatistic(ctx, validator.ValidatorID, blockNumber, blockTime, structs.ValidatorStatisticsTypeRequestedAddress, validator.RequestedAddress.Hash().Big())
+// saveTransaction stores transaction of event, fetched by PrefetchTransactions, once per transaction hash
+func (m *Manager) saveTransaction(ctx context.Context, ce structs.ContractEvent) error {
+	fetched, _ := ctx.Value(transactionsKey{}).(map[common.Hash]structs.Transaction)
+	t, ok := fetched[ce.TransactionHash]
+	if !ok {
+		stored, err := m.transactionStored(ctx, ce.TransactionHash)
 		if err != nil {
-			return fmt.Errorf("error calling SaveValidatorStatistic (ValidatorStatisticsTypeAuthorized) %w", err)
+			return err
+		}
+		if !stored {
+			return fmt.Errorf("transaction %s is not fetched", ce.TransactionHash.Hex())
 		}
+		return nil
+	}
+
+	if err := m.dataStore.SaveTransaction(ctx, t); err != nil {
+		return err
+	}
+
+	m.caches.TransactionLock.Lock()
+	m.caches.Transaction.Add(ce.TransactionHash, struct{}{})
+	m.caches.TransactionLock.Unlock()
+	return nil
+}
+
+func (m *Manager) saveValidatorStatChanges(ctx context.Context, validator structs.Validator, blockNumber uint64, blockTime time.Time) error {
+
+	err := m.saveValidatorStatistic(ctx, validator.ValidatorID, blockNumber, blockTime, structs.ValidatorStatisticsTypeFee, validator.FeeRate)
+	if err != nil {
+		return fmt.Errorf("error calling SaveValidatorStatistic (ValidatorStatisticsTypeFee) %w", err)
+	}
+
+	err = m.saveValidatorStatistic(ctx, validator.ValidatorID, blockNumber, blockTime, structs.ValidatorStatisticsTypeMDR, validator.MinimumDelegationAmount)
+	if err != nil {
+		return fmt.Errorf("error calling SaveValidatorStatistic (ValidatorStatisticsTypeMDR) %w", err)
+	}
+
+	err = m.saveValidatorStatistic(ctx, validator.ValidatorID, blockNumber, blockTime, structs.ValidatorStatisticsTypeAuthorized, boolToBigInt(validator.Authorized))
+	if err != nil {
+		return fmt.Errorf("error calling SaveValidatorStatistic (ValidatorStatisticsTypeAuthorized) %w", err)
+	}
+
+	return nil
+}
+
+// saveValidatorAddress records the addresses of validator, the change is skipped by store when they are the same as before.
+// Transaction hash and event name are empty when the change is found by synchronization
+func (m *Manager) saveValidatorAddress(ctx context.Context, validator structs.Validator, blockNumber uint64, blockTime time.Time, txHash common.Hash, eventName string) error {
+	err := m.dataStore.SaveValidatorAddress(ctx, structs.ValidatorAddress{
+		ValidatorID:      validator.ValidatorID,
+		Address:          validator.ValidatorAddress,
+		RequestedAddress: validator.RequestedAddress,
+		BlockHeight:      blockNumber,
+		Time:             blockTime,
+		TransactionHash:  txHash,
+		EventName:        eventName,
+	})
+	if err != nil {
+		return fmt.Errorf("error calling SaveValidatorAddress %w", err)
 	}
 
 	return nil
@@ -655,7 +1043,7 @@ func (m *Manager) getValidatorDelegationValues(ctx context.Context, bc transport
 		return err
 	}
 
-	err = m.dataStore.SaveValidatorStatistic(ctx, validatorID, blockNumber, blockTime, structs.ValidatorStatisticsTypeTotalStake, ts)
+	err = m.saveValidatorStatistic(ctx, validatorID, blockNumber, blockTime, structs.ValidatorStatisticsTypeTotalStake, ts)
 	if err != nil {
 		return fmt.Errorf("error calling SaveValidatorStatistic (ValidatorStatisticsTypeTotalStake) %w", err)
 	}
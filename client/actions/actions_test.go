@@ -0,0 +1,222 @@
+package actions
+
+import (
+	"context"
+	"errors"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap/zaptest"
+
+	"github.com/figment-networks/skale-indexer/client/stream"
+	"github.com/figment-networks/skale-indexer/scraper/structs"
+	"github.com/figment-networks/skale-indexer/scraper/transport"
+	"github.com/figment-networks/skale-indexer/store"
+	"github.com/figment-networks/skale-indexer/store/memory"
+)
+
+// chainTransport serves transactions, receipts and headers from memory, the other calls are not implemented
+type chainTransport struct {
+	transport.EthereumTransport
+	txs      map[common.Hash]transport.Transaction
+	receipts map[common.Hash]types.Receipt
+	headers  map[uint64]transport.Header
+}
+
+func (ct chainTransport) GetTransaction(ctx context.Context, hash common.Hash) (*transport.Transaction, error) {
+	tx := ct.txs[hash]
+	return &tx, nil
+}
+
+func (ct chainTransport) GetTransactionReceipt(ctx context.Context, hash common.Hash) (*types.Receipt, error) {
+	r := ct.receipts[hash]
+	return &r, nil
+}
+
+func (ct chainTransport) GetBlockHeader(ctx context.Context, height *big.Int) (*transport.Header, error) {
+	h := ct.headers[height.Uint64()]
+	return &h, nil
+}
+
+// noTransport panics on every call, as the node is not expected to be called
+type noTransport struct {
+	transport.EthereumTransport
+}
+
+func TestManager_saveTransaction(t *testing.T) {
+	legacy := common.HexToHash("0x01")
+	dynamic := common.HexToHash("0x02")
+	ct := chainTransport{
+		txs: map[common.Hash]transport.Transaction{
+			legacy:  {Hash: legacy, GasPrice: big.NewInt(50), Value: big.NewInt(0)},
+			dynamic: {Hash: dynamic, Type: transport.DynamicFeeTxType, GasPrice: big.NewInt(100), GasFeeCap: big.NewInt(100), GasTipCap: big.NewInt(2), Value: big.NewInt(0)},
+		},
+		receipts: map[common.Hash]types.Receipt{
+			legacy:  {BlockNumber: big.NewInt(10), GasUsed: 1000, Status: 1},
+			dynamic: {BlockNumber: big.NewInt(20), GasUsed: 1000, Status: 1},
+		},
+		headers: map[uint64]transport.Header{
+			10: {Number: 10},
+			20: {Number: 20, BaseFee: big.NewInt(30)},
+		},
+	}
+
+	ctx := context.Background()
+	ds := store.New(memory.NewDriver())
+	m := NewManager(nil, ds, ct, nil, zaptest.NewLogger(t))
+
+	tests := []struct {
+		name     string
+		hash     common.Hash
+		gasPrice int64
+		fee      int64
+	}{
+		{"legacy", legacy, 50, 50000},
+		// base fee and tip, below the fee cap of 100
+		{"dynamic fee", dynamic, 32, 32000},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			m.tr = ct
+			pctx, err := m.PrefetchTransactions(ctx, []types.Log{{TxHash: tt.hash}, {TxHash: tt.hash}}, time.Unix(1000, 0))
+			require.NoError(t, err)
+
+			// the node isn't called within unit of work, nor for stored transactions
+			m.tr = noTransport{}
+			require.NoError(t, m.Atomic(pctx, func(ctx context.Context) error {
+				return m.saveTransaction(ctx, structs.ContractEvent{TransactionHash: tt.hash, Time: time.Unix(1000, 0)})
+			}))
+			_, err = m.PrefetchTransactions(ctx, []types.Log{{TxHash: tt.hash}}, time.Unix(1000, 0))
+			require.NoError(t, err)
+
+			tx, err := ds.GetTransaction(ctx, tt.hash)
+			require.NoError(t, err)
+			require.Equal(t, big.NewInt(tt.gasPrice), tx.GasPrice)
+			require.Equal(t, big.NewInt(tt.fee), tx.Fee)
+		})
+	}
+}
+
+// webhookCountingStore counts reads of webhooks
+type webhookCountingStore struct {
+	store.DataStore
+	reads int
+}
+
+func (s *webhookCountingStore) GetWebhooks(ctx context.Context, params structs.WebhookParams) ([]structs.Webhook, error) {
+	s.reads++
+	return s.DataStore.GetWebhooks(ctx, params)
+}
+
+func TestManager_queueWebhookDeliveries(t *testing.T) {
+	ctx := context.Background()
+	ds := &webhookCountingStore{DataStore: store.New(memory.NewDriver())}
+	m := NewManager(nil, ds, nil, nil, zaptest.NewLogger(t))
+
+	accepted, err := ds.CreateWebhook(ctx, structs.Webhook{URL: "http://accepted", Kinds: []structs.SysEvtType{structs.SysEvtTypeDelegationAccepted}, Active: true})
+	require.NoError(t, err)
+	rejected, err := ds.CreateWebhook(ctx, structs.Webhook{URL: "http://rejected", Kinds: []structs.SysEvtType{structs.SysEvtTypeDelegationRejected}, Active: true})
+	require.NoError(t, err)
+
+	// the block with nested unit of work per event, as the scraper saves it
+	now := time.Now().Add(time.Minute)
+	require.NoError(t, m.Atomic(ctx, func(ctx context.Context) error {
+		for i, kind := range []structs.SysEvtType{structs.SysEvtTypeDelegationAccepted, structs.SysEvtTypeNewDelegation, structs.SysEvtTypeDelegationAccepted} {
+			se := structs.SystemEvent{Height: 10, Time: now, Kind: kind, Sender: common.BigToAddress(big.NewInt(int64(i + 1)))}
+			if err := m.Atomic(ctx, func(ctx context.Context) error {
+				return m.saveSystemEvent(ctx, se)
+			}); err != nil {
+				return err
+			}
+		}
+		return nil
+	}))
+	require.Equal(t, 1, ds.reads)
+
+	dls, err := ds.GetWebhookDeliveries(ctx, structs.WebhookDeliveryParams{WebhookID: accepted})
+	require.NoError(t, err)
+	require.Len(t, dls, 2)
+	dls, err = ds.GetWebhookDeliveries(ctx, structs.WebhookDeliveryParams{WebhookID: rejected})
+	require.NoError(t, err)
+	require.Empty(t, dls)
+
+	// every unit of work reads webhooks anew, so the deactivated one is not delivered to anymore
+	require.NoError(t, ds.UpdateWebhook(ctx, structs.Webhook{ID: accepted, URL: "http://accepted", Kinds: []structs.SysEvtType{structs.SysEvtTypeDelegationAccepted}}))
+	require.NoError(t, m.Atomic(ctx, func(ctx context.Context) error {
+		return m.saveSystemEvent(ctx, structs.SystemEvent{Height: 11, Time: now, Kind: structs.SysEvtTypeDelegationAccepted, Sender: common.BigToAddress(big.NewInt(4))})
+	}))
+	require.Equal(t, 2, ds.reads)
+	dls, err = ds.GetWebhookDeliveries(ctx, structs.WebhookDeliveryParams{WebhookID: accepted})
+	require.NoError(t, err)
+	require.Len(t, dls, 2)
+}
+
+// persistedPublisher records whether events were persisted by the time they're published
+type persistedPublisher struct {
+	t         *testing.T
+	ds        store.DataStore
+	published int
+}
+
+func (pp *persistedPublisher) Publish(events []stream.Event) {
+	for _, e := range events {
+		ces, err := pp.ds.GetContractEvents(context.Background(), structs.EventParams{TransactionHash: e.ContractEvent.TransactionHash})
+		require.NoError(pp.t, err)
+		require.Len(pp.t, ces, 1)
+
+		msgs, err := pp.ds.GetOutboxMessages(context.Background(), structs.OutboxParams{})
+		require.NoError(pp.t, err)
+		require.Len(pp.t, msgs, pp.published+1)
+		pp.published++
+	}
+}
+
+func TestManager_saveContractEventInBulk(t *testing.T) {
+	ctx := context.Background()
+	ds := store.New(memory.NewDriver())
+	pp := &persistedPublisher{t: t, ds: ds}
+	m := NewManager(nil, ds, nil, nil, zaptest.NewLogger(t))
+	m.SetPublisher(pp)
+	m.EnableOutbox()
+
+	ce := structs.ContractEvent{ContractName: "validator_service", EventName: "ValidatorRegistered", BlockHeight: 10, TransactionHash: common.HexToHash("0x01")}
+	// saved outside of unit of work, as synchronization does, it's published once bulk commits,
+	// while the one of failed unit of work is never published
+	require.NoError(t, m.Bulk(ctx, func(ctx context.Context) error {
+		if err := m.saveContractEvent(ctx, ce); err != nil {
+			return err
+		}
+		failed := ce
+		failed.TransactionHash = common.HexToHash("0x03")
+		require.Error(t, m.Atomic(ctx, func(ctx context.Context) error {
+			if err := m.saveContractEvent(ctx, failed); err != nil {
+				return err
+			}
+			return errors.New("reverted")
+		}))
+		require.Equal(t, 0, pp.published)
+		return nil
+	}))
+	require.Equal(t, 1, pp.published)
+
+	// the event indexed again keeps its message id
+	ce.ID = uuid.New()
+	require.NoError(t, m.saveContractEvent(ctx, ce))
+	msgs, err := ds.GetOutboxMessages(ctx, structs.OutboxParams{})
+	require.NoError(t, err)
+	require.Len(t, msgs, 2)
+	require.NotEmpty(t, msgs[0].MessageID)
+	require.Equal(t, msgs[0].MessageID, msgs[1].MessageID)
+
+	other := ce
+	other.TransactionHash = common.HexToHash("0x02")
+	require.NoError(t, m.saveContractEvent(ctx, other))
+	msgs, err = ds.GetOutboxMessages(ctx, structs.OutboxParams{})
+	require.NoError(t, err)
+	require.NotEqual(t, msgs[0].MessageID, msgs[2].MessageID)
+}
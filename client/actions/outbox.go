@@ -0,0 +1,111 @@
+package actions
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"strconv"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/figment-networks/skale-indexer/scraper/structs"
+)
+
+// EnableOutbox makes manager save every event and entity change it stores to the outbox as well,
+// in the same unit of work, for the relay to publish them to message bus
+func (m *Manager) EnableOutbox() {
+	m.outbox = true
+}
+
+type originKey struct{}
+
+// enqueue adds record to the outbox of unit of work, its messages are saved together when it completes.
+// Records are saved within unit of work (see inUnit), so the messages are committed together with them
+func (m *Manager) enqueue(ctx context.Context, topic structs.OutboxTopic, key string, height uint64, record interface{}) error {
+	if !m.outbox {
+		return nil
+	}
+
+	p, ok := ctx.Value(pendingKey{}).(*pending)
+	if !ok {
+		return errOutsideUnit
+	}
+
+	payload, err := json.Marshal(record)
+	if err != nil {
+		return fmt.Errorf("error encoding %s outbox message %w", topic, err)
+	}
+
+	// changes of the same record by the same event are told apart by their order
+	origin, _ := ctx.Value(originKey{}).(string)
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	var seq int
+	for _, msg := range p.outbox {
+		if msg.Topic == topic && msg.Key == key && msg.Height == height {
+			seq++
+		}
+	}
+	p.outbox = append(p.outbox, structs.NewOutboxMessage(topic, key, height, origin+"/"+strconv.Itoa(seq), payload))
+	return nil
+}
+
+func (m *Manager) saveValidator(ctx context.Context, v structs.Validator) error {
+	return m.inUnit(ctx, func(ctx context.Context) error {
+		if err := m.dataStore.SaveValidator(ctx, v); err != nil {
+			return err
+		}
+		return m.enqueue(ctx, structs.OutboxTopicValidator, v.ValidatorID.String(), v.BlockHeight, &v)
+	})
+}
+
+func (m *Manager) saveDelegation(ctx context.Context, d structs.Delegation) error {
+	return m.inUnit(ctx, func(ctx context.Context) error {
+		if err := m.dataStore.SaveDelegation(ctx, d); err != nil {
+			return err
+		}
+		return m.enqueue(ctx, structs.OutboxTopicDelegation, d.DelegationID.String(), d.BlockHeight, &d)
+	})
+}
+
+func (m *Manager) saveNodes(ctx context.Context, nodes []structs.Node, removedNodeAddress common.Address) error {
+	return m.inUnit(ctx, func(ctx context.Context) error {
+		if err := m.dataStore.SaveNodes(ctx, nodes, removedNodeAddress); err != nil {
+			return err
+		}
+		for i := range nodes {
+			n := &nodes[i]
+			if err := m.enqueue(ctx, structs.OutboxTopicNode, n.NodeID.String(), n.BlockHeight, n); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+// saveAccount saves account, its message carries no height as accounts aren't tracked by height
+func (m *Manager) saveAccount(ctx context.Context, a structs.Account) error {
+	return m.inUnit(ctx, func(ctx context.Context) error {
+		if err := m.dataStore.SaveAccount(ctx, a); err != nil {
+			return err
+		}
+		return m.enqueue(ctx, structs.OutboxTopicAccount, a.Address.Hex(), 0, &a)
+	})
+}
+
+func (m *Manager) saveValidatorStatistic(ctx context.Context, validatorID *big.Int, blockHeight uint64, blockTime time.Time, statisticsType structs.StatisticTypeVS, amount *big.Int) error {
+	return m.inUnit(ctx, func(ctx context.Context) error {
+		if err := m.dataStore.SaveValidatorStatistic(ctx, validatorID, blockHeight, blockTime, statisticsType, amount); err != nil {
+			return err
+		}
+		return m.enqueue(ctx, structs.OutboxTopicValidatorStatistic, validatorID.String()+"/"+strconv.FormatUint(uint64(statisticsType), 10), blockHeight, &structs.ValidatorStatistics{
+			ValidatorID: validatorID,
+			Amount:      amount,
+			BlockHeight: blockHeight,
+			Time:        blockTime,
+			Type:        statisticsType,
+		})
+	})
+}
@@ -82,15 +82,20 @@ func (m *Manager) SyncForBeginningOfEpoch(ctx context.Context, version string, c
 			return fmt.Errorf("error saveValidatorStatChanges %w", err)
 		}
 
+		if err := m.saveValidatorAddress(ctx, v, currentBlock, blockTime, common.Hash{}, ""); err != nil {
+			m.l.Error("error saving saveValidatorAddress ", zap.Error(err))
+			return fmt.Errorf("error saveValidatorAddress %w", err)
+		}
+
 		nInfo, ok := nodesInfo[v.ValidatorID.Uint64()]
 		if ok {
-			err := m.dataStore.SaveValidatorStatistic(ctx, v.ValidatorID, currentBlock, blockTime, structs.ValidatorStatisticsTypeActiveNodes, big.NewInt(int64(nInfo.ActiveNodeCount)))
+			err := m.saveValidatorStatistic(ctx, v.ValidatorID, currentBlock, blockTime, structs.ValidatorStatisticsTypeActiveNodes, big.NewInt(int64(nInfo.ActiveNodeCount)))
 			if err != nil {
 				m.l.Error("error saving SaveValidatorStatistic for ValidatorStatisticsTypeActiveNodes ", zap.Error(err))
 				return err
 			}
 
-			err = m.dataStore.SaveValidatorStatistic(ctx, v.ValidatorID, currentBlock, blockTime, structs.ValidatorStatisticsTypeLinkedNodes, big.NewInt(int64(nInfo.LinkedNodeCount)))
+			err = m.saveValidatorStatistic(ctx, v.ValidatorID, currentBlock, blockTime, structs.ValidatorStatisticsTypeLinkedNodes, big.NewInt(int64(nInfo.LinkedNodeCount)))
 			if err != nil {
 				m.l.Error("error saving SaveValidatorStatistic for ValidatorStatisticsTypeLinkedNodes ", zap.Error(err))
 				return err
@@ -157,7 +162,7 @@ func (m *Manager) syncDelegationsAsync(ctx context.Context, cV contract.Contract
 	}
 	var err error
 	for validatorID, v := range delegationCalculations.TotalStake {
-		err = m.dataStore.SaveValidatorStatistic(ctx, new(big.Int).SetUint64(validatorID), currentBlock, currentBlockTime, structs.ValidatorStatisticsTypeTotalStake, v)
+		err = m.saveValidatorStatistic(ctx, new(big.Int).SetUint64(validatorID), currentBlock, currentBlockTime, structs.ValidatorStatisticsTypeTotalStake, v)
 		if err != nil {
 			break
 		}
@@ -235,7 +240,7 @@ func (m *Manager) syncDelegations(ctx context.Context, cV contract.ContractsCont
 	}
 
 	d.BlockHeight = currentBlock
-	if err = m.dataStore.SaveDelegation(ctx, d); err != nil {
+	if err = m.saveDelegation(ctx, d); err != nil {
 		m.l.Error("error saving delegation ", zap.Error(err))
 		return true, dCalc, err
 	}
@@ -266,7 +271,7 @@ func (m *Manager) syncValidators(ctx context.Context, cV contract.ContractsConte
 		}
 
 		vld.BlockHeight = currentBlock
-		err = m.dataStore.SaveValidator(ctx, vld)
+		err = m.saveValidator(ctx, vld)
 		if err != nil {
 			m.l.Error("error saving validators ", zap.Error(err))
 			return validators, err
@@ -306,7 +311,7 @@ func (m *Manager) syncNodes(ctx context.Context, cV contract.ContractsContents,
 			return nodes, err
 		}
 
-		err = m.dataStore.SaveNodes(ctx, []structs.Node{n}, common.Address{})
+		err = m.saveNodes(ctx, []structs.Node{n}, common.Address{})
 		if err != nil {
 			m.l.Error("error saving nodes ", zap.Error(err))
 			return nodes, err
@@ -0,0 +1,127 @@
+package client
+
+import (
+	"context"
+	"sort"
+
+	"github.com/figment-networks/skale-indexer/scraper/structs"
+	"go.uber.org/zap"
+)
+
+// GetAddressActivity merges records of every kind touching the address, the latest first.
+// Each kind is paged after the same cursor, so the page is made of the latest records among their pages
+func (c *Client) GetAddressActivity(ctx context.Context, params structs.ActivityParams) (activity []structs.Activity, err error) {
+	for _, kind := range activityKinds(params.Kinds) {
+		found, err := c.activityOfKind(ctx, kind, params)
+		if err != nil {
+			c.log.Error("[CLIENT] Error in GetAddressActivity", zap.Any("params", params), zap.String("kind", string(kind)), zap.Error(err))
+			return nil, err
+		}
+		activity = append(activity, found...)
+	}
+
+	sort.Slice(activity, func(i, j int) bool {
+		return activity[i].Cursor().Precedes(activity[j].Cursor())
+	})
+	if params.Limit > 0 && uint64(len(activity)) > params.Limit {
+		activity = activity[:params.Limit]
+	}
+
+	// delegations keep no time of their change, it's the time of block
+	blockTimes := map[uint64]structs.Block{}
+	for i, a := range activity {
+		if a.Kind != structs.ActivityKindDelegation {
+			continue
+		}
+		b, ok := blockTimes[a.BlockHeight]
+		if !ok {
+			if b, err = c.storeEng.GetBlock(ctx, a.BlockHeight); err != nil && err != structs.ErrNotFound {
+				c.log.Error("[CLIENT] Error in GetAddressActivity", zap.Any("params", params), zap.Error(err))
+				return nil, err
+			}
+			blockTimes[a.BlockHeight] = b
+		}
+		activity[i].Time = b.Time
+	}
+	return activity, nil
+}
+
+// CountAddressActivity counts records of every kind touching the address
+func (c *Client) CountAddressActivity(ctx context.Context, params structs.ActivityParams) (count uint64, err error) {
+	for _, kind := range activityKinds(params.Kinds) {
+		var n uint64
+		switch kind {
+		case structs.ActivityKindContractEvent:
+			n, err = c.storeEng.CountContractEvents(ctx, structs.EventParams{BoundAddress: params.Address})
+		case structs.ActivityKindDelegation:
+			n, err = c.storeEng.CountDelegationTimeline(ctx, structs.DelegationParams{Holder: params.Address.Hex()})
+		case structs.ActivityKindSystemEvent:
+			n, err = c.storeEng.CountSystemEvents(ctx, structs.SystemEventParams{Address: params.Address.Hex()})
+		case structs.ActivityKindNode:
+			var changes []structs.NodeChange
+			changes, err = c.storeEng.GetNodeTimeline(ctx, structs.NodeParams{Address: params.Address.Hex()})
+			n = uint64(len(changes))
+		}
+		if err != nil {
+			c.log.Error("[CLIENT] Error in CountAddressActivity", zap.Any("params", params), zap.String("kind", string(kind)), zap.Error(err))
+			return 0, err
+		}
+		count += n
+	}
+	return count, nil
+}
+
+// activityOfKind gets the page of records of the kind touching the address
+func (c *Client) activityOfKind(ctx context.Context, kind structs.ActivityKind, params structs.ActivityParams) (activity []structs.Activity, err error) {
+	switch kind {
+	case structs.ActivityKindContractEvent:
+		ces, err := c.storeEng.GetContractEvents(ctx, structs.EventParams{BoundAddress: params.Address, Limit: params.Limit, Cursor: params.Cursor})
+		if err != nil {
+			return nil, err
+		}
+		for i := range ces {
+			ce := ces[i]
+			activity = append(activity, structs.Activity{Kind: kind, ID: ce.ID.String(), BlockHeight: ce.BlockHeight, Time: ce.Time, ContractEvent: &ce})
+		}
+	case structs.ActivityKindDelegation:
+		dls, err := c.storeEng.GetDelegationTimeline(ctx, structs.DelegationParams{Holder: params.Address.Hex(), Limit: params.Limit, Cursor: params.Cursor})
+		if err != nil {
+			return nil, err
+		}
+		for i := range dls {
+			dl := dls[i]
+			activity = append(activity, structs.Activity{Kind: kind, ID: dl.ID.String(), BlockHeight: dl.BlockHeight, Delegation: &dl})
+		}
+	case structs.ActivityKindSystemEvent:
+		ses, err := c.storeEng.GetSystemEvents(ctx, structs.SystemEventParams{Address: params.Address.Hex(), Limit: params.Limit, Cursor: params.Cursor})
+		if err != nil {
+			return nil, err
+		}
+		for i := range ses {
+			se := ses[i]
+			activity = append(activity, structs.Activity{Kind: kind, ID: se.ID, BlockHeight: se.Height, Time: se.Time, SystemEvent: &se})
+		}
+	case structs.ActivityKindNode:
+		// node timeline is not paged by cursor, address has few nodes changing rarely
+		changes, err := c.storeEng.GetNodeTimeline(ctx, structs.NodeParams{Address: params.Address.Hex()})
+		if err != nil {
+			return nil, err
+		}
+		for i := range changes {
+			ch := changes[i]
+			a := structs.Activity{Kind: kind, ID: ch.ID, BlockHeight: ch.BlockHeight, Time: ch.Time, Node: &ch}
+			if params.Cursor.IsZero() || params.Cursor.Precedes(a.Cursor()) {
+				activity = append(activity, a)
+			}
+		}
+	}
+	return activity, nil
+}
+
+// activityKinds returns kinds to look for, all of them when none is given
+func activityKinds(kinds []structs.ActivityKind) []structs.ActivityKind {
+	if len(kinds) == 0 {
+		return structs.ActivityKinds
+	}
+	return kinds
+}
@@ -0,0 +1,240 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"math"
+	"math/big"
+	"sort"
+	"time"
+
+	"github.com/figment-networks/skale-indexer/scraper/structs"
+	"go.uber.org/zap"
+)
+
+const year = 365 * 24 * time.Hour
+
+// GetAPR computes the realised APR of validator, or of the whole network when validator id is not set.
+// The range ends now and starts DefaultAPRWindow before its end when not set
+func (c *Client) GetAPR(ctx context.Context, params structs.APRParams) (apr structs.APR, err error) {
+	if apr, err = c.apr(ctx, params, time.Now()); err != nil {
+		c.log.Error("[CLIENT] Error in GetAPR", zap.Any("params", params), zap.Error(err))
+	}
+	return apr, err
+}
+
+func (c *Client) apr(ctx context.Context, params structs.APRParams, now time.Time) (apr structs.APR, err error) {
+	to, from := params.TimeTo, params.TimeFrom
+	if to.IsZero() {
+		to = now
+	}
+	if from.IsZero() {
+		from = to.Add(-structs.DefaultAPRWindow)
+	}
+	if !to.After(from) {
+		return apr, errors.New("time range is empty")
+	}
+
+	apr = structs.APR{
+		TimeFrom:           from,
+		TimeTo:             to,
+		Bounty:             new(big.Int),
+		BountyPerNodeEpoch: new(big.Int),
+		Fee:                new(big.Int),
+		FeeRate:            new(big.Int),
+		DelegatorsBounty:   new(big.Int),
+		EffectiveStakeFrom: new(big.Int),
+		EffectiveStakeTo:   new(big.Int),
+		EffectiveStake:     new(big.Int),
+		Periods:            []structs.PeriodAPR{},
+	}
+	if params.ValidatorID != "" {
+		id, ok := new(big.Int).SetString(params.ValidatorID, 10)
+		if !ok {
+			return apr, errors.New("wrong validator id")
+		}
+		apr.ValidatorID = id
+	}
+
+	bounties, err := c.nodeBounties(ctx, params.ValidatorID, from, to)
+	if err != nil {
+		return apr, err
+	}
+	for _, b := range bounties {
+		apr.Bounty.Add(apr.Bounty, b.Bounty)
+		apr.Fee.Add(apr.Fee, b.Fee)
+		apr.NodeEpochs++
+	}
+	apr.DelegatorsBounty.Sub(apr.Bounty, apr.Fee)
+	if apr.NodeEpochs > 0 {
+		apr.BountyPerNodeEpoch.Quo(apr.Bounty, new(big.Int).SetUint64(apr.NodeEpochs))
+	}
+	if apr.Bounty.Sign() > 0 {
+		apr.FeeRate.Mul(apr.Fee, big.NewInt(1000))
+		apr.FeeRate.Quo(apr.FeeRate, apr.Bounty)
+	}
+
+	var hasFrom bool
+	if apr.EffectiveStakeFrom, hasFrom, err = c.effectiveStakeAt(ctx, params.ValidatorID, from); err != nil {
+		return apr, err
+	}
+	// open ended range takes the current stake
+	if apr.EffectiveStakeTo, _, err = c.effectiveStakeAt(ctx, params.ValidatorID, params.TimeTo); err != nil {
+		return apr, err
+	}
+	apr.EffectiveStake.Set(apr.EffectiveStakeTo)
+	if hasFrom {
+		apr.EffectiveStake.Add(apr.EffectiveStakeFrom, apr.EffectiveStakeTo)
+		apr.EffectiveStake.Quo(apr.EffectiveStake, big.NewInt(2))
+	}
+
+	if apr.EffectiveStake.Sign() > 0 {
+		apr.APR = ratio(apr.DelegatorsBounty, apr.EffectiveStake) * float64(year) / float64(to.Sub(from))
+	}
+
+	months := make([]uint64, 0, len(structs.DelegationPeriodMultipliers))
+	for m := range structs.DelegationPeriodMultipliers {
+		months = append(months, m)
+	}
+	sort.Slice(months, func(i, j int) bool { return months[i] < months[j] })
+	for _, m := range months {
+		multiplier := structs.DelegationPeriodMultipliers[m]
+		p := structs.PeriodAPR{Months: m, Multiplier: multiplier, APR: apr.APR * float64(multiplier) / 100}
+		p.APY = math.Pow(1+p.APR/structs.EpochsPerYear, structs.EpochsPerYear) - 1
+		apr.Periods = append(apr.Periods, p)
+	}
+	return apr, nil
+}
+
+// nodeBounty is the bounty paid to node of validator, with the fee validator takes from it
+type nodeBounty struct {
+	ValidatorID string
+	Time        time.Time
+	Bounty      *big.Int
+	Fee         *big.Int
+}
+
+// nodeBounties returns bounties paid to nodes of validator (of all validators when id is empty) within the time range.
+// Nodes are matched with validators by their current state, fee is taken at the fee rate in effect at the block of payment
+func (c *Client) nodeBounties(ctx context.Context, validatorID string, from, to time.Time) (bounties []nodeBounty, err error) {
+	nodes, err := c.storeEng.GetNodes(ctx, structs.NodeParams{ValidatorID: validatorID})
+	if err != nil {
+		return nil, err
+	}
+	nodeValidators := map[string]string{}
+	for _, n := range nodes {
+		nodeValidators[n.NodeID.String()] = n.ValidatorID.String()
+	}
+
+	events, err := c.storeEng.GetContractEvents(ctx, structs.EventParams{
+		ContractName: "skale_manager",
+		EventName:    "BountyReceived",
+		TimeFrom:     from,
+		TimeTo:       to,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	fees := map[string][]structs.ValidatorStatistics{}
+	for _, ce := range events {
+		if ce.Removed {
+			continue
+		}
+		nodeID, okN := eventParamBig(ce.Params, "nodeIndex")
+		bounty, okB := eventParamBig(ce.Params, "bounty")
+		if !okN || !okB {
+			continue
+		}
+		vID, ok := nodeValidators[nodeID.String()]
+		if !ok {
+			continue
+		}
+
+		timeline, ok := fees[vID]
+		if !ok {
+			if timeline, err = c.feeTimeline(ctx, vID, to); err != nil {
+				return nil, err
+			}
+			fees[vID] = timeline
+		}
+
+		fee := new(big.Int).Mul(bounty, feeRateAt(timeline, ce.BlockHeight))
+		bounties = append(bounties, nodeBounty{ValidatorID: vID, Time: ce.Time, Bounty: bounty, Fee: fee.Quo(fee, big.NewInt(1000))})
+	}
+	return bounties, nil
+}
+
+// feeTimeline returns changes of validator fee rate until the time, the latest first.
+// Validator registered without any change of fee is given its current fee rate
+func (c *Client) feeTimeline(ctx context.Context, validatorID string, to time.Time) ([]structs.ValidatorStatistics, error) {
+	timeline, err := c.storeEng.GetValidatorStatisticsTimeline(ctx, structs.ValidatorStatisticsParams{
+		ValidatorID: validatorID,
+		Type:        structs.ValidatorStatisticsTypeFee,
+		TimeTo:      to,
+	})
+	if err != nil || len(timeline) > 0 {
+		return timeline, err
+	}
+
+	validators, err := c.storeEng.GetValidators(ctx, structs.ValidatorParams{ValidatorID: validatorID})
+	if err != nil || len(validators) == 0 || validators[0].FeeRate == nil {
+		return nil, err
+	}
+	return []structs.ValidatorStatistics{{Amount: validators[0].FeeRate}}, nil
+}
+
+// feeRateAt is the fee rate from the timeline (the latest first) at the height, the earliest one before the timeline starts
+func feeRateAt(timeline []structs.ValidatorStatistics, height uint64) *big.Int {
+	if len(timeline) == 0 {
+		return new(big.Int)
+	}
+	for _, fee := range timeline {
+		if fee.BlockHeight <= height {
+			return fee.Amount
+		}
+	}
+	return timeline[len(timeline)-1].Amount
+}
+
+// effectiveStakeAt sums up the effective stake of active delegations at the last block at or before the time, the current one for zero time.
+// Found is false when there is no block indexed that early
+func (c *Client) effectiveStakeAt(ctx context.Context, validatorID string, t time.Time) (stake *big.Int, found bool, err error) {
+	stake = new(big.Int)
+	var height uint64
+	if !t.IsZero() {
+		block, err := c.GetBlockAtTime(ctx, t)
+		if errors.Is(err, structs.ErrNotFound) {
+			return stake, false, nil
+		}
+		if err != nil {
+			return stake, false, err
+		}
+		height = block.Number
+	}
+
+	delegations, err := c.activeDelegations(ctx, height)
+	if err != nil {
+		return stake, false, err
+	}
+	for _, d := range delegations {
+		if validatorID == "" || d.ValidatorID.String() == validatorID {
+			stake.Add(stake, effectiveAmount(d))
+		}
+	}
+	return stake, true, nil
+}
+
+// eventParamBig reads numeric parameter of event, decoded as json.Number by stores
+func eventParamBig(params map[string]interface{}, name string) (*big.Int, bool) {
+	switch v := params[name].(type) {
+	case *big.Int:
+		return v, v != nil
+	case json.Number:
+		return new(big.Int).SetString(v.String(), 10)
+	case string:
+		return new(big.Int).SetString(v, 10)
+	}
+	return nil, false
+}
@@ -0,0 +1,131 @@
+package client
+
+import (
+	"context"
+	"math/big"
+	"sort"
+	"time"
+
+	"github.com/figment-networks/skale-indexer/scraper/structs"
+	"go.uber.org/zap"
+)
+
+// GetUnlockCalendar sums up delegations reaching the end of their period per day and per month, from today when the range is not set,
+// with the stake every validator loses next month
+func (c *Client) GetUnlockCalendar(ctx context.Context, params structs.UnlockCalendarParams) (calendar structs.UnlockCalendar, err error) {
+	delegations, err := c.storeEng.GetDelegations(ctx, structs.DelegationParams{ValidatorID: params.ValidatorID, Holder: params.Holder})
+	if err != nil {
+		c.log.Error("[CLIENT] Error in GetUnlockCalendar", zap.Any("params", params), zap.Error(err))
+		return calendar, err
+	}
+	return unlockCalendar(delegations, params, time.Now()), nil
+}
+
+func unlockCalendar(delegations []structs.Delegation, params structs.UnlockCalendarParams, now time.Time) structs.UnlockCalendar {
+	calendar := structs.UnlockCalendar{
+		Days:        []structs.UnlockPeriod{},
+		Months:      []structs.UnlockPeriod{},
+		Projections: []structs.StakeDropProjection{},
+	}
+
+	today := startOfDay(now)
+	from := params.TimeFrom
+	if from.IsZero() {
+		from = today
+	}
+	current := structs.EpochAt(now)
+
+	days := map[time.Time]*structs.UnlockPeriod{}
+	months := map[time.Time]*structs.UnlockPeriod{}
+	projections := map[string]*structs.StakeDropProjection{}
+	for _, d := range delegations {
+		if d.State != structs.DelegationStateDELEGATED && d.State != structs.DelegationStateUNDELEGATION_REQUESTED {
+			continue
+		}
+		date := unlockDate(d, today)
+
+		key := d.ValidatorID.String()
+		p, ok := projections[key]
+		if !ok {
+			p = &structs.StakeDropProjection{ValidatorID: d.ValidatorID, Stake: new(big.Int), ExpectedDrop: new(big.Int), AtRisk: new(big.Int), LargestLeaving: new(big.Int)}
+			projections[key] = p
+		}
+		p.Stake.Add(p.Stake, d.Amount)
+		if structs.EpochAt(date) == current {
+			if d.State == structs.DelegationStateUNDELEGATION_REQUESTED {
+				p.ExpectedDrop.Add(p.ExpectedDrop, d.Amount)
+				p.Leaving++
+				if d.Amount.Cmp(p.LargestLeaving) > 0 {
+					p.LargestLeaving.Set(d.Amount)
+				}
+			} else {
+				p.AtRisk.Add(p.AtRisk, d.Amount)
+			}
+		}
+
+		if date.Before(from) || (!params.TimeTo.IsZero() && date.After(params.TimeTo)) {
+			continue
+		}
+		addUnlock(days, date, d)
+		addUnlock(months, time.Date(date.Year(), date.Month(), 1, 0, 0, 0, 0, time.UTC), d)
+	}
+
+	calendar.Days = sortedUnlocks(days)
+	calendar.Months = sortedUnlocks(months)
+	for _, p := range projections {
+		if p.Stake.Sign() > 0 {
+			p.ExpectedDropShare = ratio(p.ExpectedDrop, p.Stake)
+		}
+		calendar.Projections = append(calendar.Projections, *p)
+	}
+	sort.Slice(calendar.Projections, func(i, j int) bool {
+		return calendar.Projections[i].ValidatorID.Cmp(calendar.Projections[j].ValidatorID) < 0
+	})
+	return calendar
+}
+
+// unlockDate is the last day of the current period of delegation. Period of delegation with undelegation requested ends before its finished epoch,
+// the others are renewed, so the `until` date is moved by their period until it's not in the past
+func unlockDate(d structs.Delegation, today time.Time) time.Time {
+	if d.State == structs.DelegationStateUNDELEGATION_REQUESTED && d.Finished != nil && d.Finished.Sign() > 0 {
+		return structs.EpochStart(d.Finished.Uint64()).AddDate(0, 0, -1)
+	}
+
+	until := d.Until()
+	var period uint64
+	if d.DelegationPeriod != nil {
+		period = d.DelegationPeriod.Uint64()
+	}
+	for period > 0 && until.Before(today) {
+		until = time.Date(until.Year(), until.Month()+time.Month(1+period), 1, 0, 0, 0, 0, time.UTC).AddDate(0, 0, -1)
+	}
+	return until
+}
+
+func addUnlock(periods map[time.Time]*structs.UnlockPeriod, start time.Time, d structs.Delegation) {
+	p, ok := periods[start]
+	if !ok {
+		p = &structs.UnlockPeriod{Start: start, Undelegated: new(big.Int), PeriodEnding: new(big.Int)}
+		periods[start] = p
+	}
+	if d.State == structs.DelegationStateUNDELEGATION_REQUESTED {
+		p.Undelegated.Add(p.Undelegated, d.Amount)
+	} else {
+		p.PeriodEnding.Add(p.PeriodEnding, d.Amount)
+	}
+	p.Delegations++
+}
+
+func sortedUnlocks(periods map[time.Time]*structs.UnlockPeriod) []structs.UnlockPeriod {
+	sorted := make([]structs.UnlockPeriod, 0, len(periods))
+	for _, p := range periods {
+		sorted = append(sorted, *p)
+	}
+	sort.Slice(sorted, func(i, j int) bool { return sorted[i].Start.Before(sorted[j].Start) })
+	return sorted
+}
+
+func startOfDay(t time.Time) time.Time {
+	t = t.UTC()
+	return time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.UTC)
+}
@@ -3,9 +3,11 @@ package client
 import (
 	"context"
 	"math/big"
+	"net/http"
 	"sync"
 	"time"
 
+	"github.com/ethereum/go-ethereum/common"
 	"github.com/figment-networks/skale-indexer/scraper/structs"
 	"github.com/figment-networks/skale-indexer/scraper/transport/eth/contract"
 	"github.com/figment-networks/skale-indexer/store"
@@ -15,6 +17,9 @@ import (
 type EthereumConnector interface {
 	ParseLogs(ctx context.Context, ccs *contract.Contracts, taskID string, from, to big.Int) error
 	GetLatestBlockHeight(ctx context.Context) (uint64, error)
+	GetHeightAtTime(ctx context.Context, t time.Time, from, to uint64) (height uint64, err error)
+	RetryFailedEvent(ctx context.Context, ccs *contract.Contracts, fe structs.FailedEvent) error
+	Bulk(ctx context.Context, fn func(ctx context.Context) error) error
 }
 
 type Client struct {
@@ -27,6 +32,9 @@ type Client struct {
 
 	smallestPossibleHeight uint64
 	maxHeightsPerRequest   uint64
+	bulkHeightsBehind      uint64
+
+	webhookClient *http.Client
 
 	r *Running
 }
@@ -39,10 +47,16 @@ func NewClient(log *zap.Logger, storeEng store.DataStore, ethConn EthereumConnec
 		ccs:                    ccs,
 		smallestPossibleHeight: smallestPossibleHeight,
 		maxHeightsPerRequest:   maxHeightsPerRequest,
+		webhookClient:          &http.Client{Timeout: webhookDeliveryTimeout},
 		r:                      NewRunning(),
 	}
 }
 
+// SetBulkHeightsBehind enables bulk writes for ranges ending more than given number of heights behind the latest block
+func (c *Client) SetBulkHeightsBehind(heights uint64) {
+	c.bulkHeightsBehind = heights
+}
+
 func (c *Client) GetTypesSummaryDelegations(ctx context.Context, params structs.DelegationParams) (delegations []structs.DelegationSummary, err error) {
 	ev, err := c.storeEng.GetTypesSummaryDelegations(ctx, params)
 	if err != nil {
@@ -59,6 +73,22 @@ func (c *Client) GetContractEvents(ctx context.Context, params structs.EventPara
 	return ev, err
 }
 
+func (c *Client) CountContractEvents(ctx context.Context, params structs.EventParams) (count uint64, err error) {
+	count, err = c.storeEng.CountContractEvents(ctx, params)
+	if err != nil {
+		c.log.Error("[CLIENT] Error in CountContractEvents", zap.Any("params", params), zap.Error(err))
+	}
+	return count, err
+}
+
+func (c *Client) GetTransaction(ctx context.Context, hash common.Hash) (tx structs.Transaction, err error) {
+	tx, err = c.storeEng.GetTransaction(ctx, hash)
+	if err != nil && err != structs.ErrNotFound {
+		c.log.Error("[CLIENT] Error in GetTransaction", zap.String("hash", hash.String()), zap.Error(err))
+	}
+	return tx, err
+}
+
 func (c *Client) GetNodes(ctx context.Context, params structs.NodeParams) (nodes []structs.Node, err error) {
 	n, err := c.storeEng.GetNodes(ctx, params)
 	if err != nil {
@@ -67,6 +97,14 @@ func (c *Client) GetNodes(ctx context.Context, params structs.NodeParams) (nodes
 	return n, err
 }
 
+func (c *Client) CountNodes(ctx context.Context, params structs.NodeParams) (count uint64, err error) {
+	count, err = c.storeEng.CountNodes(ctx, params)
+	if err != nil {
+		c.log.Error("[CLIENT] Error in CountNodes", zap.Any("params", params), zap.Error(err))
+	}
+	return count, err
+}
+
 func (c *Client) GetValidators(ctx context.Context, params structs.ValidatorParams) (validators []structs.Validator, err error) {
 	v, err := c.storeEng.GetValidators(ctx, params)
 	if err != nil {
@@ -75,6 +113,14 @@ func (c *Client) GetValidators(ctx context.Context, params structs.ValidatorPara
 	return v, err
 }
 
+func (c *Client) CountValidators(ctx context.Context, params structs.ValidatorParams) (count uint64, err error) {
+	count, err = c.storeEng.CountValidators(ctx, params)
+	if err != nil {
+		c.log.Error("[CLIENT] Error in CountValidators", zap.Any("params", params), zap.Error(err))
+	}
+	return count, err
+}
+
 func (c *Client) GetDelegations(ctx context.Context, params structs.DelegationParams) (delegations []structs.Delegation, err error) {
 	d, err := c.storeEng.GetDelegations(ctx, params)
 	if err != nil {
@@ -83,6 +129,14 @@ func (c *Client) GetDelegations(ctx context.Context, params structs.DelegationPa
 	return d, err
 }
 
+func (c *Client) CountDelegations(ctx context.Context, params structs.DelegationParams) (count uint64, err error) {
+	count, err = c.storeEng.CountDelegations(ctx, params)
+	if err != nil {
+		c.log.Error("[CLIENT] Error in CountDelegations", zap.Any("params", params), zap.Error(err))
+	}
+	return count, err
+}
+
 func (c *Client) GetDelegationTimeline(ctx context.Context, params structs.DelegationParams) (delegations []structs.Delegation, err error) {
 	d, err := c.storeEng.GetDelegationTimeline(ctx, params)
 	if err != nil {
@@ -91,6 +145,14 @@ func (c *Client) GetDelegationTimeline(ctx context.Context, params structs.Deleg
 	return d, err
 }
 
+func (c *Client) CountDelegationTimeline(ctx context.Context, params structs.DelegationParams) (count uint64, err error) {
+	count, err = c.storeEng.CountDelegationTimeline(ctx, params)
+	if err != nil {
+		c.log.Error("[CLIENT] Error in CountDelegationTimeline", zap.Any("params", params), zap.Error(err))
+	}
+	return count, err
+}
+
 func (c *Client) GetValidatorStatistics(ctx context.Context, params structs.ValidatorStatisticsParams) (validatorStatistics []structs.ValidatorStatistics, err error) {
 	vs, err := c.storeEng.GetValidatorStatistics(ctx, params)
 	if err != nil {
@@ -115,6 +177,14 @@ func (c *Client) GetAccounts(ctx context.Context, params structs.AccountParams)
 	return a, err
 }
 
+func (c *Client) CountAccounts(ctx context.Context, params structs.AccountParams) (count uint64, err error) {
+	count, err = c.storeEng.CountAccounts(ctx, params)
+	if err != nil {
+		c.log.Error("[CLIENT] Error in CountAccounts", zap.Any("params", params), zap.Error(err))
+	}
+	return count, err
+}
+
 func (c *Client) GetSystemEvents(ctx context.Context, params structs.SystemEventParams) (systemEvents []structs.SystemEvent, err error) {
 	systemEvents, err = c.storeEng.GetSystemEvents(ctx, params)
 	if err != nil {
@@ -123,6 +193,46 @@ func (c *Client) GetSystemEvents(ctx context.Context, params structs.SystemEvent
 	return systemEvents, err
 }
 
+func (c *Client) CountSystemEvents(ctx context.Context, params structs.SystemEventParams) (count uint64, err error) {
+	count, err = c.storeEng.CountSystemEvents(ctx, params)
+	if err != nil {
+		c.log.Error("[CLIENT] Error in CountSystemEvents", zap.Any("params", params), zap.Error(err))
+	}
+	return count, err
+}
+
+// GetBlockAtTime returns the last block produced at or before given time.
+// Stored block headers narrow down the range, the rest is resolved on chain when scraper is enabled.
+func (c *Client) GetBlockAtTime(ctx context.Context, t time.Time) (block structs.Block, err error) {
+	before, after, err := c.storeEng.GetBlockBounds(ctx, t)
+	if err != nil {
+		c.log.Error("[CLIENT] Error in GetBlockBounds", zap.Time("time", t), zap.Error(err))
+		return block, err
+	}
+
+	if c.ethConn == nil || (before.Number > 0 && (before.Time.Equal(t) || after.Number == before.Number+1)) {
+		if before.Number == 0 {
+			return block, structs.ErrNotFound
+		}
+		return before, nil
+	}
+
+	var to uint64
+	if after.Number > 0 {
+		to = after.Number - 1
+	}
+
+	height, err := c.ethConn.GetHeightAtTime(ctx, t, before.Number, to)
+	if err != nil {
+		if err != structs.ErrNotFound {
+			c.log.Error("[CLIENT] Error in GetHeightAtTime", zap.Time("time", t), zap.Error(err))
+		}
+		return block, err
+	}
+
+	return c.storeEng.GetBlock(ctx, height)
+}
+
 func (c *Client) ParseLogs(ctx context.Context, taskID string, from, to big.Int) error {
 	err := c.ethConn.ParseLogs(ctx, c.ccs, taskID, from, to)
 	if err != nil {
@@ -175,7 +285,8 @@ func (c *Client) GetLatestData(ctx context.Context, taskID string, latest uint64
 		EndHeight: to.Uint64(),
 	}
 	out := make(chan struct{})
-	go c.getRange(ctx, taskID, *from, *to, psig, out)
+	bulk := c.bulkHeightsBehind > 0 && height > to.Uint64() && height-to.Uint64() > c.bulkHeightsBehind
+	go c.getRange(ctx, taskID, *from, *to, bulk, psig, out)
 	c.r.lock.Unlock()
 
 	select {
@@ -201,9 +312,19 @@ func (c *Client) GetLatestData(ctx context.Context, taskID string, latest uint64
 
 }
 
-func (c *Client) getRange(ctx context.Context, taskID string, from, to big.Int, sig PSig, out chan struct{}) {
+func (c *Client) getRange(ctx context.Context, taskID string, from, to big.Int, bulk bool, sig PSig, out chan struct{}) {
+
+	parse := func(ctx context.Context) error {
+		return c.ethConn.ParseLogs(ctx, c.ccs, taskID, from, to)
+	}
 
-	err := c.ethConn.ParseLogs(context.Background(), c.ccs, taskID, from, to)
+	var err error
+	if bulk {
+		c.log.Debug("[CLIENT] Using bulk writes for historical range", zap.Uint64("from", from.Uint64()), zap.Uint64("to", to.Uint64()))
+		err = c.ethConn.Bulk(context.Background(), parse)
+	} else {
+		err = parse(context.Background())
+	}
 
 	c.r.lock.Lock()
 	p, ok := c.r.Processes[sig]
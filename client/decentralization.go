@@ -0,0 +1,187 @@
+package client
+
+import (
+	"context"
+	"math/big"
+	"sort"
+	"time"
+
+	"github.com/figment-networks/skale-indexer/scraper/structs"
+	"go.uber.org/zap"
+)
+
+// GetDecentralization returns stake decentralisation metrics of epochs, the latest first
+func (c *Client) GetDecentralization(ctx context.Context, params structs.DecentralizationParams) (dcs []structs.Decentralization, err error) {
+	if dcs, err = c.storeEng.GetDecentralization(ctx, params); err != nil {
+		c.log.Error("[CLIENT] Error in GetDecentralization", zap.Any("params", params), zap.Error(err))
+	}
+	return dcs, err
+}
+
+// RunDecentralization periodically computes decentralisation metrics of epochs which started since the last run
+func (c *Client) RunDecentralization(ctx context.Context, interval time.Duration) {
+	tckr := time.NewTicker(interval)
+	defer tckr.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-tckr.C:
+			if err := c.ComputeDecentralization(ctx, time.Now()); err != nil {
+				c.log.Error("[CLIENT] Error computing decentralization", zap.Error(err))
+			}
+		}
+	}
+}
+
+// ComputeDecentralization computes and stores metrics of epochs following the last one stored, until the one running at the time.
+// Epoch is computed at the last block before it starts, once a block after its start is indexed
+func (c *Client) ComputeDecentralization(ctx context.Context, now time.Time) error {
+	latest, err := c.storeEng.GetDecentralization(ctx, structs.DecentralizationParams{Limit: 1})
+	if err != nil {
+		return err
+	}
+	var epoch uint64
+	if len(latest) > 0 {
+		epoch = latest[0].Epoch + 1
+	}
+
+	for current := structs.EpochAt(now); epoch <= current; epoch++ {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		default:
+		}
+
+		start := structs.EpochStart(epoch)
+		before, after, err := c.storeEng.GetBlockBounds(ctx, start)
+		if err != nil {
+			return err
+		}
+		if after.Number == 0 {
+			// the epoch started after the last indexed block
+			return nil
+		}
+		if before.Number == 0 {
+			// the epoch started before the first indexed block
+			continue
+		}
+
+		stakes, err := c.validatorStakes(ctx, before.Number)
+		if err != nil {
+			return err
+		}
+		dc := decentralization(stakes)
+		dc.Epoch, dc.Time, dc.BlockHeight = epoch, start, before.Number
+		if err = c.storeEng.SaveDecentralization(ctx, dc); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// validatorStakes returns stake of every validator at the height, from its TOTAL_STAKE statistic.
+// Validators without the statistic at the height take the sum of their delegated delegations
+func (c *Client) validatorStakes(ctx context.Context, height uint64) ([]*big.Int, error) {
+	statistics, err := c.storeEng.GetValidatorStatistics(ctx, structs.ValidatorStatisticsParams{
+		Type:     structs.ValidatorStatisticsTypeTotalStake,
+		AtHeight: height,
+	})
+	if err != nil {
+		return nil, err
+	}
+	stakes := map[string]*big.Int{}
+	for _, vs := range statistics {
+		stakes[vs.ValidatorID.String()] = vs.Amount
+	}
+
+	delegations, err := c.activeDelegations(ctx, height)
+	if err != nil {
+		return nil, err
+	}
+	delegated := map[string]*big.Int{}
+	for _, d := range delegations {
+		key := d.ValidatorID.String()
+		if _, ok := stakes[key]; ok {
+			continue
+		}
+		if delegated[key] == nil {
+			delegated[key] = new(big.Int)
+		}
+		delegated[key].Add(delegated[key], d.Amount)
+	}
+
+	all := make([]*big.Int, 0, len(stakes)+len(delegated))
+	for _, s := range stakes {
+		all = append(all, s)
+	}
+	for _, s := range delegated {
+		all = append(all, s)
+	}
+	return all, nil
+}
+
+// decentralization computes metrics of the stakes, leaving out the ones which are not positive
+func decentralization(stakes []*big.Int) structs.Decentralization {
+	dc := structs.Decentralization{TotalStake: new(big.Int)}
+
+	var sorted []*big.Int
+	for _, s := range stakes {
+		if s != nil && s.Sign() > 0 {
+			sorted = append(sorted, s)
+			dc.TotalStake.Add(dc.TotalStake, s)
+		}
+	}
+	if len(sorted) == 0 {
+		return dc
+	}
+	// the largest first
+	sort.Slice(sorted, func(i, j int) bool { return sorted[i].Cmp(sorted[j]) > 0 })
+	n := len(sorted)
+	dc.Validators = uint64(n)
+
+	// controlling more than share num/den of stake, cumulative*den > total*num
+	over := func(cumulative *big.Int, num, den int64) bool {
+		return new(big.Int).Mul(cumulative, big.NewInt(den)).Cmp(new(big.Int).Mul(dc.TotalStake, big.NewInt(num))) > 0
+	}
+	cumulative := new(big.Int)
+	for i, s := range sorted {
+		cumulative.Add(cumulative, s)
+		k := uint64(i + 1)
+		if dc.ValidatorsOver33 == 0 && over(cumulative, 1, 3) {
+			dc.ValidatorsOver33 = k
+		}
+		if dc.ValidatorsOver50 == 0 && over(cumulative, 1, 2) {
+			dc.ValidatorsOver50 = k
+		}
+		if dc.ValidatorsOver66 == 0 && over(cumulative, 2, 3) {
+			dc.ValidatorsOver66 = k
+		}
+		switch {
+		case k == 1:
+			dc.Top1Share = ratio(cumulative, dc.TotalStake)
+		case k == 5:
+			dc.Top5Share = ratio(cumulative, dc.TotalStake)
+		case k == 10:
+			dc.Top10Share = ratio(cumulative, dc.TotalStake)
+		}
+	}
+	// fewer validators than the top N hold all of it
+	if n < 5 {
+		dc.Top5Share = 1
+	}
+	if n < 10 {
+		dc.Top10Share = 1
+	}
+	dc.NakamotoCoefficient = dc.ValidatorsOver33
+
+	// G = sum((2i - n - 1) * x_i) / (n * sum(x)) with stakes in ascending order, i from 1
+	weighted := new(big.Int)
+	for i, s := range sorted {
+		rank := n - i
+		weighted.Add(weighted, new(big.Int).Mul(s, big.NewInt(int64(2*rank-n-1))))
+	}
+	dc.Gini = ratio(weighted, new(big.Int).Mul(dc.TotalStake, big.NewInt(int64(n))))
+	return dc
+}
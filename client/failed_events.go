@@ -0,0 +1,94 @@
+package client
+
+import (
+	"context"
+	"time"
+
+	"github.com/figment-networks/skale-indexer/scraper/structs"
+	"go.uber.org/zap"
+)
+
+const failedEventsRetryBatch = 100
+
+func (c *Client) GetFailedEvents(ctx context.Context, params structs.FailedEventParams) (failedEvents []structs.FailedEvent, err error) {
+	fe, err := c.storeEng.GetFailedEvents(ctx, params)
+	if err != nil && err != structs.ErrNotFound {
+		c.log.Error("[CLIENT] Error in GetFailedEvents", zap.Any("params", params), zap.Error(err))
+	}
+	return fe, err
+}
+
+// RetryFailedEvent processes failed event immediately, regardless of its schedule
+func (c *Client) RetryFailedEvent(ctx context.Context, id string) error {
+	fes, err := c.storeEng.GetFailedEvents(ctx, structs.FailedEventParams{ID: id})
+	if err != nil {
+		if err != structs.ErrNotFound {
+			c.log.Error("[CLIENT] Error in RetryFailedEvent", zap.String("id", id), zap.Error(err))
+		}
+		return err
+	}
+	return c.retryFailedEvent(ctx, fes[0])
+}
+
+// DiscardFailedEvent removes failed event without processing it
+func (c *Client) DiscardFailedEvent(ctx context.Context, id string) error {
+	err := c.storeEng.DeleteFailedEvent(ctx, id)
+	if err != nil && err != structs.ErrNotFound {
+		c.log.Error("[CLIENT] Error in DiscardFailedEvent", zap.String("id", id), zap.Error(err))
+	}
+	return err
+}
+
+// RunFailedEventsRetry periodically retries failed events which are due, until they succeed or reach maxAttempts
+func (c *Client) RunFailedEventsRetry(ctx context.Context, interval time.Duration, maxAttempts uint64) {
+	tckr := time.NewTicker(interval)
+	defer tckr.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-tckr.C:
+			c.RetryFailedEvents(ctx, maxAttempts)
+		}
+	}
+}
+
+// RetryFailedEvents retries failed events which are due
+func (c *Client) RetryFailedEvents(ctx context.Context, maxAttempts uint64) {
+	fes, err := c.storeEng.GetFailedEvents(ctx, structs.FailedEventParams{
+		RetryBefore: time.Now(),
+		MaxAttempts: maxAttempts,
+		Limit:       failedEventsRetryBatch,
+	})
+	if err != nil {
+		c.log.Error("[CLIENT] Error getting failed events to retry", zap.Error(err))
+		return
+	}
+
+	for _, fe := range fes {
+		select {
+		case <-ctx.Done():
+			return
+		default:
+		}
+		c.retryFailedEvent(ctx, fe)
+	}
+}
+
+func (c *Client) retryFailedEvent(ctx context.Context, fe structs.FailedEvent) error {
+	err := c.ethConn.RetryFailedEvent(ctx, c.ccs, fe)
+	if err == nil {
+		c.log.Info("[CLIENT] Failed event processed", zap.String("id", fe.ID), zap.Uint64("attempts", fe.Attempts+1))
+		return nil
+	}
+
+	c.log.Warn("[CLIENT] Failed event retry failed", zap.String("id", fe.ID), zap.Uint64("attempts", fe.Attempts+1), zap.Error(err))
+	fe.Attempts++
+	fe.Error = err.Error()
+	fe.NextRetry = time.Now().Add(structs.FailedEventRetryDelay(fe.Attempts))
+	if sErr := c.storeEng.SaveFailedEvent(ctx, fe); sErr != nil {
+		c.log.Error("[CLIENT] Error updating failed event", zap.String("id", fe.ID), zap.Error(sErr))
+	}
+	return err
+}
@@ -0,0 +1,83 @@
+package client
+
+import (
+	"context"
+	"sort"
+	"time"
+
+	"github.com/figment-networks/skale-indexer/scraper/structs"
+	"go.uber.org/zap"
+)
+
+func (c *Client) GetNodeTimeline(ctx context.Context, params structs.NodeParams) (changes []structs.NodeChange, err error) {
+	ch, err := c.storeEng.GetNodeTimeline(ctx, params)
+	if err != nil {
+		c.log.Error("[CLIENT] Error in GetNodeTimeline", zap.Any("params", params), zap.Error(err))
+	}
+	return ch, err
+}
+
+// GetNodesMaintenance sums up the time nodes spent in maintenance within the time range, until now when it's open ended
+func (c *Client) GetNodesMaintenance(ctx context.Context, params structs.NodeMaintenanceParams) (maintenance []structs.NodeMaintenance, err error) {
+	changes, err := c.storeEng.GetNodeTimeline(ctx, structs.NodeParams{NodeID: params.NodeID, ValidatorID: params.ValidatorID})
+	if err != nil {
+		c.log.Error("[CLIENT] Error in GetNodesMaintenance", zap.Any("params", params), zap.Error(err))
+		return nil, err
+	}
+
+	to := params.TimeTo
+	if to.IsZero() {
+		to = time.Now()
+	}
+	return nodesMaintenance(changes, params.TimeFrom, to), nil
+}
+
+// nodesMaintenance sums up the maintenance of nodes from their timeline (the latest change first).
+// The state is tracked from the time of change until the following one; nodes which left are not tracked,
+// neither are the states starting or ending at the block of unknown time
+func nodesMaintenance(changes []structs.NodeChange, from, to time.Time) (maintenance []structs.NodeMaintenance) {
+	timelines := map[string][]structs.NodeChange{}
+	for _, ch := range changes {
+		key := ch.NodeID.String()
+		timelines[key] = append(timelines[key], ch)
+	}
+
+	for _, timeline := range timelines {
+		m := structs.NodeMaintenance{NodeID: timeline[0].NodeID, ValidatorID: timeline[0].ValidatorID}
+		var inMaintenance bool
+		for i := len(timeline) - 1; i >= 0; i-- {
+			ch := timeline[i]
+			start, end := ch.Time, to
+			if i > 0 {
+				end = timeline[i-1].Time
+			}
+			if start.IsZero() || end.IsZero() || ch.Status == structs.NodeStatusLeft {
+				inMaintenance = false
+				continue
+			}
+
+			if start.Before(from) {
+				start = from
+			}
+			if end.After(to) {
+				end = to
+			}
+			if !end.After(start) {
+				continue
+			}
+
+			m.Tracked += end.Sub(start)
+			if ch.Status == structs.NodeStatusInMaintenance {
+				m.Maintenance += end.Sub(start)
+				if !inMaintenance {
+					m.Periods++
+				}
+			}
+			inMaintenance = ch.Status == structs.NodeStatusInMaintenance
+		}
+		maintenance = append(maintenance, m)
+	}
+
+	sort.Slice(maintenance, func(i, j int) bool { return maintenance[i].NodeID.Cmp(maintenance[j].NodeID) < 0 })
+	return maintenance
+}
@@ -0,0 +1,51 @@
+package outbox
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/nats-io/nats.go"
+
+	"github.com/figment-networks/skale-indexer/scraper/structs"
+)
+
+// NATSPublisher publishes messages to NATS JetStream, on subject of their topic under the prefix (`skale.system_event`...).
+// JetStream stream has to capture the subjects. Message id is sent as Nats-Msg-Id, so the stream drops
+// messages published again within its duplicates window
+type NATSPublisher struct {
+	nc     *nats.Conn
+	js     nats.JetStreamContext
+	prefix string
+}
+
+// NewNATSPublisher connects to NATS server
+func NewNATSPublisher(url, subjectPrefix string) (*NATSPublisher, error) {
+	nc, err := nats.Connect(url, nats.Name("skale-indexer"))
+	if err != nil {
+		return nil, err
+	}
+	js, err := nc.JetStream()
+	if err != nil {
+		nc.Close()
+		return nil, err
+	}
+	return &NATSPublisher{nc: nc, js: js, prefix: subjectPrefix}, nil
+}
+
+// Publish publishes messages one by one, each acknowledged by the stream
+func (np *NATSPublisher) Publish(ctx context.Context, msgs []structs.OutboxMessage) error {
+	for _, m := range msgs {
+		data, err := json.Marshal(m)
+		if err != nil {
+			return err
+		}
+		if _, err = np.js.Publish(np.prefix+"."+string(m.Topic), data, nats.MsgId(m.MessageID), nats.Context(ctx)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (np *NATSPublisher) Close() error {
+	return np.nc.Drain()
+}
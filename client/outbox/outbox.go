@@ -0,0 +1,84 @@
+// Package outbox relays messages of the transactional outbox to message bus.
+// Messages are saved in the same unit of work as records they carry and removed only once they're published,
+// so each of them is published at least once; consumers deduplicate by message id
+package outbox
+
+import (
+	"context"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/figment-networks/skale-indexer/scraper/structs"
+)
+
+// Publisher publishes outbox messages to message bus.
+// Publish returns only once all of the messages are accepted by the bus
+type Publisher interface {
+	Publish(ctx context.Context, msgs []structs.OutboxMessage) error
+	Close() error
+}
+
+// Store is the part of the store relay reads outbox from
+type Store interface {
+	GetOutboxMessages(ctx context.Context, params structs.OutboxParams) (msgs []structs.OutboxMessage, err error)
+	DeleteOutboxMessages(ctx context.Context, ids []uint64) error
+}
+
+// Relay moves messages from the outbox to publisher
+type Relay struct {
+	l     *zap.Logger
+	store Store
+	pub   Publisher
+	batch uint64
+}
+
+// NewRelay is Relay constructor, batch is the maximum number of messages published at once
+func NewRelay(l *zap.Logger, store Store, pub Publisher, batch uint64) *Relay {
+	return &Relay{l: l, store: store, pub: pub, batch: batch}
+}
+
+// Run relays messages every interval until context is done
+func (r *Relay) Run(ctx context.Context, interval time.Duration) {
+	tckr := time.NewTicker(interval)
+	defer tckr.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-tckr.C:
+			if _, err := r.Relay(ctx); err != nil {
+				r.l.Error("[OUTBOX] Error relaying messages", zap.Error(err))
+			}
+		}
+	}
+}
+
+// Relay publishes all of the messages waiting in the outbox, batch by batch.
+// Batch is removed from the outbox once it's published, so the one failing is published again by the next call
+func (r *Relay) Relay(ctx context.Context) (published int, err error) {
+	for {
+		msgs, err := r.store.GetOutboxMessages(ctx, structs.OutboxParams{Limit: r.batch})
+		if err != nil || len(msgs) == 0 {
+			return published, err
+		}
+
+		if err = r.pub.Publish(ctx, msgs); err != nil {
+			return published, err
+		}
+
+		ids := make([]uint64, len(msgs))
+		for i, m := range msgs {
+			ids[i] = m.ID
+		}
+		if err = r.store.DeleteOutboxMessages(ctx, ids); err != nil {
+			return published, err
+		}
+		published += len(msgs)
+
+		if uint64(len(msgs)) < r.batch {
+			return published, nil
+		}
+	}
+}
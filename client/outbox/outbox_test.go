@@ -0,0 +1,92 @@
+package outbox
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap/zaptest"
+
+	"github.com/figment-networks/skale-indexer/scraper/structs"
+	"github.com/figment-networks/skale-indexer/store/memory"
+)
+
+type flakyPublisher struct {
+	fail      bool
+	published []structs.OutboxMessage
+}
+
+func (fp *flakyPublisher) Publish(ctx context.Context, msgs []structs.OutboxMessage) error {
+	if fp.fail {
+		fp.fail = false
+		return errors.New("bus unavailable")
+	}
+	fp.published = append(fp.published, msgs...)
+	return nil
+}
+
+func (fp *flakyPublisher) Close() error { return nil }
+
+func TestRelay(t *testing.T) {
+	ctx := context.Background()
+	d := memory.NewDriver()
+
+	var msgs []structs.OutboxMessage
+	for i := 0; i < 5; i++ {
+		msgs = append(msgs, structs.OutboxMessage{Topic: structs.OutboxTopicSystemEvent, Key: string(rune('a' + i)), Height: uint64(i), Payload: json.RawMessage(`{}`)})
+	}
+	require.NoError(t, d.SaveOutboxMessages(ctx, msgs))
+
+	pub := &flakyPublisher{fail: true}
+	r := NewRelay(zaptest.NewLogger(t), d, pub, 2)
+
+	// failed batch stays in the outbox
+	published, err := r.Relay(ctx)
+	require.Error(t, err)
+	require.Zero(t, published)
+	left, err := d.GetOutboxMessages(ctx, structs.OutboxParams{})
+	require.NoError(t, err)
+	require.Len(t, left, 5)
+
+	published, err = r.Relay(ctx)
+	require.NoError(t, err)
+	require.Equal(t, 5, published)
+	require.Len(t, pub.published, 5)
+	for i, m := range pub.published {
+		require.Equal(t, left[i].ID, m.ID)
+	}
+
+	left, err = d.GetOutboxMessages(ctx, structs.OutboxParams{})
+	require.NoError(t, err)
+	require.Empty(t, left)
+
+	published, err = r.Relay(ctx)
+	require.NoError(t, err)
+	require.Zero(t, published)
+}
+
+func TestWriterPublisher(t *testing.T) {
+	var buf bytes.Buffer
+	wp := NewWriterPublisher(&buf)
+	require.NoError(t, wp.Publish(context.Background(), []structs.OutboxMessage{
+		{ID: 1, Topic: structs.OutboxTopicValidator, Key: "2", Height: 10, Payload: json.RawMessage(`{"validator_id":2}`)},
+		{ID: 2, Topic: structs.OutboxTopicNode, Key: "3", Height: 10, Payload: json.RawMessage(`{"node_id":3}`)},
+	}))
+	require.NoError(t, wp.Close())
+
+	var lines []structs.OutboxMessage
+	s := bufio.NewScanner(&buf)
+	for s.Scan() {
+		var m structs.OutboxMessage
+		require.NoError(t, json.Unmarshal(s.Bytes(), &m))
+		lines = append(lines, m)
+	}
+	require.Len(t, lines, 2)
+	require.Equal(t, structs.OutboxTopicValidator, lines[0].Topic)
+	require.JSONEq(t, `{"validator_id":2}`, string(lines[0].Payload))
+	require.Equal(t, uint64(2), lines[1].ID)
+}
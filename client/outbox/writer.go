@@ -0,0 +1,64 @@
+package outbox
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"io"
+	"os"
+	"sync"
+
+	"github.com/figment-networks/skale-indexer/scraper/structs"
+)
+
+// WriterPublisher writes messages as JSON lines, meant for local use
+type WriterPublisher struct {
+	mu sync.Mutex
+	w  io.Writer
+	c  io.Closer
+}
+
+// NewWriterPublisher is WriterPublisher constructor. Writer isn't closed by the publisher
+func NewWriterPublisher(w io.Writer) *WriterPublisher {
+	return &WriterPublisher{w: w}
+}
+
+// NewFilePublisher opens file messages are appended to, "-" being stdout
+func NewFilePublisher(path string) (*WriterPublisher, error) {
+	if path == "-" {
+		return NewWriterPublisher(os.Stdout), nil
+	}
+	f, err := os.OpenFile(path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0644)
+	if err != nil {
+		return nil, err
+	}
+	return &WriterPublisher{w: f, c: f}, nil
+}
+
+// Publish writes messages, syncing the file before returning
+func (wp *WriterPublisher) Publish(ctx context.Context, msgs []structs.OutboxMessage) error {
+	wp.mu.Lock()
+	defer wp.mu.Unlock()
+
+	bw := bufio.NewWriter(wp.w)
+	enc := json.NewEncoder(bw)
+	for _, m := range msgs {
+		if err := enc.Encode(m); err != nil {
+			return err
+		}
+	}
+	if err := bw.Flush(); err != nil {
+		return err
+	}
+	if f, ok := wp.w.(*os.File); ok && f != os.Stdout {
+		return f.Sync()
+	}
+	return nil
+}
+
+func (wp *WriterPublisher) Close() error {
+	if wp.c == nil {
+		return nil
+	}
+	return wp.c.Close()
+}
@@ -0,0 +1,157 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"math/big"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/figment-networks/skale-indexer/scraper/structs"
+	"go.uber.org/zap"
+)
+
+// GetNetworkOverview sums up the network at the time (the current state when it's zero) and a month before it
+func (c *Client) GetNetworkOverview(ctx context.Context, at time.Time) (overview structs.NetworkOverview, err error) {
+	if overview, err = c.networkOverview(ctx, at, time.Now()); err != nil {
+		c.log.Error("[CLIENT] Error in GetNetworkOverview", zap.Time("at", at), zap.Error(err))
+	}
+	return overview, err
+}
+
+func (c *Client) networkOverview(ctx context.Context, at, now time.Time) (overview structs.NetworkOverview, err error) {
+	overview.Time = now
+	if !at.IsZero() {
+		block, err := c.GetBlockAtTime(ctx, at)
+		if err != nil {
+			return overview, err
+		}
+		overview.Time, overview.BlockHeight = at, block.Number
+	}
+	if overview.Current, err = c.networkFigures(ctx, overview.BlockHeight); err != nil {
+		return overview, err
+	}
+
+	overview.MonthAgoTime = overview.Time.AddDate(0, -1, 0)
+	block, err := c.GetBlockAtTime(ctx, overview.MonthAgoTime)
+	if errors.Is(err, structs.ErrNotFound) {
+		return overview, nil
+	}
+	if err != nil {
+		return overview, err
+	}
+	overview.MonthAgoBlockHeight = block.Number
+	monthAgo, err := c.networkFigures(ctx, block.Number)
+	if err != nil {
+		return overview, err
+	}
+	change := overview.Current.Sub(monthAgo)
+	overview.MonthAgo, overview.Change = &monthAgo, &change
+	return overview, nil
+}
+
+// networkFigures sums up the network at the height, the current state when it's zero
+func (c *Client) networkFigures(ctx context.Context, height uint64) (f structs.NetworkFigures, err error) {
+	f = structs.NetworkFigures{
+		TotalStake:     new(big.Int),
+		EffectiveStake: new(big.Int),
+		Slashed:        new(big.Int),
+		Forgiven:       new(big.Int),
+		TokenSupply:    new(big.Int),
+	}
+
+	validators, err := c.storeEng.GetValidators(ctx, structs.ValidatorParams{AtHeight: height})
+	if err != nil {
+		return f, err
+	}
+	for _, v := range validators {
+		f.Validators++
+		if v.Authorized {
+			f.AuthorizedValidators++
+		}
+		if v.AcceptNewRequests {
+			f.AcceptingValidators++
+		}
+	}
+
+	nodes, err := c.storeEng.GetNodes(ctx, structs.NodeParams{AtHeight: height})
+	if err != nil {
+		return f, err
+	}
+	for _, n := range nodes {
+		switch n.Status {
+		case structs.NodeStatusActive:
+			f.ActiveNodes++
+		case structs.NodeStatusLeaving:
+			f.LeavingNodes++
+		case structs.NodeStatusLeft:
+			f.LeftNodes++
+		}
+	}
+
+	delegations, err := c.activeDelegations(ctx, height)
+	if err != nil {
+		return f, err
+	}
+	holders := map[common.Address]struct{}{}
+	for _, d := range delegations {
+		f.TotalStake.Add(f.TotalStake, d.Amount)
+		f.EffectiveStake.Add(f.EffectiveStake, effectiveAmount(d))
+		holders[d.Holder] = struct{}{}
+	}
+	f.Delegators = int64(len(holders))
+
+	created, err := c.countEvents(ctx, structs.EventParams{ContractName: "schains", EventName: "SchainCreated", HeightTo: height})
+	if err != nil {
+		return f, err
+	}
+	deleted, err := c.countEvents(ctx, structs.EventParams{ContractName: "schains", EventName: "SchainDeleted", HeightTo: height})
+	if err != nil {
+		return f, err
+	}
+	f.SkaleChains = created - deleted
+
+	if err = c.sumEvents(ctx, f.Slashed, "amount", structs.EventParams{ContractName: "punisher", EventName: "Slash", HeightTo: height}); err != nil {
+		return f, err
+	}
+	if err = c.sumEvents(ctx, f.Forgiven, "amount", structs.EventParams{ContractName: "punisher", EventName: "Forgive", HeightTo: height}); err != nil {
+		return f, err
+	}
+
+	zero := common.Address{}.Hex()
+	burned := new(big.Int)
+	if err = c.sumEvents(ctx, f.TokenSupply, "value", structs.EventParams{ContractName: "skale_token", EventName: "Transfer", Params: map[string]string{"from": zero}, HeightTo: height}); err != nil {
+		return f, err
+	}
+	if err = c.sumEvents(ctx, burned, "value", structs.EventParams{ContractName: "skale_token", EventName: "Transfer", Params: map[string]string{"to": zero}, HeightTo: height}); err != nil {
+		return f, err
+	}
+	f.TokenSupply.Sub(f.TokenSupply, burned)
+	return f, nil
+}
+
+// countEvents counts events which are not removed
+func (c *Client) countEvents(ctx context.Context, params structs.EventParams) (count int64, err error) {
+	events, err := c.storeEng.GetContractEvents(ctx, params)
+	for _, ce := range events {
+		if !ce.Removed {
+			count++
+		}
+	}
+	return count, err
+}
+
+// sumEvents adds numeric parameter of events which are not removed to the sum
+func (c *Client) sumEvents(ctx context.Context, sum *big.Int, param string, params structs.EventParams) error {
+	events, err := c.storeEng.GetContractEvents(ctx, params)
+	if err != nil {
+		return err
+	}
+	for _, ce := range events {
+		if v, ok := eventParamBig(ce.Params, param); ok && !ce.Removed {
+			sum.Add(sum, v)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,204 @@
+package client
+
+import (
+	"context"
+	"math/big"
+	"sort"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/figment-networks/skale-indexer/scraper/structs"
+	"go.uber.org/zap"
+)
+
+// GetDelegatorPortfolio sums up delegations of the holder with their history per epoch
+func (c *Client) GetDelegatorPortfolio(ctx context.Context, holder common.Address) (portfolio structs.Portfolio, err error) {
+	if portfolio, err = c.delegatorPortfolio(ctx, holder, time.Now()); err != nil {
+		c.log.Error("[CLIENT] Error in GetDelegatorPortfolio", zap.String("holder", holder.Hex()), zap.Error(err))
+	}
+	return portfolio, err
+}
+
+func (c *Client) delegatorPortfolio(ctx context.Context, holder common.Address, now time.Time) (p structs.Portfolio, err error) {
+	p = structs.Portfolio{
+		Holder:     holder,
+		Locked:     new(big.Int),
+		Unlocked:   new(big.Int),
+		Earned:     new(big.Int),
+		Withdrawn:  new(big.Int),
+		Validators: []structs.PortfolioValidator{},
+		Pending:    []structs.Delegation{},
+		Unlocks:    []structs.DelegationUnlock{},
+		Epochs:     []structs.PortfolioEpoch{},
+	}
+
+	delegations, err := c.storeEng.GetDelegations(ctx, structs.DelegationParams{Holder: holder.Hex()})
+	if err != nil || len(delegations) == 0 {
+		return p, err
+	}
+
+	validators := map[string]*structs.PortfolioValidator{}
+	var validatorIDs []string
+	for _, d := range delegations {
+		key := d.ValidatorID.String()
+		v, ok := validators[key]
+		if !ok {
+			v = &structs.PortfolioValidator{ValidatorID: d.ValidatorID, ValidatorName: d.ValidatorName, Earned: new(big.Int), Withdrawn: new(big.Int)}
+			validators[key] = v
+			validatorIDs = append(validatorIDs, key)
+		}
+		addToState(v, d)
+
+		switch d.State {
+		case structs.DelegationStatePROPOSED, structs.DelegationStateACCEPTED, structs.DelegationStateDELEGATED, structs.DelegationStateUNDELEGATION_REQUESTED:
+			p.Locked.Add(p.Locked, d.Amount)
+			if until := d.Until(); until.After(now) {
+				p.Unlocks = append(p.Unlocks, structs.DelegationUnlock{DelegationID: d.DelegationID, ValidatorID: d.ValidatorID, Amount: d.Amount, State: d.State, Until: until})
+			}
+			if d.State == structs.DelegationStatePROPOSED || d.State == structs.DelegationStateACCEPTED {
+				p.Pending = append(p.Pending, d)
+			}
+		case structs.DelegationStateCOMPLETED:
+			p.Unlocked.Add(p.Unlocked, d.Amount)
+		}
+	}
+	sort.SliceStable(p.Unlocks, func(i, j int) bool { return p.Unlocks[i].Until.Before(p.Unlocks[j].Until) })
+
+	withdrawals, err := c.storeEng.GetContractEvents(ctx, structs.EventParams{
+		ContractName: "distributor",
+		EventName:    "WithdrawBounty",
+		Params:       map[string]string{"holder": holder.Hex()},
+	})
+	if err != nil {
+		return p, err
+	}
+	for _, ce := range withdrawals {
+		validatorID, okV := eventParamBig(ce.Params, "validatorId")
+		amount, okA := eventParamBig(ce.Params, "amount")
+		if ce.Removed || !okV || !okA {
+			continue
+		}
+		p.Withdrawn.Add(p.Withdrawn, amount)
+		if v, ok := validators[validatorID.String()]; ok {
+			v.Withdrawn.Add(v.Withdrawn, amount)
+		}
+	}
+
+	if err = c.portfolioEpochs(ctx, &p, delegations, validatorIDs, validators, now); err != nil {
+		return p, err
+	}
+
+	sort.Slice(validatorIDs, func(i, j int) bool {
+		return validators[validatorIDs[i]].ValidatorID.Cmp(validators[validatorIDs[j]].ValidatorID) < 0
+	})
+	for _, key := range validatorIDs {
+		v := validators[key]
+		sort.Slice(v.States, func(i, j int) bool { return v.States[i].State < v.States[j].State })
+		p.Validators = append(p.Validators, *v)
+	}
+	return p, nil
+}
+
+func addToState(v *structs.PortfolioValidator, d structs.Delegation) {
+	for i, s := range v.States {
+		if s.State == d.State {
+			v.States[i].Amount.Add(s.Amount, d.Amount)
+			v.States[i].Delegations = append(v.States[i].Delegations, d)
+			return
+		}
+	}
+	v.States = append(v.States, structs.PortfolioState{State: d.State, Amount: new(big.Int).Set(d.Amount), Delegations: []structs.Delegation{d}})
+}
+
+// activeInEpoch tells whether delegation was delegated within the epoch, delegations finish at the beginning of their finished epoch
+func activeInEpoch(d structs.Delegation, epoch uint64) bool {
+	switch d.State {
+	case structs.DelegationStateDELEGATED, structs.DelegationStateUNDELEGATION_REQUESTED, structs.DelegationStateCOMPLETED:
+	default:
+		return false
+	}
+	if d.Started == nil || d.Started.Sign() == 0 || d.Started.Uint64() > epoch {
+		return false
+	}
+	return d.Finished == nil || d.Finished.Sign() == 0 || epoch < d.Finished.Uint64()
+}
+
+// portfolioEpochs fills the value and earned bounty of every epoch from the first one holder delegated in until the current one.
+// Bounty paid to validator nodes in the epoch, without fee, is split between delegations active in it by their effective stake
+func (c *Client) portfolioEpochs(ctx context.Context, p *structs.Portfolio, delegations []structs.Delegation, validatorIDs []string, validators map[string]*structs.PortfolioValidator, now time.Time) error {
+	current := structs.EpochAt(now)
+	first := current + 1
+	for _, d := range delegations {
+		if d.Started != nil && d.Started.Sign() > 0 && d.Started.Uint64() < first {
+			first = d.Started.Uint64()
+		}
+	}
+	if first > current {
+		return nil
+	}
+
+	all, err := c.storeEng.GetDelegations(ctx, structs.DelegationParams{ValidatorIDs: validatorIDs})
+	if err != nil {
+		return err
+	}
+
+	// delegators bounty of validator per epoch
+	type validatorEpoch struct {
+		validatorID string
+		epoch       uint64
+	}
+	rewards := map[validatorEpoch]*big.Int{}
+	bounties, err := c.nodeBounties(ctx, "", structs.EpochStart(first), now)
+	if err != nil {
+		return err
+	}
+	for _, b := range bounties {
+		if _, ok := validators[b.ValidatorID]; !ok {
+			continue
+		}
+		key := validatorEpoch{b.ValidatorID, structs.EpochAt(b.Time)}
+		if rewards[key] == nil {
+			rewards[key] = new(big.Int)
+		}
+		rewards[key].Add(rewards[key], b.Bounty)
+		rewards[key].Sub(rewards[key], b.Fee)
+	}
+
+	for epoch := first; epoch <= current; epoch++ {
+		pe := structs.PortfolioEpoch{Epoch: epoch, Start: structs.EpochStart(epoch), Value: new(big.Int), Earned: new(big.Int)}
+
+		stakes := map[string]*big.Int{}
+		for _, d := range all {
+			if !activeInEpoch(d, epoch) {
+				continue
+			}
+			key := d.ValidatorID.String()
+			if stakes[key] == nil {
+				stakes[key] = new(big.Int)
+			}
+			stakes[key].Add(stakes[key], effectiveAmount(d))
+		}
+
+		for _, d := range delegations {
+			if !activeInEpoch(d, epoch) {
+				continue
+			}
+			pe.Value.Add(pe.Value, d.Amount)
+
+			key := d.ValidatorID.String()
+			reward, ok := rewards[validatorEpoch{key, epoch}]
+			if !ok || stakes[key] == nil || stakes[key].Sign() == 0 {
+				continue
+			}
+			earned := new(big.Int).Mul(reward, effectiveAmount(d))
+			earned.Quo(earned, stakes[key])
+			pe.Earned.Add(pe.Earned, earned)
+			validators[key].Earned.Add(validators[key].Earned, earned)
+		}
+
+		p.Earned.Add(p.Earned, pe.Earned)
+		p.Epochs = append(p.Epochs, pe)
+	}
+	return nil
+}
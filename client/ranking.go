@@ -0,0 +1,254 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"math/big"
+	"sort"
+	"time"
+
+	"github.com/figment-networks/skale-indexer/scraper/structs"
+	"go.uber.org/zap"
+)
+
+// GetValidatorRanking ranks validators by the weighted score of their metrics.
+// Metrics are normalized across all of the validators before they are weighed, so the score depends on the whole set
+func (c *Client) GetValidatorRanking(ctx context.Context, params structs.ValidatorRankingParams) (ranking []structs.ValidatorRank, err error) {
+	ranking, err = c.validatorRanking(ctx, params, time.Now())
+	if err != nil {
+		c.log.Error("[CLIENT] Error in GetValidatorRanking", zap.Any("params", params), zap.Error(err))
+	}
+	return ranking, err
+}
+
+func (c *Client) validatorRanking(ctx context.Context, params structs.ValidatorRankingParams, now time.Time) ([]structs.ValidatorRank, error) {
+	if params.GrowthWindow == 0 {
+		params.GrowthWindow = structs.DefaultRankingGrowthWindow
+	}
+	if params.UptimeWindow == 0 {
+		params.UptimeWindow = structs.DefaultRankingUptimeWindow
+	}
+
+	validators, err := c.storeEng.GetValidators(ctx, structs.ValidatorParams{})
+	if err != nil {
+		return nil, err
+	}
+
+	ranks := make([]structs.ValidatorRank, len(validators))
+	index := map[string]*structs.ValidatorRank{}
+	for i, v := range validators {
+		ranks[i] = structs.ValidatorRank{
+			ValidatorID:    v.ValidatorID,
+			Name:           v.Name,
+			FeeRate:        v.FeeRate,
+			ActiveNodes:    v.ActiveNodes,
+			TotalStake:     new(big.Int),
+			EffectiveStake: new(big.Int),
+			StakeGrowth:    new(big.Int),
+		}
+		if ranks[i].FeeRate == nil {
+			ranks[i].FeeRate = new(big.Int)
+		}
+		index[v.ValidatorID.String()] = &ranks[i]
+	}
+
+	stakes, err := c.storeEng.GetValidatorStatistics(ctx, structs.ValidatorStatisticsParams{Type: structs.ValidatorStatisticsTypeTotalStake})
+	if err != nil {
+		return nil, err
+	}
+	for _, s := range stakes {
+		if r, ok := index[s.ValidatorID.String()]; ok {
+			r.TotalStake.Set(s.Amount)
+		}
+	}
+
+	// stake at the beginning of growth window is the one at the last block before it, zero when nothing is indexed that early
+	past := map[string]*big.Int{}
+	block, err := c.GetBlockAtTime(ctx, now.Add(-params.GrowthWindow))
+	switch {
+	case err == nil:
+		pastStakes, err := c.storeEng.GetValidatorStatistics(ctx, structs.ValidatorStatisticsParams{Type: structs.ValidatorStatisticsTypeTotalStake, AtHeight: block.Number})
+		if err != nil {
+			return nil, err
+		}
+		for _, s := range pastStakes {
+			past[s.ValidatorID.String()] = s.Amount
+		}
+	case !errors.Is(err, structs.ErrNotFound):
+		return nil, err
+	}
+	for key, r := range index {
+		before, ok := past[key]
+		if !ok {
+			before = new(big.Int)
+		}
+		r.StakeGrowth.Sub(r.TotalStake, before)
+		if before.Sign() > 0 {
+			r.StakeGrowthRate = ratio(r.StakeGrowth, before)
+		}
+	}
+
+	delegations, err := c.activeDelegations(ctx, 0)
+	if err != nil {
+		return nil, err
+	}
+	holders := map[string]map[string]struct{}{}
+	for _, d := range delegations {
+		key := d.ValidatorID.String()
+		r, ok := index[key]
+		if !ok {
+			continue
+		}
+		r.EffectiveStake.Add(r.EffectiveStake, effectiveAmount(d))
+		if holders[key] == nil {
+			holders[key] = map[string]struct{}{}
+		}
+		holders[key][d.Holder.Hex()] = struct{}{}
+	}
+	for key, h := range holders {
+		index[key].Delegators = uint64(len(h))
+	}
+
+	changes, err := c.storeEng.GetNodeTimeline(ctx, structs.NodeParams{})
+	if err != nil {
+		return nil, err
+	}
+	tracked := map[string]time.Duration{}
+	maintenance := map[string]time.Duration{}
+	for _, m := range nodesMaintenance(changes, now.Add(-params.UptimeWindow), now) {
+		key := m.ValidatorID.String()
+		tracked[key] += m.Tracked
+		maintenance[key] += m.Maintenance
+	}
+	for key, t := range tracked {
+		if r, ok := index[key]; ok && t > 0 {
+			r.Uptime = 1 - float64(maintenance[key])/float64(t)
+		}
+	}
+
+	return rankValidators(ranks, params), nil
+}
+
+// activeDelegations returns delegations which are delegated (undelegation requested included) at given height, the current state when it's zero.
+// State is checked after the latest change of delegation is taken, as state filter of the store matches any of the changes
+func (c *Client) activeDelegations(ctx context.Context, height uint64) (active []structs.Delegation, err error) {
+	delegations, err := c.storeEng.GetDelegations(ctx, structs.DelegationParams{AtHeight: height})
+	if err != nil {
+		return nil, err
+	}
+	for _, d := range delegations {
+		if d.State == structs.DelegationStateDELEGATED || d.State == structs.DelegationStateUNDELEGATION_REQUESTED {
+			active = append(active, d)
+		}
+	}
+	return active, nil
+}
+
+// effectiveAmount is the delegated amount multiplied by the multiplier of delegation period, unknown periods having none
+func effectiveAmount(d structs.Delegation) *big.Int {
+	if d.Amount == nil {
+		return new(big.Int)
+	}
+	multiplier := int64(100)
+	if d.DelegationPeriod != nil && d.DelegationPeriod.IsUint64() {
+		if m, ok := structs.DelegationPeriodMultipliers[d.DelegationPeriod.Uint64()]; ok {
+			multiplier = m
+		}
+	}
+	a := new(big.Int).Mul(d.Amount, big.NewInt(multiplier))
+	return a.Quo(a, big.NewInt(100))
+}
+
+// rankValidators normalizes metrics between the lowest and the highest value, weighs them and sorts validators by score
+// (or by the OrderBy metric), the validator id breaking ties. Ranks are assigned before the page is cut
+func rankValidators(ranks []structs.ValidatorRank, params structs.ValidatorRankingParams) []structs.ValidatorRank {
+	weights := params.Weights
+	if len(weights) == 0 {
+		weights = map[structs.RankingMetric]float64{}
+		for _, m := range structs.RankingMetrics {
+			weights[m] = 1
+		}
+	}
+
+	values := map[structs.RankingMetric][]float64{}
+	for _, r := range ranks {
+		values[structs.RankingTotalStake] = append(values[structs.RankingTotalStake], toFloat(r.TotalStake))
+		values[structs.RankingEffectiveStake] = append(values[structs.RankingEffectiveStake], toFloat(r.EffectiveStake))
+		values[structs.RankingDelegators] = append(values[structs.RankingDelegators], float64(r.Delegators))
+		values[structs.RankingFee] = append(values[structs.RankingFee], -toFloat(r.FeeRate))
+		values[structs.RankingActiveNodes] = append(values[structs.RankingActiveNodes], float64(r.ActiveNodes))
+		values[structs.RankingUptime] = append(values[structs.RankingUptime], r.Uptime)
+		values[structs.RankingStakeGrowth] = append(values[structs.RankingStakeGrowth], r.StakeGrowthRate)
+	}
+
+	for i := range ranks {
+		ranks[i].Scores = map[structs.RankingMetric]float64{}
+	}
+	for metric, vals := range values {
+		lowest, highest := vals[0], vals[0]
+		for _, v := range vals {
+			if v < lowest {
+				lowest = v
+			}
+			if v > highest {
+				highest = v
+			}
+		}
+		for i, v := range vals {
+			score := 1.0
+			if highest > lowest {
+				score = (v - lowest) / (highest - lowest)
+			}
+			ranks[i].Scores[metric] = score
+		}
+	}
+
+	var total float64
+	for _, w := range weights {
+		total += w
+	}
+	for i := range ranks {
+		if total == 0 {
+			continue
+		}
+		for metric, w := range weights {
+			ranks[i].Score += w * ranks[i].Scores[metric]
+		}
+		ranks[i].Score /= total
+	}
+
+	sort.SliceStable(ranks, func(i, j int) bool {
+		a, b := ranks[i].Score, ranks[j].Score
+		if params.OrderBy != "" {
+			a, b = ranks[i].Scores[params.OrderBy], ranks[j].Scores[params.OrderBy]
+		}
+		if a != b {
+			return a > b
+		}
+		return ranks[i].ValidatorID.Cmp(ranks[j].ValidatorID) < 0
+	})
+	for i := range ranks {
+		ranks[i].Rank = uint64(i + 1)
+	}
+
+	from, to := uint64(0), uint64(len(ranks))
+	if params.Limit > 0 {
+		if from = params.Offset; from > to {
+			from = to
+		}
+		if from+params.Limit < to {
+			to = from + params.Limit
+		}
+	}
+	return ranks[from:to]
+}
+
+func toFloat(i *big.Int) float64 {
+	f, _ := new(big.Float).SetInt(i).Float64()
+	return f
+}
+
+func ratio(a, b *big.Int) float64 {
+	r, _ := new(big.Rat).SetFrac(a, b).Float64()
+	return r
+}
@@ -0,0 +1,130 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"math/big"
+	"time"
+
+	"github.com/figment-networks/skale-indexer/scraper/structs"
+	"go.uber.org/zap"
+)
+
+// GetStatisticsSeries returns values of validator statistic at the end of every bucket of the interval, with their network aggregate
+func (c *Client) GetStatisticsSeries(ctx context.Context, params structs.StatisticsSeriesParams) (series structs.StatisticsSeries, err error) {
+	if series, err = c.statisticsSeries(ctx, params, time.Now()); err != nil {
+		c.log.Error("[CLIENT] Error in GetStatisticsSeries", zap.Any("params", params), zap.Error(err))
+	}
+	return series, err
+}
+
+func (c *Client) statisticsSeries(ctx context.Context, params structs.StatisticsSeriesParams, now time.Time) (series structs.StatisticsSeries, err error) {
+	averaged, ok := structs.SeriesAveraged[params.Type]
+	if !ok {
+		return series, errors.New("statistic type can't be made a series of")
+	}
+	interval := params.Interval
+	if interval == "" {
+		interval = structs.SeriesIntervalDay
+	}
+	to, from := params.TimeTo, params.TimeFrom
+	if to.IsZero() {
+		to = now
+	}
+	if from.IsZero() {
+		from = to.Add(-structs.DefaultSeriesWindow)
+	}
+	if to.Before(from) {
+		return series, errors.New("time range is empty")
+	}
+
+	series = structs.StatisticsSeries{
+		Type:       params.Type,
+		Interval:   interval,
+		Buckets:    []time.Time{},
+		Validators: []structs.ValidatorSeries{},
+		Network:    []structs.NetworkPoint{},
+	}
+	for start := interval.BucketStart(from); !start.After(to); start = interval.Next(start) {
+		if len(series.Buckets) == structs.MaxSeriesBuckets {
+			return series, structs.ErrSeriesTooLong
+		}
+		series.Buckets = append(series.Buckets, start)
+	}
+
+	// network series takes all validators, whichever are requested
+	daily, err := c.storeEng.GetValidatorStatisticsDaily(ctx, structs.ValidatorStatisticsParams{
+		Type:     params.Type,
+		TimeFrom: series.Buckets[0],
+		TimeTo:   to,
+	})
+	if err != nil {
+		return series, err
+	}
+	all := carryForward(daily, series.Buckets, interval)
+
+	sums := make([]*big.Int, len(series.Buckets))
+	counts := make([]uint64, len(series.Buckets))
+	for i := range sums {
+		sums[i] = new(big.Int)
+	}
+	for _, vs := range all {
+		for i, v := range vs.Values {
+			if v != nil {
+				sums[i].Add(sums[i], v)
+				counts[i]++
+			}
+		}
+	}
+	for i, sum := range sums {
+		if averaged && counts[i] > 0 {
+			sum.Quo(sum, new(big.Int).SetUint64(counts[i]))
+		}
+		series.Network = append(series.Network, structs.NetworkPoint{Value: sum, Validators: counts[i]})
+	}
+
+	if len(params.ValidatorIDs) == 0 {
+		series.Validators = all
+		return series, nil
+	}
+	byID := map[string]structs.ValidatorSeries{}
+	for _, vs := range all {
+		byID[vs.ValidatorID.String()] = vs
+	}
+	for _, id := range params.ValidatorIDs {
+		vID, ok := new(big.Int).SetString(id, 10)
+		if !ok {
+			return series, errors.New("wrong validator id")
+		}
+		vs, ok := byID[vID.String()]
+		if !ok {
+			// validator without any value still gets its (empty) series
+			vs = structs.ValidatorSeries{ValidatorID: vID, Values: make([]*big.Int, len(series.Buckets))}
+		}
+		series.Validators = append(series.Validators, vs)
+	}
+	return series, nil
+}
+
+// carryForward turns daily values, ordered by validator and day, into values at the end of every bucket.
+// Bucket takes the last value before the following bucket starts, nil until validator has any
+func carryForward(daily []structs.ValidatorStatistics, buckets []time.Time, interval structs.SeriesInterval) (series []structs.ValidatorSeries) {
+	for i := 0; i < len(daily); {
+		vs := structs.ValidatorSeries{ValidatorID: daily[i].ValidatorID, Values: make([]*big.Int, len(buckets))}
+		var last *big.Int
+		for b, start := range buckets {
+			next := interval.Next(start)
+			for i < len(daily) && daily[i].ValidatorID.Cmp(vs.ValidatorID) == 0 && daily[i].Time.Before(next) {
+				last = daily[i].Amount
+				i++
+			}
+			vs.Values[b] = last
+		}
+		// values past the last bucket are not expected, they are skipped not to start another series of the validator
+		for i < len(daily) && daily[i].ValidatorID.Cmp(vs.ValidatorID) == 0 {
+			i++
+		}
+		series = append(series, vs)
+	}
+	return series
+}
@@ -0,0 +1,184 @@
+// Package stream fans out system and contract events to subscribers as soon as they are persisted
+package stream
+
+import (
+	"errors"
+	"math/big"
+	"sync"
+
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/figment-networks/skale-indexer/scraper/structs"
+)
+
+// ErrSlowSubscriber is returned for subscription dropped because it didn't keep up with published events
+var ErrSlowSubscriber = errors.New("subscriber is too slow, events were dropped")
+
+// Event is either system or contract event
+type Event struct {
+	SystemEvent   *structs.SystemEvent
+	ContractEvent *structs.ContractEvent
+}
+
+// Height returns the block height event was emitted at
+func (e Event) Height() uint64 {
+	if e.SystemEvent != nil {
+		return e.SystemEvent.Height
+	}
+	return e.ContractEvent.BlockHeight
+}
+
+// Key identifies event the same way stores do when saving it again, as events are published before stores assign them ids
+func (e Event) Key() string {
+	if e.SystemEvent != nil {
+		return "system/" + e.SystemEvent.Key()
+	}
+	return "contract/" + e.ContractEvent.Key()
+}
+
+// Filter selects events of subscription. System events are matched by kind, all of them when no kind is listed.
+// Contract events are sent only when their names are listed.
+type Filter struct {
+	Kinds       []structs.SysEvtType
+	EventNames  []string
+	ValidatorID *big.Int
+	Address     *common.Address
+}
+
+// Match reports whether event passes the filter
+func (f Filter) Match(e Event) bool {
+	if se := e.SystemEvent; se != nil {
+		if len(f.Kinds) > 0 && !hasKind(f.Kinds, se.Kind) {
+			return false
+		}
+		if f.ValidatorID != nil && se.SenderID.Cmp(f.ValidatorID) != 0 && se.RecipientID.Cmp(f.ValidatorID) != 0 {
+			return false
+		}
+		return f.Address == nil || se.Sender == *f.Address || se.Recipient == *f.Address
+	}
+
+	ce := e.ContractEvent
+	if !hasName(f.EventNames, ce.EventName) {
+		return false
+	}
+	if f.ValidatorID != nil && !boundToValidator(ce, f.ValidatorID) {
+		return false
+	}
+	if f.Address != nil {
+		for _, a := range ce.BoundAddress {
+			if a == *f.Address {
+				return true
+			}
+		}
+		return false
+	}
+	return true
+}
+
+// boundToValidator matches events of validator and of its delegations, like events query of stores does
+func boundToValidator(ce *structs.ContractEvent, validatorID *big.Int) bool {
+	switch ce.BoundType {
+	case "validator":
+		return len(ce.BoundID) > 0 && ce.BoundID[0].Cmp(validatorID) == 0
+	case "delegation":
+		return len(ce.BoundID) > 1 && ce.BoundID[1].Cmp(validatorID) == 0
+	}
+	return false
+}
+
+func hasKind(kinds []structs.SysEvtType, kind structs.SysEvtType) bool {
+	for _, k := range kinds {
+		if k == kind {
+			return true
+		}
+	}
+	return false
+}
+
+func hasName(names []string, name string) bool {
+	for _, n := range names {
+		if n == name {
+			return true
+		}
+	}
+	return false
+}
+
+// Broker delivers published events to the subscriptions they match
+type Broker struct {
+	mu     sync.Mutex
+	subs   map[*Subscription]struct{}
+	buffer int
+}
+
+// NewBroker is Broker constructor. Buffer is the number of events subscription can fall behind before it's dropped
+func NewBroker(buffer int) *Broker {
+	return &Broker{subs: make(map[*Subscription]struct{}), buffer: buffer}
+}
+
+// Subscribe starts subscription of events matching the filter
+func (b *Broker) Subscribe(f Filter) *Subscription {
+	s := &Subscription{b: b, filter: f, events: make(chan Event, b.buffer)}
+	b.mu.Lock()
+	b.subs[s] = struct{}{}
+	b.mu.Unlock()
+	return s
+}
+
+// Publish delivers events to subscriptions without waiting for them.
+// Subscription with full buffer is dropped, so the slow one doesn't hold up the others
+func (b *Broker) Publish(events []Event) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	for s := range b.subs {
+		for _, e := range events {
+			if !s.filter.Match(e) {
+				continue
+			}
+			select {
+			case s.events <- e:
+			default:
+				s.err = ErrSlowSubscriber
+				b.remove(s)
+			}
+			if s.err != nil {
+				break
+			}
+		}
+	}
+}
+
+func (b *Broker) remove(s *Subscription) {
+	if _, ok := b.subs[s]; ok {
+		delete(b.subs, s)
+		close(s.events)
+	}
+}
+
+// Subscription receives published events matching its filter
+type Subscription struct {
+	b      *Broker
+	filter Filter
+	events chan Event
+	err    error
+}
+
+// Events returns channel of events. It's closed when subscription is closed or dropped
+func (s *Subscription) Events() <-chan Event {
+	return s.events
+}
+
+// Err returns the reason subscription was dropped for, once its events channel is closed
+func (s *Subscription) Err() error {
+	s.b.mu.Lock()
+	defer s.b.mu.Unlock()
+	return s.err
+}
+
+// Close ends subscription
+func (s *Subscription) Close() {
+	s.b.mu.Lock()
+	defer s.b.mu.Unlock()
+	s.b.remove(s)
+}
@@ -0,0 +1,72 @@
+package stream
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/require"
+
+	"github.com/figment-networks/skale-indexer/scraper/structs"
+)
+
+func TestFilterMatch(t *testing.T) {
+	holder := common.HexToAddress("0x06dd71dab27c1a3e0b172d53735f00bf1a66eb79")
+	accepted := Event{SystemEvent: &structs.SystemEvent{Kind: structs.SysEvtTypeDelegationAccepted, Sender: holder, RecipientID: *big.NewInt(2)}}
+	delegationEvent := Event{ContractEvent: &structs.ContractEvent{
+		EventName: "DelegationAccepted", BoundType: "delegation", BoundID: []big.Int{*big.NewInt(7), *big.NewInt(2)}, BoundAddress: []common.Address{holder},
+	}}
+	nodeEvent := Event{ContractEvent: &structs.ContractEvent{EventName: "NodeCreated", BoundType: "node", BoundID: []big.Int{*big.NewInt(2)}}}
+	other := common.HexToAddress("0x1f42f0b1ae2aa1d04d4b7c62c2a8a4a8c06d0a6b")
+
+	tests := []struct {
+		name   string
+		filter Filter
+		match  []bool
+	}{
+		{"system events by default", Filter{}, []bool{true, false, false}},
+		{"kind", Filter{Kinds: []structs.SysEvtType{structs.SysEvtTypeSlashed}}, []bool{false, false, false}},
+		{"event names", Filter{EventNames: []string{"DelegationAccepted", "NodeCreated"}}, []bool{true, true, true}},
+		{"validator", Filter{EventNames: []string{"DelegationAccepted", "NodeCreated"}, ValidatorID: big.NewInt(2)}, []bool{true, true, false}},
+		{"other validator", Filter{EventNames: []string{"DelegationAccepted"}, ValidatorID: big.NewInt(7)}, []bool{false, false, false}},
+		{"address", Filter{EventNames: []string{"DelegationAccepted", "NodeCreated"}, Address: &holder}, []bool{true, true, false}},
+		{"other address", Filter{EventNames: []string{"DelegationAccepted"}, Address: &other}, []bool{false, false, false}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			require.Equal(t, tt.match, []bool{tt.filter.Match(accepted), tt.filter.Match(delegationEvent), tt.filter.Match(nodeEvent)})
+		})
+	}
+}
+
+func TestBroker(t *testing.T) {
+	b := NewBroker(2)
+	slow := b.Subscribe(Filter{})
+	fast := b.Subscribe(Filter{Kinds: []structs.SysEvtType{structs.SysEvtTypeSlashed}})
+
+	events := make([]Event, 3)
+	for i := range events {
+		events[i] = Event{SystemEvent: &structs.SystemEvent{Height: uint64(i + 1), Kind: structs.SysEvtTypeSlashed}}
+	}
+	b.Publish(events[:2])
+	require.Equal(t, events[0], <-fast.Events())
+	require.Equal(t, events[1], <-fast.Events())
+
+	// slow one still holds two events in its buffer
+	b.Publish(events[2:])
+	require.Equal(t, events[2], <-fast.Events())
+
+	var received []Event
+	for e := range slow.Events() {
+		received = append(received, e)
+	}
+	require.Equal(t, events[:2], received)
+	require.Equal(t, ErrSlowSubscriber, slow.Err())
+
+	fast.Close()
+	_, ok := <-fast.Events()
+	require.False(t, ok)
+	require.NoError(t, fast.Err())
+	// closing again, as the subscriber does on its way out, has no effect
+	slow.Close()
+}
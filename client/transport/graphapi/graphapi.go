@@ -0,0 +1,105 @@
+// Package graphapi serves the indexed records over GraphQL, so the related ones are fetched in single round-trip
+package graphapi
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"time"
+
+	graphql "github.com/graph-gophers/graphql-go"
+	"github.com/graph-gophers/graphql-go/errors"
+
+	"github.com/figment-networks/skale-indexer/scraper/structs"
+)
+
+// maxDepth limits nesting of the relations requested, as they may be followed in circles
+const maxDepth = 8
+
+// ClientContractor - method signatures for Connector
+type ClientContractor interface {
+	GetNodes(ctx context.Context, params structs.NodeParams) (nodes []structs.Node, err error)
+	GetValidators(ctx context.Context, params structs.ValidatorParams) (validators []structs.Validator, err error)
+	GetDelegations(ctx context.Context, params structs.DelegationParams) (delegations []structs.Delegation, err error)
+	GetValidatorStatistics(ctx context.Context, params structs.ValidatorStatisticsParams) (validatorStatistics []structs.ValidatorStatistics, err error)
+	GetAccounts(ctx context.Context, params structs.AccountParams) (accounts []structs.Account, err error)
+	GetContractEvents(ctx context.Context, params structs.EventParams) (contractEvents []structs.ContractEvent, err error)
+	GetSystemEvents(ctx context.Context, params structs.SystemEventParams) (systemEvents []structs.SystemEvent, err error)
+	GetBlockAtTime(ctx context.Context, t time.Time) (block structs.Block, err error)
+}
+
+// Connector is GraphQL HTTP connector for manager
+type Connector struct {
+	cli    ClientContractor
+	schema *graphql.Schema
+}
+
+// NewClientConnector is connector constructor
+func NewClientConnector(cli ClientContractor) *Connector {
+	return &Connector{
+		cli:    cli,
+		schema: graphql.MustParseSchema(schema, &queryResolver{}, graphql.MaxDepth(maxDepth)),
+	}
+}
+
+// AttachToHandler attaches handlers to http server's mux
+func (c *Connector) AttachToHandler(mux *http.ServeMux) {
+	mux.HandleFunc("/graphql", c.Query)
+}
+
+type request struct {
+	Query         string                 `json:"query"`
+	OperationName string                 `json:"operationName"`
+	Variables     map[string]interface{} `json:"variables"`
+}
+
+// Query executes GraphQL query sent in POST body or 'query' parameter of GET
+func (c *Connector) Query(w http.ResponseWriter, req *http.Request) {
+	w.Header().Add("Content-Type", "application/json")
+	allowCORSHeaders(w)
+
+	var r request
+	switch req.Method {
+	case http.MethodGet:
+		r.Query = req.URL.Query().Get("query")
+		r.OperationName = req.URL.Query().Get("operationName")
+		if v := req.URL.Query().Get("variables"); v != "" {
+			if err := json.Unmarshal([]byte(v), &r.Variables); err != nil {
+				writeError(w, http.StatusBadRequest, "error parsing 'variables' parameter")
+				return
+			}
+		}
+	case http.MethodPost:
+		if err := json.NewDecoder(req.Body).Decode(&r); err != nil {
+			writeError(w, http.StatusBadRequest, "error parsing request body")
+			return
+		}
+	case http.MethodOptions:
+		return
+	default:
+		writeError(w, http.StatusMethodNotAllowed, structs.ErrNotAllowedMethod.Error())
+		return
+	}
+
+	if r.Query == "" {
+		writeError(w, http.StatusBadRequest, "query is missing")
+		return
+	}
+
+	ctx := withLoaders(req.Context(), newLoaders(c.cli))
+	resp := c.schema.Exec(ctx, r.Query, r.OperationName, r.Variables)
+	enc := json.NewEncoder(w)
+	enc.Encode(resp)
+}
+
+func writeError(w http.ResponseWriter, code int, message string) {
+	w.WriteHeader(code)
+	enc := json.NewEncoder(w)
+	enc.Encode(graphql.Response{Errors: []*errors.QueryError{errors.Errorf("%s", message)}})
+}
+
+func allowCORSHeaders(w http.ResponseWriter) {
+	w.Header().Set("Access-Control-Allow-Origin", "*")
+	w.Header().Set("Access-Control-Allow-Methods", "POST, GET, OPTIONS")
+	w.Header().Set("Access-Control-Allow-Headers", "Content-Type")
+}
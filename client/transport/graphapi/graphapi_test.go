@@ -0,0 +1,251 @@
+package graphapi
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap/zaptest"
+
+	"github.com/figment-networks/skale-indexer/client"
+	"github.com/figment-networks/skale-indexer/scraper/structs"
+	"github.com/figment-networks/skale-indexer/store"
+	"github.com/figment-networks/skale-indexer/store/memory"
+)
+
+// countingClient counts the calls made to the store while resolving the query
+type countingClient struct {
+	ClientContractor
+
+	mu    sync.Mutex
+	calls map[string]int
+}
+
+func (c *countingClient) count(name string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.calls[name]++
+}
+
+func (c *countingClient) GetNodes(ctx context.Context, params structs.NodeParams) ([]structs.Node, error) {
+	c.count("GetNodes")
+	return c.ClientContractor.GetNodes(ctx, params)
+}
+
+func (c *countingClient) GetValidators(ctx context.Context, params structs.ValidatorParams) ([]structs.Validator, error) {
+	c.count("GetValidators")
+	return c.ClientContractor.GetValidators(ctx, params)
+}
+
+func (c *countingClient) GetDelegations(ctx context.Context, params structs.DelegationParams) ([]structs.Delegation, error) {
+	c.count("GetDelegations")
+	return c.ClientContractor.GetDelegations(ctx, params)
+}
+
+func (c *countingClient) GetValidatorStatistics(ctx context.Context, params structs.ValidatorStatisticsParams) ([]structs.ValidatorStatistics, error) {
+	c.count("GetValidatorStatistics")
+	return c.ClientContractor.GetValidatorStatistics(ctx, params)
+}
+
+func TestQueryWithStore(t *testing.T) {
+	ctx := context.Background()
+	storeDB := store.New(memory.NewDriver())
+
+	start := time.Date(2021, time.March, 10, 12, 0, 0, 0, time.UTC)
+	holderA := common.HexToAddress("0x06dd71dab27c1a3e0b172d53735f00bf1a66eb79")
+	holderB := common.HexToAddress("0x1f42f0b1ae2aa1d04d4b7c62c2a8a4a8c06d0a6b")
+	validatorAddress := common.HexToAddress("0x5a6a3e5b1fd2f2e11ae6d0e1db0e0c6b1f7a8f24")
+
+	for _, height := range []uint64{1, 10, 20} {
+		require.NoError(t, storeDB.SaveBlock(ctx, structs.Block{Number: height, Hash: common.BigToHash(big.NewInt(int64(height))), Time: start.Add(time.Duration(height) * time.Minute)}))
+	}
+	for i, name := range []string{"first", "second", "third"} {
+		id := int64(i + 1)
+		require.NoError(t, storeDB.SaveValidator(ctx, structs.Validator{
+			ValidatorID: big.NewInt(id), Name: name, ValidatorAddress: common.BigToAddress(big.NewInt(id)), BlockHeight: 1,
+			FeeRate: big.NewInt(10), MinimumDelegationAmount: big.NewInt(100), Staked: big.NewInt(0),
+		}))
+		require.NoError(t, storeDB.SaveNodes(ctx, []structs.Node{{
+			NodeID: big.NewInt(id), ValidatorID: big.NewInt(id), Name: name + "-node", StartBlock: big.NewInt(1),
+			FinishTime: big.NewInt(0), Status: structs.NodeStatusActive, BlockHeight: 10,
+		}}, common.Address{}))
+		require.NoError(t, storeDB.SaveValidatorStatistic(ctx, big.NewInt(id), 10, start, structs.ValidatorStatisticsTypeFee, big.NewInt(10*id)))
+	}
+	require.NoError(t, storeDB.SaveValidator(ctx, structs.Validator{
+		ValidatorID: big.NewInt(4), Name: "fourth", ValidatorAddress: validatorAddress, BlockHeight: 1,
+	}))
+	for i, holder := range []common.Address{holderA, holderB, holderA} {
+		id := int64(i + 1)
+		require.NoError(t, storeDB.SaveDelegation(ctx, structs.Delegation{
+			DelegationID: big.NewInt(id), Holder: holder, ValidatorID: big.NewInt(id), BlockHeight: 10, Amount: big.NewInt(100 * id),
+			DelegationPeriod: big.NewInt(3), Started: big.NewInt(0), Finished: big.NewInt(0), State: structs.DelegationStateDELEGATED,
+			TransactionHash: common.BigToHash(big.NewInt(id)), Created: start,
+		}))
+	}
+	// proposed later, so it's not delegated at height 10
+	require.NoError(t, storeDB.SaveDelegation(ctx, structs.Delegation{
+		DelegationID: big.NewInt(4), Holder: holderB, ValidatorID: big.NewInt(1), BlockHeight: 20, Amount: big.NewInt(400),
+		DelegationPeriod: big.NewInt(3), Started: big.NewInt(0), Finished: big.NewInt(0), State: structs.DelegationStatePROPOSED,
+		TransactionHash: common.BigToHash(big.NewInt(4)), Created: start,
+	}))
+	require.NoError(t, storeDB.SaveAccount(ctx, structs.Account{Address: holderA, Type: structs.AccountTypeDelegator}))
+	require.NoError(t, storeDB.SaveAccount(ctx, structs.Account{Address: holderB, Type: structs.AccountTypeDelegator}))
+	require.NoError(t, storeDB.SaveAccount(ctx, structs.Account{Address: validatorAddress, Type: structs.AccountTypeValidator}))
+	require.NoError(t, storeDB.SaveContractEvent(ctx, structs.ContractEvent{
+		ContractName: "delegation_controller", EventName: "DelegationProposed", BlockHeight: 10, Time: start,
+		TransactionHash: common.BigToHash(big.NewInt(2)), BoundType: "delegation", BoundID: []big.Int{*big.NewInt(2), *big.NewInt(2)},
+		BoundAddress: []common.Address{holderB}, Params: map[string]interface{}{"delegationId": big.NewInt(2)},
+	}))
+
+	cli := &countingClient{
+		ClientContractor: client.NewClient(zaptest.NewLogger(t), storeDB, nil, nil, 1, 1),
+		calls:            map[string]int{},
+	}
+	mux := http.NewServeMux()
+	NewClientConnector(cli).AttachToHandler(mux)
+
+	post := func(t *testing.T, query string, variables map[string]interface{}) (resp struct {
+		Data   json.RawMessage
+		Errors []struct{ Message string }
+	}) {
+		t.Helper()
+		cli.calls = map[string]int{}
+		body, err := json.Marshal(request{Query: query, Variables: variables})
+		require.NoError(t, err)
+		rr := httptest.NewRecorder()
+		mux.ServeHTTP(rr, httptest.NewRequest(http.MethodPost, "/graphql", bytes.NewReader(body)))
+		require.Equal(t, http.StatusOK, rr.Code)
+		require.NoError(t, json.NewDecoder(rr.Body).Decode(&resp))
+		return resp
+	}
+
+	t.Run("relations are loaded in batches", func(t *testing.T) {
+		resp := post(t, `{
+			validators(limit: 3) {
+				id name
+				nodes { name validator { name nodes { id } } }
+				delegations(states: ["DELEGATED"]) { id amount validator { name } }
+				statistics(type: "FEE") { amount validator { id } }
+			}
+		}`, nil)
+		require.Empty(t, resp.Errors)
+
+		var data struct {
+			Validators []struct {
+				ID    string
+				Name  string
+				Nodes []struct {
+					Name      string
+					Validator struct {
+						Name  string
+						Nodes []struct{ ID string }
+					}
+				}
+				Delegations []struct {
+					ID        string
+					Amount    string
+					Validator struct{ Name string }
+				}
+				Statistics []struct {
+					Amount    string
+					Validator struct{ ID string }
+				}
+			}
+		}
+		require.NoError(t, json.Unmarshal(resp.Data, &data))
+		require.Len(t, data.Validators, 3)
+		for _, v := range data.Validators {
+			require.Len(t, v.Nodes, 1, v.Name)
+			require.Equal(t, v.Name+"-node", v.Nodes[0].Name)
+			require.Equal(t, v.Name, v.Nodes[0].Validator.Name)
+			require.Len(t, v.Nodes[0].Validator.Nodes, 1)
+			require.Len(t, v.Delegations, 1, v.Name)
+			require.Equal(t, v.ID, v.Delegations[0].ID)
+			require.Equal(t, v.Name, v.Delegations[0].Validator.Name)
+			require.Len(t, v.Statistics, 1, v.Name)
+			require.Equal(t, v.ID+"0", v.Statistics[0].Amount)
+			require.Equal(t, v.ID, v.Statistics[0].Validator.ID)
+		}
+
+		// root list, validators of relations and single query per relation of each of them
+		require.Equal(t, map[string]int{"GetValidators": 2, "GetNodes": 2, "GetDelegations": 1, "GetValidatorStatistics": 1}, cli.calls)
+	})
+
+	t.Run("accounts", func(t *testing.T) {
+		resp := post(t, `{ accounts { address type validator { name } delegations { id validatorId } } }`, nil)
+		require.Empty(t, resp.Errors)
+
+		var data struct {
+			Accounts []struct {
+				Address     string
+				Type        string
+				Validator   *struct{ Name string }
+				Delegations []struct{ ID, ValidatorID string }
+			}
+		}
+		require.NoError(t, json.Unmarshal(resp.Data, &data))
+		require.Len(t, data.Accounts, 3)
+		delegations := map[string]int{}
+		for _, a := range data.Accounts {
+			delegations[a.Address] = len(a.Delegations)
+			if a.Address == validatorAddress.Hex() {
+				require.NotNil(t, a.Validator)
+				require.Equal(t, "fourth", a.Validator.Name)
+			} else {
+				require.Nil(t, a.Validator)
+			}
+		}
+		require.Equal(t, map[string]int{holderA.Hex(): 2, holderB.Hex(): 2, validatorAddress.Hex(): 0}, delegations)
+		require.Equal(t, 1, cli.calls["GetDelegations"])
+	})
+
+	t.Run("at height", func(t *testing.T) {
+		resp := post(t, `query($holder: String) { delegations(holder: $holder, atHeight: 10) { id state } }`, map[string]interface{}{"holder": holderB.Hex()})
+		require.Empty(t, resp.Errors)
+		require.JSONEq(t, `{"delegations": [{"id": "2", "state": "DELEGATED"}]}`, string(resp.Data))
+
+		resp = post(t, `{ delegations(holder: "`+holderB.Hex()+`", atTime: "`+start.Add(30*time.Minute).Format(time.RFC3339)+`") { id } }`, nil)
+		require.Empty(t, resp.Errors)
+		require.JSONEq(t, `{"delegations": [{"id": "4"}, {"id": "2"}]}`, string(resp.Data))
+	})
+
+	t.Run("contract events", func(t *testing.T) {
+		resp := post(t, `{ contractEvents(eventName: "DelegationProposed", params: [{name: "delegationId", value: "2"}]) { eventName params } }`, nil)
+		require.Empty(t, resp.Errors)
+		require.JSONEq(t, `{"contractEvents": [{"eventName": "DelegationProposed", "params": "{\"delegationId\":2}"}]}`, string(resp.Data))
+	})
+
+	t.Run("query by GET", func(t *testing.T) {
+		rr := httptest.NewRecorder()
+		mux.ServeHTTP(rr, httptest.NewRequest(http.MethodGet, "/graphql?query="+url.QueryEscape(`{ validator(id: "2") { name } }`), nil))
+		require.Equal(t, http.StatusOK, rr.Code)
+		require.JSONEq(t, `{"data": {"validator": {"name": "second"}}}`, rr.Body.String())
+	})
+
+	t.Run("wrong arguments", func(t *testing.T) {
+		for _, query := range []string{
+			`{ delegations(states: ["WRONG"]) { id } }`,
+			`{ nodes(address: "0x12") { id } }`,
+			`{ validators(limit: -1) { id } }`,
+			`{ contractEvents(type: "validator") { id } }`,
+			`{ validators(atHeight: 1, atTime: "2021-03-10T12:00:00Z") { id } }`,
+			`{ validators { unknown } }`,
+		} {
+			resp := post(t, query, nil)
+			require.NotEmpty(t, resp.Errors, query)
+		}
+
+		rr := httptest.NewRecorder()
+		mux.ServeHTTP(rr, httptest.NewRequest(http.MethodPost, "/graphql", bytes.NewReader([]byte("{"))))
+		require.Equal(t, http.StatusBadRequest, rr.Code)
+	})
+}
@@ -0,0 +1,173 @@
+package graphapi
+
+import (
+	"context"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/figment-networks/skale-indexer/scraper/structs"
+)
+
+// batch is shared by the records of one list. Relation requested on any of them is loaded for all the keys at once,
+// every distinct set of relation arguments being loaded only once
+type batch struct {
+	keys []string
+
+	mu    sync.Mutex
+	calls map[string]*call
+}
+
+type call struct {
+	once sync.Once
+	res  interface{}
+	err  error
+}
+
+func newBatch(keys []string) *batch {
+	return &batch{keys: keys, calls: map[string]*call{}}
+}
+
+// load returns the result of fn for the batch keys, fn is called once per arg
+func (b *batch) load(arg string, fn func(keys []string) (interface{}, error)) (interface{}, error) {
+	b.mu.Lock()
+	c, ok := b.calls[arg]
+	if !ok {
+		c = &call{}
+		b.calls[arg] = c
+	}
+	b.mu.Unlock()
+
+	c.once.Do(func() { c.res, c.err = fn(b.keys) })
+	return c.res, c.err
+}
+
+// loaders keeps records loaded while resolving one request
+type loaders struct {
+	cli ClientContractor
+	// validators are loaded all at once by height, as there's only a few of them
+	validators *batch
+}
+
+func newLoaders(cli ClientContractor) *loaders {
+	return &loaders{cli: cli, validators: newBatch(nil)}
+}
+
+// validatorsAt returns resolvers of validators by id at given height. They share the batch, so relations
+// followed back to validators are loaded at once too
+func (l *loaders) validatorsAt(ctx context.Context, height uint64) (map[string]*validatorResolver, error) {
+	res, err := l.validators.load(strconv.FormatUint(height, 10), func([]string) (interface{}, error) {
+		vs, err := l.cli.GetValidators(ctx, structs.ValidatorParams{AtHeight: height})
+		if err != nil {
+			return nil, err
+		}
+		byID := make(map[string]*validatorResolver, len(vs))
+		for _, r := range newValidators(l, height, vs) {
+			byID[r.ID()] = r
+		}
+		return byID, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return res.(map[string]*validatorResolver), nil
+}
+
+// validator returns validator of given id, nil when it doesn't exist at given height
+func (l *loaders) validator(ctx context.Context, id string, height uint64) (*validatorResolver, error) {
+	byID, err := l.validatorsAt(ctx, height)
+	if err != nil {
+		return nil, err
+	}
+	return byID[id], nil
+}
+
+// nodes returns nodes of the batch validators grouped by validator. Single validator is filtered in the store,
+// the nodes of many are loaded at once
+func (l *loaders) nodes(ctx context.Context, b *batch, height uint64, status string) (map[string][]structs.Node, error) {
+	res, err := b.load(status, func(keys []string) (interface{}, error) {
+		params := structs.NodeParams{Status: status, AtHeight: height}
+		if len(keys) == 1 {
+			params.ValidatorID = keys[0]
+		}
+		nodes, err := l.cli.GetNodes(ctx, params)
+		if err != nil {
+			return nil, err
+		}
+		grouped := map[string][]structs.Node{}
+		for _, n := range nodes {
+			grouped[n.ValidatorID.String()] = append(grouped[n.ValidatorID.String()], n)
+		}
+		return grouped, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return res.(map[string][]structs.Node), nil
+}
+
+// delegations returns the latest state of delegations of the batch validators or holders, grouped by them
+func (l *loaders) delegations(ctx context.Context, b *batch, height uint64, states []structs.DelegationState, byHolder bool) (map[string][]structs.Delegation, error) {
+	arg := make([]string, len(states))
+	for i, s := range states {
+		arg[i] = s.String()
+	}
+	res, err := b.load(strings.Join(arg, ","), func(keys []string) (interface{}, error) {
+		params := structs.DelegationParams{State: states, AtHeight: height}
+		if byHolder {
+			params.Holders = keys
+		} else {
+			params.ValidatorIDs = keys
+		}
+		delegations, err := l.cli.GetDelegations(ctx, params)
+		if err != nil {
+			return nil, err
+		}
+		grouped := map[string][]structs.Delegation{}
+		for _, d := range delegations {
+			key := d.ValidatorID.String()
+			if byHolder {
+				key = d.Holder.Hex()
+			}
+			grouped[key] = append(grouped[key], d)
+		}
+		return grouped, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return res.(map[string][]structs.Delegation), nil
+}
+
+// statistics returns the latest statistics of the batch validators grouped by validator
+func (l *loaders) statistics(ctx context.Context, b *batch, height uint64, statType structs.StatisticTypeVS) (map[string][]structs.ValidatorStatistics, error) {
+	res, err := b.load(statType.String(), func(keys []string) (interface{}, error) {
+		params := structs.ValidatorStatisticsParams{Type: statType, AtHeight: height}
+		if len(keys) == 1 {
+			params.ValidatorID = keys[0]
+		}
+		stats, err := l.cli.GetValidatorStatistics(ctx, params)
+		if err != nil {
+			return nil, err
+		}
+		grouped := map[string][]structs.ValidatorStatistics{}
+		for _, vs := range stats {
+			grouped[vs.ValidatorID.String()] = append(grouped[vs.ValidatorID.String()], vs)
+		}
+		return grouped, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return res.(map[string][]structs.ValidatorStatistics), nil
+}
+
+type loadersKey struct{}
+
+func withLoaders(ctx context.Context, l *loaders) context.Context {
+	return context.WithValue(ctx, loadersKey{}, l)
+}
+
+func loadersFrom(ctx context.Context) *loaders {
+	return ctx.Value(loadersKey{}).(*loaders)
+}
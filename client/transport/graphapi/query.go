@@ -0,0 +1,466 @@
+package graphapi
+
+import (
+	"context"
+	"errors"
+	"strconv"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	graphql "github.com/graph-gophers/graphql-go"
+
+	"github.com/figment-networks/skale-indexer/scraper/structs"
+)
+
+// queryResolver resolves the root lists, it maps their arguments onto the store params
+type queryResolver struct{}
+
+type validatorsArgs struct {
+	ID             *string
+	Address        *string
+	Authorized     *bool
+	OrderBy        *string
+	OrderDirection *string
+	AtHeight       *int32
+	AtTime         *graphql.Time
+	Limit          *int32
+	Offset         *int32
+}
+
+func (q *queryResolver) Validators(ctx context.Context, args validatorsArgs) ([]*validatorResolver, error) {
+	l := loadersFrom(ctx)
+	height, err := l.height(ctx, args.AtHeight, args.AtTime)
+	if err != nil {
+		return nil, err
+	}
+	params := structs.ValidatorParams{
+		ValidatorID:    str(args.ID),
+		OrderBy:        str(args.OrderBy),
+		OrderDirection: str(args.OrderDirection),
+		AtHeight:       height,
+	}
+	if params.Address, err = address("address", args.Address); err != nil {
+		return nil, err
+	}
+	if args.Authorized != nil {
+		params.Authorized = structs.StateFalse
+		if *args.Authorized {
+			params.Authorized = structs.StateTrue
+		}
+	}
+	if params.Limit, params.Offset, err = paging(args.Limit, args.Offset); err != nil {
+		return nil, err
+	}
+
+	vs, err := l.cli.GetValidators(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+	return newValidators(l, height, vs), nil
+}
+
+type validatorArgs struct {
+	ID       string
+	AtHeight *int32
+	AtTime   *graphql.Time
+}
+
+func (q *queryResolver) Validator(ctx context.Context, args validatorArgs) (*validatorResolver, error) {
+	l := loadersFrom(ctx)
+	height, err := l.height(ctx, args.AtHeight, args.AtTime)
+	if err != nil {
+		return nil, err
+	}
+	vs, err := l.cli.GetValidators(ctx, structs.ValidatorParams{ValidatorID: args.ID, AtHeight: height})
+	if err != nil || len(vs) == 0 {
+		return nil, err
+	}
+	return newValidators(l, height, vs[:1])[0], nil
+}
+
+type nodesArgs struct {
+	ID          *string
+	ValidatorID *string
+	Status      *string
+	Address     *string
+	AtHeight    *int32
+	AtTime      *graphql.Time
+	Limit       *int32
+	Offset      *int32
+}
+
+func (q *queryResolver) Nodes(ctx context.Context, args nodesArgs) ([]*nodeResolver, error) {
+	l := loadersFrom(ctx)
+	height, err := l.height(ctx, args.AtHeight, args.AtTime)
+	if err != nil {
+		return nil, err
+	}
+	params := structs.NodeParams{
+		NodeID:      str(args.ID),
+		ValidatorID: str(args.ValidatorID),
+		AtHeight:    height,
+	}
+	if params.Status, err = nodeStatus(args.Status); err != nil {
+		return nil, err
+	}
+	if params.Address, err = address("address", args.Address); err != nil {
+		return nil, err
+	}
+	if params.Limit, params.Offset, err = paging(args.Limit, args.Offset); err != nil {
+		return nil, err
+	}
+
+	nodes, err := l.cli.GetNodes(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+	return newNodes(l, height, nodes), nil
+}
+
+type delegationsArgs struct {
+	ID          *string
+	ValidatorID *string
+	Holder      *string
+	States      *[]string
+	AtHeight    *int32
+	AtTime      *graphql.Time
+	Limit       *int32
+	Offset      *int32
+}
+
+func (q *queryResolver) Delegations(ctx context.Context, args delegationsArgs) ([]*delegationResolver, error) {
+	l := loadersFrom(ctx)
+	height, err := l.height(ctx, args.AtHeight, args.AtTime)
+	if err != nil {
+		return nil, err
+	}
+	params := structs.DelegationParams{
+		DelegationID: str(args.ID),
+		ValidatorID:  str(args.ValidatorID),
+		AtHeight:     height,
+	}
+	if params.Holder, err = address("holder", args.Holder); err != nil {
+		return nil, err
+	}
+	if params.State, err = delegationStates(args.States); err != nil {
+		return nil, err
+	}
+	if params.Limit, params.Offset, err = paging(args.Limit, args.Offset); err != nil {
+		return nil, err
+	}
+
+	delegations, err := l.cli.GetDelegations(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+	return newDelegations(l, height, delegations), nil
+}
+
+type accountsArgs struct {
+	Type    *string
+	Address *string
+	Limit   *int32
+	Offset  *int32
+}
+
+func (q *queryResolver) Accounts(ctx context.Context, args accountsArgs) ([]*accountResolver, error) {
+	l := loadersFrom(ctx)
+	params := structs.AccountParams{Type: str(args.Type)}
+	var err error
+	if params.Address, err = address("address", args.Address); err != nil {
+		return nil, err
+	}
+	if params.Limit, params.Offset, err = paging(args.Limit, args.Offset); err != nil {
+		return nil, err
+	}
+
+	accounts, err := l.cli.GetAccounts(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+	return newAccounts(l, accounts), nil
+}
+
+type eventParam struct {
+	Name  string
+	Value string
+}
+
+type contractEventsArgs struct {
+	Type            *string
+	ID              *string
+	ContractName    *string
+	EventName       *string
+	Params          *[]eventParam
+	HeightFrom      *int32
+	HeightTo        *int32
+	From            *graphql.Time
+	To              *graphql.Time
+	BoundAddress    *string
+	TransactionHash *string
+	AtHeight        *int32
+	AtTime          *graphql.Time
+	Limit           *int32
+	Offset          *int32
+}
+
+func (q *queryResolver) ContractEvents(ctx context.Context, args contractEventsArgs) ([]*contractEventResolver, error) {
+	l := loadersFrom(ctx)
+	height, err := l.height(ctx, args.AtHeight, args.AtTime)
+	if err != nil {
+		return nil, err
+	}
+	params := structs.EventParams{
+		Type:         str(args.Type),
+		ContractName: str(args.ContractName),
+		EventName:    str(args.EventName),
+		AtHeight:     height,
+	}
+	if (args.Type == nil) != (args.ID == nil) {
+		return nil, errors.New("'type' and 'id' arguments are sent together")
+	}
+	if args.ID != nil {
+		if params.Id, err = strconv.ParseUint(*args.ID, 10, 64); err != nil {
+			return nil, errors.New("error parsing 'id' argument")
+		}
+	}
+	if args.Params != nil {
+		params.Params = map[string]string{}
+		for _, p := range *args.Params {
+			params.Params[p.Name] = p.Value
+		}
+	}
+	if params.HeightFrom, err = unsigned("heightFrom", args.HeightFrom); err != nil {
+		return nil, err
+	}
+	if params.HeightTo, err = unsigned("heightTo", args.HeightTo); err != nil {
+		return nil, err
+	}
+	if args.From != nil {
+		params.TimeFrom = args.From.Time
+	}
+	if args.To != nil {
+		params.TimeTo = args.To.Time
+	}
+	if args.BoundAddress != nil {
+		if !common.IsHexAddress(*args.BoundAddress) {
+			return nil, errors.New("error parsing 'boundAddress' argument")
+		}
+		params.BoundAddress = common.HexToAddress(*args.BoundAddress)
+	}
+	if args.TransactionHash != nil {
+		b, err := hexutil.Decode(*args.TransactionHash)
+		if err != nil || len(b) != common.HashLength {
+			return nil, errors.New("error parsing 'transactionHash' argument")
+		}
+		params.TransactionHash = common.BytesToHash(b)
+	}
+	if params.Limit, params.Offset, err = paging(args.Limit, args.Offset); err != nil {
+		return nil, err
+	}
+
+	events, err := l.cli.GetContractEvents(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+	resolvers := make([]*contractEventResolver, len(events))
+	for i, ce := range events {
+		resolvers[i] = &contractEventResolver{ce: ce}
+	}
+	return resolvers, nil
+}
+
+type systemEventsArgs struct {
+	Kind        *string
+	Address     *string
+	ValidatorID *string
+	SenderID    *string
+	RecipientID *string
+	After       *int32
+	AtHeight    *int32
+	AtTime      *graphql.Time
+	Limit       *int32
+	Offset      *int32
+}
+
+func (q *queryResolver) SystemEvents(ctx context.Context, args systemEventsArgs) ([]*systemEventResolver, error) {
+	l := loadersFrom(ctx)
+	height, err := l.height(ctx, args.AtHeight, args.AtTime)
+	if err != nil {
+		return nil, err
+	}
+	params := structs.SystemEventParams{
+		ValidatorID: str(args.ValidatorID),
+		AtHeight:    height,
+	}
+	if args.Kind != nil {
+		if params.Kind, err = systemEventKind(*args.Kind); err != nil {
+			return nil, err
+		}
+	}
+	if params.Address, err = address("address", args.Address); err != nil {
+		return nil, err
+	}
+	if args.SenderID != nil {
+		if params.SenderID, err = strconv.ParseUint(*args.SenderID, 10, 64); err != nil {
+			return nil, errors.New("error parsing 'senderId' argument")
+		}
+	}
+	if args.RecipientID != nil {
+		if params.ReceiverID, err = strconv.ParseUint(*args.RecipientID, 10, 64); err != nil {
+			return nil, errors.New("error parsing 'recipientId' argument")
+		}
+	}
+	if params.After, err = unsigned("after", args.After); err != nil {
+		return nil, err
+	}
+	if params.Limit, params.Offset, err = paging(args.Limit, args.Offset); err != nil {
+		return nil, err
+	}
+
+	events, err := l.cli.GetSystemEvents(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+	resolvers := make([]*systemEventResolver, len(events))
+	for i, se := range events {
+		resolvers[i] = &systemEventResolver{se: se}
+	}
+	return resolvers, nil
+}
+
+type validatorStatisticsArgs struct {
+	ValidatorID *string
+	Type        *string
+	AtHeight    *int32
+	AtTime      *graphql.Time
+	Limit       *int32
+	Offset      *int32
+}
+
+func (q *queryResolver) ValidatorStatistics(ctx context.Context, args validatorStatisticsArgs) ([]*statisticResolver, error) {
+	l := loadersFrom(ctx)
+	height, err := l.height(ctx, args.AtHeight, args.AtTime)
+	if err != nil {
+		return nil, err
+	}
+	params := structs.ValidatorStatisticsParams{
+		ValidatorID: str(args.ValidatorID),
+		AtHeight:    height,
+	}
+	if params.Type, err = statisticType(args.Type); err != nil {
+		return nil, err
+	}
+	if params.Limit, params.Offset, err = paging(args.Limit, args.Offset); err != nil {
+		return nil, err
+	}
+
+	stats, err := l.cli.GetValidatorStatistics(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+	return newStatistics(l, height, stats), nil
+}
+
+// height returns the block height the state is requested at, zero for the current state.
+// Time is resolved to the last block produced at or before it
+func (l *loaders) height(ctx context.Context, atHeight *int32, atTime *graphql.Time) (uint64, error) {
+	height, err := unsigned("atHeight", atHeight)
+	if err != nil || atTime == nil {
+		return height, err
+	}
+	if atHeight != nil {
+		return 0, errors.New("only one of 'atHeight' and 'atTime' arguments can be sent")
+	}
+
+	b, err := l.cli.GetBlockAtTime(ctx, atTime.Time)
+	if err != nil {
+		if err == structs.ErrNotFound {
+			return 0, errors.New("no block found at 'atTime'")
+		}
+		return 0, err
+	}
+	return b.Number, nil
+}
+
+func paging(limit, offset *int32) (l, o uint64, err error) {
+	if l, err = unsigned("limit", limit); err != nil {
+		return 0, 0, err
+	}
+	if o, err = unsigned("offset", offset); err != nil {
+		return 0, 0, err
+	}
+	return l, o, nil
+}
+
+func unsigned(name string, v *int32) (uint64, error) {
+	if v == nil {
+		return 0, nil
+	}
+	if *v < 0 {
+		return 0, errors.New("'" + name + "' argument can't be negative")
+	}
+	return uint64(*v), nil
+}
+
+func str(s *string) string {
+	if s == nil {
+		return ""
+	}
+	return *s
+}
+
+func address(name string, s *string) (string, error) {
+	if s == nil {
+		return "", nil
+	}
+	if !common.IsHexAddress(*s) {
+		return "", errors.New("error parsing '" + name + "' argument")
+	}
+	return *s, nil
+}
+
+func nodeStatus(s *string) (string, error) {
+	if s == nil {
+		return "", nil
+	}
+	if _, ok := structs.GetTypeForNode(*s); !ok {
+		return "", errors.New("unknown node status: " + *s)
+	}
+	return *s, nil
+}
+
+func delegationStates(states *[]string) (parsed []structs.DelegationState, err error) {
+	if states == nil {
+		return nil, nil
+	}
+	for _, s := range *states {
+		st := structs.DelegationStateFromString(s)
+		if st == structs.DelegationStateUNKNOWN {
+			return nil, errors.New("unknown delegation state: " + s)
+		}
+		parsed = append(parsed, st)
+	}
+	return parsed, nil
+}
+
+func statisticType(s *string) (structs.StatisticTypeVS, error) {
+	if s == nil {
+		return 0, nil
+	}
+	t, ok := structs.StatisticTypes[*s]
+	if !ok {
+		return 0, errors.New("unknown statistic type: " + *s)
+	}
+	return t, nil
+}
+
+// systemEventKind returns the number of system event kind the store filters by
+func systemEventKind(name string) (string, error) {
+	for k, n := range structs.SysEvtTypes {
+		if n == name {
+			return strconv.FormatUint(uint64(k), 10), nil
+		}
+	}
+	return "", errors.New("unknown system event kind: " + name)
+}
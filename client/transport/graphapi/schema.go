@@ -0,0 +1,123 @@
+package graphapi
+
+// schema is the GraphQL schema of indexed records. Arguments of the lists match the parameters of REST calls.
+// Relations are resolved at the same point in time as the list they're requested on, big numbers are decimal strings
+const schema = `
+schema {
+	query: Query
+}
+
+scalar Time
+
+type Query {
+	validators(id: String, address: String, authorized: Boolean, orderBy: String, orderDirection: String, atHeight: Int, atTime: Time, limit: Int, offset: Int): [Validator!]!
+	validator(id: String!, atHeight: Int, atTime: Time): Validator
+	nodes(id: String, validatorId: String, status: String, address: String, atHeight: Int, atTime: Time, limit: Int, offset: Int): [Node!]!
+	delegations(id: String, validatorId: String, holder: String, states: [String!], atHeight: Int, atTime: Time, limit: Int, offset: Int): [Delegation!]!
+	accounts(type: String, address: String, limit: Int, offset: Int): [Account!]!
+	contractEvents(type: String, id: String, contractName: String, eventName: String, params: [EventParam!], heightFrom: Int, heightTo: Int, from: Time, to: Time, boundAddress: String, transactionHash: String, atHeight: Int, atTime: Time, limit: Int, offset: Int): [ContractEvent!]!
+	systemEvents(kind: String, address: String, validatorId: String, senderId: String, recipientId: String, after: Int, atHeight: Int, atTime: Time, limit: Int, offset: Int): [SystemEvent!]!
+	validatorStatistics(validatorId: String, type: String, atHeight: Int, atTime: Time, limit: Int, offset: Int): [ValidatorStatistic!]!
+}
+
+input EventParam {
+	name: String!
+	value: String!
+}
+
+type Validator {
+	id: String!
+	name: String!
+	description: String!
+	validatorAddress: String!
+	requestedAddress: String!
+	feeRate: String!
+	registrationTime: Time!
+	minimumDelegationAmount: String!
+	acceptNewRequests: Boolean!
+	authorized: Boolean!
+	activeNodes: Int!
+	linkedNodes: Int!
+	staked: String!
+	blockHeight: Int!
+	nodes(status: String): [Node!]!
+	delegations(states: [String!]): [Delegation!]!
+	statistics(type: String): [ValidatorStatistic!]!
+}
+
+type Node {
+	id: String!
+	name: String!
+	address: String!
+	ip: String!
+	publicIp: String!
+	port: Int!
+	startBlock: String!
+	nextRewardDate: Time!
+	lastRewardDate: Time!
+	finishTime: String!
+	status: String!
+	validatorId: String!
+	blockHeight: Int!
+	validator: Validator
+}
+
+type Delegation {
+	id: String!
+	holder: String!
+	validatorId: String!
+	validatorName: String!
+	blockHeight: Int!
+	transactionHash: String!
+	amount: String!
+	delegationPeriod: String!
+	created: Time!
+	started: String!
+	finished: String!
+	info: String!
+	state: String!
+	validator: Validator
+}
+
+type Account {
+	address: String!
+	type: String!
+	delegations(states: [String!]): [Delegation!]!
+	validator: Validator
+}
+
+type ContractEvent {
+	id: String!
+	contractName: String!
+	eventName: String!
+	contractAddress: String!
+	blockHeight: Int!
+	time: Time!
+	transactionHash: String!
+	removed: Boolean!
+	params: String!
+}
+
+type SystemEvent {
+	id: String!
+	height: Int!
+	time: Time!
+	kind: String!
+	senderId: String!
+	recipientId: String!
+	sender: String!
+	recipient: String!
+	before: String!
+	after: String!
+	change: String!
+}
+
+type ValidatorStatistic {
+	validatorId: String!
+	type: String!
+	amount: String!
+	blockHeight: Int!
+	time: Time!
+	validator: Validator
+}
+`
@@ -0,0 +1,277 @@
+package graphapi
+
+import (
+	"context"
+	"encoding/json"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+	graphql "github.com/graph-gophers/graphql-go"
+
+	"github.com/figment-networks/skale-indexer/scraper/structs"
+)
+
+type validatorResolver struct {
+	l      *loaders
+	b      *batch
+	height uint64
+	v      structs.Validator
+}
+
+// newValidators creates resolvers of the validators sharing the batch their relations are loaded with
+func newValidators(l *loaders, height uint64, vs []structs.Validator) []*validatorResolver {
+	keys := make([]string, len(vs))
+	for i, v := range vs {
+		keys[i] = v.ValidatorID.String()
+	}
+	b := newBatch(keys)
+
+	resolvers := make([]*validatorResolver, len(vs))
+	for i, v := range vs {
+		resolvers[i] = &validatorResolver{l: l, b: b, height: height, v: v}
+	}
+	return resolvers
+}
+
+func (r *validatorResolver) ID() string               { return bigString(r.v.ValidatorID) }
+func (r *validatorResolver) Name() string             { return r.v.Name }
+func (r *validatorResolver) Description() string      { return r.v.Description }
+func (r *validatorResolver) ValidatorAddress() string { return r.v.ValidatorAddress.Hex() }
+func (r *validatorResolver) RequestedAddress() string { return r.v.RequestedAddress.Hex() }
+func (r *validatorResolver) FeeRate() string          { return bigString(r.v.FeeRate) }
+func (r *validatorResolver) RegistrationTime() graphql.Time {
+	return graphql.Time{Time: r.v.RegistrationTime}
+}
+func (r *validatorResolver) MinimumDelegationAmount() string {
+	return bigString(r.v.MinimumDelegationAmount)
+}
+func (r *validatorResolver) AcceptNewRequests() bool { return r.v.AcceptNewRequests }
+func (r *validatorResolver) Authorized() bool        { return r.v.Authorized }
+func (r *validatorResolver) ActiveNodes() int32      { return int32(r.v.ActiveNodes) }
+func (r *validatorResolver) LinkedNodes() int32      { return int32(r.v.LinkedNodes) }
+func (r *validatorResolver) Staked() string          { return bigString(r.v.Staked) }
+func (r *validatorResolver) BlockHeight() int32      { return int32(r.v.BlockHeight) }
+
+func (r *validatorResolver) Nodes(ctx context.Context, args struct{ Status *string }) ([]*nodeResolver, error) {
+	status, err := nodeStatus(args.Status)
+	if err != nil {
+		return nil, err
+	}
+	grouped, err := r.l.nodes(ctx, r.b, r.height, status)
+	if err != nil {
+		return nil, err
+	}
+	return newNodes(r.l, r.height, grouped[r.ID()]), nil
+}
+
+func (r *validatorResolver) Delegations(ctx context.Context, args struct{ States *[]string }) ([]*delegationResolver, error) {
+	states, err := delegationStates(args.States)
+	if err != nil {
+		return nil, err
+	}
+	grouped, err := r.l.delegations(ctx, r.b, r.height, states, false)
+	if err != nil {
+		return nil, err
+	}
+	return newDelegations(r.l, r.height, grouped[r.ID()]), nil
+}
+
+func (r *validatorResolver) Statistics(ctx context.Context, args struct{ Type *string }) ([]*statisticResolver, error) {
+	statType, err := statisticType(args.Type)
+	if err != nil {
+		return nil, err
+	}
+	grouped, err := r.l.statistics(ctx, r.b, r.height, statType)
+	if err != nil {
+		return nil, err
+	}
+	return newStatistics(r.l, r.height, grouped[r.ID()]), nil
+}
+
+type nodeResolver struct {
+	l      *loaders
+	height uint64
+	n      structs.Node
+}
+
+func newNodes(l *loaders, height uint64, nodes []structs.Node) []*nodeResolver {
+	resolvers := make([]*nodeResolver, len(nodes))
+	for i, n := range nodes {
+		resolvers[i] = &nodeResolver{l: l, height: height, n: n}
+	}
+	return resolvers
+}
+
+func (r *nodeResolver) ID() string                   { return bigString(r.n.NodeID) }
+func (r *nodeResolver) Name() string                 { return r.n.Name }
+func (r *nodeResolver) Address() string              { return r.n.Address.Hex() }
+func (r *nodeResolver) IP() string                   { return r.n.IP.String() }
+func (r *nodeResolver) PublicIP() string             { return r.n.PublicIP.String() }
+func (r *nodeResolver) Port() int32                  { return int32(r.n.Port) }
+func (r *nodeResolver) StartBlock() string           { return bigString(r.n.StartBlock) }
+func (r *nodeResolver) NextRewardDate() graphql.Time { return graphql.Time{Time: r.n.NextRewardDate} }
+func (r *nodeResolver) LastRewardDate() graphql.Time { return graphql.Time{Time: r.n.LastRewardDate} }
+func (r *nodeResolver) FinishTime() string           { return bigString(r.n.FinishTime) }
+func (r *nodeResolver) Status() string               { return r.n.Status.String() }
+func (r *nodeResolver) ValidatorID() string          { return bigString(r.n.ValidatorID) }
+func (r *nodeResolver) BlockHeight() int32           { return int32(r.n.BlockHeight) }
+
+func (r *nodeResolver) Validator(ctx context.Context) (*validatorResolver, error) {
+	return r.l.validator(ctx, r.ValidatorID(), r.height)
+}
+
+type delegationResolver struct {
+	l      *loaders
+	height uint64
+	d      structs.Delegation
+}
+
+func newDelegations(l *loaders, height uint64, delegations []structs.Delegation) []*delegationResolver {
+	resolvers := make([]*delegationResolver, len(delegations))
+	for i, d := range delegations {
+		resolvers[i] = &delegationResolver{l: l, height: height, d: d}
+	}
+	return resolvers
+}
+
+func (r *delegationResolver) ID() string               { return bigString(r.d.DelegationID) }
+func (r *delegationResolver) Holder() string           { return r.d.Holder.Hex() }
+func (r *delegationResolver) ValidatorID() string      { return bigString(r.d.ValidatorID) }
+func (r *delegationResolver) ValidatorName() string    { return r.d.ValidatorName }
+func (r *delegationResolver) BlockHeight() int32       { return int32(r.d.BlockHeight) }
+func (r *delegationResolver) TransactionHash() string  { return r.d.TransactionHash.Hex() }
+func (r *delegationResolver) Amount() string           { return bigString(r.d.Amount) }
+func (r *delegationResolver) DelegationPeriod() string { return bigString(r.d.DelegationPeriod) }
+func (r *delegationResolver) Created() graphql.Time    { return graphql.Time{Time: r.d.Created} }
+func (r *delegationResolver) Started() string          { return bigString(r.d.Started) }
+func (r *delegationResolver) Finished() string         { return bigString(r.d.Finished) }
+func (r *delegationResolver) Info() string             { return r.d.Info }
+func (r *delegationResolver) State() string            { return r.d.State.String() }
+
+func (r *delegationResolver) Validator(ctx context.Context) (*validatorResolver, error) {
+	return r.l.validator(ctx, r.ValidatorID(), r.height)
+}
+
+type accountResolver struct {
+	l *loaders
+	b *batch
+	a structs.Account
+}
+
+func newAccounts(l *loaders, accounts []structs.Account) []*accountResolver {
+	keys := make([]string, len(accounts))
+	for i, a := range accounts {
+		keys[i] = a.Address.Hex()
+	}
+	b := newBatch(keys)
+
+	resolvers := make([]*accountResolver, len(accounts))
+	for i, a := range accounts {
+		resolvers[i] = &accountResolver{l: l, b: b, a: a}
+	}
+	return resolvers
+}
+
+func (r *accountResolver) Address() string { return r.a.Address.Hex() }
+func (r *accountResolver) Type() string    { return string(r.a.Type) }
+
+func (r *accountResolver) Delegations(ctx context.Context, args struct{ States *[]string }) ([]*delegationResolver, error) {
+	states, err := delegationStates(args.States)
+	if err != nil {
+		return nil, err
+	}
+	grouped, err := r.l.delegations(ctx, r.b, 0, states, true)
+	if err != nil {
+		return nil, err
+	}
+	return newDelegations(r.l, 0, grouped[r.Address()]), nil
+}
+
+// Validator returns the validator account is the address of
+func (r *accountResolver) Validator(ctx context.Context) (*validatorResolver, error) {
+	byID, err := r.l.validatorsAt(ctx, 0)
+	if err != nil {
+		return nil, err
+	}
+	for _, v := range byID {
+		if v.v.ValidatorAddress == r.a.Address {
+			return v, nil
+		}
+	}
+	return nil, nil
+}
+
+type contractEventResolver struct {
+	ce structs.ContractEvent
+}
+
+func (r *contractEventResolver) ID() string              { return r.ce.ID.String() }
+func (r *contractEventResolver) ContractName() string    { return r.ce.ContractName }
+func (r *contractEventResolver) EventName() string       { return r.ce.EventName }
+func (r *contractEventResolver) ContractAddress() string { return r.ce.ContractAddress.Hex() }
+func (r *contractEventResolver) BlockHeight() int32      { return int32(r.ce.BlockHeight) }
+func (r *contractEventResolver) Time() graphql.Time      { return graphql.Time{Time: r.ce.Time} }
+func (r *contractEventResolver) TransactionHash() string { return r.ce.TransactionHash.Hex() }
+func (r *contractEventResolver) Removed() bool           { return r.ce.Removed }
+
+// Params returns decoded parameters of event as JSON object
+func (r *contractEventResolver) Params() (string, error) {
+	b, err := json.Marshal(structs.FloatEventParams(r.ce.Params))
+	return string(b), err
+}
+
+type systemEventResolver struct {
+	se structs.SystemEvent
+}
+
+func (r *systemEventResolver) ID() string          { return r.se.ID }
+func (r *systemEventResolver) Height() int32       { return int32(r.se.Height) }
+func (r *systemEventResolver) Time() graphql.Time  { return graphql.Time{Time: r.se.Time} }
+func (r *systemEventResolver) Kind() string        { return structs.SysEvtTypes[r.se.Kind] }
+func (r *systemEventResolver) SenderID() string    { return r.se.SenderID.String() }
+func (r *systemEventResolver) RecipientID() string { return r.se.RecipientID.String() }
+func (r *systemEventResolver) Sender() string      { return r.se.Sender.Hex() }
+func (r *systemEventResolver) Recipient() string   { return r.se.Recipient.Hex() }
+func (r *systemEventResolver) Before() string      { return r.se.Before.String() }
+func (r *systemEventResolver) After() string       { return r.se.After.String() }
+func (r *systemEventResolver) Change() string      { return r.se.Change.String() }
+
+type statisticResolver struct {
+	l      *loaders
+	height uint64
+	vs     structs.ValidatorStatistics
+}
+
+func newStatistics(l *loaders, height uint64, stats []structs.ValidatorStatistics) []*statisticResolver {
+	resolvers := make([]*statisticResolver, len(stats))
+	for i, vs := range stats {
+		resolvers[i] = &statisticResolver{l: l, height: height, vs: vs}
+	}
+	return resolvers
+}
+
+func (r *statisticResolver) ValidatorID() string { return bigString(r.vs.ValidatorID) }
+func (r *statisticResolver) Type() string        { return r.vs.Type.String() }
+func (r *statisticResolver) Amount() string      { return statisticAmount(r.vs) }
+func (r *statisticResolver) BlockHeight() int32  { return int32(r.vs.BlockHeight) }
+func (r *statisticResolver) Time() graphql.Time  { return graphql.Time{Time: r.vs.Time} }
+
+func (r *statisticResolver) Validator(ctx context.Context) (*validatorResolver, error) {
+	return r.l.validator(ctx, r.ValidatorID(), r.height)
+}
+
+// statisticAmount formats address statistics as address, the rest as number
+func statisticAmount(vs structs.ValidatorStatistics) string {
+	switch vs.Type {
+	case structs.ValidatorStatisticsTypeValidatorAddress, structs.ValidatorStatisticsTypeRequestedAddress:
+		return common.BigToAddress(vs.Amount).Hex()
+	}
+	return bigString(vs.Amount)
+}
+
+func bigString(i *big.Int) string {
+	if i == nil {
+		return "0"
+	}
+	return i.String()
+}
@@ -0,0 +1,187 @@
+package grpcapi
+
+import (
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/figment-networks/skale-indexer/client/transport/grpcapi/indexerpb"
+	"github.com/figment-networks/skale-indexer/scraper/structs"
+)
+
+func toValidator(v structs.Validator) *indexerpb.Validator {
+	return &indexerpb.Validator{
+		Id:                      bigString(v.ValidatorID),
+		Name:                    v.Name,
+		Description:             v.Description,
+		ValidatorAddress:        v.ValidatorAddress.Hex(),
+		RequestedAddress:        v.RequestedAddress.Hex(),
+		FeeRate:                 bigString(v.FeeRate),
+		RegistrationTime:        toTimestamp(v.RegistrationTime),
+		MinimumDelegationAmount: bigString(v.MinimumDelegationAmount),
+		AcceptNewRequests:       v.AcceptNewRequests,
+		Authorized:              v.Authorized,
+		ActiveNodes:             uint32(v.ActiveNodes),
+		LinkedNodes:             uint32(v.LinkedNodes),
+		Staked:                  bigString(v.Staked),
+		BlockHeight:             v.BlockHeight,
+	}
+}
+
+func toDelegation(d structs.Delegation) *indexerpb.Delegation {
+	return &indexerpb.Delegation{
+		Id:               bigString(d.DelegationID),
+		Holder:           d.Holder.Hex(),
+		ValidatorId:      bigString(d.ValidatorID),
+		ValidatorName:    d.ValidatorName,
+		BlockHeight:      d.BlockHeight,
+		TransactionHash:  d.TransactionHash.Hex(),
+		Amount:           bigString(d.Amount),
+		DelegationPeriod: bigString(d.DelegationPeriod),
+		Created:          toTimestamp(d.Created),
+		Started:          bigString(d.Started),
+		Finished:         bigString(d.Finished),
+		Info:             d.Info,
+		State:            toDelegationState(d.State),
+	}
+}
+
+// toDelegationState maps delegation state onto the enum shifted by unspecified value
+func toDelegationState(s structs.DelegationState) indexerpb.DelegationState {
+	if s > structs.DelegationStateCOMPLETED {
+		return indexerpb.DelegationState_DELEGATION_STATE_UNSPECIFIED
+	}
+	return indexerpb.DelegationState(s + 1)
+}
+
+func fromDelegationState(s indexerpb.DelegationState) structs.DelegationState {
+	return structs.DelegationState(s - 1)
+}
+
+func toNode(n structs.Node) *indexerpb.Node {
+	return &indexerpb.Node{
+		Id:             bigString(n.NodeID),
+		Name:           n.Name,
+		Address:        n.Address.Hex(),
+		Ip:             n.IP.String(),
+		PublicIp:       n.PublicIP.String(),
+		Port:           uint32(n.Port),
+		StartBlock:     bigString(n.StartBlock),
+		NextRewardDate: toTimestamp(n.NextRewardDate),
+		LastRewardDate: toTimestamp(n.LastRewardDate),
+		FinishTime:     bigString(n.FinishTime),
+		Status:         toNodeStatus(n.Status),
+		ValidatorId:    bigString(n.ValidatorID),
+		BlockHeight:    n.BlockHeight,
+	}
+}
+
+// toNodeStatus maps node status onto the enum shifted by unspecified value
+func toNodeStatus(s structs.NodeStatus) indexerpb.NodeStatus {
+	if s > structs.NodeStatusInMaintenance {
+		return indexerpb.NodeStatus_NODE_STATUS_UNSPECIFIED
+	}
+	return indexerpb.NodeStatus(s + 1)
+}
+
+func fromNodeStatus(s indexerpb.NodeStatus) structs.NodeStatus {
+	return structs.NodeStatus(s - 1)
+}
+
+func toValidatorStatistic(vs structs.ValidatorStatistics) *indexerpb.ValidatorStatistic {
+	st := &indexerpb.ValidatorStatistic{
+		ValidatorId: bigString(vs.ValidatorID),
+		Type:        indexerpb.StatisticType(vs.Type),
+		Amount:      bigString(vs.Amount),
+		BlockHeight: vs.BlockHeight,
+		Time:        toTimestamp(vs.Time),
+	}
+	// addresses recorded as statistics before validator address history was introduced
+	if vs.Type == structs.ValidatorStatisticsTypeValidatorAddress || vs.Type == structs.ValidatorStatisticsTypeRequestedAddress {
+		st.Amount = common.BigToAddress(vs.Amount).Hex()
+	}
+	return st
+}
+
+func toSystemEvent(se structs.SystemEvent) *indexerpb.SystemEvent {
+	return &indexerpb.SystemEvent{
+		Id:          se.ID,
+		Height:      se.Height,
+		Time:        toTimestamp(se.Time),
+		Kind:        indexerpb.SystemEventKind(se.Kind),
+		SenderId:    se.SenderID.String(),
+		RecipientId: se.RecipientID.String(),
+		Sender:      se.Sender.Hex(),
+		Recipient:   se.Recipient.Hex(),
+		Before:      se.Before.String(),
+		After:       se.After.String(),
+		Change:      se.Change.Text('f', -1),
+	}
+}
+
+func toContractEvent(ce structs.ContractEvent) (*indexerpb.ContractEvent, error) {
+	e := &indexerpb.ContractEvent{
+		Id:              ce.ID.String(),
+		ContractName:    ce.ContractName,
+		EventName:       ce.EventName,
+		ContractAddress: ce.ContractAddress.Hex(),
+		BlockHeight:     ce.BlockHeight,
+		Time:            toTimestamp(ce.Time),
+		TransactionHash: ce.TransactionHash.Hex(),
+		Removed:         ce.Removed,
+		Params:          make(map[string]string, len(ce.Params)),
+	}
+	for k, v := range ce.Params {
+		text, err := paramText(v)
+		if err != nil {
+			return nil, fmt.Errorf("param %s: %w", k, err)
+		}
+		e.Params[k] = text
+	}
+	return e, nil
+}
+
+// paramText formats decoded event parameter as text, lists and other structured values as JSON
+func paramText(v interface{}) (string, error) {
+	switch p := v.(type) {
+	case string:
+		return p, nil
+	case json.Number:
+		return p.String(), nil
+	case *big.Int:
+		return p.String(), nil
+	case bool:
+		if p {
+			return "true", nil
+		}
+		return "false", nil
+	}
+	b, err := json.Marshal(v)
+	return string(b), err
+}
+
+func bigString(i *big.Int) string {
+	if i == nil {
+		return "0"
+	}
+	return i.String()
+}
+
+// toTimestamp converts time, leaving zero time unset
+func toTimestamp(t time.Time) *timestamppb.Timestamp {
+	if t.IsZero() {
+		return nil
+	}
+	return timestamppb.New(t)
+}
+
+func fromTimestamp(ts *timestamppb.Timestamp) time.Time {
+	if ts == nil {
+		return time.Time{}
+	}
+	return ts.AsTime()
+}
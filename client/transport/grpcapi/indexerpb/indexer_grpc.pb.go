@@ -0,0 +1,313 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+
+package indexerpb
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion7
+
+// IndexerClient is the client API for Indexer service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type IndexerClient interface {
+	GetValidators(ctx context.Context, in *GetValidatorsRequest, opts ...grpc.CallOption) (*GetValidatorsResponse, error)
+	GetDelegations(ctx context.Context, in *GetDelegationsRequest, opts ...grpc.CallOption) (*GetDelegationsResponse, error)
+	GetTypesSummaryDelegations(ctx context.Context, in *GetTypesSummaryDelegationsRequest, opts ...grpc.CallOption) (*GetTypesSummaryDelegationsResponse, error)
+	GetNodes(ctx context.Context, in *GetNodesRequest, opts ...grpc.CallOption) (*GetNodesResponse, error)
+	GetValidatorStatistics(ctx context.Context, in *GetValidatorStatisticsRequest, opts ...grpc.CallOption) (*GetValidatorStatisticsResponse, error)
+	GetSystemEvents(ctx context.Context, in *GetSystemEventsRequest, opts ...grpc.CallOption) (*GetSystemEventsResponse, error)
+	GetContractEvents(ctx context.Context, in *GetContractEventsRequest, opts ...grpc.CallOption) (*GetContractEventsResponse, error)
+}
+
+type indexerClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewIndexerClient(cc grpc.ClientConnInterface) IndexerClient {
+	return &indexerClient{cc}
+}
+
+func (c *indexerClient) GetValidators(ctx context.Context, in *GetValidatorsRequest, opts ...grpc.CallOption) (*GetValidatorsResponse, error) {
+	out := new(GetValidatorsResponse)
+	err := c.cc.Invoke(ctx, "/skale.indexer.v1.Indexer/GetValidators", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *indexerClient) GetDelegations(ctx context.Context, in *GetDelegationsRequest, opts ...grpc.CallOption) (*GetDelegationsResponse, error) {
+	out := new(GetDelegationsResponse)
+	err := c.cc.Invoke(ctx, "/skale.indexer.v1.Indexer/GetDelegations", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *indexerClient) GetTypesSummaryDelegations(ctx context.Context, in *GetTypesSummaryDelegationsRequest, opts ...grpc.CallOption) (*GetTypesSummaryDelegationsResponse, error) {
+	out := new(GetTypesSummaryDelegationsResponse)
+	err := c.cc.Invoke(ctx, "/skale.indexer.v1.Indexer/GetTypesSummaryDelegations", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *indexerClient) GetNodes(ctx context.Context, in *GetNodesRequest, opts ...grpc.CallOption) (*GetNodesResponse, error) {
+	out := new(GetNodesResponse)
+	err := c.cc.Invoke(ctx, "/skale.indexer.v1.Indexer/GetNodes", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *indexerClient) GetValidatorStatistics(ctx context.Context, in *GetValidatorStatisticsRequest, opts ...grpc.CallOption) (*GetValidatorStatisticsResponse, error) {
+	out := new(GetValidatorStatisticsResponse)
+	err := c.cc.Invoke(ctx, "/skale.indexer.v1.Indexer/GetValidatorStatistics", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *indexerClient) GetSystemEvents(ctx context.Context, in *GetSystemEventsRequest, opts ...grpc.CallOption) (*GetSystemEventsResponse, error) {
+	out := new(GetSystemEventsResponse)
+	err := c.cc.Invoke(ctx, "/skale.indexer.v1.Indexer/GetSystemEvents", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *indexerClient) GetContractEvents(ctx context.Context, in *GetContractEventsRequest, opts ...grpc.CallOption) (*GetContractEventsResponse, error) {
+	out := new(GetContractEventsResponse)
+	err := c.cc.Invoke(ctx, "/skale.indexer.v1.Indexer/GetContractEvents", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// IndexerServer is the server API for Indexer service.
+// All implementations must embed UnimplementedIndexerServer
+// for forward compatibility
+type IndexerServer interface {
+	GetValidators(context.Context, *GetValidatorsRequest) (*GetValidatorsResponse, error)
+	GetDelegations(context.Context, *GetDelegationsRequest) (*GetDelegationsResponse, error)
+	GetTypesSummaryDelegations(context.Context, *GetTypesSummaryDelegationsRequest) (*GetTypesSummaryDelegationsResponse, error)
+	GetNodes(context.Context, *GetNodesRequest) (*GetNodesResponse, error)
+	GetValidatorStatistics(context.Context, *GetValidatorStatisticsRequest) (*GetValidatorStatisticsResponse, error)
+	GetSystemEvents(context.Context, *GetSystemEventsRequest) (*GetSystemEventsResponse, error)
+	GetContractEvents(context.Context, *GetContractEventsRequest) (*GetContractEventsResponse, error)
+	mustEmbedUnimplementedIndexerServer()
+}
+
+// UnimplementedIndexerServer must be embedded to have forward compatible implementations.
+type UnimplementedIndexerServer struct {
+}
+
+func (UnimplementedIndexerServer) GetValidators(context.Context, *GetValidatorsRequest) (*GetValidatorsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetValidators not implemented")
+}
+func (UnimplementedIndexerServer) GetDelegations(context.Context, *GetDelegationsRequest) (*GetDelegationsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetDelegations not implemented")
+}
+func (UnimplementedIndexerServer) GetTypesSummaryDelegations(context.Context, *GetTypesSummaryDelegationsRequest) (*GetTypesSummaryDelegationsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTypesSummaryDelegations not implemented")
+}
+func (UnimplementedIndexerServer) GetNodes(context.Context, *GetNodesRequest) (*GetNodesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetNodes not implemented")
+}
+func (UnimplementedIndexerServer) GetValidatorStatistics(context.Context, *GetValidatorStatisticsRequest) (*GetValidatorStatisticsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetValidatorStatistics not implemented")
+}
+func (UnimplementedIndexerServer) GetSystemEvents(context.Context, *GetSystemEventsRequest) (*GetSystemEventsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetSystemEvents not implemented")
+}
+func (UnimplementedIndexerServer) GetContractEvents(context.Context, *GetContractEventsRequest) (*GetContractEventsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetContractEvents not implemented")
+}
+func (UnimplementedIndexerServer) mustEmbedUnimplementedIndexerServer() {}
+
+// UnsafeIndexerServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to IndexerServer will
+// result in compilation errors.
+type UnsafeIndexerServer interface {
+	mustEmbedUnimplementedIndexerServer()
+}
+
+func RegisterIndexerServer(s grpc.ServiceRegistrar, srv IndexerServer) {
+	s.RegisterService(&_Indexer_serviceDesc, srv)
+}
+
+func _Indexer_GetValidators_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetValidatorsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(IndexerServer).GetValidators(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/skale.indexer.v1.Indexer/GetValidators",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(IndexerServer).GetValidators(ctx, req.(*GetValidatorsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Indexer_GetDelegations_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetDelegationsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(IndexerServer).GetDelegations(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/skale.indexer.v1.Indexer/GetDelegations",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(IndexerServer).GetDelegations(ctx, req.(*GetDelegationsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Indexer_GetTypesSummaryDelegations_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetTypesSummaryDelegationsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(IndexerServer).GetTypesSummaryDelegations(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/skale.indexer.v1.Indexer/GetTypesSummaryDelegations",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(IndexerServer).GetTypesSummaryDelegations(ctx, req.(*GetTypesSummaryDelegationsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Indexer_GetNodes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetNodesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(IndexerServer).GetNodes(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/skale.indexer.v1.Indexer/GetNodes",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(IndexerServer).GetNodes(ctx, req.(*GetNodesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Indexer_GetValidatorStatistics_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetValidatorStatisticsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(IndexerServer).GetValidatorStatistics(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/skale.indexer.v1.Indexer/GetValidatorStatistics",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(IndexerServer).GetValidatorStatistics(ctx, req.(*GetValidatorStatisticsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Indexer_GetSystemEvents_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetSystemEventsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(IndexerServer).GetSystemEvents(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/skale.indexer.v1.Indexer/GetSystemEvents",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(IndexerServer).GetSystemEvents(ctx, req.(*GetSystemEventsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Indexer_GetContractEvents_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetContractEventsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(IndexerServer).GetContractEvents(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/skale.indexer.v1.Indexer/GetContractEvents",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(IndexerServer).GetContractEvents(ctx, req.(*GetContractEventsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Indexer_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "skale.indexer.v1.Indexer",
+	HandlerType: (*IndexerServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GetValidators",
+			Handler:    _Indexer_GetValidators_Handler,
+		},
+		{
+			MethodName: "GetDelegations",
+			Handler:    _Indexer_GetDelegations_Handler,
+		},
+		{
+			MethodName: "GetTypesSummaryDelegations",
+			Handler:    _Indexer_GetTypesSummaryDelegations_Handler,
+		},
+		{
+			MethodName: "GetNodes",
+			Handler:    _Indexer_GetNodes_Handler,
+		},
+		{
+			MethodName: "GetValidatorStatistics",
+			Handler:    _Indexer_GetValidatorStatistics_Handler,
+		},
+		{
+			MethodName: "GetSystemEvents",
+			Handler:    _Indexer_GetSystemEvents_Handler,
+		},
+		{
+			MethodName: "GetContractEvents",
+			Handler:    _Indexer_GetContractEvents_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "client/transport/grpcapi/indexerpb/indexer.proto",
+}
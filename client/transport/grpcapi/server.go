@@ -0,0 +1,320 @@
+// Package grpcapi serves the indexer queries over gRPC, with the definitions of messages in indexerpb
+package grpcapi
+
+import (
+	"context"
+	"errors"
+	"strconv"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"go.uber.org/zap"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/figment-networks/skale-indexer/client/transport/grpcapi/indexerpb"
+	"github.com/figment-networks/skale-indexer/scraper/structs"
+)
+
+// ClientContractor - method signatures for Server
+type ClientContractor interface {
+	GetNodes(ctx context.Context, params structs.NodeParams) (nodes []structs.Node, err error)
+	GetValidators(ctx context.Context, params structs.ValidatorParams) (validators []structs.Validator, err error)
+	GetDelegations(ctx context.Context, params structs.DelegationParams) (delegations []structs.Delegation, err error)
+	GetTypesSummaryDelegations(ctx context.Context, params structs.DelegationParams) (delegations []structs.DelegationSummary, err error)
+	GetValidatorStatistics(ctx context.Context, params structs.ValidatorStatisticsParams) (validatorStatistics []structs.ValidatorStatistics, err error)
+	GetContractEvents(ctx context.Context, params structs.EventParams) (contractEvents []structs.ContractEvent, err error)
+	GetSystemEvents(ctx context.Context, params structs.SystemEventParams) (systemEvents []structs.SystemEvent, err error)
+	GetBlockAtTime(ctx context.Context, t time.Time) (block structs.Block, err error)
+}
+
+// Server implements indexer gRPC service
+type Server struct {
+	indexerpb.UnimplementedIndexerServer
+
+	log *zap.Logger
+	cli ClientContractor
+}
+
+// NewServer is Server constructor
+func NewServer(log *zap.Logger, cli ClientContractor) *Server {
+	return &Server{log: log, cli: cli}
+}
+
+// Register registers the service in grpc server
+func (s *Server) Register(gs *grpc.Server) {
+	indexerpb.RegisterIndexerServer(gs, s)
+}
+
+func (s *Server) GetValidators(ctx context.Context, req *indexerpb.GetValidatorsRequest) (*indexerpb.GetValidatorsResponse, error) {
+	height, err := s.height(ctx, req.GetPointInTime())
+	if err != nil {
+		return nil, err
+	}
+	params := structs.ValidatorParams{
+		ValidatorID:    req.GetValidatorId(),
+		OrderBy:        req.GetOrderBy(),
+		OrderDirection: req.GetOrderDirection(),
+		TimeFrom:       fromTimestamp(req.GetTimeFrom()),
+		TimeTo:         fromTimestamp(req.GetTimeTo()),
+		AtHeight:       height,
+		Limit:          req.GetPage().GetLimit(),
+		Offset:         req.GetPage().GetOffset(),
+	}
+	if params.Address, err = address("address", req.GetAddress()); err != nil {
+		return nil, err
+	}
+	if a := req.GetAuthorized(); a != nil {
+		params.Authorized = structs.StateFalse
+		if a.GetValue() {
+			params.Authorized = structs.StateTrue
+		}
+	}
+
+	vs, err := s.cli.GetValidators(ctx, params)
+	if err != nil {
+		return nil, toStatus(err)
+	}
+	resp := &indexerpb.GetValidatorsResponse{Validators: make([]*indexerpb.Validator, len(vs))}
+	for i, v := range vs {
+		resp.Validators[i] = toValidator(v)
+	}
+	return resp, nil
+}
+
+func (s *Server) GetDelegations(ctx context.Context, req *indexerpb.GetDelegationsRequest) (*indexerpb.GetDelegationsResponse, error) {
+	height, err := s.height(ctx, req.GetPointInTime())
+	if err != nil {
+		return nil, err
+	}
+	params := structs.DelegationParams{
+		DelegationID: req.GetDelegationId(),
+		ValidatorID:  req.GetValidatorId(),
+		TimeAt:       fromTimestamp(req.GetTimeAt()),
+		TimeFrom:     fromTimestamp(req.GetTimeFrom()),
+		TimeTo:       fromTimestamp(req.GetTimeTo()),
+		AtHeight:     height,
+		Limit:        req.GetPage().GetLimit(),
+		Offset:       req.GetPage().GetOffset(),
+	}
+	if params.Holder, err = address("holder", req.GetHolder()); err != nil {
+		return nil, err
+	}
+	for _, st := range req.GetStates() {
+		if st == indexerpb.DelegationState_DELEGATION_STATE_UNSPECIFIED {
+			return nil, status.Error(codes.InvalidArgument, "unspecified delegation state")
+		}
+		params.State = append(params.State, fromDelegationState(st))
+	}
+
+	delegations, err := s.cli.GetDelegations(ctx, params)
+	if err != nil {
+		return nil, toStatus(err)
+	}
+	resp := &indexerpb.GetDelegationsResponse{Delegations: make([]*indexerpb.Delegation, len(delegations))}
+	for i, d := range delegations {
+		resp.Delegations[i] = toDelegation(d)
+	}
+	return resp, nil
+}
+
+func (s *Server) GetTypesSummaryDelegations(ctx context.Context, req *indexerpb.GetTypesSummaryDelegationsRequest) (*indexerpb.GetTypesSummaryDelegationsResponse, error) {
+	height, err := s.height(ctx, req.GetPointInTime())
+	if err != nil {
+		return nil, err
+	}
+	summaries, err := s.cli.GetTypesSummaryDelegations(ctx, structs.DelegationParams{
+		ValidatorID: req.GetValidatorId(),
+		TimeAt:      fromTimestamp(req.GetTimeAt()),
+		TimeFrom:    fromTimestamp(req.GetTimeFrom()),
+		TimeTo:      fromTimestamp(req.GetTimeTo()),
+		AtHeight:    height,
+	})
+	if err != nil {
+		return nil, toStatus(err)
+	}
+	resp := &indexerpb.GetTypesSummaryDelegationsResponse{Summaries: make([]*indexerpb.DelegationSummary, len(summaries))}
+	for i, sum := range summaries {
+		resp.Summaries[i] = &indexerpb.DelegationSummary{
+			State:  toDelegationState(sum.State),
+			Count:  bigString(sum.Count),
+			Amount: bigString(sum.Amount),
+		}
+	}
+	return resp, nil
+}
+
+func (s *Server) GetNodes(ctx context.Context, req *indexerpb.GetNodesRequest) (*indexerpb.GetNodesResponse, error) {
+	height, err := s.height(ctx, req.GetPointInTime())
+	if err != nil {
+		return nil, err
+	}
+	params := structs.NodeParams{
+		NodeID:      req.GetNodeId(),
+		ValidatorID: req.GetValidatorId(),
+		AtHeight:    height,
+		Limit:       req.GetPage().GetLimit(),
+		Offset:      req.GetPage().GetOffset(),
+	}
+	if req.GetStatus() != indexerpb.NodeStatus_NODE_STATUS_UNSPECIFIED {
+		params.Status = fromNodeStatus(req.GetStatus()).String()
+	}
+	if params.Address, err = address("address", req.GetAddress()); err != nil {
+		return nil, err
+	}
+
+	nodes, err := s.cli.GetNodes(ctx, params)
+	if err != nil {
+		return nil, toStatus(err)
+	}
+	resp := &indexerpb.GetNodesResponse{Nodes: make([]*indexerpb.Node, len(nodes))}
+	for i, n := range nodes {
+		resp.Nodes[i] = toNode(n)
+	}
+	return resp, nil
+}
+
+func (s *Server) GetValidatorStatistics(ctx context.Context, req *indexerpb.GetValidatorStatisticsRequest) (*indexerpb.GetValidatorStatisticsResponse, error) {
+	height, err := s.height(ctx, req.GetPointInTime())
+	if err != nil {
+		return nil, err
+	}
+	stats, err := s.cli.GetValidatorStatistics(ctx, structs.ValidatorStatisticsParams{
+		ValidatorID: req.GetValidatorId(),
+		Type:        structs.StatisticTypeVS(req.GetType()),
+		AtHeight:    height,
+		Limit:       req.GetPage().GetLimit(),
+		Offset:      req.GetPage().GetOffset(),
+	})
+	if err != nil {
+		return nil, toStatus(err)
+	}
+	resp := &indexerpb.GetValidatorStatisticsResponse{Statistics: make([]*indexerpb.ValidatorStatistic, len(stats))}
+	for i, vs := range stats {
+		resp.Statistics[i] = toValidatorStatistic(vs)
+	}
+	return resp, nil
+}
+
+func (s *Server) GetSystemEvents(ctx context.Context, req *indexerpb.GetSystemEventsRequest) (*indexerpb.GetSystemEventsResponse, error) {
+	height, err := s.height(ctx, req.GetPointInTime())
+	if err != nil {
+		return nil, err
+	}
+	params := structs.SystemEventParams{
+		ID:          req.GetId(),
+		After:       req.GetAfter(),
+		ValidatorID: req.GetValidatorId(),
+		SenderID:    req.GetSenderId(),
+		ReceiverID:  req.GetRecipientId(),
+		AtHeight:    height,
+		Limit:       req.GetPage().GetLimit(),
+		Offset:      req.GetPage().GetOffset(),
+	}
+	if req.GetKind() != indexerpb.SystemEventKind_SYSTEM_EVENT_KIND_UNSPECIFIED {
+		params.Kind = strconv.FormatInt(int64(req.GetKind()), 10)
+	}
+	if params.Address, err = address("address", req.GetAddress()); err != nil {
+		return nil, err
+	}
+
+	events, err := s.cli.GetSystemEvents(ctx, params)
+	if err != nil {
+		return nil, toStatus(err)
+	}
+	resp := &indexerpb.GetSystemEventsResponse{Events: make([]*indexerpb.SystemEvent, len(events))}
+	for i, se := range events {
+		resp.Events[i] = toSystemEvent(se)
+	}
+	return resp, nil
+}
+
+func (s *Server) GetContractEvents(ctx context.Context, req *indexerpb.GetContractEventsRequest) (*indexerpb.GetContractEventsResponse, error) {
+	height, err := s.height(ctx, req.GetPointInTime())
+	if err != nil {
+		return nil, err
+	}
+	params := structs.EventParams{
+		Type:         req.GetType(),
+		Id:           req.GetId(),
+		TimeFrom:     fromTimestamp(req.GetTimeFrom()),
+		TimeTo:       fromTimestamp(req.GetTimeTo()),
+		ContractName: req.GetContractName(),
+		EventName:    req.GetEventName(),
+		Params:       req.GetParams(),
+		HeightFrom:   req.GetHeightFrom(),
+		HeightTo:     req.GetHeightTo(),
+		AtHeight:     height,
+		Limit:        req.GetPage().GetLimit(),
+		Offset:       req.GetPage().GetOffset(),
+	}
+	if a := req.GetBoundAddress(); a != "" {
+		if !common.IsHexAddress(a) {
+			return nil, status.Error(codes.InvalidArgument, "error parsing 'bound_address' field")
+		}
+		params.BoundAddress = common.HexToAddress(a)
+	}
+	if h := req.GetTransactionHash(); h != "" {
+		b, err := hexutil.Decode(h)
+		if err != nil || len(b) != common.HashLength {
+			return nil, status.Error(codes.InvalidArgument, "error parsing 'transaction_hash' field")
+		}
+		params.TransactionHash = common.BytesToHash(b)
+	}
+
+	events, err := s.cli.GetContractEvents(ctx, params)
+	if err != nil {
+		return nil, toStatus(err)
+	}
+	resp := &indexerpb.GetContractEventsResponse{Events: make([]*indexerpb.ContractEvent, len(events))}
+	for i, ce := range events {
+		if resp.Events[i], err = toContractEvent(ce); err != nil {
+			s.log.Error("[GRPC] Error converting contract event", zap.Stringer("id", ce.ID), zap.Error(err))
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+	}
+	return resp, nil
+}
+
+// height returns the block height the state is requested at, zero for the current state
+func (s *Server) height(ctx context.Context, p *indexerpb.PointInTime) (uint64, error) {
+	if p.GetAtTime() == nil {
+		return p.GetAtHeight(), nil
+	}
+	if p.GetAtHeight() > 0 {
+		return 0, status.Error(codes.InvalidArgument, "only one of 'at_height' and 'at_time' fields can be sent")
+	}
+
+	b, err := s.cli.GetBlockAtTime(ctx, p.GetAtTime().AsTime())
+	if err != nil {
+		if err == structs.ErrNotFound {
+			return 0, status.Error(codes.NotFound, "no block found at 'at_time'")
+		}
+		return 0, toStatus(err)
+	}
+	return b.Number, nil
+}
+
+func address(name, a string) (string, error) {
+	if a != "" && !common.IsHexAddress(a) {
+		return "", status.Error(codes.InvalidArgument, "error parsing '"+name+"' field")
+	}
+	return a, nil
+}
+
+// toStatus maps errors of the client onto gRPC codes
+func toStatus(err error) error {
+	switch {
+	case errors.Is(err, structs.ErrNotFound):
+		return status.Error(codes.NotFound, err.Error())
+	case errors.Is(err, structs.ErrMissingParameter):
+		return status.Error(codes.InvalidArgument, err.Error())
+	case errors.Is(err, context.Canceled):
+		return status.Error(codes.Canceled, err.Error())
+	case errors.Is(err, context.DeadlineExceeded):
+		return status.Error(codes.DeadlineExceeded, err.Error())
+	}
+	return status.Error(codes.Internal, err.Error())
+}
@@ -0,0 +1,141 @@
+package grpcapi
+
+import (
+	"context"
+	"math/big"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap/zaptest"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/grpc/test/bufconn"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/figment-networks/skale-indexer/client"
+	"github.com/figment-networks/skale-indexer/client/transport/grpcapi/indexerpb"
+	"github.com/figment-networks/skale-indexer/scraper/structs"
+	"github.com/figment-networks/skale-indexer/store"
+	"github.com/figment-networks/skale-indexer/store/memory"
+)
+
+func TestServerWithStore(t *testing.T) {
+	ctx := context.Background()
+	storeDB := store.New(memory.NewDriver())
+
+	start := time.Date(2021, time.March, 10, 12, 0, 0, 0, time.UTC)
+	holder := common.HexToAddress("0x06dd71dab27c1a3e0b172d53735f00bf1a66eb79")
+	// exceeds the precision of float, so it'd be mangled decoded as JSON number
+	amount, _ := new(big.Int).SetString("123456789012345678901234567", 10)
+
+	for _, height := range []uint64{1, 10, 20} {
+		require.NoError(t, storeDB.SaveBlock(ctx, structs.Block{Number: height, Hash: common.BigToHash(big.NewInt(int64(height))), Time: start.Add(time.Duration(height) * time.Minute)}))
+	}
+	require.NoError(t, storeDB.SaveValidator(ctx, structs.Validator{
+		ValidatorID: big.NewInt(1), Name: "first", ValidatorAddress: common.BigToAddress(big.NewInt(1)), BlockHeight: 1,
+		FeeRate: big.NewInt(10), MinimumDelegationAmount: big.NewInt(100), Staked: amount, Authorized: true,
+	}))
+	require.NoError(t, storeDB.SaveNodes(ctx, []structs.Node{{
+		NodeID: big.NewInt(1), ValidatorID: big.NewInt(1), Name: "node", StartBlock: big.NewInt(1),
+		FinishTime: big.NewInt(0), Status: structs.NodeStatusLeaving, BlockHeight: 10,
+	}}, common.Address{}))
+	require.NoError(t, storeDB.SaveDelegation(ctx, structs.Delegation{
+		DelegationID: big.NewInt(1), Holder: holder, ValidatorID: big.NewInt(1), BlockHeight: 10, Amount: amount,
+		DelegationPeriod: big.NewInt(3), Started: big.NewInt(0), Finished: big.NewInt(0), State: structs.DelegationStateDELEGATED,
+		TransactionHash: common.BigToHash(big.NewInt(1)), Created: start,
+	}))
+	require.NoError(t, storeDB.SaveDelegation(ctx, structs.Delegation{
+		DelegationID: big.NewInt(1), Holder: holder, ValidatorID: big.NewInt(1), BlockHeight: 20, Amount: amount,
+		DelegationPeriod: big.NewInt(3), Started: big.NewInt(0), Finished: big.NewInt(0), State: structs.DelegationStateUNDELEGATION_REQUESTED,
+		TransactionHash: common.BigToHash(big.NewInt(2)), Created: start,
+	}))
+	require.NoError(t, storeDB.SaveContractEvent(ctx, structs.ContractEvent{
+		ContractName: "delegation_controller", EventName: "DelegationProposed", BlockHeight: 10, Time: start,
+		TransactionHash: common.BigToHash(big.NewInt(1)), BoundType: "delegation", BoundID: []big.Int{*big.NewInt(1)},
+		BoundAddress: []common.Address{holder}, Params: map[string]interface{}{"delegationId": big.NewInt(1), "amount": amount},
+	}))
+
+	lis := bufconn.Listen(1 << 20)
+	gs := grpc.NewServer()
+	NewServer(zaptest.NewLogger(t), client.NewClient(zaptest.NewLogger(t), storeDB, nil, nil, 1, 1)).Register(gs)
+	go gs.Serve(lis)
+	defer gs.Stop()
+
+	conn, err := grpc.DialContext(ctx, "bufnet", grpc.WithInsecure(), grpc.WithContextDialer(func(context.Context, string) (net.Conn, error) {
+		return lis.Dial()
+	}))
+	require.NoError(t, err)
+	defer conn.Close()
+	cli := indexerpb.NewIndexerClient(conn)
+
+	t.Run("validators", func(t *testing.T) {
+		resp, err := cli.GetValidators(ctx, &indexerpb.GetValidatorsRequest{})
+		require.NoError(t, err)
+		require.Len(t, resp.Validators, 1)
+		require.Equal(t, "first", resp.Validators[0].Name)
+		require.Equal(t, amount.String(), resp.Validators[0].Staked)
+		require.True(t, resp.Validators[0].Authorized)
+	})
+
+	t.Run("delegations", func(t *testing.T) {
+		resp, err := cli.GetDelegations(ctx, &indexerpb.GetDelegationsRequest{Holder: holder.Hex()})
+		require.NoError(t, err)
+		require.Len(t, resp.Delegations, 1)
+		require.Equal(t, amount.String(), resp.Delegations[0].Amount)
+		require.Equal(t, indexerpb.DelegationState_DELEGATION_STATE_UNDELEGATION_REQUESTED, resp.Delegations[0].State)
+
+		resp, err = cli.GetDelegations(ctx, &indexerpb.GetDelegationsRequest{
+			Holder:      holder.Hex(),
+			PointInTime: &indexerpb.PointInTime{AtTime: timestamppb.New(start.Add(15 * time.Minute))},
+		})
+		require.NoError(t, err)
+		require.Len(t, resp.Delegations, 1)
+		require.Equal(t, indexerpb.DelegationState_DELEGATION_STATE_DELEGATED, resp.Delegations[0].State)
+
+		sum, err := cli.GetTypesSummaryDelegations(ctx, &indexerpb.GetTypesSummaryDelegationsRequest{ValidatorId: "1"})
+		require.NoError(t, err)
+		require.Len(t, sum.Summaries, 1)
+		require.Equal(t, amount.String(), sum.Summaries[0].Amount)
+		require.Equal(t, "1", sum.Summaries[0].Count)
+	})
+
+	t.Run("nodes", func(t *testing.T) {
+		resp, err := cli.GetNodes(ctx, &indexerpb.GetNodesRequest{Status: indexerpb.NodeStatus_NODE_STATUS_LEAVING})
+		require.NoError(t, err)
+		require.Len(t, resp.Nodes, 1)
+		require.Equal(t, indexerpb.NodeStatus_NODE_STATUS_LEAVING, resp.Nodes[0].Status)
+
+		resp, err = cli.GetNodes(ctx, &indexerpb.GetNodesRequest{Status: indexerpb.NodeStatus_NODE_STATUS_ACTIVE})
+		require.NoError(t, err)
+		require.Empty(t, resp.Nodes)
+	})
+
+	t.Run("contract events", func(t *testing.T) {
+		resp, err := cli.GetContractEvents(ctx, &indexerpb.GetContractEventsRequest{
+			EventName: "DelegationProposed",
+			Params:    map[string]string{"delegationId": "1"},
+		})
+		require.NoError(t, err)
+		require.Len(t, resp.Events, 1)
+		require.Equal(t, map[string]string{"delegationId": "1", "amount": amount.String()}, resp.Events[0].Params)
+	})
+
+	t.Run("wrong arguments", func(t *testing.T) {
+		_, err := cli.GetDelegations(ctx, &indexerpb.GetDelegationsRequest{Holder: "0x12"})
+		require.Equal(t, codes.InvalidArgument, status.Code(err))
+
+		_, err = cli.GetValidators(ctx, &indexerpb.GetValidatorsRequest{
+			PointInTime: &indexerpb.PointInTime{AtHeight: 1, AtTime: timestamppb.New(start)},
+		})
+		require.Equal(t, codes.InvalidArgument, status.Code(err))
+
+		_, err = cli.GetNodes(ctx, &indexerpb.GetNodesRequest{
+			PointInTime: &indexerpb.PointInTime{AtTime: timestamppb.New(start.Add(-time.Hour))},
+		})
+		require.Equal(t, codes.NotFound, status.Code(err))
+	})
+}
@@ -0,0 +1,151 @@
+package webapi
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/figment-networks/skale-indexer/scraper/structs"
+)
+
+// GetAddressActivity returns records of every kind touching the address, the latest first, paged by cursor
+//
+// GET /addresses/{address}/activity (kind, cursor, limit, total)
+func (c *Connector) GetAddressActivity(w http.ResponseWriter, req *http.Request) {
+	w.Header().Add("Content-Type", "application/json")
+	allowCORSHeaders(w)
+
+	switch req.Method {
+	case http.MethodGet:
+	case http.MethodOptions:
+		return
+	default:
+		w.WriteHeader(http.StatusMethodNotAllowed)
+		w.Write(newApiError(structs.ErrNotAllowedMethod, http.StatusMethodNotAllowed))
+		return
+	}
+
+	parts := strings.Split(strings.Trim(strings.TrimPrefix(req.URL.Path, "/addresses"), "/"), "/")
+	if len(parts) != 2 || parts[1] != "activity" {
+		w.WriteHeader(http.StatusBadRequest)
+		w.Write(newApiError(errors.New("wrong address activity path"), http.StatusBadRequest))
+		return
+	}
+	if !common.IsHexAddress(parts[0]) {
+		w.WriteHeader(http.StatusBadRequest)
+		w.Write(newApiError(errors.New("address given in wrong format"), http.StatusBadRequest))
+		return
+	}
+	params := structs.ActivityParams{Address: common.HexToAddress(parts[0])}
+
+	if kinds := req.URL.Query().Get("kind"); kinds != "" {
+		for _, k := range strings.Split(kinds, ",") {
+			if !knownActivityKind(structs.ActivityKind(k)) {
+				w.WriteHeader(http.StatusBadRequest)
+				w.Write(newApiError(errors.New("wrong activity kind literal"), http.StatusBadRequest))
+				return
+			}
+			params.Kinds = append(params.Kinds, structs.ActivityKind(k))
+		}
+	}
+
+	token, total, err := parseCursor(req.URL.Query())
+	if err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		w.Write(newApiError(err, http.StatusBadRequest))
+		return
+	}
+	if limit := req.URL.Query().Get("limit"); limit != "" {
+		if params.Limit, err = strconv.ParseUint(limit, 10, 64); err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			w.Write(newApiError(errors.New("error parsing 'limit' parameter"), http.StatusBadRequest))
+			return
+		}
+	}
+	// activity is always paged, the first page is returned without cursor
+	if token == nil {
+		token = new(string)
+	}
+	cursor, ok := cursorPaging(w, token, &params.Limit)
+	if !ok {
+		return
+	}
+	params.Cursor = cursor
+
+	res, err := c.cli.GetAddressActivity(req.Context(), params)
+	if err != nil {
+		w.WriteHeader(http.StatusInternalServerError)
+		w.Write(newApiError(err, http.StatusInternalServerError))
+		return
+	}
+
+	resp := AddressActivity{Address: params.Address.Hex(), Items: []Activity{}}
+	accounts, err := c.cli.GetAccounts(req.Context(), structs.AccountParams{Address: params.Address.Hex()})
+	if err != nil {
+		w.WriteHeader(http.StatusInternalServerError)
+		w.Write(newApiError(err, http.StatusInternalServerError))
+		return
+	}
+	if len(accounts) > 0 {
+		resp.AccountType = string(accounts[0].Type)
+	}
+
+	for _, a := range res {
+		resp.Items = append(resp.Items, toActivity(a))
+	}
+	if len(res) > 0 && uint64(len(res)) == params.Limit {
+		resp.NextCursor = encodeCursor(res[len(res)-1].Cursor())
+	}
+	if total {
+		count, err := c.cli.CountAddressActivity(req.Context(), params)
+		if err != nil {
+			w.WriteHeader(http.StatusInternalServerError)
+			w.Write(newApiError(err, http.StatusInternalServerError))
+			return
+		}
+		resp.Total = &count
+	}
+
+	enc := json.NewEncoder(w)
+	w.WriteHeader(http.StatusOK)
+	if err := enc.Encode(resp); err != nil {
+		w.WriteHeader(http.StatusInternalServerError)
+		w.Write(newApiError(err, http.StatusInternalServerError))
+	}
+}
+
+func knownActivityKind(kind structs.ActivityKind) bool {
+	for _, k := range structs.ActivityKinds {
+		if k == kind {
+			return true
+		}
+	}
+	return false
+}
+
+func toActivity(a structs.Activity) Activity {
+	act := Activity{Kind: string(a.Kind), BlockHeight: a.BlockHeight}
+	if !a.Time.IsZero() {
+		t := a.Time
+		act.Time = &t
+	}
+	switch {
+	case a.ContractEvent != nil:
+		ce := toContractEvent(*a.ContractEvent)
+		act.ContractEvent = &ce
+	case a.Delegation != nil:
+		dlg := toDelegation(*a.Delegation)
+		act.Delegation = &dlg
+	case a.Node != nil:
+		nc := toNodeChange(*a.Node)
+		act.Node = &nc
+	case a.SystemEvent != nil:
+		se := toSystemEvent(*a.SystemEvent)
+		act.SystemEvent = &se
+	}
+	return act
+}
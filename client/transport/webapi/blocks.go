@@ -0,0 +1,57 @@
+package webapi
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"time"
+
+	"github.com/figment-networks/skale-indexer/scraper/structs"
+)
+
+// GetBlockAtTime returns the last block produced at or before given time
+func (c *Connector) GetBlockAtTime(w http.ResponseWriter, req *http.Request) {
+	w.Header().Add("Content-Type", "application/json")
+	allowCORSHeaders(w)
+
+	switch req.Method {
+	case http.MethodGet:
+	case http.MethodOptions:
+		return
+	default:
+		w.WriteHeader(http.StatusMethodNotAllowed)
+		w.Write(newApiError(structs.ErrNotAllowedMethod, http.StatusMethodNotAllowed))
+		return
+	}
+
+	t, err := time.Parse(structs.Layout, req.URL.Query().Get("time"))
+	if err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		w.Write(newApiError(errors.New("error parsing 'time' parameter"), http.StatusBadRequest))
+		return
+	}
+
+	b, err := c.cli.GetBlockAtTime(req.Context(), t)
+	if err != nil {
+		if err == structs.ErrNotFound {
+			w.WriteHeader(http.StatusNotFound)
+			w.Write(newApiError(err, http.StatusNotFound))
+			return
+		}
+		w.WriteHeader(http.StatusInternalServerError)
+		w.Write(newApiError(err, http.StatusInternalServerError))
+		return
+	}
+
+	enc := json.NewEncoder(w)
+	w.WriteHeader(http.StatusOK)
+	if err := enc.Encode(Block{
+		Number:     b.Number,
+		Hash:       b.Hash,
+		ParentHash: b.ParentHash,
+		Time:       b.Time,
+	}); err != nil {
+		w.WriteHeader(http.StatusInternalServerError)
+		w.Write(newApiError(err, http.StatusInternalServerError))
+	}
+}
@@ -0,0 +1,110 @@
+package webapi
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/figment-networks/skale-indexer/scraper/structs"
+)
+
+// GetUnlockCalendar returns amounts of delegations reaching the end of their period per day and per month,
+// with the stake validators lose next month
+//
+// GET /calendar/unlocks (validator_id, holder, from, to)
+func (c *Connector) GetUnlockCalendar(w http.ResponseWriter, req *http.Request) {
+	w.Header().Add("Content-Type", "application/json")
+	allowCORSHeaders(w)
+
+	switch req.Method {
+	case http.MethodGet:
+	case http.MethodOptions:
+		return
+	default:
+		w.WriteHeader(http.StatusMethodNotAllowed)
+		w.Write(newApiError(structs.ErrNotAllowedMethod, http.StatusMethodNotAllowed))
+		return
+	}
+
+	query := req.URL.Query()
+	params := structs.UnlockCalendarParams{ValidatorID: query.Get("validator_id")}
+	if params.ValidatorID != "" {
+		if _, err := strconv.ParseUint(params.ValidatorID, 10, 64); err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			w.Write(newApiError(errors.New("validator id given in wrong format"), http.StatusBadRequest))
+			return
+		}
+	}
+	if holder := query.Get("holder"); holder != "" {
+		if !common.IsHexAddress(holder) {
+			w.WriteHeader(http.StatusBadRequest)
+			w.Write(newApiError(errors.New("holder given in wrong format"), http.StatusBadRequest))
+			return
+		}
+		params.Holder = holder
+	}
+
+	var err error
+	if from := query.Get("from"); from != "" {
+		if params.TimeFrom, err = time.Parse(structs.Layout, from); err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			w.Write(newApiError(errors.New("error parsing 'from' parameter"), http.StatusBadRequest))
+			return
+		}
+	}
+	if to := query.Get("to"); to != "" {
+		if params.TimeTo, err = time.Parse(structs.Layout, to); err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			w.Write(newApiError(errors.New("error parsing 'to' parameter"), http.StatusBadRequest))
+			return
+		}
+	}
+
+	res, err := c.cli.GetUnlockCalendar(req.Context(), params)
+	if err != nil {
+		w.WriteHeader(http.StatusInternalServerError)
+		w.Write(newApiError(err, http.StatusInternalServerError))
+		return
+	}
+
+	calendar := UnlockCalendar{
+		Days:        toUnlockPeriods(res.Days),
+		Months:      toUnlockPeriods(res.Months),
+		Projections: []StakeDropProjection{},
+	}
+	for _, p := range res.Projections {
+		calendar.Projections = append(calendar.Projections, StakeDropProjection{
+			ValidatorID:       p.ValidatorID,
+			Stake:             p.Stake.String(),
+			ExpectedDrop:      p.ExpectedDrop.String(),
+			ExpectedDropShare: p.ExpectedDropShare,
+			AtRisk:            p.AtRisk.String(),
+			LargestLeaving:    p.LargestLeaving.String(),
+			Leaving:           p.Leaving,
+		})
+	}
+
+	enc := json.NewEncoder(w)
+	w.WriteHeader(http.StatusOK)
+	if err := enc.Encode(calendar); err != nil {
+		w.WriteHeader(http.StatusInternalServerError)
+		w.Write(newApiError(err, http.StatusInternalServerError))
+	}
+}
+
+func toUnlockPeriods(periods []structs.UnlockPeriod) []UnlockPeriod {
+	converted := []UnlockPeriod{}
+	for _, p := range periods {
+		converted = append(converted, UnlockPeriod{
+			Start:        p.Start,
+			Undelegated:  p.Undelegated.String(),
+			PeriodEnding: p.PeriodEnding.String(),
+			Delegations:  p.Delegations,
+		})
+	}
+	return converted
+}
@@ -0,0 +1,151 @@
+package webapi
+
+import (
+	"encoding/base64"
+	"errors"
+	"math/big"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/google/uuid"
+
+	"github.com/figment-networks/skale-indexer/scraper/structs"
+)
+
+// defaultCursorLimit is the size of cursor page when limit is not sent
+const defaultCursorLimit = 100
+
+var errWrongCursor = errors.New("error parsing 'cursor' parameter")
+
+// parseCursor parses 'cursor' and 'total' query parameters. Cursor is nil unless paging by cursor is requested,
+// it's empty for the first page
+func parseCursor(query url.Values) (cursor *string, total bool, err error) {
+	if c, ok := query["cursor"]; ok && len(c) > 0 {
+		cursor = &c[0]
+	}
+	if t := query.Get("total"); t != "" {
+		if total, err = strconv.ParseBool(t); err != nil {
+			return nil, false, errors.New("error parsing 'total' parameter")
+		}
+	}
+	return cursor, total, nil
+}
+
+// encodeCursor makes opaque token of the position of record
+func encodeCursor(c structs.Cursor) string {
+	return base64.RawURLEncoding.EncodeToString([]byte(strconv.FormatUint(c.BlockHeight, 10) + ":" + c.ID))
+}
+
+// decodeCursor reads the position out of token, zero cursor is returned for empty one
+func decodeCursor(token string) (c structs.Cursor, err error) {
+	if token == "" {
+		return c, nil
+	}
+	b, err := base64.RawURLEncoding.DecodeString(token)
+	if err != nil {
+		return c, errWrongCursor
+	}
+	parts := strings.SplitN(string(b), ":", 2)
+	if len(parts) != 2 {
+		return c, errWrongCursor
+	}
+	if c.BlockHeight, err = strconv.ParseUint(parts[0], 10, 64); err != nil {
+		return c, errWrongCursor
+	}
+	id, err := uuid.Parse(parts[1])
+	if err != nil {
+		return c, errWrongCursor
+	}
+	c.ID = id.String()
+	return c, nil
+}
+
+// encodeKeyCursor makes opaque token of the position of record in list of the current state
+func encodeKeyCursor(c structs.KeyCursor) string {
+	token := c.Key
+	if !c.Time.IsZero() {
+		token = strconv.FormatInt(c.Time.UnixNano(), 10) + ":" + token
+	}
+	return base64.RawURLEncoding.EncodeToString([]byte(token))
+}
+
+// decodeKeyCursor reads the position out of token, zero cursor is returned for empty one.
+// Key is the id of record, unless timed, when it's the address following the time (of account creation)
+func decodeKeyCursor(token string, timed bool) (c structs.KeyCursor, err error) {
+	if token == "" {
+		return c, nil
+	}
+	b, err := base64.RawURLEncoding.DecodeString(token)
+	if err != nil {
+		return c, errWrongCursor
+	}
+
+	if !timed {
+		if _, ok := new(big.Int).SetString(string(b), 10); !ok {
+			return c, errWrongCursor
+		}
+		c.Key = string(b)
+		return c, nil
+	}
+
+	parts := strings.SplitN(string(b), ":", 2)
+	if len(parts) != 2 || !common.IsHexAddress(parts[1]) {
+		return c, errWrongCursor
+	}
+	nanos, err := strconv.ParseInt(parts[0], 10, 64)
+	if err != nil {
+		return c, errWrongCursor
+	}
+	c.Time = time.Unix(0, nanos).UTC()
+	c.Key = parts[1]
+	return c, nil
+}
+
+// cursorPaging decodes the cursor of the list requested by it and sets default page size. The error response
+// is written when cursor is malformed
+func cursorPaging(w http.ResponseWriter, token *string, limit *uint64) (c structs.Cursor, ok bool) {
+	if token == nil {
+		return c, true
+	}
+	c, err := decodeCursor(*token)
+	if err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		w.Write(newApiError(err, http.StatusBadRequest))
+		return c, false
+	}
+	if *limit == 0 {
+		*limit = defaultCursorLimit
+	}
+	return c, true
+}
+
+// keyCursorPaging is cursorPaging of the lists of the current state
+func keyCursorPaging(w http.ResponseWriter, token *string, limit *uint64, timed bool) (c structs.KeyCursor, ok bool) {
+	if token == nil {
+		return c, true
+	}
+	c, err := decodeKeyCursor(*token, timed)
+	if err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		w.Write(newApiError(err, http.StatusBadRequest))
+		return c, false
+	}
+	if *limit == 0 {
+		*limit = defaultCursorLimit
+	}
+	return c, true
+}
+
+// newPage wraps items of the list requested by cursor. Page which is full gets the cursor of its last record,
+// as there may be more records following it
+func newPage(items interface{}, n int, limit uint64, last func() string) Page {
+	p := Page{Items: items}
+	if n > 0 && uint64(n) == limit {
+		p.NextCursor = last()
+	}
+	return p
+}
@@ -0,0 +1,80 @@
+package webapi
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"strconv"
+
+	"github.com/figment-networks/skale-indexer/scraper/structs"
+)
+
+// GetDecentralization returns stake decentralisation metrics computed at the beginning of every epoch, the latest first
+//
+// GET /network/decentralization (from_epoch, to_epoch, limit, offset)
+func (c *Connector) GetDecentralization(w http.ResponseWriter, req *http.Request) {
+	w.Header().Add("Content-Type", "application/json")
+	allowCORSHeaders(w)
+
+	switch req.Method {
+	case http.MethodGet:
+	case http.MethodOptions:
+		return
+	default:
+		w.WriteHeader(http.StatusMethodNotAllowed)
+		w.Write(newApiError(structs.ErrNotAllowedMethod, http.StatusMethodNotAllowed))
+		return
+	}
+
+	query := req.URL.Query()
+	params := structs.DecentralizationParams{}
+	for name, v := range map[string]*uint64{
+		"from_epoch": &params.EpochFrom,
+		"to_epoch":   &params.EpochTo,
+		"limit":      &params.Limit,
+		"offset":     &params.Offset,
+	} {
+		if s := query.Get(name); s != "" {
+			n, err := strconv.ParseUint(s, 10, 64)
+			if err != nil {
+				w.WriteHeader(http.StatusBadRequest)
+				w.Write(newApiError(errors.New("error parsing '"+name+"' parameter"), http.StatusBadRequest))
+				return
+			}
+			*v = n
+		}
+	}
+
+	res, err := c.cli.GetDecentralization(req.Context(), params)
+	if err != nil {
+		w.WriteHeader(http.StatusInternalServerError)
+		w.Write(newApiError(err, http.StatusInternalServerError))
+		return
+	}
+
+	dcs := []Decentralization{}
+	for _, dc := range res {
+		dcs = append(dcs, Decentralization{
+			Epoch:               dc.Epoch,
+			Time:                dc.Time,
+			BlockHeight:         dc.BlockHeight,
+			Validators:          dc.Validators,
+			TotalStake:          dc.TotalStake.String(),
+			NakamotoCoefficient: dc.NakamotoCoefficient,
+			Gini:                dc.Gini,
+			Top1Share:           dc.Top1Share,
+			Top5Share:           dc.Top5Share,
+			Top10Share:          dc.Top10Share,
+			ValidatorsOver33:    dc.ValidatorsOver33,
+			ValidatorsOver50:    dc.ValidatorsOver50,
+			ValidatorsOver66:    dc.ValidatorsOver66,
+		})
+	}
+
+	enc := json.NewEncoder(w)
+	w.WriteHeader(http.StatusOK)
+	if err := enc.Encode(dcs); err != nil {
+		w.WriteHeader(http.StatusInternalServerError)
+		w.Write(newApiError(err, http.StatusInternalServerError))
+	}
+}
@@ -0,0 +1,100 @@
+package webapi
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/figment-networks/skale-indexer/scraper/structs"
+)
+
+// GetDelegatorPortfolio returns delegations of the holder grouped by validator and state, with totals and history per epoch
+//
+// GET /delegators/{address}/portfolio
+func (c *Connector) GetDelegatorPortfolio(w http.ResponseWriter, req *http.Request) {
+	w.Header().Add("Content-Type", "application/json")
+	allowCORSHeaders(w)
+
+	switch req.Method {
+	case http.MethodGet:
+	case http.MethodOptions:
+		return
+	default:
+		w.WriteHeader(http.StatusMethodNotAllowed)
+		w.Write(newApiError(structs.ErrNotAllowedMethod, http.StatusMethodNotAllowed))
+		return
+	}
+
+	parts := strings.Split(strings.Trim(strings.TrimPrefix(req.URL.Path, "/delegators"), "/"), "/")
+	if len(parts) != 2 || parts[1] != "portfolio" {
+		w.WriteHeader(http.StatusBadRequest)
+		w.Write(newApiError(errors.New("wrong delegator portfolio path"), http.StatusBadRequest))
+		return
+	}
+	if !common.IsHexAddress(parts[0]) {
+		w.WriteHeader(http.StatusBadRequest)
+		w.Write(newApiError(errors.New("address given in wrong format"), http.StatusBadRequest))
+		return
+	}
+
+	res, err := c.cli.GetDelegatorPortfolio(req.Context(), common.HexToAddress(parts[0]))
+	if err != nil {
+		w.WriteHeader(http.StatusInternalServerError)
+		w.Write(newApiError(err, http.StatusInternalServerError))
+		return
+	}
+
+	portfolio := Portfolio{
+		Holder:     res.Holder,
+		Locked:     res.Locked.String(),
+		Unlocked:   res.Unlocked.String(),
+		Earned:     res.Earned.String(),
+		Withdrawn:  res.Withdrawn.String(),
+		Validators: []PortfolioValidator{},
+		Pending:    []Delegation{},
+		Unlocks:    []DelegationUnlock{},
+		Epochs:     []PortfolioEpoch{},
+	}
+	for _, v := range res.Validators {
+		pv := PortfolioValidator{
+			ValidatorID:   v.ValidatorID,
+			ValidatorName: v.ValidatorName,
+			Earned:        v.Earned.String(),
+			Withdrawn:     v.Withdrawn.String(),
+			States:        []PortfolioState{},
+		}
+		for _, s := range v.States {
+			ps := PortfolioState{State: s.State.String(), Amount: s.Amount.String(), Delegations: []Delegation{}}
+			for _, d := range s.Delegations {
+				ps.Delegations = append(ps.Delegations, toDelegation(d))
+			}
+			pv.States = append(pv.States, ps)
+		}
+		portfolio.Validators = append(portfolio.Validators, pv)
+	}
+	for _, d := range res.Pending {
+		portfolio.Pending = append(portfolio.Pending, toDelegation(d))
+	}
+	for _, u := range res.Unlocks {
+		portfolio.Unlocks = append(portfolio.Unlocks, DelegationUnlock{
+			DelegationID: u.DelegationID,
+			ValidatorID:  u.ValidatorID,
+			Amount:       u.Amount.String(),
+			State:        u.State.String(),
+			Until:        u.Until,
+		})
+	}
+	for _, e := range res.Epochs {
+		portfolio.Epochs = append(portfolio.Epochs, PortfolioEpoch{Epoch: e.Epoch, Start: e.Start, Value: e.Value.String(), Earned: e.Earned.String()})
+	}
+
+	enc := json.NewEncoder(w)
+	w.WriteHeader(http.StatusOK)
+	if err := enc.Encode(portfolio); err != nil {
+		w.WriteHeader(http.StatusInternalServerError)
+		w.Write(newApiError(err, http.StatusInternalServerError))
+	}
+}
@@ -0,0 +1,65 @@
+package webapi
+
+import (
+	"errors"
+	"net/url"
+	"strconv"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+)
+
+// paramsPrefix prefixes query keys filtering by decoded event parameters, as in params.holder=0x...
+const paramsPrefix = "params."
+
+// parseEventSearch parses contract events search parameters out of query
+func parseEventSearch(query url.Values, params *EventParams) (err error) {
+	params.ContractName = query.Get("contract_name")
+	params.EventName = query.Get("event_name")
+	params.TransactionHash = query.Get("transaction_hash")
+
+	if h := query.Get("height_from"); h != "" {
+		if params.HeightFrom, err = strconv.ParseUint(h, 10, 64); err != nil {
+			return errors.New("error parsing 'height_from' parameter")
+		}
+	}
+	if h := query.Get("height_to"); h != "" {
+		if params.HeightTo, err = strconv.ParseUint(h, 10, 64); err != nil {
+			return errors.New("error parsing 'height_to' parameter")
+		}
+	}
+
+	for k, v := range query {
+		if !strings.HasPrefix(k, paramsPrefix) || len(v) == 0 {
+			continue
+		}
+		key := strings.TrimPrefix(k, paramsPrefix)
+		if key == "" {
+			return errors.New("error parsing 'params' parameter, name is missing")
+		}
+		if params.Params == nil {
+			params.Params = map[string]string{}
+		}
+		params.Params[key] = v[0]
+	}
+	return nil
+}
+
+// searching reports whether events are narrowed by search parameters, so the time range may be left out
+func (p EventParams) searching() bool {
+	return p.ContractName != "" || p.EventName != "" || len(p.Params) > 0 ||
+		p.HeightFrom > 0 || p.HeightTo > 0 || p.TransactionHash != ""
+}
+
+// parseTransactionHash parses optional transaction hash filter
+func parseTransactionHash(h string) (hash common.Hash, err error) {
+	if h == "" {
+		return hash, nil
+	}
+	b, err := hexutil.Decode(h)
+	if err != nil || len(b) != common.HashLength {
+		return hash, errors.New("transaction hash given in wrong format")
+	}
+	return common.BytesToHash(b), nil
+}
@@ -0,0 +1,153 @@
+package webapi
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/google/uuid"
+
+	"github.com/figment-networks/skale-indexer/scraper/structs"
+)
+
+// FailedEvent event log which failed processing
+type FailedEvent struct {
+	ID              string      `json:"id"`
+	CreatedAt       time.Time   `json:"created_at"`
+	UpdatedAt       time.Time   `json:"updated_at"`
+	BlockHeight     uint64      `json:"block_height"`
+	TransactionHash common.Hash `json:"transaction_hash"`
+	LogIndex        uint        `json:"log_index"`
+	ContractName    string      `json:"contract_name"`
+	EventName       string      `json:"event_name"`
+	Log             types.Log   `json:"log"`
+	Error           string      `json:"error"`
+	Attempts        uint64      `json:"attempts"`
+	NextRetry       time.Time   `json:"next_retry"`
+}
+
+/*
+ * Lists, retries and discards events which failed processing
+ *
+ * GET    /admin/failed_events           - list (limit, offset)
+ * GET    /admin/failed_events/{id}      - single failed event
+ * POST   /admin/failed_events/{id}/retry - process event again
+ * DELETE /admin/failed_events/{id}      - discard event
+ */
+func (sc *ScrapeConnector) FailedEvents(w http.ResponseWriter, req *http.Request) {
+	w.Header().Add("Content-Type", "application/json")
+
+	path := strings.Trim(strings.TrimPrefix(req.URL.Path, "/admin/failed_events"), "/")
+	if path == "" {
+		if req.Method != http.MethodGet {
+			w.WriteHeader(http.StatusMethodNotAllowed)
+			w.Write(newApiError(structs.ErrNotAllowedMethod, http.StatusMethodNotAllowed))
+			return
+		}
+		sc.listFailedEvents(w, req)
+		return
+	}
+
+	parts := strings.Split(path, "/")
+	if _, err := uuid.Parse(parts[0]); err != nil || len(parts) > 2 || (len(parts) == 2 && parts[1] != "retry") {
+		w.WriteHeader(http.StatusBadRequest)
+		w.Write(newApiError(errors.New("wrong failed event path"), http.StatusBadRequest))
+		return
+	}
+	id := parts[0]
+
+	var err error
+	switch {
+	case len(parts) == 2 && req.Method == http.MethodPost:
+		err = sc.cli.RetryFailedEvent(req.Context(), id)
+	case len(parts) == 1 && req.Method == http.MethodDelete:
+		err = sc.cli.DiscardFailedEvent(req.Context(), id)
+	case len(parts) == 1 && req.Method == http.MethodGet:
+		var fes []structs.FailedEvent
+		if fes, err = sc.cli.GetFailedEvents(req.Context(), structs.FailedEventParams{ID: id}); err == nil {
+			sc.writeFailedEvents(w, toFailedEvent(fes[0]))
+			return
+		}
+	default:
+		w.WriteHeader(http.StatusMethodNotAllowed)
+		w.Write(newApiError(structs.ErrNotAllowedMethod, http.StatusMethodNotAllowed))
+		return
+	}
+
+	if err != nil {
+		if err == structs.ErrNotFound {
+			w.WriteHeader(http.StatusNotFound)
+			w.Write(newApiError(err, http.StatusNotFound))
+			return
+		}
+		w.WriteHeader(http.StatusInternalServerError)
+		w.Write(newApiError(err, http.StatusInternalServerError))
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+	w.Write([]byte(`{"status":"ok"}`))
+}
+
+func (sc *ScrapeConnector) listFailedEvents(w http.ResponseWriter, req *http.Request) {
+	params := structs.FailedEventParams{}
+	var err error
+	if limit := req.URL.Query().Get("limit"); limit != "" {
+		if params.Limit, err = strconv.ParseUint(limit, 10, 64); err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			w.Write(newApiError(errors.New("error parsing 'limit' parameter"), http.StatusBadRequest))
+			return
+		}
+	}
+	if offset := req.URL.Query().Get("offset"); offset != "" {
+		if params.Offset, err = strconv.ParseUint(offset, 10, 64); err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			w.Write(newApiError(errors.New("error parsing 'offset' parameter"), http.StatusBadRequest))
+			return
+		}
+	}
+
+	fes, err := sc.cli.GetFailedEvents(req.Context(), params)
+	if err != nil {
+		w.WriteHeader(http.StatusInternalServerError)
+		w.Write(newApiError(err, http.StatusInternalServerError))
+		return
+	}
+
+	res := []FailedEvent{}
+	for _, fe := range fes {
+		res = append(res, toFailedEvent(fe))
+	}
+	sc.writeFailedEvents(w, res)
+}
+
+func (sc *ScrapeConnector) writeFailedEvents(w http.ResponseWriter, v interface{}) {
+	enc := json.NewEncoder(w)
+	w.WriteHeader(http.StatusOK)
+	if err := enc.Encode(v); err != nil {
+		w.WriteHeader(http.StatusInternalServerError)
+		w.Write(newApiError(err, http.StatusInternalServerError))
+	}
+}
+
+func toFailedEvent(fe structs.FailedEvent) FailedEvent {
+	return FailedEvent{
+		ID:              fe.ID,
+		CreatedAt:       fe.CreatedAt,
+		UpdatedAt:       fe.UpdatedAt,
+		BlockHeight:     fe.BlockHeight,
+		TransactionHash: fe.TransactionHash,
+		LogIndex:        fe.LogIndex,
+		ContractName:    fe.ContractName,
+		EventName:       fe.EventName,
+		Log:             fe.Log,
+		Error:           fe.Error,
+		Attempts:        fe.Attempts,
+		NextRetry:       fe.NextRetry,
+	}
+}
@@ -9,7 +9,7 @@ import (
 	"strings"
 	"time"
 
-	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/common"
 	"github.com/figment-networks/skale-indexer/scraper/structs"
 )
 
@@ -18,18 +18,41 @@ import (
 // ClientContractor - method signatures for Connector
 type ClientContractor interface {
 	GetNodes(ctx context.Context, params structs.NodeParams) (nodes []structs.Node, err error)
+	CountNodes(ctx context.Context, params structs.NodeParams) (count uint64, err error)
+	GetNodeTimeline(ctx context.Context, params structs.NodeParams) (changes []structs.NodeChange, err error)
+	GetNodesMaintenance(ctx context.Context, params structs.NodeMaintenanceParams) (maintenance []structs.NodeMaintenance, err error)
 	GetValidators(ctx context.Context, params structs.ValidatorParams) (validators []structs.Validator, err error)
+	CountValidators(ctx context.Context, params structs.ValidatorParams) (count uint64, err error)
+	GetValidatorAddresses(ctx context.Context, params structs.ValidatorAddressParams) (addresses []structs.ValidatorAddress, err error)
+	GetValidatorRanking(ctx context.Context, params structs.ValidatorRankingParams) (ranking []structs.ValidatorRank, err error)
+	GetAPR(ctx context.Context, params structs.APRParams) (apr structs.APR, err error)
 	GetDelegations(ctx context.Context, params structs.DelegationParams) (delegations []structs.Delegation, err error)
+	CountDelegations(ctx context.Context, params structs.DelegationParams) (count uint64, err error)
 	GetDelegationTimeline(ctx context.Context, params structs.DelegationParams) (delegations []structs.Delegation, err error)
+	GetDelegatorPortfolio(ctx context.Context, holder common.Address) (portfolio structs.Portfolio, err error)
+	GetUnlockCalendar(ctx context.Context, params structs.UnlockCalendarParams) (calendar structs.UnlockCalendar, err error)
+	GetStatisticsSeries(ctx context.Context, params structs.StatisticsSeriesParams) (series structs.StatisticsSeries, err error)
+	GetNetworkOverview(ctx context.Context, at time.Time) (overview structs.NetworkOverview, err error)
+	GetDecentralization(ctx context.Context, params structs.DecentralizationParams) (dcs []structs.Decentralization, err error)
+	CountDelegationTimeline(ctx context.Context, params structs.DelegationParams) (count uint64, err error)
 
 	GetValidatorStatistics(ctx context.Context, params structs.ValidatorStatisticsParams) (validatorStatistics []structs.ValidatorStatistics, err error)
 	GetValidatorStatisticsTimeline(ctx context.Context, params structs.ValidatorStatisticsParams) (validatorStatistics []structs.ValidatorStatistics, err error)
 	GetAccounts(ctx context.Context, params structs.AccountParams) (accounts []structs.Account, err error)
+	CountAccounts(ctx context.Context, params structs.AccountParams) (count uint64, err error)
 
 	GetContractEvents(ctx context.Context, params structs.EventParams) (contractEvents []structs.ContractEvent, err error)
+	CountContractEvents(ctx context.Context, params structs.EventParams) (count uint64, err error)
+	GetTransaction(ctx context.Context, hash common.Hash) (tx structs.Transaction, err error)
 	GetSystemEvents(ctx context.Context, params structs.SystemEventParams) (systemEvents []structs.SystemEvent, err error)
+	CountSystemEvents(ctx context.Context, params structs.SystemEventParams) (count uint64, err error)
+
+	GetAddressActivity(ctx context.Context, params structs.ActivityParams) (activity []structs.Activity, err error)
+	CountAddressActivity(ctx context.Context, params structs.ActivityParams) (count uint64, err error)
 
 	GetTypesSummaryDelegations(ctx context.Context, params structs.DelegationParams) (delegations []structs.DelegationSummary, err error)
+
+	GetBlockAtTime(ctx context.Context, t time.Time) (block structs.Block, err error)
 }
 
 // Connector is main HTTP connector for manager
@@ -79,6 +102,18 @@ func (c *Connector) GetContractEvents(w http.ResponseWriter, req *http.Request)
 			}
 		}
 
+		if params.AtHeight, params.AtTime, err = parsePointInTime(req.URL.Query()); err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			w.Write(newApiError(err, http.StatusBadRequest))
+			return
+		}
+
+		if params.Cursor, params.Total, err = parseCursor(req.URL.Query()); err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			w.Write(newApiError(err, http.StatusBadRequest))
+			return
+		}
+
 		limit := req.URL.Query().Get("limit")
 		if limit != "" {
 			if params.Limit, err = strconv.ParseUint(limit, 10, 64); err != nil {
@@ -86,24 +121,34 @@ func (c *Connector) GetContractEvents(w http.ResponseWriter, req *http.Request)
 				w.Write(newApiError(errors.New("error parsing 'limit' parameter"), http.StatusBadRequest))
 				return
 			}
-			offset := req.URL.Query().Get("offset")
-			if params.Offset, err = strconv.ParseUint(offset, 10, 64); err != nil {
-				w.WriteHeader(http.StatusBadRequest)
-				w.Write(newApiError(errors.New("error parsing 'offset' parameter"), http.StatusBadRequest))
-				return
+			// offset is optional when paging by cursor
+			if offset := req.URL.Query().Get("offset"); offset != "" || params.Cursor == nil {
+				if params.Offset, err = strconv.ParseUint(offset, 10, 64); err != nil {
+					w.WriteHeader(http.StatusBadRequest)
+					w.Write(newApiError(errors.New("error parsing 'offset' parameter"), http.StatusBadRequest))
+					return
+				}
 			}
 		}
-		timeFrom, errFrom := time.Parse(structs.Layout, from)
-		timeTo, errTo := time.Parse(structs.Layout, to)
-		if errFrom == nil && errTo == nil {
-			params.TimeFrom = timeFrom
-			params.TimeTo = timeTo
-		} else {
+		if err = parseEventSearch(req.URL.Query(), &params); err != nil {
 			w.WriteHeader(http.StatusBadRequest)
-			w.Write(newApiError(structs.ErrMissingParameter, http.StatusBadRequest))
+			w.Write(newApiError(err, http.StatusBadRequest))
 			return
 		}
 
+		if from != "" || to != "" || !params.searching() {
+			timeFrom, errFrom := time.Parse(structs.Layout, from)
+			timeTo, errTo := time.Parse(structs.Layout, to)
+			if errFrom == nil && errTo == nil {
+				params.TimeFrom = timeFrom
+				params.TimeTo = timeTo
+			} else {
+				w.WriteHeader(http.StatusBadRequest)
+				w.Write(newApiError(structs.ErrMissingParameter, http.StatusBadRequest))
+				return
+			}
+		}
+
 		if (typeParam == "") != (idParam == "") {
 			w.WriteHeader(http.StatusBadRequest)
 			w.Write(newApiError(structs.ErrMissingParameter, http.StatusBadRequest))
@@ -136,14 +181,40 @@ func (c *Connector) GetContractEvents(w http.ResponseWriter, req *http.Request)
 		return
 	}
 
-	res, err := c.cli.GetContractEvents(req.Context(), structs.EventParams{
-		Id:       params.ID,
-		Type:     params.Type,
-		TimeFrom: params.TimeFrom,
-		TimeTo:   params.TimeTo,
-		Offset:   params.Offset,
-		Limit:    params.Limit,
-	})
+	height, ok := c.resolveHeight(w, req, params.AtHeight, params.AtTime)
+	if !ok {
+		return
+	}
+
+	cursor, ok := cursorPaging(w, params.Cursor, &params.Limit)
+	if !ok {
+		return
+	}
+
+	txHash, err := parseTransactionHash(params.TransactionHash)
+	if err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		w.Write(newApiError(err, http.StatusBadRequest))
+		return
+	}
+
+	evParams := structs.EventParams{
+		Id:              params.ID,
+		Type:            params.Type,
+		TimeFrom:        params.TimeFrom,
+		TimeTo:          params.TimeTo,
+		ContractName:    params.ContractName,
+		EventName:       params.EventName,
+		Params:          params.Params,
+		HeightFrom:      params.HeightFrom,
+		HeightTo:        params.HeightTo,
+		TransactionHash: txHash,
+		Offset:          params.Offset,
+		Limit:           params.Limit,
+		AtHeight:        height,
+		Cursor:          cursor,
+	}
+	res, err := c.cli.GetContractEvents(req.Context(), evParams)
 	if err != nil {
 		w.WriteHeader(http.StatusInternalServerError)
 		w.Write(newApiError(err, http.StatusInternalServerError))
@@ -152,25 +223,38 @@ func (c *Connector) GetContractEvents(w http.ResponseWriter, req *http.Request)
 
 	ceva := ContractEvents{}
 	for _, r := range res {
-		ceva = append(ceva, ContractEvent{
-			ID:              r.ID,
-			ContractName:    r.ContractName,
-			ContractAddress: r.ContractAddress,
-			EventName:       r.EventName,
-			BlockHeight:     r.BlockHeight,
-			Time:            r.Time,
-			TransactionHash: r.TransactionHash,
-			Params:          r.Params,
-			Removed:         r.Removed,
+		ceva = append(ceva, toContractEvent(r))
+	}
+
+	var resp interface{} = ceva
+	if params.Cursor != nil {
+		p := newPage(ceva, len(res), params.Limit, func() string {
+			last := res[len(res)-1]
+			return encodeCursor(structs.Cursor{BlockHeight: last.BlockHeight, ID: last.ID.String()})
 		})
+		if params.Total {
+			total, err := c.cli.CountContractEvents(req.Context(), evParams)
+			if err != nil {
+				w.WriteHeader(http.StatusInternalServerError)
+				w.Write(newApiError(err, http.StatusInternalServerError))
+				return
+			}
+			p.Total = &total
+		}
+		resp = p
 	}
 
 	enc := json.NewEncoder(w)
 	w.WriteHeader(http.StatusOK)
-	enc.Encode(ceva)
+	enc.Encode(resp)
 }
 
 func (c *Connector) GetNode(w http.ResponseWriter, req *http.Request) {
+	if strings.HasSuffix(strings.TrimRight(req.URL.Path, "/"), "/timeline") {
+		c.GetNodeTimeline(w, req)
+		return
+	}
+
 	w.Header().Add("Content-Type", "application/json")
 	params := NodeParams{}
 
@@ -191,6 +275,18 @@ func (c *Connector) GetNode(w http.ResponseWriter, req *http.Request) {
 		params.ValidatorID = req.URL.Query().Get("validator_id")
 		params.Status = req.URL.Query().Get("status")
 
+		if params.AtHeight, params.AtTime, err = parsePointInTime(req.URL.Query()); err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			w.Write(newApiError(err, http.StatusBadRequest))
+			return
+		}
+
+		if params.Cursor, params.Total, err = parseCursor(req.URL.Query()); err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			w.Write(newApiError(err, http.StatusBadRequest))
+			return
+		}
+
 		limit := req.URL.Query().Get("limit")
 		if limit != "" {
 			if params.Limit, err = strconv.ParseUint(limit, 10, 64); err != nil {
@@ -198,11 +294,13 @@ func (c *Connector) GetNode(w http.ResponseWriter, req *http.Request) {
 				w.Write(newApiError(errors.New("error parsing 'limit' parameter"), http.StatusBadRequest))
 				return
 			}
-			offset := req.URL.Query().Get("offset")
-			if params.Offset, err = strconv.ParseUint(offset, 10, 64); err != nil {
-				w.WriteHeader(http.StatusBadRequest)
-				w.Write(newApiError(errors.New("error parsing 'offset' parameter"), http.StatusBadRequest))
-				return
+			// offset is optional when paging by cursor
+			if offset := req.URL.Query().Get("offset"); offset != "" || params.Cursor == nil {
+				if params.Offset, err = strconv.ParseUint(offset, 10, 64); err != nil {
+					w.WriteHeader(http.StatusBadRequest)
+					w.Write(newApiError(errors.New("error parsing 'offset' parameter"), http.StatusBadRequest))
+					return
+				}
 			}
 		}
 
@@ -231,11 +329,23 @@ func (c *Connector) GetNode(w http.ResponseWriter, req *http.Request) {
 		w.Write(newApiError(structs.ErrNotAllowedMethod, http.StatusMethodNotAllowed))
 		return
 	}
+	height, ok := c.resolveHeight(w, req, params.AtHeight, params.AtTime)
+	if !ok {
+		return
+	}
+
+	cursor, ok := keyCursorPaging(w, params.Cursor, &params.Limit, false)
+	if !ok {
+		return
+	}
+
 	nParams := structs.NodeParams{
 		NodeID:      params.NodeID,
 		ValidatorID: params.ValidatorID,
 		Offset:      params.Offset,
 		Limit:       params.Limit,
+		AtHeight:    height,
+		Cursor:      cursor,
 	}
 	if params.Status != "" {
 		var ok bool
@@ -256,28 +366,41 @@ func (c *Connector) GetNode(w http.ResponseWriter, req *http.Request) {
 
 	nodes := []Node{}
 	for _, n := range res {
-		nodes = append(nodes, Node{
-			NodeID:         n.NodeID,
-			Name:           n.Name,
-			IP:             n.IP.String(),
-			PublicIP:       n.PublicIP.String(),
-			Port:           n.Port,
-			StartBlock:     n.StartBlock,
-			NextRewardDate: n.NextRewardDate,
-			LastRewardDate: n.LastRewardDate,
-			FinishTime:     n.FinishTime,
-			ValidatorID:    n.ValidatorID,
-			Status:         n.Status.String(),
-			Address:        n.Address,
+		nodes = append(nodes, toNode(n))
+	}
+
+	var resp interface{} = nodes
+	if params.Cursor != nil {
+		p := newPage(nodes, len(res), params.Limit, func() string {
+			return encodeKeyCursor(structs.KeyCursor{Key: res[len(res)-1].NodeID.String()})
 		})
+		if params.Total {
+			total, err := c.cli.CountNodes(req.Context(), nParams)
+			if err != nil {
+				w.WriteHeader(http.StatusInternalServerError)
+				w.Write(newApiError(err, http.StatusInternalServerError))
+				return
+			}
+			p.Total = &total
+		}
+		resp = p
 	}
 
 	enc := json.NewEncoder(w)
 	w.WriteHeader(http.StatusOK)
-	enc.Encode(nodes)
+	enc.Encode(resp)
 }
 
 func (c *Connector) GetValidator(w http.ResponseWriter, req *http.Request) {
+	if strings.HasSuffix(strings.TrimRight(req.URL.Path, "/"), "/addresses") {
+		c.GetValidatorAddresses(w, req)
+		return
+	}
+	if strings.HasSuffix(strings.TrimRight(req.URL.Path, "/"), "/apr") {
+		c.GetValidatorAPR(w, req)
+		return
+	}
+
 	w.Header().Add("Content-Type", "application/json")
 	allowCORSHeaders(w)
 
@@ -311,6 +434,18 @@ func (c *Connector) GetValidator(w http.ResponseWriter, req *http.Request) {
 		}
 		params.Address = req.URL.Query().Get("address")
 
+		if params.AtHeight, params.AtTime, err = parsePointInTime(req.URL.Query()); err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			w.Write(newApiError(err, http.StatusBadRequest))
+			return
+		}
+
+		if params.Cursor, params.Total, err = parseCursor(req.URL.Query()); err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			w.Write(newApiError(err, http.StatusBadRequest))
+			return
+		}
+
 		limit := req.URL.Query().Get("limit")
 		if limit != "" {
 			if params.Limit, err = strconv.ParseUint(limit, 10, 64); err != nil {
@@ -318,11 +453,13 @@ func (c *Connector) GetValidator(w http.ResponseWriter, req *http.Request) {
 				w.Write(newApiError(errors.New("error parsing 'limit' parameter"), http.StatusBadRequest))
 				return
 			}
-			offset := req.URL.Query().Get("offset")
-			if params.Offset, err = strconv.ParseUint(offset, 10, 64); err != nil {
-				w.WriteHeader(http.StatusBadRequest)
-				w.Write(newApiError(errors.New("error parsing 'offset' parameter"), http.StatusBadRequest))
-				return
+			// offset is optional when paging by cursor
+			if offset := req.URL.Query().Get("offset"); offset != "" || params.Cursor == nil {
+				if params.Offset, err = strconv.ParseUint(offset, 10, 64); err != nil {
+					w.WriteHeader(http.StatusBadRequest)
+					w.Write(newApiError(errors.New("error parsing 'offset' parameter"), http.StatusBadRequest))
+					return
+				}
 			}
 		}
 		if m != nil {
@@ -365,7 +502,17 @@ func (c *Connector) GetValidator(w http.ResponseWriter, req *http.Request) {
 		return
 	}
 
-	res, err := c.cli.GetValidators(req.Context(), structs.ValidatorParams{
+	height, ok := c.resolveHeight(w, req, params.AtHeight, params.AtTime)
+	if !ok {
+		return
+	}
+
+	cursor, ok := keyCursorPaging(w, params.Cursor, &params.Limit, false)
+	if !ok {
+		return
+	}
+
+	vParams := structs.ValidatorParams{
 		ValidatorID: params.ValidatorID,
 		TimeFrom:    params.TimeFrom,
 		TimeTo:      params.TimeTo,
@@ -373,7 +520,10 @@ func (c *Connector) GetValidator(w http.ResponseWriter, req *http.Request) {
 		Address:     params.Address,
 		Offset:      params.Offset,
 		Limit:       params.Limit,
-	})
+		AtHeight:    height,
+		Cursor:      cursor,
+	}
+	res, err := c.cli.GetValidators(req.Context(), vParams)
 	if err != nil {
 		w.WriteHeader(http.StatusInternalServerError)
 		w.Write(newApiError(errors.New("error during server query"), http.StatusInternalServerError))
@@ -399,9 +549,26 @@ func (c *Connector) GetValidator(w http.ResponseWriter, req *http.Request) {
 		})
 	}
 
+	var resp interface{} = vlds
+	if params.Cursor != nil {
+		p := newPage(vlds, len(res), params.Limit, func() string {
+			return encodeKeyCursor(structs.KeyCursor{Key: res[len(res)-1].ValidatorID.String()})
+		})
+		if params.Total {
+			total, err := c.cli.CountValidators(req.Context(), vParams)
+			if err != nil {
+				w.WriteHeader(http.StatusInternalServerError)
+				w.Write(newApiError(err, http.StatusInternalServerError))
+				return
+			}
+			p.Total = &total
+		}
+		resp = p
+	}
+
 	enc := json.NewEncoder(w)
 	w.WriteHeader(http.StatusOK)
-	if err := enc.Encode(vlds); err != nil {
+	if err := enc.Encode(resp); err != nil {
 		w.WriteHeader(http.StatusInternalServerError)
 		w.Write(newApiError(err, http.StatusInternalServerError))
 	}
@@ -448,6 +615,12 @@ func (c *Connector) GetValidatorStatistics(w http.ResponseWriter, req *http.Requ
 			}
 		}
 
+		if params.AtHeight, params.AtTime, err = parsePointInTime(req.URL.Query()); err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			w.Write(newApiError(err, http.StatusBadRequest))
+			return
+		}
+
 		limit := req.URL.Query().Get("limit")
 		if limit != "" {
 			if params.Limit, err = strconv.ParseUint(limit, 10, 64); err != nil {
@@ -494,12 +667,18 @@ func (c *Connector) GetValidatorStatistics(w http.ResponseWriter, req *http.Requ
 		return
 	}
 
+	height, ok := c.resolveHeight(w, req, params.AtHeight, params.AtTime)
+	if !ok {
+		return
+	}
+
 	vParams := structs.ValidatorStatisticsParams{
 		ValidatorID: params.ValidatorID,
 		TimeFrom:    params.TimeFrom,
 		TimeTo:      params.TimeTo,
 		Limit:       params.Limit,
 		Offset:      params.Offset,
+		AtHeight:    height,
 	}
 
 	if params.Type != "" || params.Timeline {
@@ -533,8 +712,9 @@ func (c *Connector) GetValidatorStatistics(w http.ResponseWriter, req *http.Requ
 			BlockTime:   v.Time,
 			Amount:      v.Amount.String(),
 		}
+		// addresses recorded as statistics before validator address history was introduced
 		if v.Type == structs.ValidatorStatisticsTypeValidatorAddress || v.Type == structs.ValidatorStatisticsTypeRequestedAddress {
-			vld.Amount = hexutil.Encode(v.Amount.Bytes())
+			vld.Amount = common.BigToAddress(v.Amount).Hex()
 		}
 
 		vlds = append(vlds, vld)
@@ -552,7 +732,7 @@ func (c *Connector) GetAccount(w http.ResponseWriter, req *http.Request) {
 	w.Header().Add("Content-Type", "application/json")
 	allowCORSHeaders(w)
 
-	params := structs.AccountParams{}
+	params := AccountParams{}
 	switch req.Method {
 	case http.MethodGet:
 		m := map[string]string{}
@@ -568,6 +748,12 @@ func (c *Connector) GetAccount(w http.ResponseWriter, req *http.Request) {
 		params.Type = req.URL.Query().Get("type")
 		params.Address = req.URL.Query().Get("address")
 
+		if params.Cursor, params.Total, err = parseCursor(req.URL.Query()); err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			w.Write(newApiError(err, http.StatusBadRequest))
+			return
+		}
+
 		limit := req.URL.Query().Get("limit")
 		if limit != "" {
 			if params.Limit, err = strconv.ParseUint(limit, 10, 64); err != nil {
@@ -575,11 +761,13 @@ func (c *Connector) GetAccount(w http.ResponseWriter, req *http.Request) {
 				w.Write(newApiError(errors.New("error parsing 'limit' parameter"), http.StatusBadRequest))
 				return
 			}
-			offset := req.URL.Query().Get("offset")
-			if params.Offset, err = strconv.ParseUint(offset, 10, 64); err != nil {
-				w.WriteHeader(http.StatusBadRequest)
-				w.Write(newApiError(errors.New("error parsing 'offset' parameter"), http.StatusBadRequest))
-				return
+			// offset is optional when paging by cursor
+			if offset := req.URL.Query().Get("offset"); offset != "" || params.Cursor == nil {
+				if params.Offset, err = strconv.ParseUint(offset, 10, 64); err != nil {
+					w.WriteHeader(http.StatusBadRequest)
+					w.Write(newApiError(errors.New("error parsing 'offset' parameter"), http.StatusBadRequest))
+					return
+				}
 			}
 		}
 
@@ -606,12 +794,19 @@ func (c *Connector) GetAccount(w http.ResponseWriter, req *http.Request) {
 		return
 	}
 
-	res, err := c.cli.GetAccounts(req.Context(), structs.AccountParams{
+	cursor, ok := keyCursorPaging(w, params.Cursor, &params.Limit, true)
+	if !ok {
+		return
+	}
+
+	aParams := structs.AccountParams{
 		Address: params.Address,
 		Type:    params.Type,
 		Limit:   params.Limit,
 		Offset:  params.Offset,
-	})
+		Cursor:  cursor,
+	}
+	res, err := c.cli.GetAccounts(req.Context(), aParams)
 
 	if err != nil {
 		w.WriteHeader(http.StatusInternalServerError)
@@ -627,9 +822,27 @@ func (c *Connector) GetAccount(w http.ResponseWriter, req *http.Request) {
 		})
 	}
 
+	var resp interface{} = accs
+	if params.Cursor != nil {
+		p := newPage(accs, len(res), params.Limit, func() string {
+			last := res[len(res)-1]
+			return encodeKeyCursor(structs.KeyCursor{Key: last.Address.Hex(), Time: last.CreatedAt})
+		})
+		if params.Total {
+			total, err := c.cli.CountAccounts(req.Context(), aParams)
+			if err != nil {
+				w.WriteHeader(http.StatusInternalServerError)
+				w.Write(newApiError(err, http.StatusInternalServerError))
+				return
+			}
+			p.Total = &total
+		}
+		resp = p
+	}
+
 	enc := json.NewEncoder(w)
 	w.WriteHeader(http.StatusOK)
-	if err := enc.Encode(accs); err != nil {
+	if err := enc.Encode(resp); err != nil {
 		w.WriteHeader(http.StatusInternalServerError)
 		w.Write(newApiError(err, http.StatusInternalServerError))
 	}
@@ -660,6 +873,18 @@ func (c *Connector) GetDelegation(w http.ResponseWriter, req *http.Request) {
 			params.State = strings.Split(state, ",")
 		}
 
+		if params.AtHeight, params.AtTime, err = parsePointInTime(req.URL.Query()); err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			w.Write(newApiError(err, http.StatusBadRequest))
+			return
+		}
+
+		if params.Cursor, params.Total, err = parseCursor(req.URL.Query()); err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			w.Write(newApiError(err, http.StatusBadRequest))
+			return
+		}
+
 		limit := req.URL.Query().Get("limit")
 		if limit != "" {
 			if params.Limit, err = strconv.ParseUint(limit, 10, 64); err != nil {
@@ -667,11 +892,13 @@ func (c *Connector) GetDelegation(w http.ResponseWriter, req *http.Request) {
 				w.Write(newApiError(errors.New("error parsing 'limit' parameter"), http.StatusBadRequest))
 				return
 			}
-			offset := req.URL.Query().Get("offset")
-			if params.Offset, err = strconv.ParseUint(offset, 10, 64); err != nil {
-				w.WriteHeader(http.StatusBadRequest)
-				w.Write(newApiError(errors.New("error parsing 'offset' parameter"), http.StatusBadRequest))
-				return
+			// offset is optional when paging by cursor
+			if offset := req.URL.Query().Get("offset"); offset != "" || params.Cursor == nil {
+				if params.Offset, err = strconv.ParseUint(offset, 10, 64); err != nil {
+					w.WriteHeader(http.StatusBadRequest)
+					w.Write(newApiError(errors.New("error parsing 'offset' parameter"), http.StatusBadRequest))
+					return
+				}
 			}
 		}
 
@@ -726,6 +953,11 @@ func (c *Connector) GetDelegation(w http.ResponseWriter, req *http.Request) {
 		return
 	}
 
+	height, ok := c.resolveHeight(w, req, params.AtHeight, params.AtTime)
+	if !ok {
+		return
+	}
+
 	// DelegationState
 	var dss []structs.DelegationState
 	for _, st := range params.State {
@@ -738,6 +970,19 @@ func (c *Connector) GetDelegation(w http.ResponseWriter, req *http.Request) {
 		dss = append(dss, ds)
 	}
 
+	var (
+		cursor    structs.Cursor
+		keyCursor structs.KeyCursor
+	)
+	if params.Timeline {
+		cursor, ok = cursorPaging(w, params.Cursor, &params.Limit)
+	} else {
+		keyCursor, ok = keyCursorPaging(w, params.Cursor, &params.Limit, false)
+	}
+	if !ok {
+		return
+	}
+
 	dParams := structs.DelegationParams{
 		ValidatorID:  params.ValidatorID,
 		DelegationID: params.DelegationID,
@@ -745,9 +990,12 @@ func (c *Connector) GetDelegation(w http.ResponseWriter, req *http.Request) {
 		Holder:       params.Holder,
 		//		TimeFrom:     params.TimeFrom,
 		//		TimeTo:       params.TimeTo,
-		TimeAt: params.TimeAt,
-		Offset: params.Offset,
-		Limit:  params.Limit,
+		TimeAt:    params.TimeAt,
+		Offset:    params.Offset,
+		Limit:     params.Limit,
+		AtHeight:  height,
+		Cursor:    cursor,
+		KeyCursor: keyCursor,
 	}
 
 	var (
@@ -768,26 +1016,38 @@ func (c *Connector) GetDelegation(w http.ResponseWriter, req *http.Request) {
 
 	dlgs := []Delegation{}
 	for _, dlg := range res {
-		dlgs = append(dlgs, Delegation{
-			DelegationID:    dlg.DelegationID,
-			TransactionHash: dlg.TransactionHash,
-			Holder:          dlg.Holder,
-			ValidatorID:     dlg.ValidatorID,
-			ValidatorName:   dlg.ValidatorName,
-			BlockHeight:     dlg.BlockHeight,
-			Amount:          dlg.Amount.String(),
-			Period:          dlg.DelegationPeriod,
-			Started:         dlg.Started,
-			Created:         dlg.Created,
-			Finished:        dlg.Finished,
-			Info:            dlg.Info,
-			State:           dlg.State.String(),
+		dlgs = append(dlgs, toDelegation(dlg))
+	}
+
+	var resp interface{} = dlgs
+	if params.Cursor != nil {
+		p := newPage(dlgs, len(res), params.Limit, func() string {
+			last := res[len(res)-1]
+			if params.Timeline {
+				return encodeCursor(structs.Cursor{BlockHeight: last.BlockHeight, ID: last.ID.String()})
+			}
+			return encodeKeyCursor(structs.KeyCursor{Key: last.DelegationID.String()})
 		})
+		if params.Total {
+			var total uint64
+			if params.Timeline {
+				total, err = c.cli.CountDelegationTimeline(req.Context(), dParams)
+			} else {
+				total, err = c.cli.CountDelegations(req.Context(), dParams)
+			}
+			if err != nil {
+				w.WriteHeader(http.StatusInternalServerError)
+				w.Write(newApiError(err, http.StatusInternalServerError))
+				return
+			}
+			p.Total = &total
+		}
+		resp = p
 	}
 
 	enc := json.NewEncoder(w)
 	w.WriteHeader(http.StatusOK)
-	if err := enc.Encode(dlgs); err != nil {
+	if err := enc.Encode(resp); err != nil {
 		w.WriteHeader(http.StatusInternalServerError)
 		w.Write(newApiError(err, http.StatusInternalServerError))
 	}
@@ -816,6 +1076,18 @@ func (c *Connector) GetSystemEvents(w http.ResponseWriter, req *http.Request) {
 		params.Kind = req.URL.Query().Get("kind")
 		after := req.URL.Query().Get("after")
 
+		if params.AtHeight, params.AtTime, err = parsePointInTime(req.URL.Query()); err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			w.Write(newApiError(err, http.StatusBadRequest))
+			return
+		}
+
+		if params.Cursor, params.Total, err = parseCursor(req.URL.Query()); err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			w.Write(newApiError(err, http.StatusBadRequest))
+			return
+		}
+
 		limit := req.URL.Query().Get("limit")
 		if limit != "" {
 			if params.Limit, err = strconv.ParseUint(limit, 10, 64); err != nil {
@@ -823,11 +1095,13 @@ func (c *Connector) GetSystemEvents(w http.ResponseWriter, req *http.Request) {
 				w.Write(newApiError(errors.New("error parsing 'limit' parameter"), http.StatusBadRequest))
 				return
 			}
-			offset := req.URL.Query().Get("offset")
-			if params.Offset, err = strconv.ParseUint(offset, 10, 64); err != nil {
-				w.WriteHeader(http.StatusBadRequest)
-				w.Write(newApiError(errors.New("error parsing 'offset' parameter"), http.StatusBadRequest))
-				return
+			// offset is optional when paging by cursor
+			if offset := req.URL.Query().Get("offset"); offset != "" || params.Cursor == nil {
+				if params.Offset, err = strconv.ParseUint(offset, 10, 64); err != nil {
+					w.WriteHeader(http.StatusBadRequest)
+					w.Write(newApiError(errors.New("error parsing 'offset' parameter"), http.StatusBadRequest))
+					return
+				}
 			}
 		}
 
@@ -865,7 +1139,17 @@ func (c *Connector) GetSystemEvents(w http.ResponseWriter, req *http.Request) {
 		return
 	}
 
-	res, err := c.cli.GetSystemEvents(req.Context(), structs.SystemEventParams{
+	height, ok := c.resolveHeight(w, req, params.AtHeight, params.AtTime)
+	if !ok {
+		return
+	}
+
+	cursor, ok := cursorPaging(w, params.Cursor, &params.Limit)
+	if !ok {
+		return
+	}
+
+	seParams := structs.SystemEventParams{
 		After:       params.After,
 		Kind:        params.Kind,
 		Address:     params.Address,
@@ -875,7 +1159,10 @@ func (c *Connector) GetSystemEvents(w http.ResponseWriter, req *http.Request) {
 		ReceiverID:  params.ReceiverID,
 		Limit:       params.Limit,
 		Offset:      params.Offset,
-	})
+		AtHeight:    height,
+		Cursor:      cursor,
+	}
+	res, err := c.cli.GetSystemEvents(req.Context(), seParams)
 
 	if err != nil {
 		w.WriteHeader(http.StatusInternalServerError)
@@ -885,27 +1172,30 @@ func (c *Connector) GetSystemEvents(w http.ResponseWriter, req *http.Request) {
 
 	sEvts := []SystemEvent{}
 	for _, evt := range res {
-		sevt := structs.SysEvtTypes[evt.Kind]
-
-		sEvts = append(sEvts, SystemEvent{
-			ID:          evt.ID,
-			Height:      evt.Height,
-			Time:        evt.Time,
-			Kind:        sevt,
-			Sender:      evt.Sender,
-			Recipient:   evt.Recipient,
-			SenderID:    evt.SenderID.Uint64(),
-			RecipientID: evt.RecipientID.Uint64(),
-			Data: SystemEventData{
-				After:  evt.After,
-				Before: evt.Before,
-			},
+		sEvts = append(sEvts, toSystemEvent(evt))
+	}
+
+	var resp interface{} = sEvts
+	if params.Cursor != nil {
+		p := newPage(sEvts, len(res), params.Limit, func() string {
+			last := res[len(res)-1]
+			return encodeCursor(structs.Cursor{BlockHeight: last.Height, ID: last.ID})
 		})
+		if params.Total {
+			total, err := c.cli.CountSystemEvents(req.Context(), seParams)
+			if err != nil {
+				w.WriteHeader(http.StatusInternalServerError)
+				w.Write(newApiError(errors.New("error during server query"), http.StatusInternalServerError))
+				return
+			}
+			p.Total = &total
+		}
+		resp = p
 	}
 
 	enc := json.NewEncoder(w)
 	w.WriteHeader(http.StatusOK)
-	if err := enc.Encode(sEvts); err != nil {
+	if err := enc.Encode(resp); err != nil {
 		w.WriteHeader(http.StatusInternalServerError)
 		w.Write(newApiError(err, http.StatusInternalServerError))
 	}
@@ -942,6 +1232,12 @@ func (c *Connector) GetSummary(w http.ResponseWriter, req *http.Request) {
 		}
 		params.ValidatorID = vID
 
+		if params.AtHeight, params.AtTime, err = parsePointInTime(req.URL.Query()); err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			w.Write(newApiError(err, http.StatusBadRequest))
+			return
+		}
+
 		if at != "" {
 			params.TimeAt, err = time.Parse(structs.Layout, at)
 			if err != nil {
@@ -966,12 +1262,18 @@ func (c *Connector) GetSummary(w http.ResponseWriter, req *http.Request) {
 		return
 	}
 
+	height, ok := c.resolveHeight(w, req, params.AtHeight, params.AtTime)
+	if !ok {
+		return
+	}
+
 	dParams := structs.DelegationParams{
 		ValidatorID:  params.ValidatorID,
 		DelegationID: params.DelegationID,
 		TimeAt:       params.TimeAt,
 		Offset:       params.Offset,
 		Limit:        params.Limit,
+		AtHeight:     height,
 	}
 
 	var (
@@ -1028,17 +1330,17 @@ func (c *Connector) AttachToHandler(mux *http.ServeMux) {
 	//     x-go-type:
 	//       import:
 	//         package: "time"
-	//     required: true
+	//     required: false
 	//     type: string
-	//     description: the inclusive beginning of the time range for event time
+	//     description: the inclusive beginning of the time range for event time, required unless events are searched by name, params, block range or transaction
 	//   - in: query
 	//     name: to
 	//     x-go-type:
 	//       import:
 	//         package: "time"
-	//     required: true
+	//     required: false
 	//     type: string
-	//     description: the inclusive ending of the time range for event time
+	//     description: the inclusive ending of the time range for event time, required unless events are searched by name, params, block range or transaction
 	//   - in: query
 	//     name: type
 	//     type: string
@@ -1050,6 +1352,61 @@ func (c *Connector) AttachToHandler(mux *http.ServeMux) {
 	//     type: string
 	//     required: false
 	//     description: bound id
+	//   - in: query
+	//     name: contract_name
+	//     type: string
+	//     required: false
+	//     description: name of contract emitting events
+	//     example: delegation_controller
+	//   - in: query
+	//     name: event_name
+	//     type: string
+	//     required: false
+	//     description: event name
+	//     example: DelegationProposed
+	//   - in: query
+	//     name: params.{name}
+	//     type: string
+	//     required: false
+	//     description: decoded event parameter, matched as text, number, boolean or case insensitive hex. Several of them may be sent
+	//     example: params.holder=0x06dd71dab27c1a3e0b172d53735f00bf1a66eb79
+	//   - in: query
+	//     name: height_from
+	//     type: int
+	//     required: false
+	//     description: the inclusive beginning of the block range
+	//   - in: query
+	//     name: height_to
+	//     type: int
+	//     required: false
+	//     description: the inclusive ending of the block range
+	//   - in: query
+	//     name: transaction_hash
+	//     type: string
+	//     required: false
+	//     description: hash of transaction emitting events
+	//   - in: query
+	//     name: at_height
+	//     type: int
+	//     required: false
+	//     description: block height to return the state at, not to be sent with at_time
+	//     example: 12000000
+	//   - in: query
+	//     name: at_time
+	//     type: string
+	//     required: false
+	//     description: time to return the state at, resolved to the last block at or before it, not to be sent with at_height
+	//     example: 2021-06-01T00:00:00Z
+	//   - in: query
+	//     name: cursor
+	//     type: string
+	//     required: false
+	//     description: next_cursor of the previous page, empty for the first one. When sent, records are wrapped in Page
+	//   - in: query
+	//     name: total
+	//     type: boolean
+	//     required: false
+	//     description: whether to count all the records matching the filter, used with cursor only
 	//
 	// Responses:
 	//   default:
@@ -1144,16 +1501,38 @@ func (c *Connector) AttachToHandler(mux *http.ServeMux) {
 	//     required: false
 	//     description: offset of records returned
 	//     example: 1
-	//
-	// Responses:
-	//   default:
-	//     schema:
-	//       "$ref": "#/definitions/ApiError"
-	//   '200':
-	//     schema:
-	//       "$ref": "#/definitions/Nodes"
-	//   '400':
-	//     schema:
+	//   - in: query
+	//     name: at_height
+	//     type: int
+	//     required: false
+	//     description: block height to return the state at, not to be sent with at_time
+	//     example: 12000000
+	//   - in: query
+	//     name: at_time
+	//     type: string
+	//     required: false
+	//     description: time to return the state at, resolved to the last block at or before it, not to be sent with at_height
+	//     example: 2021-06-01T00:00:00Z
+	//   - in: query
+	//     name: cursor
+	//     type: string
+	//     required: false
+	//     description: next_cursor of the previous page, empty for the first one. When sent, records are wrapped in Page
+	//   - in: query
+	//     name: total
+	//     type: boolean
+	//     required: false
+	//     description: whether to count all the records matching the filter, used with cursor only
+	//
+	// Responses:
+	//   default:
+	//     schema:
+	//       "$ref": "#/definitions/ApiError"
+	//   '200':
+	//     schema:
+	//       "$ref": "#/definitions/Nodes"
+	//   '400':
+	//     schema:
 	//       "$ref": "#/definitions/ApiError"
 	//   '500':
 	//     schema:
@@ -1197,6 +1576,106 @@ func (c *Connector) AttachToHandler(mux *http.ServeMux) {
 	mux.HandleFunc("/nodes/", c.GetNode)
 	mux.HandleFunc("/nodes", c.GetNode)
 
+	// swagger:operation GET /nodes/{id}/timeline Nodes getNodeTimeline
+	//
+	// Node timeline endpoint
+	//
+	// This endpoint returns every change of node (status, ip, port, address...), the latest first
+	//
+	// ---
+	// Produces:
+	// - application/json
+	// Schemes:
+	// - http
+	//
+	// Parameters:
+	//   - in: path
+	//     name: id
+	//     type: string
+	//     required: true
+	//     description: the index of node in SKALE deployed smart contract
+	//   - in: query
+	//     name: limit
+	//     type: int
+	//     required: false
+	//     description: limit of records returned
+	//     example: 1
+	//   - in: query
+	//     name: offset
+	//     type: int
+	//     required: false
+	//     description: offset of records returned
+	//     example: 1
+	//
+	// Responses:
+	//   default:
+	//     schema:
+	//       "$ref": "#/definitions/ApiError"
+	//   '200':
+	//     schema:
+	//       type: array
+	//       items:
+	//         "$ref": "#/definitions/NodeChange"
+	//   '400':
+	//     schema:
+	//       "$ref": "#/definitions/ApiError"
+	//   '500':
+	//     schema:
+	//       "$ref": "#/definitions/ApiError"
+
+	// swagger:operation GET /nodes/maintenance Nodes getNodesMaintenance
+	//
+	// Nodes maintenance endpoint
+	//
+	// This endpoint returns the time nodes spent in maintenance, per node and summed up per validator
+	//
+	// ---
+	// Produces:
+	// - application/json
+	// Schemes:
+	// - http
+	//
+	// Parameters:
+	//   - in: query
+	//     name: id
+	//     type: string
+	//     required: false
+	//     description: the index of node in SKALE deployed smart contract
+	//   - in: query
+	//     name: validator_id
+	//     type: string
+	//     required: false
+	//     description: the index of validator in SKALE deployed smart contract
+	//   - in: query
+	//     name: from
+	//     type: string
+	//     required: false
+	//     description: the inclusive beginning of the time range, unbounded when not sent
+	//     example: 2020-09-22T12:42:31Z
+	//   - in: query
+	//     name: to
+	//     type: string
+	//     required: false
+	//     description: the inclusive ending of the time range, now when not sent
+	//     example: 2021-09-22T12:42:31Z
+	//
+	// Responses:
+	//   default:
+	//     schema:
+	//       "$ref": "#/definitions/ApiError"
+	//   '200':
+	//     schema:
+	//       type: array
+	//       items:
+	//         "$ref": "#/definitions/ValidatorMaintenance"
+	//   '400':
+	//     schema:
+	//       "$ref": "#/definitions/ApiError"
+	//   '500':
+	//     schema:
+	//       "$ref": "#/definitions/ApiError"
+	mux.HandleFunc("/nodes/maintenance", c.GetNodesMaintenance)
+
 	// swagger:operation GET /validators Validator getValidators
 	//
 	// Validators returning endpoint
@@ -1248,6 +1727,28 @@ func (c *Connector) AttachToHandler(mux *http.ServeMux) {
 	//     required: false
 	//     description: offset of records returned
 	//     example: 1
+	//   - in: query
+	//     name: at_height
+	//     type: int
+	//     required: false
+	//     description: block height to return the state at, not to be sent with at_time
+	//     example: 12000000
+	//   - in: query
+	//     name: at_time
+	//     type: string
+	//     required: false
+	//     description: time to return the state at, resolved to the last block at or before it, not to be sent with at_height
+	//     example: 2021-06-01T00:00:00Z
+	//   - in: query
+	//     name: cursor
+	//     type: string
+	//     required: false
+	//     description: next_cursor of the previous page, empty for the first one. When sent, records are wrapped in Page
+	//   - in: query
+	//     name: total
+	//     type: boolean
+	//     required: false
+	//     description: whether to count all the records matching the filter, used with cursor only
 	//
 	// Responses:
 	//   default:
@@ -1291,15 +1792,305 @@ func (c *Connector) AttachToHandler(mux *http.ServeMux) {
 	//       "$ref": "#/definitions/ApiError"
 	//   '200':
 	//     schema:
-	//       "$ref": "#/definitions/Validators"
+	//       "$ref": "#/definitions/Validators"
+	//   '400':
+	//     schema:
+	//       "$ref": "#/definitions/ApiError"
+	//   '500':
+	//     schema:
+	//       "$ref": "#/definitions/ApiError"
+	mux.HandleFunc("/validators/", c.GetValidator)
+	mux.HandleFunc("/validators", c.GetValidator)
+
+	// swagger:operation GET /validators/ranking Validator getValidatorRanking
+	//
+	// Validator ranking endpoint
+	//
+	// This endpoint returns validators ranked by the weighted score of total stake, effective stake, delegators, fee,
+	// active nodes, uptime and stake growth. Metrics are normalized between the lowest and the highest value of all validators
+	//
+	// ---
+	// Produces:
+	// - application/json
+	// Schemes:
+	// - http
+	//
+	// Parameters:
+	//   - in: query
+	//     name: order_by
+	//     type: string
+	//     required: false
+	//     description: score (default) or a single metric to rank by - total_stake, effective_stake, delegators, fee, active_nodes, uptime, stake_growth
+	//   - in: query
+	//     name: weight_{metric}
+	//     type: number
+	//     required: false
+	//     description: weight of the metric, e.g. weight_fee=2. Metrics without weight weigh zero, all of them weigh one when none is sent
+	//   - in: query
+	//     name: growth_days
+	//     type: int
+	//     required: false
+	//     description: number of days stake growth is computed over, 30 by default
+	//   - in: query
+	//     name: uptime_days
+	//     type: int
+	//     required: false
+	//     description: number of days uptime is computed over, 30 by default
+	//   - in: query
+	//     name: limit
+	//     type: int
+	//     required: false
+	//     description: limit of records returned
+	//   - in: query
+	//     name: offset
+	//     type: int
+	//     required: false
+	//     description: offset of records returned
+	//
+	// Responses:
+	//   default:
+	//     schema:
+	//       "$ref": "#/definitions/ApiError"
+	//   '200':
+	//     schema:
+	//       type: array
+	//       items:
+	//         "$ref": "#/definitions/ValidatorRank"
+	//   '400':
+	//     schema:
+	//       "$ref": "#/definitions/ApiError"
+	//   '500':
+	//     schema:
+	//       "$ref": "#/definitions/ApiError"
+	mux.HandleFunc("/validators/ranking", c.GetValidatorRanking)
+
+	// swagger:operation GET /validators/{id}/apr Validator getValidatorAPR
+	//
+	// Validator APR endpoint
+	//
+	// This endpoint returns the realised APR and APY of validator delegations per delegation period, computed from bounty paid
+	// to validator nodes, validator fee and effective stake within the time range. Formula inputs are returned with the result
+	//
+	// ---
+	// Produces:
+	// - application/json
+	// Schemes:
+	// - http
+	//
+	// Parameters:
+	//   - in: path
+	//     name: id
+	//     type: string
+	//     required: true
+	//     description: the index of validator in SKALE deployed smart contract
+	//   - in: query
+	//     name: from
+	//     type: string
+	//     required: false
+	//     description: the beginning of the time range, 30 days before its ending when not sent
+	//     example: 2021-05-01T00:00:00Z
+	//   - in: query
+	//     name: to
+	//     type: string
+	//     required: false
+	//     description: the ending of the time range, now when not sent
+	//     example: 2021-06-01T00:00:00Z
+	//
+	// Responses:
+	//   default:
+	//     schema:
+	//       "$ref": "#/definitions/ApiError"
+	//   '200':
+	//     schema:
+	//       "$ref": "#/definitions/APR"
+	//   '400':
+	//     schema:
+	//       "$ref": "#/definitions/ApiError"
+	//   '500':
+	//     schema:
+	//       "$ref": "#/definitions/ApiError"
+
+	// swagger:operation GET /network/apr Network getNetworkAPR
+	//
+	// Network APR endpoint
+	//
+	// This endpoint returns the realised APR and APY of all delegations per delegation period, computed from bounty paid
+	// to all nodes, validator fees and effective stake within the time range. Formula inputs are returned with the result
+	//
+	// ---
+	// Produces:
+	// - application/json
+	// Schemes:
+	// - http
+	//
+	// Parameters:
+	//   - in: query
+	//     name: from
+	//     type: string
+	//     required: false
+	//     description: the beginning of the time range, 30 days before its ending when not sent
+	//     example: 2021-05-01T00:00:00Z
+	//   - in: query
+	//     name: to
+	//     type: string
+	//     required: false
+	//     description: the ending of the time range, now when not sent
+	//     example: 2021-06-01T00:00:00Z
+	//
+	// Responses:
+	//   default:
+	//     schema:
+	//       "$ref": "#/definitions/ApiError"
+	//   '200':
+	//     schema:
+	//       "$ref": "#/definitions/APR"
+	//   '400':
+	//     schema:
+	//       "$ref": "#/definitions/ApiError"
+	//   '500':
+	//     schema:
+	//       "$ref": "#/definitions/ApiError"
+	mux.HandleFunc("/network/apr", c.GetNetworkAPR)
+
+	// swagger:operation GET /network/overview Network getNetworkOverview
+	//
+	// Network overview endpoint
+	//
+	// This endpoint returns total and effective stake, numbers of validators (authorized, accepting delegation requests),
+	// of active, leaving and left nodes, of unique delegators and of skale chains, slashed and forgiven totals and token supply,
+	// with the figures a month before and their change since
+	//
+	// ---
+	// Produces:
+	// - application/json
+	// Schemes:
+	// - http
+	//
+	// Parameters:
+	//   - in: query
+	//     name: at_time
+	//     type: string
+	//     required: false
+	//     description: the time network is summed up at, the current state when not sent
+	//     example: 2021-06-01T00:00:00Z
+	//
+	// Responses:
+	//   default:
+	//     schema:
+	//       "$ref": "#/definitions/ApiError"
+	//   '200':
+	//     schema:
+	//       "$ref": "#/definitions/NetworkOverview"
+	//   '400':
+	//     schema:
+	//       "$ref": "#/definitions/ApiError"
+	//   '404':
+	//     schema:
+	//       "$ref": "#/definitions/ApiError"
+	//   '500':
+	//     schema:
+	//       "$ref": "#/definitions/ApiError"
+	mux.HandleFunc("/network/overview", c.GetNetworkOverview)
+
+	// swagger:operation GET /network/decentralization Network getDecentralization
+	//
+	// Stake decentralisation endpoint
+	//
+	// This endpoint returns metrics of distribution of stake between validators at the beginning of every epoch, the latest first:
+	// Nakamoto coefficient, Gini coefficient, shares of the largest 1, 5 and 10 validators
+	// and the numbers of validators controlling more than 33%, 50% and 66% of stake
+	//
+	// ---
+	// Produces:
+	// - application/json
+	// Schemes:
+	// - http
+	//
+	// Parameters:
+	//   - in: query
+	//     name: from_epoch
+	//     type: integer
+	//     required: false
+	//     description: the first epoch, months since January 2020
+	//   - in: query
+	//     name: to_epoch
+	//     type: integer
+	//     required: false
+	//     description: the last epoch
+	//   - in: query
+	//     name: limit
+	//     type: integer
+	//     required: false
+	//     description: number of epochs returned
+	//   - in: query
+	//     name: offset
+	//     type: integer
+	//     required: false
+	//     description: number of epochs skipped
+	//
+	// Responses:
+	//   default:
+	//     schema:
+	//       "$ref": "#/definitions/ApiError"
+	//   '200':
+	//     schema:
+	//       type: array
+	//       items:
+	//         "$ref": "#/definitions/Decentralization"
+	//   '400':
+	//     schema:
+	//       "$ref": "#/definitions/ApiError"
+	//   '500':
+	//     schema:
+	//       "$ref": "#/definitions/ApiError"
+	mux.HandleFunc("/network/decentralization", c.GetDecentralization)
+
+	// swagger:operation GET /validators/{id}/addresses Validators getValidatorAddresses
+	//
+	// Validator addresses endpoint
+	//
+	// This endpoint returns every change of validator address and of the address requested to replace it, the latest first
+	//
+	// ---
+	// Produces:
+	// - application/json
+	// Schemes:
+	// - http
+	//
+	// Parameters:
+	//   - in: path
+	//     name: id
+	//     type: string
+	//     required: true
+	//     description: the index of validator in SKALE deployed smart contract
+	//   - in: query
+	//     name: limit
+	//     type: int
+	//     required: false
+	//     description: limit of records returned
+	//     example: 1
+	//   - in: query
+	//     name: offset
+	//     type: int
+	//     required: false
+	//     description: offset of records returned
+	//     example: 1
+	//
+	// Responses:
+	//   default:
+	//     schema:
+	//       "$ref": "#/definitions/ApiError"
+	//   '200':
+	//     schema:
+	//       type: array
+	//       items:
+	//         "$ref": "#/definitions/ValidatorAddress"
 	//   '400':
 	//     schema:
 	//       "$ref": "#/definitions/ApiError"
 	//   '500':
 	//     schema:
 	//       "$ref": "#/definitions/ApiError"
-	mux.HandleFunc("/validators/", c.GetValidator)
-	mux.HandleFunc("/validators", c.GetValidator)
 
 	// swagger:operation GET /validators/statistics ValidatorStatistics getValidatorStatistics
 	//
@@ -1359,6 +2150,18 @@ func (c *Connector) AttachToHandler(mux *http.ServeMux) {
 	//     description: offset of records returned
 	//     example: 1
 	//
+	//   - in: query
+	//     name: at_height
+	//     type: int
+	//     required: false
+	//     description: block height to return the state at, not to be sent with at_time
+	//     example: 12000000
+	//   - in: query
+	//     name: at_time
+	//     type: string
+	//     required: false
+	//     description: time to return the state at, resolved to the last block at or before it, not to be sent with at_height
+	//     example: 2021-06-01T00:00:00Z
 	//
 	// Responses:
 	//   default:
@@ -1409,6 +2212,65 @@ func (c *Connector) AttachToHandler(mux *http.ServeMux) {
 	//   '500':
 	//     schema:
 	//       "$ref": "#/definitions/ApiError"
+	// swagger:operation GET /validators/statistics/series ValidatorStatistics getStatisticsSeries
+	//
+	// Statistics series endpoint
+	//
+	// This endpoint returns values of validator statistic at the end of every day, week or month (UTC, weeks start on Monday),
+	// carried forward from the last change, for the validators and aggregated for the whole network.
+	// Network aggregate sums stake and nodes, and averages MDR and fee rate
+	//
+	// ---
+	// Produces:
+	// - application/json
+	// Schemes:
+	// - http
+	//
+	// Parameters:
+	//   - in: query
+	//     name: type
+	//     type: string
+	//     required: true
+	//     description: statistics type, one of TOTAL_STAKE, ACTIVE_NODES, LINKED_NODES, AUTHORIZED, MDR, FEE
+	//     example: TOTAL_STAKE
+	//   - in: query
+	//     name: interval
+	//     type: string
+	//     required: false
+	//     description: length of buckets, one of day, week, month. Day when not sent
+	//   - in: query
+	//     name: validator_id
+	//     type: string
+	//     required: false
+	//     description: comma separated indexes of validators, all validators when not sent
+	//     example: 1,2
+	//   - in: query
+	//     name: from
+	//     type: string
+	//     required: false
+	//     description: the inclusive beginning of the time range, a year before its end when not sent
+	//     example: 2021-01-01T00:00:00Z
+	//   - in: query
+	//     name: to
+	//     type: string
+	//     required: false
+	//     description: the inclusive ending of the time range, now when not sent
+	//     example: 2021-12-31T00:00:00Z
+	//
+	// Responses:
+	//   default:
+	//     schema:
+	//       "$ref": "#/definitions/ApiError"
+	//   '200':
+	//     schema:
+	//       "$ref": "#/definitions/StatisticsSeries"
+	//   '400':
+	//     schema:
+	//       "$ref": "#/definitions/ApiError"
+	//   '500':
+	//     schema:
+	//       "$ref": "#/definitions/ApiError"
+	mux.HandleFunc("/validators/statistics/series", c.GetStatisticsSeries)
 	mux.HandleFunc("/validators/statistics/", c.GetValidatorStatistics)
 	mux.HandleFunc("/validators/statistics", c.GetValidatorStatistics)
 
@@ -1478,6 +2340,28 @@ func (c *Connector) AttachToHandler(mux *http.ServeMux) {
 	//     required: false
 	//     description: offset of records returned
 	//     example: 1
+	//   - in: query
+	//     name: at_height
+	//     type: int
+	//     required: false
+	//     description: block height to return the state at, not to be sent with at_time
+	//     example: 12000000
+	//   - in: query
+	//     name: at_time
+	//     type: string
+	//     required: false
+	//     description: time to return the state at, resolved to the last block at or before it, not to be sent with at_height
+	//     example: 2021-06-01T00:00:00Z
+	//   - in: query
+	//     name: cursor
+	//     type: string
+	//     required: false
+	//     description: next_cursor of the previous page, empty for the first one. When sent, records are wrapped in Page
+	//   - in: query
+	//     name: total
+	//     type: boolean
+	//     required: false
+	//     description: whether to count all the records matching the filter, used with cursor only
 	//
 	// Responses:
 	//   default:
@@ -1531,6 +2415,93 @@ func (c *Connector) AttachToHandler(mux *http.ServeMux) {
 	mux.HandleFunc("/delegations/", c.GetDelegation)
 	mux.HandleFunc("/delegations", c.GetDelegation)
 
+	// swagger:operation GET /delegators/{address}/portfolio Delegations getDelegatorPortfolio
+	//
+	// Delegator portfolio endpoint
+	//
+	// This endpoint returns delegations of the holder grouped by validator and state, locked, unlocked and pending amounts,
+	// upcoming ends of delegation periods, earned and withdrawn bounty and the value of delegations per epoch
+	//
+	// ---
+	// Produces:
+	// - application/json
+	// Schemes:
+	// - http
+	//
+	// Parameters:
+	//   - in: path
+	//     name: address
+	//     type: string
+	//     required: true
+	//     description: address of the token holder
+	//
+	// Responses:
+	//   default:
+	//     schema:
+	//       "$ref": "#/definitions/ApiError"
+	//   '200':
+	//     schema:
+	//       "$ref": "#/definitions/Portfolio"
+	//   '400':
+	//     schema:
+	//       "$ref": "#/definitions/ApiError"
+	//   '500':
+	//     schema:
+	//       "$ref": "#/definitions/ApiError"
+	mux.HandleFunc("/delegators/", c.GetDelegatorPortfolio)
+
+	// swagger:operation GET /calendar/unlocks Delegations getUnlockCalendar
+	//
+	// Unlock calendar endpoint
+	//
+	// This endpoint returns amounts of delegated delegations reaching the end of their period per day and per month,
+	// split to the ones with undelegation requested and the ones renewed unless it's requested, with the stake every validator loses next month
+	//
+	// ---
+	// Produces:
+	// - application/json
+	// Schemes:
+	// - http
+	//
+	// Parameters:
+	//   - in: query
+	//     name: validator_id
+	//     type: string
+	//     required: false
+	//     description: the index of validator in SKALE deployed smart contract
+	//   - in: query
+	//     name: holder
+	//     type: string
+	//     required: false
+	//     description: address of the token holder
+	//   - in: query
+	//     name: from
+	//     type: string
+	//     required: false
+	//     description: the inclusive beginning of the range of unlock dates, today when not sent
+	//     example: 2021-06-01T00:00:00Z
+	//   - in: query
+	//     name: to
+	//     type: string
+	//     required: false
+	//     description: the inclusive ending of the range of unlock dates, unbounded when not sent
+	//     example: 2021-12-31T00:00:00Z
+	//
+	// Responses:
+	//   default:
+	//     schema:
+	//       "$ref": "#/definitions/ApiError"
+	//   '200':
+	//     schema:
+	//       "$ref": "#/definitions/UnlockCalendar"
+	//   '400':
+	//     schema:
+	//       "$ref": "#/definitions/ApiError"
+	//   '500':
+	//     schema:
+	//       "$ref": "#/definitions/ApiError"
+	mux.HandleFunc("/calendar/unlocks", c.GetUnlockCalendar)
+
 	// swagger:operation GET /accounts Account getAccounts
 	//
 	// Accounts returning endpoint
@@ -1567,6 +2538,16 @@ func (c *Connector) AttachToHandler(mux *http.ServeMux) {
 	//     required: false
 	//     description: offset of records returned
 	//     example: 1
+	//   - in: query
+	//     name: cursor
+	//     type: string
+	//     required: false
+	//     description: next_cursor of the previous page, empty for the first one. When sent, records are wrapped in Page
+	//   - in: query
+	//     name: total
+	//     type: boolean
+	//     required: false
+	//     description: whether to count all the records matching the filter, used with cursor only
 	//
 	// Responses:
 	//   default:
@@ -1625,6 +2606,138 @@ func (c *Connector) AttachToHandler(mux *http.ServeMux) {
 
 	mux.HandleFunc("/summary/", c.GetSummary)
 	mux.HandleFunc("/summary", c.GetSummary)
+
+	// swagger:operation GET /blocks/at Block getBlockAtTime
+	//
+	// Block at time endpoint
+	//
+	// This endpoint returns the last ethereum block produced at or before given time
+	//
+	// ---
+	// Produces:
+	// - application/json
+	// Schemes:
+	// - http
+	//
+	// Parameters:
+	//   - in: query
+	//     name: time
+	//     x-go-type:
+	//       import:
+	//         package: "time"
+	//     required: true
+	//     type: string
+	//     description: the point in time to resolve block height for
+	//
+	// Responses:
+	//   default:
+	//     schema:
+	//       "$ref": "#/definitions/ApiError"
+	//   '200':
+	//     schema:
+	//       "$ref": "#/definitions/Block"
+	//   '400':
+	//     schema:
+	//       "$ref": "#/definitions/ApiError"
+	//   '404':
+	//     schema:
+	//       "$ref": "#/definitions/ApiError"
+	//   '500':
+	//     schema:
+	//       "$ref": "#/definitions/ApiError"
+	mux.HandleFunc("/blocks/at", c.GetBlockAtTime)
+
+	// swagger:operation GET /transactions/{hash} Transaction getTransaction
+	//
+	// Transaction endpoint
+	//
+	// This endpoint returns transaction details together with all decoded events emitted in it
+	//
+	// ---
+	// Produces:
+	// - application/json
+	// Schemes:
+	// - http
+	//
+	// Parameters:
+	//   - in: path
+	//     name: hash
+	//     type: string
+	//     required: true
+	//     description: transaction hash
+	//
+	// Responses:
+	//   default:
+	//     schema:
+	//       "$ref": "#/definitions/ApiError"
+	//   '200':
+	//     schema:
+	//       "$ref": "#/definitions/Transaction"
+	//   '400':
+	//     schema:
+	//       "$ref": "#/definitions/ApiError"
+	//   '404':
+	//     schema:
+	//       "$ref": "#/definitions/ApiError"
+	//   '500':
+	//     schema:
+	//       "$ref": "#/definitions/ApiError"
+	mux.HandleFunc("/transactions/", c.GetTransaction)
+
+	// swagger:operation GET /addresses/{address}/activity Address getAddressActivity
+	//
+	// Address activity endpoint
+	//
+	// This endpoint returns contract events, delegations, node changes and system events touching the address, the latest first
+	//
+	// ---
+	// Produces:
+	// - application/json
+	// Schemes:
+	// - http
+	//
+	// Parameters:
+	//   - in: path
+	//     name: address
+	//     type: string
+	//     required: true
+	//     description: address in hexadecimal
+	//   - in: query
+	//     name: kind
+	//     type: string
+	//     required: false
+	//     description: comma separated kinds of records, all of them by default
+	//     example: delegation,system_event
+	//   - in: query
+	//     name: cursor
+	//     type: string
+	//     required: false
+	//     description: next_cursor of the previous page, the first page when not sent
+	//   - in: query
+	//     name: limit
+	//     type: int
+	//     required: false
+	//     description: size of the page, 100 by default
+	//   - in: query
+	//     name: total
+	//     type: boolean
+	//     required: false
+	//     description: whether to count all the records touching the address
+	//
+	// Responses:
+	//   default:
+	//     schema:
+	//       "$ref": "#/definitions/ApiError"
+	//   '200':
+	//     schema:
+	//       "$ref": "#/definitions/AddressActivity"
+	//   '400':
+	//     schema:
+	//       "$ref": "#/definitions/ApiError"
+	//   '500':
+	//     schema:
+	//       "$ref": "#/definitions/ApiError"
+	mux.HandleFunc("/addresses/", c.GetAddressActivity)
 }
 
 func pathParams(path, key string) (map[string]string, error) {
@@ -1662,3 +2775,52 @@ func allowCORSHeaders(w http.ResponseWriter) {
 	w.Header().Set("Access-Control-Allow-Headers", "Content-Type")
 	w.Header().Set("Access-Control-Expose-Headers", "Authorization")
 }
+
+func toContractEvent(ce structs.ContractEvent) ContractEvent {
+	return ContractEvent{
+		ID:              ce.ID,
+		ContractName:    ce.ContractName,
+		ContractAddress: ce.ContractAddress,
+		EventName:       ce.EventName,
+		BlockHeight:     ce.BlockHeight,
+		Time:            ce.Time,
+		TransactionHash: ce.TransactionHash,
+		Params:          structs.FloatEventParams(ce.Params),
+		Removed:         ce.Removed,
+	}
+}
+
+func toDelegation(dlg structs.Delegation) Delegation {
+	return Delegation{
+		DelegationID:    dlg.DelegationID,
+		TransactionHash: dlg.TransactionHash,
+		Holder:          dlg.Holder,
+		ValidatorID:     dlg.ValidatorID,
+		ValidatorName:   dlg.ValidatorName,
+		BlockHeight:     dlg.BlockHeight,
+		Amount:          dlg.Amount.String(),
+		Period:          dlg.DelegationPeriod,
+		Started:         dlg.Started,
+		Created:         dlg.Created,
+		Finished:        dlg.Finished,
+		Info:            dlg.Info,
+		State:           dlg.State.String(),
+	}
+}
+
+func toSystemEvent(evt structs.SystemEvent) SystemEvent {
+	return SystemEvent{
+		ID:          evt.ID,
+		Height:      evt.Height,
+		Time:        evt.Time,
+		Kind:        structs.SysEvtTypes[evt.Kind],
+		Sender:      evt.Sender,
+		Recipient:   evt.Recipient,
+		SenderID:    evt.SenderID.Uint64(),
+		RecipientID: evt.RecipientID.Uint64(),
+		Data: SystemEventData{
+			After:  evt.After,
+			Before: evt.Before,
+		},
+	}
+}
@@ -1,17 +1,29 @@
 package webapi
 
 import (
+	"bufio"
+	"context"
+	"encoding/json"
 	"errors"
+	"io/ioutil"
+	"math"
 	"math/big"
 	"net/http"
 	"net/http/httptest"
 	"net/url"
+	"strconv"
 	"strings"
 	"testing"
 	"time"
 
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/gorilla/websocket"
+
 	"github.com/figment-networks/skale-indexer/client"
+	"github.com/figment-networks/skale-indexer/client/stream"
 	"github.com/figment-networks/skale-indexer/scraper/structs"
+	"github.com/figment-networks/skale-indexer/store"
+	"github.com/figment-networks/skale-indexer/store/memory"
 	storeMocks "github.com/figment-networks/skale-indexer/store/mocks"
 	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/require"
@@ -706,3 +718,1976 @@ func TestHandler(t *testing.T) {
 		})
 	}
 }
+
+func TestBlockHandler(t *testing.T) {
+	at, _ := time.Parse(structs.Layout, "2021-01-02T15:04:05Z")
+
+	tests := []struct {
+		name        string
+		query       string
+		dbBefore    structs.Block
+		dbResponse  error
+		expectQuery bool
+		code        int
+	}{
+		{
+			name:  "bad parameter time",
+			query: "time=2021",
+			code:  http.StatusBadRequest,
+		},
+		{
+			name:        "block not found",
+			query:       "time=2021-01-02T15:04:05Z",
+			expectQuery: true,
+			code:        http.StatusNotFound,
+		},
+		{
+			name:        "internal server error",
+			query:       "time=2021-01-02T15:04:05Z",
+			dbResponse:  errors.New("internal error"),
+			expectQuery: true,
+			code:        http.StatusInternalServerError,
+		},
+		{
+			name:        "success response",
+			query:       "time=2021-01-02T15:04:05Z",
+			dbBefore:    structs.Block{Number: 11000000, Time: at.Add(-time.Second)},
+			expectQuery: true,
+			code:        http.StatusOK,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+
+			mockDB := storeMocks.NewMockDataStore(mockCtrl)
+			contractor := *client.NewClient(zaptest.NewLogger(t), mockDB, nil, nil, 1, 1)
+			connector := NewClientConnector(&contractor)
+
+			req := &http.Request{Method: http.MethodGet, URL: &url.URL{Path: "/blocks/at", RawQuery: tt.query}}
+			if tt.expectQuery {
+				mockDB.EXPECT().GetBlockBounds(req.Context(), at).Return(tt.dbBefore, structs.Block{}, tt.dbResponse)
+			}
+
+			rr := httptest.NewRecorder()
+			http.HandlerFunc(connector.GetBlockAtTime).ServeHTTP(rr, req)
+			require.Equal(t, tt.code, rr.Code)
+		})
+	}
+}
+
+func TestTransactionHandler(t *testing.T) {
+	hash := common.HexToHash("0x7aa0e2f8e3c9fc2b1f4a6d0ab58b0a1b42e4a0e8d8cfb3c5a16ee5fcbf4c6c11")
+	tx := structs.Transaction{
+		Hash:     hash,
+		Value:    big.NewInt(0),
+		GasPrice: big.NewInt(1000),
+		Fee:      big.NewInt(21000000),
+		Status:   1,
+	}
+
+	tests := []struct {
+		name        string
+		path        string
+		dbTx        structs.Transaction
+		dbResponse  error
+		expectQuery bool
+		expectEvent bool
+		code        int
+	}{
+		{
+			name: "bad hash",
+			path: "/transactions/0x1234",
+			code: http.StatusBadRequest,
+		},
+		{
+			name:        "transaction not found",
+			path:        "/transactions/" + hash.Hex(),
+			dbResponse:  structs.ErrNotFound,
+			expectQuery: true,
+			code:        http.StatusNotFound,
+		},
+		{
+			name:        "internal server error",
+			path:        "/transactions/" + hash.Hex(),
+			dbResponse:  errors.New("internal error"),
+			expectQuery: true,
+			code:        http.StatusInternalServerError,
+		},
+		{
+			name:        "success response",
+			path:        "/transactions/" + hash.Hex(),
+			dbTx:        tx,
+			expectQuery: true,
+			expectEvent: true,
+			code:        http.StatusOK,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+
+			mockDB := storeMocks.NewMockDataStore(mockCtrl)
+			contractor := *client.NewClient(zaptest.NewLogger(t), mockDB, nil, nil, 1, 1)
+			connector := NewClientConnector(&contractor)
+
+			req := &http.Request{Method: http.MethodGet, URL: &url.URL{Path: tt.path}}
+			if tt.expectQuery {
+				mockDB.EXPECT().GetTransaction(req.Context(), hash).Return(tt.dbTx, tt.dbResponse)
+			}
+			if tt.expectEvent {
+				mockDB.EXPECT().GetContractEvents(req.Context(), structs.EventParams{TransactionHash: hash}).Return([]structs.ContractEvent{{EventName: "DelegationProposed", TransactionHash: hash}}, nil)
+			}
+
+			rr := httptest.NewRecorder()
+			http.HandlerFunc(connector.GetTransaction).ServeHTTP(rr, req)
+			require.Equal(t, tt.code, rr.Code)
+		})
+	}
+}
+
+func TestFailedEventsHandler(t *testing.T) {
+	id := "f1c1e3a6-47a8-4b3f-9d8e-3c6a1b2a9d10"
+
+	tests := []struct {
+		name   string
+		method string
+		path   string
+		query  string
+		expect func(mockDB *storeMocks.MockDataStore)
+		code   int
+	}{
+		{
+			name:   "list failed events",
+			method: http.MethodGet,
+			path:   "/admin/failed_events",
+			query:  "limit=10&offset=10",
+			expect: func(mockDB *storeMocks.MockDataStore) {
+				mockDB.EXPECT().GetFailedEvents(gomock.Any(), structs.FailedEventParams{Limit: 10, Offset: 10}).Return([]structs.FailedEvent{{ID: id, Attempts: 2}}, nil)
+			},
+			code: http.StatusOK,
+		},
+		{
+			name:   "bad limit",
+			method: http.MethodGet,
+			path:   "/admin/failed_events",
+			query:  "limit=a",
+			code:   http.StatusBadRequest,
+		},
+		{
+			name:   "list internal server error",
+			method: http.MethodGet,
+			path:   "/admin/failed_events",
+			expect: func(mockDB *storeMocks.MockDataStore) {
+				mockDB.EXPECT().GetFailedEvents(gomock.Any(), structs.FailedEventParams{}).Return(nil, errors.New("internal error"))
+			},
+			code: http.StatusInternalServerError,
+		},
+		{
+			name:   "failed event not found",
+			method: http.MethodGet,
+			path:   "/admin/failed_events/" + id,
+			expect: func(mockDB *storeMocks.MockDataStore) {
+				mockDB.EXPECT().GetFailedEvents(gomock.Any(), structs.FailedEventParams{ID: id}).Return(nil, structs.ErrNotFound)
+			},
+			code: http.StatusNotFound,
+		},
+		{
+			name:   "discard failed event",
+			method: http.MethodDelete,
+			path:   "/admin/failed_events/" + id,
+			expect: func(mockDB *storeMocks.MockDataStore) {
+				mockDB.EXPECT().DeleteFailedEvent(gomock.Any(), id).Return(nil)
+			},
+			code: http.StatusOK,
+		},
+		{
+			name:   "wrong id",
+			method: http.MethodDelete,
+			path:   "/admin/failed_events/123",
+			code:   http.StatusBadRequest,
+		},
+		{
+			name:   "wrong method",
+			method: http.MethodPut,
+			path:   "/admin/failed_events/" + id + "/retry",
+			code:   http.StatusMethodNotAllowed,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+
+			mockDB := storeMocks.NewMockDataStore(mockCtrl)
+			contractor := client.NewClient(zaptest.NewLogger(t), mockDB, nil, nil, 1, 1)
+			connector := NewScrapeConnector(zaptest.NewLogger(t), contractor, time.Second)
+
+			if tt.expect != nil {
+				tt.expect(mockDB)
+			}
+
+			req := &http.Request{Method: tt.method, URL: &url.URL{Path: tt.path, RawQuery: tt.query}}
+			rr := httptest.NewRecorder()
+			http.HandlerFunc(connector.FailedEvents).ServeHTTP(rr, req)
+			require.Equal(t, tt.code, rr.Code)
+		})
+	}
+}
+
+func TestDelegationHandlerWithStore(t *testing.T) {
+	ctx := context.Background()
+	storeDB := store.New(memory.NewDriver())
+
+	require.NoError(t, storeDB.SaveValidator(ctx, structs.Validator{ValidatorID: big.NewInt(1), Name: "validator", BlockHeight: 1}))
+	holder := common.HexToAddress("0x06dD71dAb27C1A3e0B172d53735f00Bf1a66Eb79")
+	for i, state := range []structs.DelegationState{structs.DelegationStatePROPOSED, structs.DelegationStateACCEPTED, structs.DelegationStateDELEGATED} {
+		require.NoError(t, storeDB.SaveDelegation(ctx, structs.Delegation{
+			DelegationID:     big.NewInt(7),
+			Holder:           holder,
+			ValidatorID:      big.NewInt(1),
+			BlockHeight:      uint64(10 + i),
+			TransactionHash:  common.BigToHash(big.NewInt(int64(10 + i))),
+			Amount:           big.NewInt(1000),
+			DelegationPeriod: big.NewInt(3),
+			Created:          time.Date(2021, time.March, 10, 12, 0, 0, 0, time.UTC),
+			Started:          big.NewInt(0),
+			Finished:         big.NewInt(0),
+			State:            state,
+		}))
+	}
+
+	tests := []struct {
+		name   string
+		query  string
+		code   int
+		states []string
+	}{
+		{
+			name:   "latest state",
+			query:  "validator_id=1",
+			code:   http.StatusOK,
+			states: []string{"DELEGATED"},
+		},
+		{
+			name:   "timeline",
+			query:  "validator_id=1&timeline=true",
+			code:   http.StatusOK,
+			states: []string{"DELEGATED", "ACCEPTED", "PROPOSED"},
+		},
+		{
+			name:   "state filter",
+			query:  "holder=" + holder.Hex() + "&timeline=true&state=[PROPOSED,ACCEPTED]",
+			code:   http.StatusOK,
+			states: []string{"ACCEPTED", "PROPOSED"},
+		},
+		{
+			name:   "other validator",
+			query:  "validator_id=2",
+			code:   http.StatusOK,
+			states: []string{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			contractor := client.NewClient(zaptest.NewLogger(t), storeDB, nil, nil, 1, 1)
+			connector := NewClientConnector(contractor)
+
+			req := &http.Request{Method: http.MethodGet, URL: &url.URL{Path: "/delegations", RawQuery: tt.query}}
+			rr := httptest.NewRecorder()
+			http.HandlerFunc(connector.GetDelegation).ServeHTTP(rr, req)
+			require.Equal(t, tt.code, rr.Code)
+
+			var dlgs []Delegation
+			require.NoError(t, json.NewDecoder(rr.Body).Decode(&dlgs))
+			states := []string{}
+			for _, dlg := range dlgs {
+				require.Equal(t, "validator", dlg.ValidatorName)
+				require.Equal(t, holder, dlg.Holder)
+				states = append(states, dlg.State)
+			}
+			require.Equal(t, tt.states, states)
+		})
+	}
+}
+
+func TestValidatorHandlerAtHeight(t *testing.T) {
+	ctx := context.Background()
+	storeDB := store.New(memory.NewDriver())
+
+	registered := time.Date(2021, time.March, 10, 12, 0, 0, 0, time.UTC)
+	for i, fee := range []int64{10, 25} {
+		height := uint64(10 * (i + 1))
+		require.NoError(t, storeDB.SaveValidator(ctx, structs.Validator{
+			ValidatorID:             big.NewInt(1),
+			Name:                    "validator",
+			FeeRate:                 big.NewInt(fee),
+			MinimumDelegationAmount: big.NewInt(1000),
+			RegistrationTime:        registered,
+			BlockHeight:             height,
+		}))
+		require.NoError(t, storeDB.SaveBlock(ctx, structs.Block{
+			Number: height,
+			Hash:   common.BigToHash(big.NewInt(int64(height))),
+			Time:   registered.Add(time.Duration(height) * time.Minute),
+		}))
+	}
+
+	tests := []struct {
+		name  string
+		query string
+		code  int
+		fees  []int64
+	}{
+		{
+			name:  "current state",
+			query: "",
+			code:  http.StatusOK,
+			fees:  []int64{25},
+		},
+		{
+			name:  "at height",
+			query: "at_height=15",
+			code:  http.StatusOK,
+			fees:  []int64{10},
+		},
+		{
+			name:  "before registration",
+			query: "at_height=5",
+			code:  http.StatusOK,
+			fees:  []int64{},
+		},
+		{
+			name:  "at time",
+			query: "at_time=2021-03-10T12:25:00Z",
+			code:  http.StatusOK,
+			fees:  []int64{25},
+		},
+		{
+			name:  "at time before the first block",
+			query: "at_time=2021-03-10T12:05:00Z",
+			code:  http.StatusNotFound,
+		},
+		{
+			name:  "both height and time",
+			query: "at_height=15&at_time=2021-03-10T12:25:00Z",
+			code:  http.StatusBadRequest,
+		},
+		{
+			name:  "bad parameter at_height",
+			query: "at_height=latest",
+			code:  http.StatusBadRequest,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			contractor := client.NewClient(zaptest.NewLogger(t), storeDB, nil, nil, 1, 1)
+			connector := NewClientConnector(contractor)
+
+			req := &http.Request{Method: http.MethodGet, URL: &url.URL{Path: "/validators", RawQuery: tt.query}}
+			rr := httptest.NewRecorder()
+			http.HandlerFunc(connector.GetValidator).ServeHTTP(rr, req)
+			require.Equal(t, tt.code, rr.Code)
+			if tt.code != http.StatusOK {
+				return
+			}
+
+			var vlds []Validator
+			require.NoError(t, json.NewDecoder(rr.Body).Decode(&vlds))
+			fees := []int64{}
+			for _, vld := range vlds {
+				fees = append(fees, vld.FeeRate.Int64())
+			}
+			require.Equal(t, tt.fees, fees)
+		})
+	}
+}
+
+func TestNodeTimelineHandlerWithStore(t *testing.T) {
+	ctx := context.Background()
+	storeDB := store.New(memory.NewDriver())
+
+	start := time.Date(2021, time.March, 10, 12, 0, 0, 0, time.UTC)
+	blocks := map[uint64]time.Time{10: start, 20: start.Add(time.Hour), 30: start.Add(3 * time.Hour)}
+	for height, bt := range blocks {
+		require.NoError(t, storeDB.SaveBlock(ctx, structs.Block{Number: height, Hash: common.BigToHash(big.NewInt(int64(height))), Time: bt}))
+	}
+	node := func(id int64, height uint64, status structs.NodeStatus) structs.Node {
+		return structs.Node{
+			NodeID:      big.NewInt(id),
+			ValidatorID: big.NewInt(1),
+			Name:        "node",
+			StartBlock:  big.NewInt(5),
+			FinishTime:  big.NewInt(0),
+			Status:      status,
+			BlockHeight: height,
+		}
+	}
+	require.NoError(t, storeDB.SaveNodes(ctx, []structs.Node{node(1, 10, structs.NodeStatusActive), node(2, 10, structs.NodeStatusActive)}, common.Address{}))
+	require.NoError(t, storeDB.SaveNodes(ctx, []structs.Node{node(1, 20, structs.NodeStatusInMaintenance), node(2, 20, structs.NodeStatusLeft)}, common.Address{}))
+	require.NoError(t, storeDB.SaveNodes(ctx, []structs.Node{node(1, 30, structs.NodeStatusActive), node(2, 30, structs.NodeStatusLeft)}, common.Address{}))
+
+	contractor := client.NewClient(zaptest.NewLogger(t), storeDB, nil, nil, 1, 1)
+	mux := http.NewServeMux()
+	NewClientConnector(contractor).AttachToHandler(mux)
+
+	t.Run("timeline", func(t *testing.T) {
+		rr := httptest.NewRecorder()
+		mux.ServeHTTP(rr, httptest.NewRequest(http.MethodGet, "/nodes/1/timeline", nil))
+		require.Equal(t, http.StatusOK, rr.Code)
+
+		var changes []NodeChange
+		require.NoError(t, json.NewDecoder(rr.Body).Decode(&changes))
+		require.Len(t, changes, 3)
+		require.Equal(t, "Active", changes[0].Status)
+		require.Equal(t, uint64(0), changes[0].ValidTo)
+		require.Equal(t, "In_Maintenance", changes[1].Status)
+		require.Equal(t, uint64(20), changes[1].BlockHeight)
+		require.Equal(t, uint64(30), changes[1].ValidTo)
+		require.True(t, blocks[20].Equal(*changes[1].Time))
+	})
+
+	t.Run("bad node id", func(t *testing.T) {
+		rr := httptest.NewRecorder()
+		mux.ServeHTTP(rr, httptest.NewRequest(http.MethodGet, "/nodes/first/timeline", nil))
+		require.Equal(t, http.StatusBadRequest, rr.Code)
+	})
+
+	t.Run("maintenance", func(t *testing.T) {
+		rr := httptest.NewRecorder()
+		mux.ServeHTTP(rr, httptest.NewRequest(http.MethodGet, "/nodes/maintenance?validator_id=1&from=2021-03-10T12:00:00Z&to=2021-03-10T17:00:00Z", nil))
+		require.Equal(t, http.StatusOK, rr.Code)
+
+		var validators []ValidatorMaintenance
+		require.NoError(t, json.NewDecoder(rr.Body).Decode(&validators))
+		require.Len(t, validators, 1)
+		require.Equal(t, uint64(6*3600), validators[0].TrackedSeconds)
+		require.Equal(t, uint64(2*3600), validators[0].MaintenanceSeconds)
+		require.Equal(t, []NodeMaintenance{
+			{NodeID: big.NewInt(1), TrackedSeconds: 5 * 3600, MaintenanceSeconds: 2 * 3600, Periods: 1},
+			{NodeID: big.NewInt(2), TrackedSeconds: 3600},
+		}, validators[0].Nodes)
+	})
+
+	t.Run("bad time", func(t *testing.T) {
+		rr := httptest.NewRecorder()
+		mux.ServeHTTP(rr, httptest.NewRequest(http.MethodGet, "/nodes/maintenance?from=yesterday", nil))
+		require.Equal(t, http.StatusBadRequest, rr.Code)
+	})
+}
+
+func TestValidatorAddressesHandlerWithStore(t *testing.T) {
+	ctx := context.Background()
+	storeDB := store.New(memory.NewDriver())
+
+	start := time.Date(2021, time.March, 10, 12, 0, 0, 0, time.UTC)
+	first := common.HexToAddress("0x06dd71dab27c1a3e0b172d53735f00bf1a66eb79")
+	second := common.HexToAddress("0x1f42f0b1ae2aa1d04d4b7c62c2a8a4a8c06d0a6b")
+	txHash := common.HexToHash("0x8a6e1b9a4b6d6a53aee4c1e8b7c5a2b1d1c4c6f1e0a3f5c2b9c3e1d1a0b0c0d0")
+	require.NoError(t, storeDB.SaveValidatorAddress(ctx, structs.ValidatorAddress{
+		ValidatorID: big.NewInt(1), Address: first, BlockHeight: 10, Time: start,
+	}))
+	require.NoError(t, storeDB.SaveValidatorAddress(ctx, structs.ValidatorAddress{
+		ValidatorID: big.NewInt(1), Address: first, RequestedAddress: second, BlockHeight: 20, Time: start.Add(time.Hour),
+		TransactionHash: txHash, EventName: "RequestedNewAddress",
+	}))
+
+	contractor := client.NewClient(zaptest.NewLogger(t), storeDB, nil, nil, 1, 1)
+	mux := http.NewServeMux()
+	NewClientConnector(contractor).AttachToHandler(mux)
+
+	t.Run("addresses", func(t *testing.T) {
+		rr := httptest.NewRecorder()
+		mux.ServeHTTP(rr, httptest.NewRequest(http.MethodGet, "/validators/1/addresses", nil))
+		require.Equal(t, http.StatusOK, rr.Code)
+
+		var addresses []ValidatorAddress
+		require.NoError(t, json.NewDecoder(rr.Body).Decode(&addresses))
+		require.Len(t, addresses, 2)
+		require.Equal(t, "0x06dD71dAb27C1A3e0B172d53735f00Bf1a66Eb79", addresses[0].Address)
+		require.Equal(t, second.Hex(), addresses[0].RequestedAddress)
+		require.Equal(t, txHash.Hex(), addresses[0].TransactionHash)
+		require.Equal(t, "RequestedNewAddress", addresses[0].EventName)
+		require.Equal(t, uint64(10), addresses[1].BlockHeight)
+		require.Empty(t, addresses[1].RequestedAddress)
+		require.Empty(t, addresses[1].TransactionHash)
+	})
+
+	t.Run("bad validator id", func(t *testing.T) {
+		rr := httptest.NewRecorder()
+		mux.ServeHTTP(rr, httptest.NewRequest(http.MethodGet, "/validators/first/addresses", nil))
+		require.Equal(t, http.StatusBadRequest, rr.Code)
+	})
+}
+
+func TestCursorPagingHandlerWithStore(t *testing.T) {
+	ctx := context.Background()
+	storeDB := store.New(memory.NewDriver())
+
+	start := time.Date(2021, time.March, 10, 12, 0, 0, 0, time.UTC)
+	for i, height := range []uint64{10, 11, 11, 12, 13} {
+		require.NoError(t, storeDB.SaveContractEvent(ctx, structs.ContractEvent{
+			ContractName:    "validator_service",
+			EventName:       "ValidatorRegistered",
+			BlockHeight:     height,
+			Time:            start.Add(time.Duration(i) * time.Minute),
+			TransactionHash: common.BigToHash(big.NewInt(int64(i + 1))),
+			BoundType:       "validator",
+			BoundID:         []big.Int{*big.NewInt(1)},
+		}))
+	}
+
+	contractor := client.NewClient(zaptest.NewLogger(t), storeDB, nil, nil, 1, 1)
+	mux := http.NewServeMux()
+	NewClientConnector(contractor).AttachToHandler(mux)
+
+	type eventsPage struct {
+		Items      ContractEvents `json:"items"`
+		NextCursor string         `json:"next_cursor"`
+		Total      *uint64        `json:"total"`
+	}
+	get := func(t *testing.T, query string) (p eventsPage) {
+		rr := httptest.NewRecorder()
+		mux.ServeHTTP(rr, httptest.NewRequest(http.MethodGet, "/events?from=2021-03-01T00:00:00Z&to=2021-04-01T00:00:00Z&"+query, nil))
+		require.Equal(t, http.StatusOK, rr.Code)
+		require.NoError(t, json.NewDecoder(rr.Body).Decode(&p))
+		return p
+	}
+
+	t.Run("walk pages", func(t *testing.T) {
+		p := get(t, "cursor=&limit=2&total=true")
+		require.Len(t, p.Items, 2)
+		require.Equal(t, uint64(13), p.Items[0].BlockHeight)
+		require.NotNil(t, p.Total)
+		require.Equal(t, uint64(5), *p.Total)
+
+		seen := map[string]bool{}
+		var heights []uint64
+		for {
+			for _, ce := range p.Items {
+				require.False(t, seen[ce.ID.String()])
+				seen[ce.ID.String()] = true
+				heights = append(heights, ce.BlockHeight)
+			}
+			if p.NextCursor == "" {
+				break
+			}
+			p = get(t, "limit=2&cursor="+p.NextCursor)
+			require.Nil(t, p.Total)
+		}
+		require.Equal(t, []uint64{13, 12, 11, 11, 10}, heights)
+	})
+
+	t.Run("default page size", func(t *testing.T) {
+		p := get(t, "cursor=")
+		require.Len(t, p.Items, 5)
+		require.Empty(t, p.NextCursor)
+	})
+
+	t.Run("bare array without cursor", func(t *testing.T) {
+		rr := httptest.NewRecorder()
+		mux.ServeHTTP(rr, httptest.NewRequest(http.MethodGet, "/events?from=2021-03-01T00:00:00Z&to=2021-04-01T00:00:00Z&limit=2&offset=1", nil))
+		require.Equal(t, http.StatusOK, rr.Code)
+		var ces ContractEvents
+		require.NoError(t, json.NewDecoder(rr.Body).Decode(&ces))
+		require.Equal(t, []uint64{12, 11}, []uint64{ces[0].BlockHeight, ces[1].BlockHeight})
+	})
+
+	for name, path := range map[string]string{
+		"malformed cursor":          "/events?from=2021-03-01T00:00:00Z&to=2021-04-01T00:00:00Z&cursor=bm90LWEtY3Vyc29y",
+		"malformed total":           "/events?from=2021-03-01T00:00:00Z&to=2021-04-01T00:00:00Z&cursor=&total=maybe",
+		"malformed key cursor":      "/validators?cursor=bm90LWEtbnVtYmVy",
+		"malformed account cursor":  "/accounts?cursor=MTA",
+		"malformed timeline cursor": "/delegations?timeline=true&cursor=MTA6eA",
+	} {
+		t.Run(name, func(t *testing.T) {
+			rr := httptest.NewRecorder()
+			mux.ServeHTTP(rr, httptest.NewRequest(http.MethodGet, path, nil))
+			require.Equal(t, http.StatusBadRequest, rr.Code)
+		})
+	}
+}
+
+func TestKeyCursorPagingHandlerWithStore(t *testing.T) {
+	ctx := context.Background()
+	storeDB := store.New(memory.NewDriver())
+
+	for i := int64(1); i <= 3; i++ {
+		address := common.BigToAddress(big.NewInt(i))
+		require.NoError(t, storeDB.SaveValidator(ctx, structs.Validator{ValidatorID: big.NewInt(i), Name: "validator", BlockHeight: 10}))
+		require.NoError(t, storeDB.SaveAccount(ctx, structs.Account{Address: address, Type: structs.AccountTypeDelegator}))
+		require.NoError(t, storeDB.SaveDelegation(ctx, structs.Delegation{
+			DelegationID: big.NewInt(i), Holder: address, ValidatorID: big.NewInt(1), BlockHeight: 10, Amount: big.NewInt(100),
+			DelegationPeriod: big.NewInt(3), Started: big.NewInt(0), Finished: big.NewInt(0), State: structs.DelegationStatePROPOSED,
+			TransactionHash: common.BigToHash(big.NewInt(i)),
+		}))
+	}
+	require.NoError(t, storeDB.SaveNodes(ctx, []structs.Node{
+		{NodeID: big.NewInt(1), ValidatorID: big.NewInt(1), Name: "node", StartBlock: big.NewInt(5), FinishTime: big.NewInt(0), Status: structs.NodeStatusActive, BlockHeight: 10},
+		{NodeID: big.NewInt(2), ValidatorID: big.NewInt(1), Name: "node", StartBlock: big.NewInt(5), FinishTime: big.NewInt(0), Status: structs.NodeStatusActive, BlockHeight: 10},
+		{NodeID: big.NewInt(3), ValidatorID: big.NewInt(1), Name: "node", StartBlock: big.NewInt(5), FinishTime: big.NewInt(0), Status: structs.NodeStatusActive, BlockHeight: 10},
+	}, common.Address{}))
+
+	contractor := client.NewClient(zaptest.NewLogger(t), storeDB, nil, nil, 1, 1)
+	mux := http.NewServeMux()
+	NewClientConnector(contractor).AttachToHandler(mux)
+
+	type page struct {
+		Items      []json.RawMessage `json:"items"`
+		NextCursor string            `json:"next_cursor"`
+		Total      *uint64           `json:"total"`
+	}
+	get := func(t *testing.T, path string) (p page) {
+		rr := httptest.NewRecorder()
+		mux.ServeHTTP(rr, httptest.NewRequest(http.MethodGet, path, nil))
+		require.Equal(t, http.StatusOK, rr.Code)
+		require.NoError(t, json.NewDecoder(rr.Body).Decode(&p))
+		return p
+	}
+
+	for name, path := range map[string]string{
+		"validators":          "/validators?",
+		"nodes":               "/nodes?",
+		"accounts":            "/accounts?",
+		"current delegations": "/delegations?",
+	} {
+		t.Run(name, func(t *testing.T) {
+			var all []json.RawMessage
+			rr := httptest.NewRecorder()
+			mux.ServeHTTP(rr, httptest.NewRequest(http.MethodGet, path+"limit=100&offset=0", nil))
+			require.Equal(t, http.StatusOK, rr.Code)
+			require.NoError(t, json.NewDecoder(rr.Body).Decode(&all))
+			require.Len(t, all, 3)
+
+			p := get(t, path+"cursor=&limit=2&total=true")
+			require.NotNil(t, p.Total)
+			require.Equal(t, uint64(3), *p.Total)
+
+			var items []json.RawMessage
+			for {
+				items = append(items, p.Items...)
+				if p.NextCursor == "" {
+					break
+				}
+				p = get(t, path+"limit=2&cursor="+p.NextCursor)
+			}
+			require.Equal(t, all, items)
+		})
+	}
+}
+
+func TestContractEventParamsHandlerWithStore(t *testing.T) {
+	ctx := context.Background()
+	storeDB := store.New(memory.NewDriver())
+
+	amount, _ := new(big.Int).SetString("1000000000000000000000", 10)
+	require.NoError(t, storeDB.SaveContractEvent(ctx, structs.ContractEvent{
+		ContractName:    "delegation_controller",
+		EventName:       "DelegationProposed",
+		BlockHeight:     10,
+		Time:            time.Date(2021, time.March, 10, 12, 0, 0, 0, time.UTC),
+		TransactionHash: common.BigToHash(big.NewInt(1)),
+		Params:          map[string]interface{}{"delegationId": big.NewInt(1), "amount": amount},
+		BoundType:       "delegation",
+		BoundID:         []big.Int{*big.NewInt(1)},
+	}))
+
+	contractor := client.NewClient(zaptest.NewLogger(t), storeDB, nil, nil, 1, 1)
+	mux := http.NewServeMux()
+	NewClientConnector(contractor).AttachToHandler(mux)
+
+	rr := httptest.NewRecorder()
+	mux.ServeHTTP(rr, httptest.NewRequest(http.MethodGet, "/events?from=2021-03-01T00:00:00Z&to=2021-04-01T00:00:00Z", nil))
+	require.Equal(t, http.StatusOK, rr.Code)
+
+	// numeric params are encoded as float numbers
+	var ces []struct {
+		Params json.RawMessage `json:"params"`
+	}
+	require.NoError(t, json.NewDecoder(rr.Body).Decode(&ces))
+	require.Len(t, ces, 1)
+	require.JSONEq(t, `{"delegationId":1,"amount":1e+21}`, string(ces[0].Params))
+	require.Contains(t, string(ces[0].Params), `"amount":1e+21`)
+}
+
+func TestEventsSearchHandlerWithStore(t *testing.T) {
+	ctx := context.Background()
+	storeDB := store.New(memory.NewDriver())
+
+	start := time.Date(2021, time.March, 10, 12, 0, 0, 0, time.UTC)
+	holder := common.HexToAddress("0x06dd71dab27c1a3e0b172d53735f00bf1a66eb79")
+	txHash := common.HexToHash("0x8a6e1b9a4b6d6a53aee4c1e8b7c5a2b1d1c4c6f1e0a3f5c2b9c3e1d1a0b0c0d0")
+	for i, ce := range []structs.ContractEvent{
+		{ContractName: "delegation_controller", EventName: "DelegationProposed", TransactionHash: txHash,
+			Params: map[string]interface{}{"holder": holder, "delegationId": big.NewInt(1)}},
+		{ContractName: "delegation_controller", EventName: "DelegationAccepted",
+			Params: map[string]interface{}{"delegationId": big.NewInt(1)}},
+		{ContractName: "validator_service", EventName: "ValidatorRegistered",
+			Params: map[string]interface{}{"validatorId": big.NewInt(1)}},
+	} {
+		ce.BlockHeight = uint64(10 + i)
+		ce.Time = start.Add(time.Duration(i) * time.Minute)
+		if ce.TransactionHash == (common.Hash{}) {
+			ce.TransactionHash = common.BigToHash(big.NewInt(int64(i + 1)))
+		}
+		ce.BoundType = "delegation"
+		ce.BoundID = []big.Int{*big.NewInt(1), *big.NewInt(1)}
+		require.NoError(t, storeDB.SaveContractEvent(ctx, ce))
+	}
+
+	contractor := client.NewClient(zaptest.NewLogger(t), storeDB, nil, nil, 1, 1)
+	mux := http.NewServeMux()
+	NewClientConnector(contractor).AttachToHandler(mux)
+
+	tests := []struct {
+		name    string
+		query   string
+		heights []uint64
+	}{
+		{"event name", "event_name=DelegationAccepted", []uint64{11}},
+		{"contract name", "contract_name=delegation_controller", []uint64{11, 10}},
+		{"param", "params.holder=" + holder.Hex(), []uint64{10}},
+		{"params together", "params.delegationId=1&event_name=DelegationProposed", []uint64{10}},
+		{"block range", "height_from=11&height_to=12", []uint64{12, 11}},
+		{"transaction", "transaction_hash=" + txHash.Hex(), []uint64{10}},
+		{"search within time range", "contract_name=delegation_controller&from=2021-03-10T12:01:00Z&to=2021-03-11T00:00:00Z", []uint64{11}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rr := httptest.NewRecorder()
+			mux.ServeHTTP(rr, httptest.NewRequest(http.MethodGet, "/events?"+tt.query, nil))
+			require.Equal(t, http.StatusOK, rr.Code)
+
+			var ces ContractEvents
+			require.NoError(t, json.NewDecoder(rr.Body).Decode(&ces))
+			var heights []uint64
+			for _, ce := range ces {
+				heights = append(heights, ce.BlockHeight)
+			}
+			require.Equal(t, tt.heights, heights)
+		})
+	}
+
+	for name, query := range map[string]string{
+		"no time range nor search": "type=validator&id=1",
+		"half of time range":       "event_name=DelegationAccepted&from=2021-03-10T12:01:00Z",
+		"param without name":       "params.=1",
+		"malformed block range":    "height_from=ten",
+		"malformed transaction":    "transaction_hash=0x1234",
+	} {
+		t.Run(name, func(t *testing.T) {
+			rr := httptest.NewRecorder()
+			mux.ServeHTTP(rr, httptest.NewRequest(http.MethodGet, "/events?"+query, nil))
+			require.Equal(t, http.StatusBadRequest, rr.Code)
+		})
+	}
+}
+
+func TestAddressActivityHandlerWithStore(t *testing.T) {
+	ctx := context.Background()
+	storeDB := store.New(memory.NewDriver())
+
+	start := time.Date(2021, time.March, 10, 12, 0, 0, 0, time.UTC)
+	holder := common.HexToAddress("0x06dd71dab27c1a3e0b172d53735f00bf1a66eb79")
+	other := common.HexToAddress("0x1f42f0b1ae2aa1d04d4b7c62c2a8a4a8c06d0a6b")
+	for _, height := range []uint64{10, 11, 12, 13} {
+		require.NoError(t, storeDB.SaveBlock(ctx, structs.Block{Number: height, Hash: common.BigToHash(big.NewInt(int64(height))), Time: start.Add(time.Duration(height) * time.Minute)}))
+	}
+
+	require.NoError(t, storeDB.SaveAccount(ctx, structs.Account{Address: holder, Type: structs.AccountTypeDelegator}))
+	require.NoError(t, storeDB.SaveValidator(ctx, structs.Validator{ValidatorID: big.NewInt(1), Name: "validator", BlockHeight: 1}))
+	require.NoError(t, storeDB.SaveContractEvent(ctx, structs.ContractEvent{
+		ContractName: "delegation_controller", EventName: "DelegationProposed", BlockHeight: 10, Time: start.Add(10 * time.Minute),
+		TransactionHash: common.BigToHash(big.NewInt(10)), BoundType: "delegation", BoundID: []big.Int{*big.NewInt(1), *big.NewInt(1)},
+		BoundAddress: []common.Address{holder},
+	}))
+	require.NoError(t, storeDB.SaveContractEvent(ctx, structs.ContractEvent{
+		ContractName: "delegation_controller", EventName: "DelegationProposed", BlockHeight: 11, Time: start.Add(11 * time.Minute),
+		TransactionHash: common.BigToHash(big.NewInt(11)), BoundType: "delegation", BoundID: []big.Int{*big.NewInt(2), *big.NewInt(1)},
+		BoundAddress: []common.Address{other},
+	}))
+	require.NoError(t, storeDB.SaveDelegation(ctx, structs.Delegation{
+		DelegationID: big.NewInt(1), Holder: holder, ValidatorID: big.NewInt(1), BlockHeight: 10, Amount: big.NewInt(100),
+		DelegationPeriod: big.NewInt(3), Started: big.NewInt(0), Finished: big.NewInt(0), State: structs.DelegationStatePROPOSED,
+		TransactionHash: common.BigToHash(big.NewInt(10)),
+	}))
+	require.NoError(t, storeDB.SaveDelegation(ctx, structs.Delegation{
+		DelegationID: big.NewInt(1), Holder: holder, ValidatorID: big.NewInt(1), BlockHeight: 12, Amount: big.NewInt(100),
+		DelegationPeriod: big.NewInt(3), Started: big.NewInt(0), Finished: big.NewInt(0), State: structs.DelegationStateACCEPTED,
+		TransactionHash: common.BigToHash(big.NewInt(12)),
+	}))
+	require.NoError(t, storeDB.SaveNodes(ctx, []structs.Node{{
+		NodeID: big.NewInt(1), ValidatorID: big.NewInt(1), Address: holder, Name: "node", StartBlock: big.NewInt(5),
+		FinishTime: big.NewInt(0), Status: structs.NodeStatusActive, BlockHeight: 11,
+	}}, common.Address{}))
+	se := structs.SystemEvent{Height: 13, Time: start.Add(13 * time.Minute), Kind: structs.SysEvtTypeDelegationAccepted, Sender: other, Recipient: holder}
+	se.SenderID.SetInt64(1)
+	se.RecipientID.SetInt64(1)
+	require.NoError(t, storeDB.SaveSystemEvent(ctx, se))
+
+	contractor := client.NewClient(zaptest.NewLogger(t), storeDB, nil, nil, 1, 1)
+	mux := http.NewServeMux()
+	NewClientConnector(contractor).AttachToHandler(mux)
+
+	get := func(t *testing.T, path string) (activity AddressActivity) {
+		rr := httptest.NewRecorder()
+		mux.ServeHTTP(rr, httptest.NewRequest(http.MethodGet, path, nil))
+		require.Equal(t, http.StatusOK, rr.Code)
+		require.NoError(t, json.NewDecoder(rr.Body).Decode(&activity))
+		return activity
+	}
+
+	t.Run("walk pages", func(t *testing.T) {
+		activity := get(t, "/addresses/"+strings.ToLower(holder.Hex())+"/activity?limit=2&total=true")
+		require.Equal(t, holder.Hex(), activity.Address)
+		require.Equal(t, "delegator", activity.AccountType)
+		require.NotNil(t, activity.Total)
+		require.Equal(t, uint64(5), *activity.Total)
+
+		var kinds []string
+		var heights []uint64
+		for {
+			for _, a := range activity.Items {
+				kinds = append(kinds, a.Kind)
+				heights = append(heights, a.BlockHeight)
+				require.NotNil(t, a.Time)
+				require.Equal(t, start.Add(time.Duration(a.BlockHeight)*time.Minute), a.Time.UTC())
+			}
+			if activity.NextCursor == "" {
+				break
+			}
+			activity = get(t, "/addresses/"+holder.Hex()+"/activity?limit=2&cursor="+activity.NextCursor)
+		}
+		require.Equal(t, []uint64{13, 12, 11, 10, 10}, heights)
+		require.Equal(t, []string{"system_event", "delegation", "node"}, kinds[:3])
+		require.ElementsMatch(t, []string{"contract_event", "delegation"}, kinds[3:])
+	})
+
+	t.Run("typed items", func(t *testing.T) {
+		activity := get(t, "/addresses/"+holder.Hex()+"/activity")
+		require.Len(t, activity.Items, 5)
+		require.Empty(t, activity.NextCursor)
+		require.Equal(t, "delegation_accepted", activity.Items[0].SystemEvent.Kind)
+		require.Equal(t, "ACCEPTED", activity.Items[1].Delegation.State)
+		require.Equal(t, "node", activity.Items[2].Node.Name)
+		require.Nil(t, activity.Items[2].Delegation)
+	})
+
+	t.Run("kinds", func(t *testing.T) {
+		activity := get(t, "/addresses/"+holder.Hex()+"/activity?kind=contract_event,node")
+		require.Len(t, activity.Items, 2)
+		require.Equal(t, "node", activity.Items[0].Kind)
+		require.Equal(t, "DelegationProposed", activity.Items[1].ContractEvent.EventName)
+	})
+
+	t.Run("other address", func(t *testing.T) {
+		activity := get(t, "/addresses/"+other.Hex()+"/activity?total=true")
+		require.Empty(t, activity.AccountType)
+		require.Len(t, activity.Items, 2)
+		require.Equal(t, uint64(2), *activity.Total)
+	})
+
+	for name, path := range map[string]string{
+		"wrong address": "/addresses/0x1234/activity",
+		"wrong path":    "/addresses/" + holder.Hex(),
+		"wrong kind":    "/addresses/" + holder.Hex() + "/activity?kind=account",
+		"wrong cursor":  "/addresses/" + holder.Hex() + "/activity?cursor=bm90",
+	} {
+		t.Run(name, func(t *testing.T) {
+			rr := httptest.NewRecorder()
+			mux.ServeHTTP(rr, httptest.NewRequest(http.MethodGet, path, nil))
+			require.Equal(t, http.StatusBadRequest, rr.Code)
+		})
+	}
+}
+
+func TestStreamHandlerWithStore(t *testing.T) {
+	ctx := context.Background()
+	storeDB := store.New(memory.NewDriver())
+
+	start := time.Date(2021, time.March, 10, 12, 0, 0, 0, time.UTC)
+	holder := common.HexToAddress("0x06dd71dab27c1a3e0b172d53735f00bf1a66eb79")
+	accepted := func(height uint64, validatorID int64) structs.SystemEvent {
+		return structs.SystemEvent{Height: height, Time: start, Kind: structs.SysEvtTypeDelegationAccepted, Sender: holder, RecipientID: *big.NewInt(validatorID)}
+	}
+	for _, se := range []structs.SystemEvent{accepted(5, 2), accepted(10, 2), accepted(10, 3)} {
+		require.NoError(t, storeDB.SaveSystemEvent(ctx, se))
+	}
+	require.NoError(t, storeDB.SaveContractEvent(ctx, structs.ContractEvent{
+		ContractName: "delegation_controller", EventName: "DelegationAccepted", BlockHeight: 10, Time: start,
+		TransactionHash: common.BigToHash(big.NewInt(10)), BoundType: "delegation", BoundID: []big.Int{*big.NewInt(1), *big.NewInt(2)},
+		Params: map[string]interface{}{"delegationId": big.NewInt(1)},
+	}))
+
+	broker := stream.NewBroker(10)
+	mux := http.NewServeMux()
+	NewStreamConnector(zaptest.NewLogger(t), client.NewClient(zaptest.NewLogger(t), storeDB, nil, nil, 1, 1), broker).AttachToHandler(mux)
+	srv := httptest.NewServer(mux)
+	defer srv.Close()
+
+	t.Run("server-sent events resumed from height", func(t *testing.T) {
+		ctx, cancel := context.WithTimeout(ctx, 10*time.Second)
+		defer cancel()
+		req, err := http.NewRequestWithContext(ctx, http.MethodGet, srv.URL+"/system_events/stream?validator_id=2&event_name=DelegationAccepted", nil)
+		require.NoError(t, err)
+		req.Header.Set("Last-Event-ID", "10")
+		resp, err := http.DefaultClient.Do(req)
+		require.NoError(t, err)
+		defer resp.Body.Close()
+		require.Equal(t, http.StatusOK, resp.StatusCode)
+		require.Equal(t, "text/event-stream", resp.Header.Get("Content-Type"))
+
+		r := bufio.NewReader(resp.Body)
+		next := func() (fields map[string]string) {
+			fields = map[string]string{}
+			for {
+				line, err := r.ReadString('\n')
+				require.NoError(t, err)
+				if line = strings.TrimSuffix(line, "\n"); line == "" {
+					return fields
+				}
+				if i := strings.Index(line, ": "); i > 0 {
+					fields[line[:i]] = line[i+2:]
+				}
+			}
+		}
+
+		var types []string
+		for i := 0; i < 2; i++ {
+			f := next()
+			require.Equal(t, "10", f["id"])
+			types = append(types, f["event"])
+		}
+		require.ElementsMatch(t, []string{"system_event", "contract_event"}, types)
+
+		// already resumed one, the one of other validator and the live one
+		resumed, other, live := accepted(10, 2), accepted(11, 3), accepted(12, 2)
+		broker.Publish([]stream.Event{{SystemEvent: &resumed}, {SystemEvent: &other}, {SystemEvent: &live}})
+
+		f := next()
+		require.Equal(t, "12", f["id"])
+		var se StreamEvent
+		require.NoError(t, json.Unmarshal([]byte(f["data"]), &se))
+		require.Equal(t, "system_event", se.Type)
+		require.Equal(t, uint64(2), se.SystemEvent.RecipientID)
+		require.Equal(t, holder, se.SystemEvent.Sender)
+	})
+
+	t.Run("websocket", func(t *testing.T) {
+		conn, resp, err := websocket.DefaultDialer.Dial("ws"+strings.TrimPrefix(srv.URL, "http")+"/system_events/stream?kind=slashed&address="+holder.Hex(), nil)
+		require.NoError(t, err)
+		defer conn.Close()
+		require.Equal(t, http.StatusSwitchingProtocols, resp.StatusCode)
+
+		slashed := structs.SystemEvent{Height: 20, Time: start, Kind: structs.SysEvtTypeSlashed, Recipient: holder, After: *big.NewInt(100)}
+		delegation := accepted(20, 2)
+		ce := structs.ContractEvent{EventName: "Slash", BlockHeight: 20, BoundAddress: []common.Address{holder}}
+		broker.Publish([]stream.Event{{SystemEvent: &delegation}, {ContractEvent: &ce}, {SystemEvent: &slashed}})
+
+		conn.SetReadDeadline(time.Now().Add(10 * time.Second))
+		var se StreamEvent
+		require.NoError(t, conn.ReadJSON(&se))
+		require.Equal(t, "system_event", se.Type)
+		require.Equal(t, uint64(20), se.Height)
+		require.Equal(t, holder, se.SystemEvent.Recipient)
+	})
+
+	t.Run("wrong parameters", func(t *testing.T) {
+		for _, query := range []string{"kind=unknown", "validator_id=x", "address=0x12", "from_height=-1"} {
+			rr := httptest.NewRecorder()
+			mux.ServeHTTP(rr, httptest.NewRequest(http.MethodGet, "/system_events/stream?"+query, nil))
+			require.Equal(t, http.StatusBadRequest, rr.Code, query)
+		}
+	})
+}
+
+func TestWebhooksHandlerWithStore(t *testing.T) {
+	ctx := context.Background()
+	storeDB := store.New(memory.NewDriver())
+	cli := client.NewClient(zaptest.NewLogger(t), storeDB, nil, nil, 1, 1)
+	mux := http.NewServeMux()
+	NewScrapeConnector(zaptest.NewLogger(t), cli, time.Second).AttachToHandler(mux)
+
+	// partner's endpoint fails the first delivery
+	var received []client.WebhookPayload
+	partner := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		body, err := ioutil.ReadAll(req.Body)
+		require.NoError(t, err)
+		ts, err := strconv.ParseInt(req.Header.Get(client.WebhookTimestampHeader), 10, 64)
+		require.NoError(t, err)
+		expected := structs.Webhook{Secret: "s3cret"}.Sign(time.Unix(ts, 0), body)
+		require.Equal(t, "sha256="+expected, req.Header.Get(client.WebhookSignatureHeader))
+
+		var p client.WebhookPayload
+		require.NoError(t, json.Unmarshal(body, &p))
+		received = append(received, p)
+		if len(received) == 1 {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer partner.Close()
+
+	do := func(method, target, body string) *httptest.ResponseRecorder {
+		rr := httptest.NewRecorder()
+		mux.ServeHTTP(rr, httptest.NewRequest(method, target, strings.NewReader(body)))
+		return rr
+	}
+
+	rr := do(http.MethodPost, "/admin/webhooks", `{"url":"`+partner.URL+`","secret":"s3cret","kinds":["delegation_accepted","7"],"validator_id":"2"}`)
+	require.Equal(t, http.StatusCreated, rr.Code, rr.Body.String())
+	var wh Webhook
+	require.NoError(t, json.Unmarshal(rr.Body.Bytes(), &wh))
+	require.Equal(t, "s3cret", wh.Secret)
+	require.Equal(t, []string{"delegation_accepted", "slashed"}, wh.Kinds)
+	require.Equal(t, "2", wh.ValidatorID)
+	require.True(t, wh.Active)
+
+	rr = do(http.MethodGet, "/admin/webhooks/"+wh.ID, "")
+	require.Equal(t, http.StatusOK, rr.Code)
+	require.NotContains(t, rr.Body.String(), "s3cret")
+
+	for _, body := range []string{`{"url":"ftp://partner"}`, `{"url":"http://partner","kinds":["unknown"]}`, `{"url":"http://partner","address":"0x1"}`} {
+		require.Equal(t, http.StatusBadRequest, do(http.MethodPost, "/admin/webhooks", body).Code, body)
+	}
+	require.Equal(t, http.StatusNotFound, do(http.MethodGet, "/admin/webhooks/00000000-0000-0000-0000-000000000000", "").Code)
+	require.Equal(t, http.StatusBadRequest, do(http.MethodGet, "/admin/webhooks/"+wh.ID+"/other", "").Code)
+
+	se := structs.SystemEvent{Height: 10, Time: time.Now(), Kind: structs.SysEvtTypeDelegationAccepted, RecipientID: *big.NewInt(2)}
+	se.After.SetInt64(150)
+	require.NoError(t, storeDB.CreateWebhookDelivery(ctx, structs.WebhookDelivery{
+		WebhookID: wh.ID, Event: se, Status: structs.WebhookDeliveryPending, NextRetry: time.Now().Add(-time.Second),
+	}))
+
+	deliveries := func() (wds []WebhookDelivery) {
+		rr := do(http.MethodGet, "/admin/webhooks/"+wh.ID+"/deliveries", "")
+		require.Equal(t, http.StatusOK, rr.Code)
+		require.NoError(t, json.Unmarshal(rr.Body.Bytes(), &wds))
+		return wds
+	}
+
+	cli.DeliverWebhooks(ctx, 3)
+	wds := deliveries()
+	require.Len(t, wds, 1)
+	require.Equal(t, "pending", wds[0].Status)
+	require.Equal(t, uint64(1), wds[0].Attempts)
+	require.Equal(t, http.StatusServiceUnavailable, wds[0].ResponseCode)
+	require.NotEmpty(t, wds[0].Error)
+	require.True(t, wds[0].NextRetry.After(time.Now()))
+
+	// not due yet
+	cli.DeliverWebhooks(ctx, 3)
+	require.Len(t, received, 1)
+
+	stored, err := storeDB.GetWebhookDeliveries(ctx, structs.WebhookDeliveryParams{ID: wds[0].ID})
+	require.NoError(t, err)
+	stored[0].NextRetry = time.Now().Add(-time.Second)
+	require.NoError(t, storeDB.UpdateWebhookDelivery(ctx, stored[0]))
+
+	cli.DeliverWebhooks(ctx, 3)
+	wds = deliveries()
+	require.Equal(t, "delivered", wds[0].Status)
+	require.Equal(t, uint64(2), wds[0].Attempts)
+	require.Equal(t, http.StatusNoContent, wds[0].ResponseCode)
+	require.Empty(t, wds[0].Error)
+	require.NotNil(t, wds[0].DeliveredAt)
+
+	require.Len(t, received, 2)
+	require.Equal(t, wds[0].ID, received[1].DeliveryID)
+	require.Equal(t, "delegation_accepted", received[1].Event.Kind)
+	require.Equal(t, uint64(2), received[1].Event.RecipientID)
+	require.Equal(t, "150", received[1].Event.Data.After)
+
+	rr = do(http.MethodPut, "/admin/webhooks/"+wh.ID, `{"url":"`+partner.URL+`","active":false}`)
+	require.Equal(t, http.StatusOK, rr.Code, rr.Body.String())
+	var updated Webhook
+	require.NoError(t, json.Unmarshal(rr.Body.Bytes(), &updated))
+	require.False(t, updated.Active)
+	require.Empty(t, updated.Kinds)
+	require.Empty(t, updated.ValidatorID)
+	require.Empty(t, updated.Secret)
+
+	require.Equal(t, http.StatusOK, do(http.MethodDelete, "/admin/webhooks/"+wh.ID, "").Code)
+	require.Equal(t, http.StatusNotFound, do(http.MethodGet, "/admin/webhooks/"+wh.ID+"/deliveries", "").Code)
+	require.Equal(t, http.StatusNotFound, do(http.MethodDelete, "/admin/webhooks/"+wh.ID, "").Code)
+}
+
+func TestValidatorRankingHandlerWithStore(t *testing.T) {
+	ctx := context.Background()
+	storeDB := store.New(memory.NewDriver())
+
+	now := time.Now().UTC()
+	blocks := map[uint64]time.Time{10: now.Add(-40 * 24 * time.Hour), 20: now.Add(-10 * 24 * time.Hour)}
+	for height, bt := range blocks {
+		require.NoError(t, storeDB.SaveBlock(ctx, structs.Block{Number: height, Hash: common.BigToHash(big.NewInt(int64(height))), Time: bt}))
+	}
+
+	stakes := map[int64][]int64{1: {100, 200}, 2: {300, 300}}
+	for id, fee := range map[int64]int64{1: 100, 2: 50} {
+		require.NoError(t, storeDB.SaveValidator(ctx, structs.Validator{ValidatorID: big.NewInt(id), Name: "validator", FeeRate: big.NewInt(fee), BlockHeight: 10}))
+		for i, height := range []uint64{10, 20} {
+			require.NoError(t, storeDB.SaveValidatorStatistic(ctx, big.NewInt(id), height, blocks[height], structs.ValidatorStatisticsTypeTotalStake, big.NewInt(stakes[id][i])))
+		}
+		require.NoError(t, storeDB.SaveValidatorStatistic(ctx, big.NewInt(id), 20, blocks[20], structs.ValidatorStatisticsTypeActiveNodes, big.NewInt(id)))
+	}
+
+	delegations := []struct {
+		validator, amount, period int64
+		holder                    string
+	}{
+		{1, 100, 12, "0x06dD71dAb27C1A3e0B172d53735f00Bf1a66Eb79"},
+		{1, 100, 2, "0x1f42F0b1aE2aa1d04D4b7c62C2A8A4a8c06D0a6b"},
+		{2, 300, 2, "0x06dD71dAb27C1A3e0B172d53735f00Bf1a66Eb79"},
+	}
+	for i, d := range delegations {
+		require.NoError(t, storeDB.SaveDelegation(ctx, structs.Delegation{
+			DelegationID:     big.NewInt(int64(i)),
+			Holder:           common.HexToAddress(d.holder),
+			ValidatorID:      big.NewInt(d.validator),
+			BlockHeight:      20,
+			TransactionHash:  common.BigToHash(big.NewInt(int64(i))),
+			Amount:           big.NewInt(d.amount),
+			DelegationPeriod: big.NewInt(d.period),
+			Created:          blocks[20],
+			Started:          big.NewInt(0),
+			Finished:         big.NewInt(0),
+			State:            structs.DelegationStateDELEGATED,
+		}))
+	}
+	// delegation which was completed doesn't count anymore
+	for _, state := range []structs.DelegationState{structs.DelegationStateDELEGATED, structs.DelegationStateCOMPLETED} {
+		height := uint64(10)
+		if state == structs.DelegationStateCOMPLETED {
+			height = 20
+		}
+		require.NoError(t, storeDB.SaveDelegation(ctx, structs.Delegation{
+			DelegationID:     big.NewInt(9),
+			Holder:           common.HexToAddress("0x1f42F0b1aE2aa1d04D4b7c62C2A8A4a8c06D0a6b"),
+			ValidatorID:      big.NewInt(2),
+			BlockHeight:      height,
+			TransactionHash:  common.BigToHash(big.NewInt(int64(height))),
+			Amount:           big.NewInt(500),
+			DelegationPeriod: big.NewInt(2),
+			Created:          blocks[10],
+			Started:          big.NewInt(0),
+			Finished:         big.NewInt(0),
+			State:            state,
+		}))
+	}
+
+	contractor := client.NewClient(zaptest.NewLogger(t), storeDB, nil, nil, 1, 1)
+	mux := http.NewServeMux()
+	NewClientConnector(contractor).AttachToHandler(mux)
+
+	rank := func(t *testing.T, query string) (ranking []ValidatorRank) {
+		rr := httptest.NewRecorder()
+		mux.ServeHTTP(rr, httptest.NewRequest(http.MethodGet, "/validators/ranking?"+query, nil))
+		require.Equal(t, http.StatusOK, rr.Code)
+		require.NoError(t, json.NewDecoder(rr.Body).Decode(&ranking))
+		return ranking
+	}
+
+	t.Run("metrics", func(t *testing.T) {
+		ranking := rank(t, "")
+		require.Len(t, ranking, 2)
+		byID := map[int64]ValidatorRank{}
+		for _, r := range ranking {
+			byID[r.ValidatorID.Int64()] = r
+		}
+
+		require.Equal(t, "200", byID[1].TotalStake)
+		require.Equal(t, "300", byID[1].EffectiveStake)
+		require.Equal(t, uint64(2), byID[1].Delegators)
+		require.Equal(t, "100", byID[1].StakeGrowth)
+		require.Equal(t, 1.0, byID[1].StakeGrowthRate)
+		require.Equal(t, "0", byID[2].StakeGrowth)
+		require.Equal(t, uint64(1), byID[2].Delegators)
+		require.Equal(t, "300", byID[2].EffectiveStake)
+		require.Equal(t, 1.0, byID[2].Scores["fee"])
+		require.Equal(t, 0.0, byID[1].Scores["fee"])
+	})
+
+	t.Run("single metric", func(t *testing.T) {
+		ranking := rank(t, "order_by=total_stake")
+		require.Equal(t, int64(2), ranking[0].ValidatorID.Int64())
+		require.Equal(t, uint64(1), ranking[0].Rank)
+
+		ranking = rank(t, "order_by=stake_growth")
+		require.Equal(t, int64(1), ranking[0].ValidatorID.Int64())
+	})
+
+	t.Run("weights", func(t *testing.T) {
+		ranking := rank(t, "weight_fee=1")
+		require.Equal(t, int64(2), ranking[0].ValidatorID.Int64())
+		require.Equal(t, 1.0, ranking[0].Score)
+
+		ranking = rank(t, "weight_delegators=3&weight_fee=1&limit=1&offset=1")
+		require.Len(t, ranking, 1)
+		require.Equal(t, uint64(2), ranking[0].Rank)
+		require.Equal(t, int64(2), ranking[0].ValidatorID.Int64())
+		require.Equal(t, 0.25, ranking[0].Score)
+	})
+
+	t.Run("growth window", func(t *testing.T) {
+		// nothing is indexed 50 days back, so the whole stake is the growth
+		ranking := rank(t, "growth_days=50&order_by=total_stake")
+		require.Equal(t, "300", ranking[0].StakeGrowth)
+		require.Equal(t, 0.0, ranking[0].StakeGrowthRate)
+	})
+
+	for _, query := range []string{"order_by=name", "weight_fee=-1", "growth_days=month"} {
+		t.Run("bad "+query, func(t *testing.T) {
+			rr := httptest.NewRecorder()
+			mux.ServeHTTP(rr, httptest.NewRequest(http.MethodGet, "/validators/ranking?"+query, nil))
+			require.Equal(t, http.StatusBadRequest, rr.Code)
+		})
+	}
+}
+
+func TestAPRHandlerWithStore(t *testing.T) {
+	ctx := context.Background()
+	storeDB := store.New(memory.NewDriver())
+
+	day := func(d int) time.Time { return time.Date(2021, time.May, d, 0, 0, 0, 0, time.UTC) }
+	blocks := map[uint64]time.Time{5: day(1).Add(-24 * time.Hour), 20: day(15), 25: day(20), 30: day(30)}
+	for height, bt := range blocks {
+		require.NoError(t, storeDB.SaveBlock(ctx, structs.Block{Number: height, Hash: common.BigToHash(big.NewInt(int64(height))), Time: bt}))
+	}
+
+	for id, fee := range map[int64]int64{1: 100, 2: 50} {
+		require.NoError(t, storeDB.SaveValidator(ctx, structs.Validator{ValidatorID: big.NewInt(id), Name: "validator", FeeRate: big.NewInt(fee), BlockHeight: 5}))
+	}
+	// fee of the first validator is raised in the middle of the range, the second one keeps its registration fee
+	require.NoError(t, storeDB.SaveValidatorStatistic(ctx, big.NewInt(1), 5, blocks[5], structs.ValidatorStatisticsTypeFee, big.NewInt(100)))
+	require.NoError(t, storeDB.SaveValidatorStatistic(ctx, big.NewInt(1), 25, blocks[25], structs.ValidatorStatisticsTypeFee, big.NewInt(200)))
+
+	for nodeID, validatorID := range map[int64]int64{1: 1, 2: 1, 3: 2} {
+		require.NoError(t, storeDB.SaveNodes(ctx, []structs.Node{{
+			NodeID:      big.NewInt(nodeID),
+			ValidatorID: big.NewInt(validatorID),
+			Name:        "node",
+			StartBlock:  big.NewInt(5),
+			FinishTime:  big.NewInt(0),
+			Status:      structs.NodeStatusActive,
+			BlockHeight: 5,
+		}}, common.Address{}))
+	}
+
+	delegations := []struct {
+		id, validator, amount, period int64
+		height                        uint64
+	}{
+		{1, 1, 1000, 2, 5},
+		{2, 1, 1000, 12, 20},
+		{3, 2, 500, 6, 5},
+	}
+	for _, d := range delegations {
+		require.NoError(t, storeDB.SaveDelegation(ctx, structs.Delegation{
+			DelegationID:     big.NewInt(d.id),
+			Holder:           common.HexToAddress("0x06dD71dAb27C1A3e0B172d53735f00Bf1a66Eb79"),
+			ValidatorID:      big.NewInt(d.validator),
+			BlockHeight:      d.height,
+			TransactionHash:  common.BigToHash(big.NewInt(d.id)),
+			Amount:           big.NewInt(d.amount),
+			DelegationPeriod: big.NewInt(d.period),
+			Created:          blocks[d.height],
+			Started:          big.NewInt(0),
+			Finished:         big.NewInt(0),
+			State:            structs.DelegationStateDELEGATED,
+		}))
+	}
+
+	bounties := []struct {
+		node, bounty int64
+		height       uint64
+	}{
+		{1, 100, 20},
+		{2, 100, 30},
+		{3, 500, 20},
+	}
+	for i, b := range bounties {
+		require.NoError(t, storeDB.SaveContractEvent(ctx, structs.ContractEvent{
+			ContractName:    "skale_manager",
+			EventName:       "BountyReceived",
+			BlockHeight:     b.height,
+			Time:            blocks[b.height],
+			TransactionHash: common.BigToHash(big.NewInt(int64(100 + i))),
+			Params:          map[string]interface{}{"nodeIndex": big.NewInt(b.node), "bounty": big.NewInt(b.bounty)},
+			BoundType:       "node",
+			BoundID:         []big.Int{*big.NewInt(b.node)},
+		}))
+	}
+
+	contractor := client.NewClient(zaptest.NewLogger(t), storeDB, nil, nil, 1, 1)
+	mux := http.NewServeMux()
+	NewClientConnector(contractor).AttachToHandler(mux)
+
+	get := func(t *testing.T, path string) (apr APR) {
+		rr := httptest.NewRecorder()
+		mux.ServeHTTP(rr, httptest.NewRequest(http.MethodGet, path+"?from=2021-05-01T00:00:00Z&to=2021-05-31T00:00:00Z", nil))
+		require.Equal(t, http.StatusOK, rr.Code)
+		require.NoError(t, json.NewDecoder(rr.Body).Decode(&apr))
+		return apr
+	}
+	yearShare := 365.0 / 30
+
+	t.Run("validator", func(t *testing.T) {
+		apr := get(t, "/validators/1/apr")
+		require.Equal(t, int64(1), apr.ValidatorID.Int64())
+		require.Equal(t, "200", apr.Bounty)
+		require.Equal(t, uint64(2), apr.NodeEpochs)
+		require.Equal(t, "100", apr.BountyPerNodeEpoch)
+		require.Equal(t, "30", apr.Fee)
+		require.Equal(t, int64(150), apr.FeeRate.Int64())
+		require.Equal(t, "170", apr.DelegatorsBounty)
+		require.Equal(t, "1000", apr.EffectiveStakeFrom)
+		require.Equal(t, "3000", apr.EffectiveStakeTo)
+		require.Equal(t, "2000", apr.EffectiveStake)
+		require.InDelta(t, 170.0/2000*yearShare, apr.APR, 1e-9)
+
+		require.Len(t, apr.Periods, 4)
+		year := apr.Periods[3]
+		require.Equal(t, uint64(12), year.Months)
+		require.Equal(t, int64(200), year.Multiplier)
+		require.InDelta(t, 2*apr.APR, year.APR, 1e-9)
+		require.InDelta(t, math.Pow(1+year.APR/12, 12)-1, year.APY, 1e-9)
+	})
+
+	t.Run("network", func(t *testing.T) {
+		apr := get(t, "/network/apr")
+		require.Nil(t, apr.ValidatorID)
+		require.Equal(t, "700", apr.Bounty)
+		require.Equal(t, uint64(3), apr.NodeEpochs)
+		require.Equal(t, "55", apr.Fee)
+		require.Equal(t, "645", apr.DelegatorsBounty)
+		require.Equal(t, "2750", apr.EffectiveStake)
+		require.InDelta(t, 645.0/2750*yearShare, apr.APR, 1e-9)
+	})
+
+	t.Run("validator without bounty", func(t *testing.T) {
+		rr := httptest.NewRecorder()
+		mux.ServeHTTP(rr, httptest.NewRequest(http.MethodGet, "/validators/7/apr", nil))
+		require.Equal(t, http.StatusOK, rr.Code)
+		var apr APR
+		require.NoError(t, json.NewDecoder(rr.Body).Decode(&apr))
+		require.Equal(t, "0", apr.Bounty)
+		require.Zero(t, apr.APR)
+	})
+
+	for _, path := range []string{"/validators/first/apr", "/network/apr?from=yesterday", "/network/apr?from=2021-05-31T00:00:00Z&to=2021-05-01T00:00:00Z"} {
+		t.Run("bad "+path, func(t *testing.T) {
+			rr := httptest.NewRecorder()
+			mux.ServeHTTP(rr, httptest.NewRequest(http.MethodGet, path, nil))
+			require.Equal(t, http.StatusBadRequest, rr.Code)
+		})
+	}
+}
+
+func TestDelegatorPortfolioHandlerWithStore(t *testing.T) {
+	ctx := context.Background()
+	storeDB := store.New(memory.NewDriver())
+
+	current := structs.EpochAt(time.Now())
+	holder := common.HexToAddress("0x06dD71dAb27C1A3e0B172d53735f00Bf1a66Eb79")
+	other := common.HexToAddress("0x1f42F0b1aE2aa1d04D4b7c62C2A8A4a8c06D0a6b")
+
+	for id, fee := range map[int64]int64{1: 100, 2: 50} {
+		require.NoError(t, storeDB.SaveValidator(ctx, structs.Validator{ValidatorID: big.NewInt(id), Name: "validator " + strconv.Itoa(int(id)), FeeRate: big.NewInt(fee), BlockHeight: 1}))
+	}
+	require.NoError(t, storeDB.SaveNodes(ctx, []structs.Node{{
+		NodeID:      big.NewInt(1),
+		ValidatorID: big.NewInt(1),
+		Name:        "node",
+		StartBlock:  big.NewInt(1),
+		FinishTime:  big.NewInt(0),
+		Status:      structs.NodeStatusActive,
+		BlockHeight: 1,
+	}}, common.Address{}))
+
+	delegations := []struct {
+		id, validator, amount, period int64
+		holder                        common.Address
+		state                         structs.DelegationState
+		created                       time.Time
+		started, finished             uint64
+	}{
+		{1, 1, 1000, 3, holder, structs.DelegationStateCOMPLETED, structs.EpochStart(current - 6), current - 5, current - 2},
+		{2, 1, 2000, 12, holder, structs.DelegationStateDELEGATED, structs.EpochStart(current - 4), current - 3, 0},
+		{3, 2, 500, 2, holder, structs.DelegationStatePROPOSED, time.Now(), 0, 0},
+		{4, 1, 2000, 2, other, structs.DelegationStateDELEGATED, structs.EpochStart(current - 4), current - 3, 0},
+	}
+	for _, d := range delegations {
+		require.NoError(t, storeDB.SaveDelegation(ctx, structs.Delegation{
+			DelegationID:     big.NewInt(d.id),
+			Holder:           d.holder,
+			ValidatorID:      big.NewInt(d.validator),
+			BlockHeight:      uint64(d.id),
+			TransactionHash:  common.BigToHash(big.NewInt(d.id)),
+			Amount:           big.NewInt(d.amount),
+			DelegationPeriod: big.NewInt(d.period),
+			Created:          d.created,
+			Started:          new(big.Int).SetUint64(d.started),
+			Finished:         new(big.Int).SetUint64(d.finished),
+			State:            d.state,
+		}))
+	}
+
+	require.NoError(t, storeDB.SaveContractEvent(ctx, structs.ContractEvent{
+		ContractName:    "skale_manager",
+		EventName:       "BountyReceived",
+		BlockHeight:     10,
+		Time:            structs.EpochStart(current - 1).Add(10 * 24 * time.Hour),
+		TransactionHash: common.BigToHash(big.NewInt(10)),
+		Params:          map[string]interface{}{"nodeIndex": big.NewInt(1), "bounty": big.NewInt(1000)},
+		BoundType:       "node",
+		BoundID:         []big.Int{*big.NewInt(1)},
+	}))
+	require.NoError(t, storeDB.SaveContractEvent(ctx, structs.ContractEvent{
+		ContractName:    "distributor",
+		EventName:       "WithdrawBounty",
+		BlockHeight:     11,
+		Time:            time.Now(),
+		TransactionHash: common.BigToHash(big.NewInt(11)),
+		Params:          map[string]interface{}{"holder": holder, "validatorId": big.NewInt(1), "destination": holder, "amount": big.NewInt(300)},
+		BoundType:       "validator",
+		BoundID:         []big.Int{*big.NewInt(1)},
+		BoundAddress:    []common.Address{holder},
+	}))
+
+	contractor := client.NewClient(zaptest.NewLogger(t), storeDB, nil, nil, 1, 1)
+	mux := http.NewServeMux()
+	NewClientConnector(contractor).AttachToHandler(mux)
+
+	t.Run("portfolio", func(t *testing.T) {
+		rr := httptest.NewRecorder()
+		mux.ServeHTTP(rr, httptest.NewRequest(http.MethodGet, "/delegators/"+holder.Hex()+"/portfolio", nil))
+		require.Equal(t, http.StatusOK, rr.Code)
+
+		var p Portfolio
+		require.NoError(t, json.NewDecoder(rr.Body).Decode(&p))
+		require.Equal(t, holder, p.Holder)
+		require.Equal(t, "2500", p.Locked)
+		require.Equal(t, "1000", p.Unlocked)
+		// 90% of bounty split with the other holder by effective stake, 4000 to 2000
+		require.Equal(t, "600", p.Earned)
+		require.Equal(t, "300", p.Withdrawn)
+
+		require.Len(t, p.Validators, 2)
+		require.Equal(t, int64(1), p.Validators[0].ValidatorID.Int64())
+		require.Equal(t, "validator 1", p.Validators[0].ValidatorName)
+		require.Equal(t, "600", p.Validators[0].Earned)
+		require.Equal(t, "300", p.Validators[0].Withdrawn)
+		require.Len(t, p.Validators[0].States, 2)
+		require.Equal(t, "DELEGATED", p.Validators[0].States[0].State)
+		require.Equal(t, "2000", p.Validators[0].States[0].Amount)
+		require.Equal(t, "COMPLETED", p.Validators[0].States[1].State)
+		require.Equal(t, "PROPOSED", p.Validators[1].States[0].State)
+
+		require.Len(t, p.Pending, 1)
+		require.Equal(t, int64(3), p.Pending[0].DelegationID.Int64())
+
+		require.Len(t, p.Unlocks, 2)
+		require.Equal(t, int64(3), p.Unlocks[0].DelegationID.Int64())
+		require.Equal(t, int64(2), p.Unlocks[1].DelegationID.Int64())
+		require.True(t, p.Unlocks[0].Until.Before(p.Unlocks[1].Until))
+
+		values := []string{}
+		for _, e := range p.Epochs {
+			values = append(values, e.Value)
+		}
+		require.Equal(t, []string{"1000", "1000", "3000", "2000", "2000", "2000"}, values)
+		require.Equal(t, current-5, p.Epochs[0].Epoch)
+		require.Equal(t, "600", p.Epochs[4].Earned)
+	})
+
+	t.Run("no delegations", func(t *testing.T) {
+		rr := httptest.NewRecorder()
+		mux.ServeHTTP(rr, httptest.NewRequest(http.MethodGet, "/delegators/0x0000000000000000000000000000000000000001/portfolio", nil))
+		require.Equal(t, http.StatusOK, rr.Code)
+		require.JSONEq(t, `{"holder":"0x0000000000000000000000000000000000000001","locked":"0","unlocked":"0","earned":"0","withdrawn":"0","validators":[],"pending":[],"unlocks":[],"epochs":[]}`, rr.Body.String())
+	})
+
+	t.Run("bad address", func(t *testing.T) {
+		rr := httptest.NewRecorder()
+		mux.ServeHTTP(rr, httptest.NewRequest(http.MethodGet, "/delegators/holder/portfolio", nil))
+		require.Equal(t, http.StatusBadRequest, rr.Code)
+	})
+}
+
+func TestUnlockCalendarHandlerWithStore(t *testing.T) {
+	ctx := context.Background()
+	storeDB := store.New(memory.NewDriver())
+
+	current := structs.EpochAt(time.Now())
+	holder := common.HexToAddress("0x06dD71dAb27C1A3e0B172d53735f00Bf1a66Eb79")
+	other := common.HexToAddress("0x1f42F0b1aE2aa1d04D4b7c62C2A8A4a8c06D0a6b")
+	for _, id := range []int64{1, 2} {
+		require.NoError(t, storeDB.SaveValidator(ctx, structs.Validator{ValidatorID: big.NewInt(id), Name: "validator", BlockHeight: 1}))
+	}
+
+	delegations := []struct {
+		id, validator, amount, period int64
+		holder                        common.Address
+		state                         structs.DelegationState
+		created                       uint64
+		finished                      uint64
+	}{
+		{1, 1, 1000, 2, holder, structs.DelegationStateUNDELEGATION_REQUESTED, current - 4, current + 1},
+		{2, 1, 3000, 2, holder, structs.DelegationStateDELEGATED, current - 2, 0},
+		// renewed twice, the period now ends in two months
+		{3, 2, 500, 3, holder, structs.DelegationStateDELEGATED, current - 7, 0},
+		{4, 1, 200, 2, holder, structs.DelegationStateCOMPLETED, current - 5, current - 2},
+		{5, 2, 700, 2, other, structs.DelegationStateUNDELEGATION_REQUESTED, current - 1, current + 3},
+	}
+	for _, d := range delegations {
+		require.NoError(t, storeDB.SaveDelegation(ctx, structs.Delegation{
+			DelegationID:     big.NewInt(d.id),
+			Holder:           d.holder,
+			ValidatorID:      big.NewInt(d.validator),
+			BlockHeight:      uint64(d.id),
+			TransactionHash:  common.BigToHash(big.NewInt(d.id)),
+			Amount:           big.NewInt(d.amount),
+			DelegationPeriod: big.NewInt(d.period),
+			Created:          structs.EpochStart(d.created).Add(12 * time.Hour),
+			Started:          new(big.Int).SetUint64(d.created + 1),
+			Finished:         new(big.Int).SetUint64(d.finished),
+			State:            d.state,
+		}))
+	}
+
+	contractor := client.NewClient(zaptest.NewLogger(t), storeDB, nil, nil, 1, 1)
+	mux := http.NewServeMux()
+	NewClientConnector(contractor).AttachToHandler(mux)
+
+	get := func(t *testing.T, query string) (calendar UnlockCalendar) {
+		rr := httptest.NewRecorder()
+		mux.ServeHTTP(rr, httptest.NewRequest(http.MethodGet, "/calendar/unlocks?"+query, nil))
+		require.Equal(t, http.StatusOK, rr.Code)
+		require.NoError(t, json.NewDecoder(rr.Body).Decode(&calendar))
+		return calendar
+	}
+	lastDay := func(epoch uint64) time.Time { return structs.EpochStart(epoch+1).AddDate(0, 0, -1) }
+
+	t.Run("calendar", func(t *testing.T) {
+		calendar := get(t, "")
+		require.Equal(t, []UnlockPeriod{
+			{Start: lastDay(current), Undelegated: "1000", PeriodEnding: "3000", Delegations: 2},
+			{Start: lastDay(current + 2), Undelegated: "700", PeriodEnding: "500", Delegations: 2},
+		}, calendar.Days)
+		require.Equal(t, []UnlockPeriod{
+			{Start: structs.EpochStart(current), Undelegated: "1000", PeriodEnding: "3000", Delegations: 2},
+			{Start: structs.EpochStart(current + 2), Undelegated: "700", PeriodEnding: "500", Delegations: 2},
+		}, calendar.Months)
+
+		require.Equal(t, []StakeDropProjection{
+			{ValidatorID: big.NewInt(1), Stake: "4000", ExpectedDrop: "1000", ExpectedDropShare: 0.25, AtRisk: "3000", LargestLeaving: "1000", Leaving: 1},
+			{ValidatorID: big.NewInt(2), Stake: "1200", ExpectedDrop: "0", AtRisk: "0", LargestLeaving: "0"},
+		}, calendar.Projections)
+	})
+
+	t.Run("filters", func(t *testing.T) {
+		calendar := get(t, "holder="+other.Hex())
+		require.Len(t, calendar.Days, 1)
+		require.Equal(t, "700", calendar.Days[0].Undelegated)
+
+		calendar = get(t, "validator_id=2")
+		require.Len(t, calendar.Days, 1)
+		require.Len(t, calendar.Projections, 1)
+
+		calendar = get(t, "to="+lastDay(current+1).Format(structs.Layout))
+		require.Len(t, calendar.Days, 1)
+		require.Len(t, calendar.Projections, 2)
+	})
+
+	for _, query := range []string{"validator_id=first", "holder=0x12", "from=today"} {
+		t.Run("bad "+query, func(t *testing.T) {
+			rr := httptest.NewRecorder()
+			mux.ServeHTTP(rr, httptest.NewRequest(http.MethodGet, "/calendar/unlocks?"+query, nil))
+			require.Equal(t, http.StatusBadRequest, rr.Code)
+		})
+	}
+}
+
+func TestStatisticsSeriesHandlerWithStore(t *testing.T) {
+	ctx := context.Background()
+	storeDB := store.New(memory.NewDriver())
+
+	day := func(d, hour int) time.Time { return time.Date(2021, time.March, d, hour, 0, 0, 0, time.UTC) }
+	statistics := []struct {
+		validator int64
+		height    uint64
+		time      time.Time
+		statType  structs.StatisticTypeVS
+		amount    int64
+	}{
+		{1, 1, day(-1, 12), structs.ValidatorStatisticsTypeTotalStake, 100},
+		{1, 2, day(2, 10), structs.ValidatorStatisticsTypeTotalStake, 150},
+		{1, 3, day(2, 18), structs.ValidatorStatisticsTypeTotalStake, 200},
+		{2, 5, day(3, 0), structs.ValidatorStatisticsTypeTotalStake, 50},
+		{1, 6, day(9, 0), structs.ValidatorStatisticsTypeTotalStake, 300},
+		{1, 1, day(-1, 12), structs.ValidatorStatisticsTypeFee, 100},
+		{2, 5, day(3, 0), structs.ValidatorStatisticsTypeFee, 50},
+	}
+	for _, s := range statistics {
+		require.NoError(t, storeDB.SaveValidatorStatistic(ctx, big.NewInt(s.validator), s.height, s.time, s.statType, big.NewInt(s.amount)))
+	}
+
+	contractor := client.NewClient(zaptest.NewLogger(t), storeDB, nil, nil, 1, 1)
+	mux := http.NewServeMux()
+	NewClientConnector(contractor).AttachToHandler(mux)
+
+	get := func(t *testing.T, query string, code int) (series StatisticsSeries) {
+		rr := httptest.NewRecorder()
+		mux.ServeHTTP(rr, httptest.NewRequest(http.MethodGet, "/validators/statistics/series?"+query, nil))
+		require.Equal(t, code, rr.Code, rr.Body.String())
+		if code == http.StatusOK {
+			require.NoError(t, json.NewDecoder(rr.Body).Decode(&series))
+		}
+		return series
+	}
+	values := func(vs ...string) (values []*string) {
+		for _, v := range vs {
+			if v == "" {
+				values = append(values, nil)
+				continue
+			}
+			v := v
+			values = append(values, &v)
+		}
+		return values
+	}
+
+	t.Run("days", func(t *testing.T) {
+		series := get(t, "type=TOTAL_STAKE&from="+day(1, 0).Format(structs.Layout)+"&to="+day(4, 12).Format(structs.Layout), http.StatusOK)
+		require.Equal(t, "TOTAL_STAKE", series.Type)
+		require.Equal(t, "day", series.Interval)
+		require.Equal(t, []time.Time{day(1, 0), day(2, 0), day(3, 0), day(4, 0)}, series.Buckets)
+		require.Equal(t, []ValidatorSeries{
+			{ValidatorID: big.NewInt(1), Values: values("100", "200", "200", "200")},
+			{ValidatorID: big.NewInt(2), Values: values("", "", "50", "50")},
+		}, series.Validators)
+		require.Equal(t, []NetworkPoint{{"100", 1}, {"200", 1}, {"250", 2}, {"250", 2}}, series.Network)
+	})
+
+	t.Run("weeks and months", func(t *testing.T) {
+		series := get(t, "type=TOTAL_STAKE&interval=week&from="+day(3, 0).Format(structs.Layout)+"&to="+day(10, 0).Format(structs.Layout), http.StatusOK)
+		require.Equal(t, []time.Time{day(1, 0), day(8, 0)}, series.Buckets)
+		require.Equal(t, values("200", "300"), series.Validators[0].Values)
+		require.Equal(t, []NetworkPoint{{"250", 2}, {"350", 2}}, series.Network)
+
+		series = get(t, "type=TOTAL_STAKE&interval=month&from="+day(-10, 0).Format(structs.Layout)+"&to="+day(31, 0).Format(structs.Layout), http.StatusOK)
+		require.Equal(t, []time.Time{day(-27, 0), day(1, 0)}, series.Buckets)
+		require.Equal(t, values("100", "300"), series.Validators[0].Values)
+		require.Equal(t, values("", "50"), series.Validators[1].Values)
+	})
+
+	t.Run("validators and averaged network", func(t *testing.T) {
+		series := get(t, "type=FEE&validator_id=2,3&from="+day(2, 0).Format(structs.Layout)+"&to="+day(3, 0).Format(structs.Layout), http.StatusOK)
+		require.Equal(t, []ValidatorSeries{
+			{ValidatorID: big.NewInt(2), Values: values("", "50")},
+			{ValidatorID: big.NewInt(3), Values: values("", "")},
+		}, series.Validators)
+		require.Equal(t, []NetworkPoint{{"100", 1}, {"75", 2}}, series.Network)
+	})
+
+	t.Run("wrong parameters", func(t *testing.T) {
+		get(t, "type=STAKE", http.StatusBadRequest)
+		get(t, "type=VALIDATOR_ADDRESS", http.StatusBadRequest)
+		get(t, "type=TOTAL_STAKE&interval=hour", http.StatusBadRequest)
+		get(t, "type=TOTAL_STAKE&validator_id=a", http.StatusBadRequest)
+		get(t, "type=TOTAL_STAKE&from="+day(1, 0).AddDate(-5, 0, 0).Format(structs.Layout)+"&to="+day(1, 0).Format(structs.Layout), http.StatusBadRequest)
+	})
+}
+
+func TestNetworkOverviewHandlerWithStore(t *testing.T) {
+	ctx := context.Background()
+	storeDB := store.New(memory.NewDriver())
+
+	blocks := map[uint64]time.Time{
+		10: time.Date(2021, time.April, 10, 0, 0, 0, 0, time.UTC),
+		20: time.Date(2021, time.May, 10, 0, 0, 0, 0, time.UTC),
+		30: time.Date(2021, time.May, 20, 0, 0, 0, 0, time.UTC),
+	}
+	for height, bt := range blocks {
+		require.NoError(t, storeDB.SaveBlock(ctx, structs.Block{Number: height, Hash: common.BigToHash(big.NewInt(int64(height))), Time: bt}))
+	}
+
+	require.NoError(t, storeDB.SaveValidator(ctx, structs.Validator{ValidatorID: big.NewInt(1), Name: "validator", Authorized: true, AcceptNewRequests: true, BlockHeight: 10}))
+	require.NoError(t, storeDB.SaveValidator(ctx, structs.Validator{ValidatorID: big.NewInt(2), Name: "validator", AcceptNewRequests: true, BlockHeight: 20}))
+
+	nodes := []struct {
+		id, validator int64
+		status        structs.NodeStatus
+		height        uint64
+	}{
+		{1, 1, structs.NodeStatusActive, 10},
+		{2, 1, structs.NodeStatusActive, 10},
+		{2, 1, structs.NodeStatusLeft, 20},
+		{3, 2, structs.NodeStatusActive, 30},
+	}
+	for _, n := range nodes {
+		require.NoError(t, storeDB.SaveNodes(ctx, []structs.Node{{
+			NodeID:      big.NewInt(n.id),
+			ValidatorID: big.NewInt(n.validator),
+			Name:        "node",
+			StartBlock:  big.NewInt(10),
+			FinishTime:  big.NewInt(0),
+			Status:      n.status,
+			BlockHeight: n.height,
+		}}, common.Address{}))
+	}
+
+	holderA := common.HexToAddress("0x06dD71dAb27C1A3e0B172d53735f00Bf1a66Eb79")
+	holderB := common.HexToAddress("0x1f42F0b1aE2aa1d04D4b7c62C2A8A4a8c06D0a6b")
+	delegations := []struct {
+		id, validator, amount, period int64
+		holder                        common.Address
+		height                        uint64
+	}{
+		{1, 1, 1000, 2, holderA, 10},
+		{2, 2, 500, 12, holderB, 20},
+		{3, 2, 300, 6, holderA, 30},
+	}
+	for _, d := range delegations {
+		require.NoError(t, storeDB.SaveDelegation(ctx, structs.Delegation{
+			DelegationID:     big.NewInt(d.id),
+			Holder:           d.holder,
+			ValidatorID:      big.NewInt(d.validator),
+			BlockHeight:      d.height,
+			TransactionHash:  common.BigToHash(big.NewInt(d.id)),
+			Amount:           big.NewInt(d.amount),
+			DelegationPeriod: big.NewInt(d.period),
+			Created:          blocks[d.height],
+			Started:          big.NewInt(0),
+			Finished:         big.NewInt(0),
+			State:            structs.DelegationStateDELEGATED,
+		}))
+	}
+
+	zero := common.Address{}
+	events := []struct {
+		contract, event string
+		height          uint64
+		params          map[string]interface{}
+	}{
+		{"schains", "SchainCreated", 10, map[string]interface{}{"name": "first"}},
+		{"schains", "SchainCreated", 20, map[string]interface{}{"name": "second"}},
+		{"schains", "SchainDeleted", 20, map[string]interface{}{"name": "first"}},
+		{"punisher", "Slash", 20, map[string]interface{}{"validatorId": big.NewInt(1), "amount": big.NewInt(50)}},
+		{"punisher", "Forgive", 30, map[string]interface{}{"wallet": holderA, "amount": big.NewInt(20)}},
+		{"skale_token", "Transfer", 10, map[string]interface{}{"from": zero, "to": holderA, "value": big.NewInt(1000000)}},
+		{"skale_token", "Transfer", 20, map[string]interface{}{"from": holderA, "to": holderB, "value": big.NewInt(500)}},
+		{"skale_token", "Transfer", 20, map[string]interface{}{"from": holderB, "to": zero, "value": big.NewInt(1000)}},
+	}
+	for i, e := range events {
+		require.NoError(t, storeDB.SaveContractEvent(ctx, structs.ContractEvent{
+			ContractName:    e.contract,
+			EventName:       e.event,
+			BlockHeight:     e.height,
+			Time:            blocks[e.height],
+			TransactionHash: common.BigToHash(big.NewInt(int64(100 + i))),
+			Params:          e.params,
+		}))
+	}
+
+	contractor := client.NewClient(zaptest.NewLogger(t), storeDB, nil, nil, 1, 1)
+	mux := http.NewServeMux()
+	NewClientConnector(contractor).AttachToHandler(mux)
+
+	get := func(t *testing.T, query string, code int) (overview NetworkOverview) {
+		rr := httptest.NewRecorder()
+		mux.ServeHTTP(rr, httptest.NewRequest(http.MethodGet, "/network/overview?"+query, nil))
+		require.Equal(t, code, rr.Code, rr.Body.String())
+		if code == http.StatusOK {
+			require.NoError(t, json.NewDecoder(rr.Body).Decode(&overview))
+		}
+		return overview
+	}
+
+	t.Run("at time", func(t *testing.T) {
+		overview := get(t, "at_time=2021-05-15T00:00:00Z", http.StatusOK)
+		require.Equal(t, uint64(20), overview.BlockHeight)
+		require.Equal(t, uint64(10), overview.MonthAgoBlockHeight)
+		require.Equal(t, NetworkFigures{
+			TotalStake:           "1500",
+			EffectiveStake:       "2000",
+			Validators:           2,
+			AuthorizedValidators: 1,
+			AcceptingValidators:  2,
+			ActiveNodes:          1,
+			LeftNodes:            1,
+			Delegators:           2,
+			SkaleChains:          1,
+			Slashed:              "50",
+			Forgiven:             "0",
+			TokenSupply:          "999000",
+		}, overview.Current)
+		require.NotNil(t, overview.MonthAgo)
+		require.Equal(t, NetworkFigures{
+			TotalStake:           "1000",
+			EffectiveStake:       "1000",
+			Validators:           1,
+			AuthorizedValidators: 1,
+			AcceptingValidators:  1,
+			ActiveNodes:          2,
+			Delegators:           1,
+			SkaleChains:          1,
+			Slashed:              "0",
+			Forgiven:             "0",
+			TokenSupply:          "1000000",
+		}, *overview.MonthAgo)
+		require.Equal(t, NetworkFigures{
+			TotalStake:          "500",
+			EffectiveStake:      "1000",
+			Validators:          1,
+			AcceptingValidators: 1,
+			ActiveNodes:         -1,
+			LeftNodes:           1,
+			Delegators:          1,
+			Slashed:             "50",
+			Forgiven:            "0",
+			TokenSupply:         "-1000",
+		}, *overview.Change)
+	})
+
+	t.Run("current", func(t *testing.T) {
+		overview := get(t, "", http.StatusOK)
+		require.Equal(t, uint64(0), overview.BlockHeight)
+		require.Equal(t, "1800", overview.Current.TotalStake)
+		require.Equal(t, int64(2), overview.Current.ActiveNodes)
+		require.Equal(t, "20", overview.Current.Forgiven)
+		// the last block indexed is older than a month
+		require.Equal(t, uint64(30), overview.MonthAgoBlockHeight)
+		require.Equal(t, "0", overview.Change.TotalStake)
+	})
+
+	t.Run("without month before", func(t *testing.T) {
+		overview := get(t, "at_time=2021-04-12T00:00:00Z", http.StatusOK)
+		require.Equal(t, uint64(10), overview.BlockHeight)
+		require.Nil(t, overview.MonthAgo)
+		require.Nil(t, overview.Change)
+	})
+
+	t.Run("wrong parameters", func(t *testing.T) {
+		get(t, "at_time=yesterday", http.StatusBadRequest)
+		get(t, "at_time=2021-01-01T00:00:00Z", http.StatusNotFound)
+	})
+}
+
+func TestDecentralizationHandlerWithStore(t *testing.T) {
+	ctx := context.Background()
+	storeDB := store.New(memory.NewDriver())
+
+	blocks := map[uint64]time.Time{
+		10: time.Date(2021, time.April, 10, 0, 0, 0, 0, time.UTC),
+		20: time.Date(2021, time.May, 10, 0, 0, 0, 0, time.UTC),
+		30: time.Date(2021, time.June, 10, 0, 0, 0, 0, time.UTC),
+	}
+	for height, bt := range blocks {
+		require.NoError(t, storeDB.SaveBlock(ctx, structs.Block{Number: height, Hash: common.BigToHash(big.NewInt(int64(height))), Time: bt}))
+	}
+
+	stakes := []struct {
+		validator, amount int64
+		height            uint64
+	}{
+		{1, 500, 10},
+		{2, 300, 10},
+	}
+	for _, s := range stakes {
+		require.NoError(t, storeDB.SaveValidatorStatistic(ctx, big.NewInt(s.validator), s.height, blocks[s.height], structs.ValidatorStatisticsTypeTotalStake, big.NewInt(s.amount)))
+	}
+
+	// validator 3 has no statistic, its stake is the sum of its delegations
+	require.NoError(t, storeDB.SaveValidator(ctx, structs.Validator{ValidatorID: big.NewInt(3), Name: "validator", BlockHeight: 20}))
+	require.NoError(t, storeDB.SaveDelegation(ctx, structs.Delegation{
+		DelegationID:     big.NewInt(1),
+		Holder:           common.HexToAddress("0x06dD71dAb27C1A3e0B172d53735f00Bf1a66Eb79"),
+		ValidatorID:      big.NewInt(3),
+		BlockHeight:      20,
+		TransactionHash:  common.BigToHash(big.NewInt(1)),
+		Amount:           big.NewInt(200),
+		DelegationPeriod: big.NewInt(3),
+		Created:          blocks[20],
+		Started:          big.NewInt(0),
+		Finished:         big.NewInt(0),
+		State:            structs.DelegationStateDELEGATED,
+	}))
+
+	contractor := client.NewClient(zaptest.NewLogger(t), storeDB, nil, nil, 1, 1)
+	require.NoError(t, contractor.ComputeDecentralization(ctx, time.Date(2021, time.June, 15, 0, 0, 0, 0, time.UTC)))
+
+	mux := http.NewServeMux()
+	NewClientConnector(contractor).AttachToHandler(mux)
+
+	get := func(t *testing.T, query string, code int) (dcs []Decentralization) {
+		rr := httptest.NewRecorder()
+		mux.ServeHTTP(rr, httptest.NewRequest(http.MethodGet, "/network/decentralization?"+query, nil))
+		require.Equal(t, code, rr.Code, rr.Body.String())
+		if code == http.StatusOK {
+			require.NoError(t, json.NewDecoder(rr.Body).Decode(&dcs))
+		}
+		return dcs
+	}
+
+	t.Run("all epochs", func(t *testing.T) {
+		dcs := get(t, "", http.StatusOK)
+		require.Equal(t, []Decentralization{{
+			Epoch:               17,
+			Time:                time.Date(2021, time.June, 1, 0, 0, 0, 0, time.UTC),
+			BlockHeight:         20,
+			Validators:          3,
+			TotalStake:          "1000",
+			NakamotoCoefficient: 1,
+			Gini:                0.2,
+			Top1Share:           0.5,
+			Top5Share:           1,
+			Top10Share:          1,
+			ValidatorsOver33:    1,
+			ValidatorsOver50:    2,
+			ValidatorsOver66:    2,
+		}, {
+			Epoch:               16,
+			Time:                time.Date(2021, time.May, 1, 0, 0, 0, 0, time.UTC),
+			BlockHeight:         10,
+			Validators:          2,
+			TotalStake:          "800",
+			NakamotoCoefficient: 1,
+			Gini:                0.125,
+			Top1Share:           0.625,
+			Top5Share:           1,
+			Top10Share:          1,
+			ValidatorsOver33:    1,
+			ValidatorsOver50:    1,
+			ValidatorsOver66:    2,
+		}}, dcs)
+	})
+
+	t.Run("range", func(t *testing.T) {
+		dcs := get(t, "from_epoch=10&to_epoch=16", http.StatusOK)
+		require.Len(t, dcs, 1)
+		require.Equal(t, uint64(16), dcs[0].Epoch)
+
+		dcs = get(t, "limit=1&offset=1", http.StatusOK)
+		require.Len(t, dcs, 1)
+		require.Equal(t, uint64(16), dcs[0].Epoch)
+	})
+
+	t.Run("computed once", func(t *testing.T) {
+		require.NoError(t, contractor.ComputeDecentralization(ctx, time.Date(2021, time.July, 15, 0, 0, 0, 0, time.UTC)))
+		require.Len(t, get(t, "", http.StatusOK), 2)
+	})
+
+	t.Run("wrong parameters", func(t *testing.T) {
+		get(t, "from_epoch=may", http.StatusBadRequest)
+		get(t, "limit=-1", http.StatusBadRequest)
+	})
+}
@@ -0,0 +1,52 @@
+package webapi
+
+import (
+	"errors"
+	"net/http"
+	"net/url"
+	"strconv"
+	"time"
+
+	"github.com/figment-networks/skale-indexer/scraper/structs"
+)
+
+// parsePointInTime parses 'at_height' and 'at_time' query parameters
+func parsePointInTime(query url.Values) (height uint64, t time.Time, err error) {
+	if h := query.Get("at_height"); h != "" {
+		if height, err = strconv.ParseUint(h, 10, 64); err != nil {
+			return 0, t, errors.New("error parsing 'at_height' parameter")
+		}
+	}
+	if at := query.Get("at_time"); at != "" {
+		if t, err = time.Parse(structs.Layout, at); err != nil {
+			return 0, t, errors.New("error parsing 'at_time' parameter")
+		}
+	}
+	return height, t, nil
+}
+
+// resolveHeight returns the block height the state is requested at, zero for the current state.
+// Time is resolved to the last block produced at or before it. Error response is written when it returns false
+func (c *Connector) resolveHeight(w http.ResponseWriter, req *http.Request, height uint64, t time.Time) (uint64, bool) {
+	if t.IsZero() {
+		return height, true
+	}
+	if height > 0 {
+		w.WriteHeader(http.StatusBadRequest)
+		w.Write(newApiError(errors.New("only one of 'at_height' and 'at_time' parameters can be sent"), http.StatusBadRequest))
+		return 0, false
+	}
+
+	b, err := c.cli.GetBlockAtTime(req.Context(), t)
+	if err != nil {
+		if err == structs.ErrNotFound {
+			w.WriteHeader(http.StatusNotFound)
+			w.Write(newApiError(errors.New("no block found at 'at_time'"), http.StatusNotFound))
+			return 0, false
+		}
+		w.WriteHeader(http.StatusInternalServerError)
+		w.Write(newApiError(err, http.StatusInternalServerError))
+		return 0, false
+	}
+	return b.Number, true
+}
@@ -7,6 +7,7 @@ import (
 	"net/http"
 	"time"
 
+	"github.com/figment-networks/skale-indexer/scraper/structs"
 	"github.com/google/uuid"
 	"go.uber.org/zap"
 )
@@ -14,6 +15,16 @@ import (
 type ScrapeContractor interface {
 	ParseLogs(ctx context.Context, taskID string, from, to big.Int) error
 	GetLatestData(ctx context.Context, taskID string, latest uint64) (lastHeight uint64, isRunning bool, err error)
+
+	GetFailedEvents(ctx context.Context, params structs.FailedEventParams) (failedEvents []structs.FailedEvent, err error)
+	RetryFailedEvent(ctx context.Context, id string) error
+	DiscardFailedEvent(ctx context.Context, id string) error
+
+	CreateWebhook(ctx context.Context, wh structs.Webhook) (structs.Webhook, error)
+	UpdateWebhook(ctx context.Context, wh structs.Webhook) error
+	GetWebhooks(ctx context.Context, params structs.WebhookParams) (webhooks []structs.Webhook, err error)
+	DeleteWebhook(ctx context.Context, id string) error
+	GetWebhookDeliveries(ctx context.Context, params structs.WebhookDeliveryParams) (deliveries []structs.WebhookDelivery, err error)
 }
 
 // ScrapeConnector is main HTTP connector for manager
@@ -32,6 +43,10 @@ func NewScrapeConnector(l *zap.Logger, sc ScrapeContractor, scrapeLatestTimeout
 func (sc *ScrapeConnector) AttachToHandler(mux *http.ServeMux) {
 	mux.HandleFunc("/getLogs", sc.GetLogs)
 	mux.HandleFunc("/scrape_latest", sc.GetLatest)
+	mux.HandleFunc("/admin/failed_events", sc.FailedEvents)
+	mux.HandleFunc("/admin/failed_events/", sc.FailedEvents)
+	mux.HandleFunc("/admin/webhooks", sc.Webhooks)
+	mux.HandleFunc("/admin/webhooks/", sc.Webhooks)
 }
 
 /*
@@ -0,0 +1,168 @@
+package webapi
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"time"
+
+	"github.com/figment-networks/skale-indexer/scraper/structs"
+)
+
+// GetNetworkAPR returns the realised APR of all delegations with the inputs it's computed from
+//
+// GET /network/apr (from, to)
+func (c *Connector) GetNetworkAPR(w http.ResponseWriter, req *http.Request) {
+	w.Header().Add("Content-Type", "application/json")
+	allowCORSHeaders(w)
+
+	switch req.Method {
+	case http.MethodGet:
+	case http.MethodOptions:
+		return
+	default:
+		w.WriteHeader(http.StatusMethodNotAllowed)
+		w.Write(newApiError(structs.ErrNotAllowedMethod, http.StatusMethodNotAllowed))
+		return
+	}
+
+	c.writeAPR(w, req, "")
+}
+
+// GetNetworkOverview returns network figures, at the time when 'at_time' is sent, with their change in the month before
+//
+// GET /network/overview (at_time)
+func (c *Connector) GetNetworkOverview(w http.ResponseWriter, req *http.Request) {
+	w.Header().Add("Content-Type", "application/json")
+	allowCORSHeaders(w)
+
+	switch req.Method {
+	case http.MethodGet:
+	case http.MethodOptions:
+		return
+	default:
+		w.WriteHeader(http.StatusMethodNotAllowed)
+		w.Write(newApiError(structs.ErrNotAllowedMethod, http.StatusMethodNotAllowed))
+		return
+	}
+
+	var (
+		at  time.Time
+		err error
+	)
+	if t := req.URL.Query().Get("at_time"); t != "" {
+		if at, err = time.Parse(structs.Layout, t); err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			w.Write(newApiError(errors.New("error parsing 'at_time' parameter"), http.StatusBadRequest))
+			return
+		}
+	}
+
+	res, err := c.cli.GetNetworkOverview(req.Context(), at)
+	if err == structs.ErrNotFound {
+		w.WriteHeader(http.StatusNotFound)
+		w.Write(newApiError(errors.New("no block found at 'at_time'"), http.StatusNotFound))
+		return
+	}
+	if err != nil {
+		w.WriteHeader(http.StatusInternalServerError)
+		w.Write(newApiError(err, http.StatusInternalServerError))
+		return
+	}
+
+	overview := NetworkOverview{
+		Time:                res.Time,
+		BlockHeight:         res.BlockHeight,
+		Current:             toNetworkFigures(res.Current),
+		MonthAgoTime:        res.MonthAgoTime,
+		MonthAgoBlockHeight: res.MonthAgoBlockHeight,
+	}
+	if res.MonthAgo != nil {
+		monthAgo, change := toNetworkFigures(*res.MonthAgo), toNetworkFigures(*res.Change)
+		overview.MonthAgo, overview.Change = &monthAgo, &change
+	}
+
+	enc := json.NewEncoder(w)
+	w.WriteHeader(http.StatusOK)
+	if err := enc.Encode(overview); err != nil {
+		w.WriteHeader(http.StatusInternalServerError)
+		w.Write(newApiError(err, http.StatusInternalServerError))
+	}
+}
+
+func toNetworkFigures(f structs.NetworkFigures) NetworkFigures {
+	return NetworkFigures{
+		TotalStake:           f.TotalStake.String(),
+		EffectiveStake:       f.EffectiveStake.String(),
+		Validators:           f.Validators,
+		AuthorizedValidators: f.AuthorizedValidators,
+		AcceptingValidators:  f.AcceptingValidators,
+		ActiveNodes:          f.ActiveNodes,
+		LeavingNodes:         f.LeavingNodes,
+		LeftNodes:            f.LeftNodes,
+		Delegators:           f.Delegators,
+		SkaleChains:          f.SkaleChains,
+		Slashed:              f.Slashed.String(),
+		Forgiven:             f.Forgiven.String(),
+		TokenSupply:          f.TokenSupply.String(),
+	}
+}
+
+// writeAPR parses the time range of APR request and writes APR of validator, of the network when validator id is empty
+func (c *Connector) writeAPR(w http.ResponseWriter, req *http.Request, validatorID string) {
+	params := structs.APRParams{ValidatorID: validatorID}
+	var err error
+	if from := req.URL.Query().Get("from"); from != "" {
+		if params.TimeFrom, err = time.Parse(structs.Layout, from); err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			w.Write(newApiError(errors.New("error parsing 'from' parameter"), http.StatusBadRequest))
+			return
+		}
+	}
+	if to := req.URL.Query().Get("to"); to != "" {
+		if params.TimeTo, err = time.Parse(structs.Layout, to); err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			w.Write(newApiError(errors.New("error parsing 'to' parameter"), http.StatusBadRequest))
+			return
+		}
+	}
+	if !params.TimeFrom.IsZero() && !params.TimeTo.IsZero() && !params.TimeTo.After(params.TimeFrom) {
+		w.WriteHeader(http.StatusBadRequest)
+		w.Write(newApiError(errors.New("'to' has to be after 'from'"), http.StatusBadRequest))
+		return
+	}
+
+	res, err := c.cli.GetAPR(req.Context(), params)
+	if err != nil {
+		w.WriteHeader(http.StatusInternalServerError)
+		w.Write(newApiError(err, http.StatusInternalServerError))
+		return
+	}
+
+	apr := APR{
+		ValidatorID:        res.ValidatorID,
+		TimeFrom:           res.TimeFrom,
+		TimeTo:             res.TimeTo,
+		Bounty:             res.Bounty.String(),
+		NodeEpochs:         res.NodeEpochs,
+		BountyPerNodeEpoch: res.BountyPerNodeEpoch.String(),
+		Fee:                res.Fee.String(),
+		FeeRate:            res.FeeRate,
+		DelegatorsBounty:   res.DelegatorsBounty.String(),
+		EffectiveStakeFrom: res.EffectiveStakeFrom.String(),
+		EffectiveStakeTo:   res.EffectiveStakeTo.String(),
+		EffectiveStake:     res.EffectiveStake.String(),
+		APR:                res.APR,
+		Periods:            []PeriodAPR{},
+	}
+	for _, p := range res.Periods {
+		apr.Periods = append(apr.Periods, PeriodAPR{Months: p.Months, Multiplier: p.Multiplier, APR: p.APR, APY: p.APY})
+	}
+
+	enc := json.NewEncoder(w)
+	w.WriteHeader(http.StatusOK)
+	if err := enc.Encode(apr); err != nil {
+		w.WriteHeader(http.StatusInternalServerError)
+		w.Write(newApiError(err, http.StatusInternalServerError))
+	}
+}
@@ -0,0 +1,185 @@
+package webapi
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/figment-networks/skale-indexer/scraper/structs"
+)
+
+// GetNodeTimeline returns changes of node, the latest first
+//
+// GET /nodes/{id}/timeline (limit, offset)
+func (c *Connector) GetNodeTimeline(w http.ResponseWriter, req *http.Request) {
+	w.Header().Add("Content-Type", "application/json")
+	allowCORSHeaders(w)
+
+	switch req.Method {
+	case http.MethodGet:
+	case http.MethodOptions:
+		return
+	default:
+		w.WriteHeader(http.StatusMethodNotAllowed)
+		w.Write(newApiError(structs.ErrNotAllowedMethod, http.StatusMethodNotAllowed))
+		return
+	}
+
+	parts := strings.Split(strings.Trim(strings.TrimPrefix(req.URL.Path, "/nodes"), "/"), "/")
+	if len(parts) != 2 || parts[1] != "timeline" {
+		w.WriteHeader(http.StatusBadRequest)
+		w.Write(newApiError(errors.New("wrong node timeline path"), http.StatusBadRequest))
+		return
+	}
+	if _, err := strconv.ParseUint(parts[0], 10, 64); err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		w.Write(newApiError(errors.New("node id given in wrong format"), http.StatusBadRequest))
+		return
+	}
+
+	params := structs.NodeParams{NodeID: parts[0]}
+	var err error
+	limit := req.URL.Query().Get("limit")
+	if limit != "" {
+		if params.Limit, err = strconv.ParseUint(limit, 10, 64); err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			w.Write(newApiError(errors.New("error parsing 'limit' parameter"), http.StatusBadRequest))
+			return
+		}
+		offset := req.URL.Query().Get("offset")
+		if params.Offset, err = strconv.ParseUint(offset, 10, 64); err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			w.Write(newApiError(errors.New("error parsing 'offset' parameter"), http.StatusBadRequest))
+			return
+		}
+	}
+
+	res, err := c.cli.GetNodeTimeline(req.Context(), params)
+	if err != nil {
+		w.WriteHeader(http.StatusInternalServerError)
+		w.Write(newApiError(err, http.StatusInternalServerError))
+		return
+	}
+
+	changes := []NodeChange{}
+	for _, ch := range res {
+		changes = append(changes, toNodeChange(ch))
+	}
+
+	enc := json.NewEncoder(w)
+	w.WriteHeader(http.StatusOK)
+	if err := enc.Encode(changes); err != nil {
+		w.WriteHeader(http.StatusInternalServerError)
+		w.Write(newApiError(err, http.StatusInternalServerError))
+	}
+}
+
+// GetNodesMaintenance returns the time nodes spent in maintenance, summed up per validator.
+// Range is open ended when time from or to is not sent
+//
+// GET /nodes/maintenance (id, validator_id, from, to)
+func (c *Connector) GetNodesMaintenance(w http.ResponseWriter, req *http.Request) {
+	w.Header().Add("Content-Type", "application/json")
+	allowCORSHeaders(w)
+
+	switch req.Method {
+	case http.MethodGet:
+	case http.MethodOptions:
+		return
+	default:
+		w.WriteHeader(http.StatusMethodNotAllowed)
+		w.Write(newApiError(structs.ErrNotAllowedMethod, http.StatusMethodNotAllowed))
+		return
+	}
+
+	params := structs.NodeMaintenanceParams{
+		NodeID:      req.URL.Query().Get("id"),
+		ValidatorID: req.URL.Query().Get("validator_id"),
+	}
+	var err error
+	if from := req.URL.Query().Get("from"); from != "" {
+		if params.TimeFrom, err = time.Parse(structs.Layout, from); err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			w.Write(newApiError(errors.New("error parsing 'from' parameter"), http.StatusBadRequest))
+			return
+		}
+	}
+	if to := req.URL.Query().Get("to"); to != "" {
+		if params.TimeTo, err = time.Parse(structs.Layout, to); err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			w.Write(newApiError(errors.New("error parsing 'to' parameter"), http.StatusBadRequest))
+			return
+		}
+	}
+
+	res, err := c.cli.GetNodesMaintenance(req.Context(), params)
+	if err != nil {
+		w.WriteHeader(http.StatusInternalServerError)
+		w.Write(newApiError(err, http.StatusInternalServerError))
+		return
+	}
+
+	validators := []ValidatorMaintenance{}
+	index := map[string]int{}
+	for _, m := range res {
+		key := m.ValidatorID.String()
+		i, ok := index[key]
+		if !ok {
+			i = len(validators)
+			index[key] = i
+			validators = append(validators, ValidatorMaintenance{ValidatorID: m.ValidatorID, Nodes: []NodeMaintenance{}})
+		}
+
+		nm := NodeMaintenance{
+			NodeID:             m.NodeID,
+			TrackedSeconds:     uint64(m.Tracked / time.Second),
+			MaintenanceSeconds: uint64(m.Maintenance / time.Second),
+			Periods:            m.Periods,
+		}
+		validators[i].TrackedSeconds += nm.TrackedSeconds
+		validators[i].MaintenanceSeconds += nm.MaintenanceSeconds
+		validators[i].Nodes = append(validators[i].Nodes, nm)
+	}
+	sort.Slice(validators, func(i, j int) bool { return validators[i].ValidatorID.Cmp(validators[j].ValidatorID) < 0 })
+
+	enc := json.NewEncoder(w)
+	w.WriteHeader(http.StatusOK)
+	if err := enc.Encode(validators); err != nil {
+		w.WriteHeader(http.StatusInternalServerError)
+		w.Write(newApiError(err, http.StatusInternalServerError))
+	}
+}
+
+func toNode(n structs.Node) Node {
+	return Node{
+		NodeID:         n.NodeID,
+		Name:           n.Name,
+		IP:             n.IP.String(),
+		PublicIP:       n.PublicIP.String(),
+		Port:           n.Port,
+		StartBlock:     n.StartBlock,
+		NextRewardDate: n.NextRewardDate,
+		LastRewardDate: n.LastRewardDate,
+		FinishTime:     n.FinishTime,
+		ValidatorID:    n.ValidatorID,
+		Status:         n.Status.String(),
+		Address:        n.Address,
+	}
+}
+
+func toNodeChange(ch structs.NodeChange) NodeChange {
+	nc := NodeChange{
+		Node:        toNode(ch.Node),
+		BlockHeight: ch.BlockHeight,
+		ValidTo:     ch.ValidTo,
+	}
+	if !ch.Time.IsZero() {
+		t := ch.Time
+		nc.Time = &t
+	}
+	return nc
+}
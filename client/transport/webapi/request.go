@@ -18,7 +18,7 @@ type EventParams struct {
 	Type string `json:"type"`
 	// TimeFrom - the inclusive beginning of the time range for event time
 	//
-	// supposed to be sent with time to
+	// supposed to be sent with time to, may be left out when searching by name, params, block range or transaction
 	// required: true
 	// time format: RFC3339
 	// example: 2020-09-22T12:42:31Z
@@ -30,6 +30,43 @@ type EventParams struct {
 	// time format: RFC3339
 	// example: 2021-09-22T12:42:31Z
 	TimeTo time.Time `json:"to"`
+	// ContractName - filtering events by the name of contract emitting them
+	//
+	// example: delegation_controller
+	ContractName string `json:"contract_name"`
+	// EventName - filtering events by name
+	//
+	// example: DelegationProposed
+	EventName string `json:"event_name"`
+	// Params - filtering events by decoded parameters, sent as params.{name} in query
+	//
+	// values are matched as text, numbers, booleans and case insensitive hex
+	// example: {"holder": "0x06dd71dab27c1a3e0b172d53735f00bf1a66eb79"}
+	Params map[string]string `json:"params"`
+	// HeightFrom - the inclusive beginning of the block range
+	//
+	// format: unsigned integer
+	HeightFrom uint64 `json:"height_from"`
+	// HeightTo - the inclusive ending of the block range
+	//
+	// format: unsigned integer
+	HeightTo uint64 `json:"height_to"`
+	// TransactionHash - filtering events by transaction emitting them
+	//
+	// format: hexadecimal
+	TransactionHash string `json:"transaction_hash"`
+	// AtHeight - block height of the state to return
+	//
+	// not to be sent together with at_time
+	// format: unsigned integer
+	// required: false
+	AtHeight uint64 `json:"at_height"`
+	// AtTime - time of the state to return, resolved to the last block at or before it
+	//
+	// not to be sent together with at_height
+	// time format: RFC3339
+	// example: 2021-09-22T12:42:31Z
+	AtTime time.Time `json:"at_time"`
 	// Limit - Limit of the records per page
 	//
 	// required: false
@@ -38,6 +75,15 @@ type EventParams struct {
 	//
 	// required: false
 	Offset uint64 `json:"offset"`
+	// Cursor - opaque position of the last record of previous page
+	//
+	// empty for the first page, when sent the response is a page with next_cursor
+	// required: false
+	Cursor *string `json:"cursor,omitempty"`
+	// Total - whether to count all the records matching the filter, used with cursor only
+	//
+	// required: false
+	Total bool `json:"total"`
 }
 
 // NodeParams a set of fields to be used for nodes search
@@ -55,6 +101,18 @@ type NodeParams struct {
 	//
 	// example: Active
 	Status string `json:"status"`
+	// AtHeight - block height of the state to return
+	//
+	// not to be sent together with at_time
+	// format: unsigned integer
+	// required: false
+	AtHeight uint64 `json:"at_height"`
+	// AtTime - time of the state to return, resolved to the last block at or before it
+	//
+	// not to be sent together with at_height
+	// time format: RFC3339
+	// example: 2021-09-22T12:42:31Z
+	AtTime time.Time `json:"at_time"`
 	// Limit - Limit of the records per page
 	//
 	// required: false
@@ -63,6 +121,15 @@ type NodeParams struct {
 	//
 	// required: false
 	Offset uint64 `json:"offset"`
+	// Cursor - opaque position of the last record of previous page
+	//
+	// empty for the first page, when sent the response is a page with next_cursor
+	// required: false
+	Cursor *string `json:"cursor,omitempty"`
+	// Total - whether to count all the records matching the filter, used with cursor only
+	//
+	// required: false
+	Total bool `json:"total"`
 }
 
 // AccountParams a set of fields to be used for accounts search
@@ -83,6 +150,15 @@ type AccountParams struct {
 	//
 	// required: false
 	Offset uint64 `json:"offset"`
+	// Cursor - opaque position of the last record of previous page
+	//
+	// empty for the first page, when sent the response is a page with next_cursor
+	// required: false
+	Cursor *string `json:"cursor,omitempty"`
+	// Total - whether to count all the records matching the filter, used with cursor only
+	//
+	// required: false
+	Total bool `json:"total"`
 }
 
 // DelegationParams a set of fields to be used for accounts search
@@ -111,6 +187,18 @@ type DelegationParams struct {
 	// case false to fetch recent info for filtered delegations
 	// case true to fetch whole delegations for the filter
 	Timeline bool `json:"timeline"`
+	// AtHeight - block height of the state to return
+	//
+	// not to be sent together with at_time
+	// format: unsigned integer
+	// required: false
+	AtHeight uint64 `json:"at_height"`
+	// AtTime - time of the state to return, resolved to the last block at or before it
+	//
+	// not to be sent together with at_height
+	// time format: RFC3339
+	// example: 2021-09-22T12:42:31Z
+	AtTime time.Time `json:"at_time"`
 	// Limit - Limit of the records per page
 	//
 	// required: false
@@ -123,6 +211,15 @@ type DelegationParams struct {
 	//
 	// required: false
 	State []string `json:"state"`
+	// Cursor - opaque position of the last record of previous page
+	//
+	// empty for the first page, when sent the response is a page with next_cursor
+	// required: false
+	Cursor *string `json:"cursor,omitempty"`
+	// Total - whether to count all the records matching the filter, used with cursor only
+	//
+	// required: false
+	Total bool `json:"total"`
 }
 
 // ValidatorParams a set of fields to be used for validators search
@@ -152,6 +249,18 @@ type ValidatorParams struct {
 	//
 	// format: hexadecimal
 	Address string `json:"address"`
+	// AtHeight - block height of the state to return
+	//
+	// not to be sent together with at_time
+	// format: unsigned integer
+	// required: false
+	AtHeight uint64 `json:"at_height"`
+	// AtTime - time of the state to return, resolved to the last block at or before it
+	//
+	// not to be sent together with at_height
+	// time format: RFC3339
+	// example: 2021-09-22T12:42:31Z
+	AtTime time.Time `json:"at_time"`
 	// Limit - Limit of the records per page
 	//
 	// required: false
@@ -160,6 +269,15 @@ type ValidatorParams struct {
 	//
 	// required: false
 	Offset uint64 `json:"offset"`
+	// Cursor - opaque position of the last record of previous page
+	//
+	// empty for the first page, when sent the response is a page with next_cursor
+	// required: false
+	Cursor *string `json:"cursor,omitempty"`
+	// Total - whether to count all the records matching the filter, used with cursor only
+	//
+	// required: false
+	Total bool `json:"total"`
 }
 
 // ValidatorStatisticsParams a set of fields to be used for validator statistics search
@@ -192,6 +310,18 @@ type ValidatorStatisticsParams struct {
 	// case false to fetch recent info for filtered statistics
 	// case true to fetch whole statistics for the filter
 	Timeline bool `json:"timeline"`
+	// AtHeight - block height of the state to return
+	//
+	// not to be sent together with at_time
+	// format: unsigned integer
+	// required: false
+	AtHeight uint64 `json:"at_height"`
+	// AtTime - time of the state to return, resolved to the last block at or before it
+	//
+	// not to be sent together with at_height
+	// time format: RFC3339
+	// example: 2021-09-22T12:42:31Z
+	AtTime time.Time `json:"at_time"`
 	// Limit - Limit of the records per page
 	//
 	// required: false
@@ -204,13 +334,17 @@ type ValidatorStatisticsParams struct {
 
 // SystemEventParams a set of fields to be used for system events
 type SystemEventParams struct {
-	After       uint64 `json:"after"`
-	Kind        string `json:"kind"`
-	Address     string `json:"address"`
-	ValidatorID string `json:"validator_id"`
-	ID          string `json:"id"`
-	SenderID    uint64 `json:"sender_id"`
-	ReceiverID  uint64 `json:"receiver_id"`
-	Limit       uint64 `json:"limit"`
-	Offset      uint64 `json:"offset"`
+	After       uint64    `json:"after"`
+	Kind        string    `json:"kind"`
+	Address     string    `json:"address"`
+	ValidatorID string    `json:"validator_id"`
+	ID          string    `json:"id"`
+	SenderID    uint64    `json:"sender_id"`
+	ReceiverID  uint64    `json:"receiver_id"`
+	AtHeight    uint64    `json:"at_height"`
+	AtTime      time.Time `json:"at_time"`
+	Limit       uint64    `json:"limit"`
+	Offset      uint64    `json:"offset"`
+	Cursor      *string   `json:"cursor,omitempty"`
+	Total       bool      `json:"total"`
 }
@@ -158,6 +158,50 @@ type Node struct {
 	Address common.Address `json:"address"`
 }
 
+// NodeChange a state of node from the block it was changed at until the following change
+// swagger:model
+type NodeChange struct {
+	Node
+	// BlockHeight - Block number at ETH mainnet node was changed at
+	BlockHeight uint64 `json:"block_height"`
+	// ValidTo - Block number of the following change, 0 for the current state
+	ValidTo uint64 `json:"valid_to"`
+	// Time - time of the block node was changed at, omitted when the block is not stored
+	//
+	// package: time
+	Time *time.Time `json:"time,omitempty"`
+}
+
+// ValidatorMaintenance time nodes of validator spent in maintenance
+// swagger:model
+type ValidatorMaintenance struct {
+	// ValidatorID - the index of validator in SKALE deployed smart contract
+	//
+	// package: math/big
+	ValidatorID *big.Int `json:"validator_id"`
+	// TrackedSeconds - total time the state of validator nodes is known for
+	TrackedSeconds uint64 `json:"tracked_seconds"`
+	// MaintenanceSeconds - total time validator nodes spent in maintenance
+	MaintenanceSeconds uint64 `json:"maintenance_seconds"`
+	// Nodes - maintenance of every node
+	Nodes []NodeMaintenance `json:"nodes"`
+}
+
+// NodeMaintenance time node spent in maintenance
+// swagger:model
+type NodeMaintenance struct {
+	// NodeID - the index of node in SKALE deployed smart contract
+	//
+	// package: math/big
+	NodeID *big.Int `json:"id"`
+	// TrackedSeconds - time the state of node is known for, while it has not left
+	TrackedSeconds uint64 `json:"tracked_seconds"`
+	// MaintenanceSeconds - time node spent in maintenance
+	MaintenanceSeconds uint64 `json:"maintenance_seconds"`
+	// Periods - number of maintenance periods
+	Periods uint64 `json:"periods"`
+}
+
 // Validator a set of fields to show returned validators by search
 // swagger:model
 type Validator struct {
@@ -203,6 +247,362 @@ type Validator struct {
 	Staked string `json:"staked"`
 }
 
+// ValidatorRank position of validator in the ranking with the metrics it is computed from
+// swagger:model
+type ValidatorRank struct {
+	// Rank - position in the ranking, starting with 1
+	Rank uint64 `json:"rank"`
+	// ValidatorID - the index of validator in SKALE deployed smart contract
+	//
+	// package: math/big
+	ValidatorID *big.Int `json:"id"`
+	// Name - validator name
+	Name string `json:"name"`
+	// Score - weighted mean of the normalized metrics, between 0 and 1
+	Score float64 `json:"score"`
+	// Scores - metrics normalized between the lowest and the highest value of all validators, higher being better
+	Scores map[string]float64 `json:"scores"`
+	// TotalStake - total stake amount
+	TotalStake string `json:"total_stake"`
+	// EffectiveStake - stake of active delegations multiplied by the bonus of their delegation period
+	EffectiveStake string `json:"effective_stake"`
+	// Delegators - number of holders with active delegations
+	Delegators uint64 `json:"delegators"`
+	// FeeRate - fee rate
+	//
+	// package: math/big
+	FeeRate *big.Int `json:"fee_rate"`
+	// ActiveNodes - number of active nodes attached to the validator
+	ActiveNodes uint `json:"active_nodes"`
+	// Uptime - share of time nodes were not in maintenance within the uptime window
+	Uptime float64 `json:"uptime"`
+	// StakeGrowth - change of total stake within the growth window
+	StakeGrowth string `json:"stake_growth"`
+	// StakeGrowthRate - change of total stake relative to the stake at the beginning of the growth window
+	StakeGrowthRate float64 `json:"stake_growth_rate"`
+}
+
+// APR realised return of delegations within the time range with the inputs of the formula:
+// apr = delegators_bounty / effective_stake * year / (time_to - time_from), multiplied by the period multiplier for every period
+// swagger:model
+type APR struct {
+	// ValidatorID - the index of validator in SKALE deployed smart contract, omitted for the network
+	//
+	// package: math/big
+	ValidatorID *big.Int `json:"id,omitempty"`
+	// TimeFrom - the beginning of the time range
+	TimeFrom time.Time `json:"time_from"`
+	// TimeTo - the ending of the time range
+	TimeTo time.Time `json:"time_to"`
+	// Bounty - sum of bounties paid to nodes
+	Bounty string `json:"bounty"`
+	// NodeEpochs - number of bounty payments to nodes, one per node per epoch
+	NodeEpochs uint64 `json:"node_epochs"`
+	// BountyPerNodeEpoch - mean bounty paid to node per epoch
+	BountyPerNodeEpoch string `json:"bounty_per_node_epoch"`
+	// Fee - part of bounty taken by validators, at the fee rate in effect when bounty was paid
+	Fee string `json:"fee"`
+	// FeeRate - realised fee, per mille of bounty
+	//
+	// package: math/big
+	FeeRate *big.Int `json:"fee_rate"`
+	// DelegatorsBounty - bounty left for delegators, bounty without fee
+	DelegatorsBounty string `json:"delegators_bounty"`
+	// EffectiveStakeFrom - effective stake of delegations at the beginning of the time range
+	EffectiveStakeFrom string `json:"effective_stake_from"`
+	// EffectiveStakeTo - effective stake of delegations at the ending of the time range
+	EffectiveStakeTo string `json:"effective_stake_to"`
+	// EffectiveStake - mean of the effective stake at the beginning and at the ending, the latter when nothing was indexed by the beginning
+	EffectiveStake string `json:"effective_stake"`
+	// APR - annual return of the stake with multiplier of 100%
+	APR float64 `json:"apr"`
+	// Periods - return per delegation period
+	Periods []PeriodAPR `json:"periods"`
+}
+
+// PeriodAPR realised return of delegation with given period
+// swagger:model
+type PeriodAPR struct {
+	// Months - delegation period in months
+	Months uint64 `json:"months"`
+	// Multiplier - stake multiplier of the period in percents
+	Multiplier int64 `json:"multiplier"`
+	// APR - annual return
+	APR float64 `json:"apr"`
+	// APY - annual return with bounty compounded monthly
+	APY float64 `json:"apy"`
+}
+
+// Portfolio delegations of the holder with totals and history per epoch
+// swagger:model
+type Portfolio struct {
+	// Holder - address of the token holder
+	//
+	// package: github.com/ethereum/go-ethereum/common
+	// format: [20]byte
+	Holder common.Address `json:"holder"`
+	// Locked - amount of delegations which are proposed, accepted, delegated or requested to be undelegated
+	Locked string `json:"locked"`
+	// Unlocked - amount of completed delegations
+	Unlocked string `json:"unlocked"`
+	// Earned - bounty earned by delegations, estimated from bounty of validator nodes split by effective stake of delegations active in the epoch
+	Earned string `json:"earned"`
+	// Withdrawn - bounty withdrawn by the holder
+	Withdrawn string `json:"withdrawn"`
+	// Validators - delegations grouped by validator and state
+	Validators []PortfolioValidator `json:"validators"`
+	// Pending - delegations waiting for acceptance or for their first epoch
+	Pending []Delegation `json:"pending"`
+	// Unlocks - ends of the period of locked delegations, the earliest first
+	Unlocks []DelegationUnlock `json:"unlocks"`
+	// Epochs - value and earned bounty per epoch, from the first one holder delegated in
+	Epochs []PortfolioEpoch `json:"epochs"`
+}
+
+// PortfolioValidator delegations of the holder to validator grouped by state
+// swagger:model
+type PortfolioValidator struct {
+	// ValidatorID - the index of validator in SKALE deployed smart contract
+	//
+	// package: math/big
+	ValidatorID *big.Int `json:"validator_id"`
+	// ValidatorName - validator name
+	ValidatorName string `json:"validator_name"`
+	// Earned - bounty earned by delegations to validator
+	Earned string `json:"earned"`
+	// Withdrawn - bounty withdrawn from validator
+	Withdrawn string `json:"withdrawn"`
+	// States - delegations per state
+	States []PortfolioState `json:"states"`
+}
+
+// PortfolioState delegations in the same state
+// swagger:model
+type PortfolioState struct {
+	// State - delegation state
+	State string `json:"state"`
+	// Amount - sum of delegation amounts
+	Amount string `json:"amount"`
+	// Delegations - delegations in the state
+	Delegations []Delegation `json:"delegations"`
+}
+
+// DelegationUnlock end of the delegation period
+// swagger:model
+type DelegationUnlock struct {
+	// DelegationID - the index of delegation in SKALE deployed smart contract
+	//
+	// package: math/big
+	DelegationID *big.Int `json:"delegation_id"`
+	// ValidatorID - the index of validator in SKALE deployed smart contract
+	//
+	// package: math/big
+	ValidatorID *big.Int `json:"validator_id"`
+	// Amount - delegation amount
+	Amount string `json:"amount"`
+	// State - delegation state
+	State string `json:"state"`
+	// Until - last day of the month delegation period ends in
+	Until time.Time `json:"until"`
+}
+
+// PortfolioEpoch value of delegations in the epoch
+// swagger:model
+type PortfolioEpoch struct {
+	// Epoch - month number counted from January 2020, as delegation started and finished epochs
+	Epoch uint64 `json:"epoch"`
+	// Start - the beginning of the epoch
+	Start time.Time `json:"start"`
+	// Value - amount of delegations active in the epoch
+	Value string `json:"value"`
+	// Earned - bounty earned in the epoch
+	Earned string `json:"earned"`
+}
+
+// UnlockCalendar amounts of delegations reaching the end of their period
+// swagger:model
+type UnlockCalendar struct {
+	// Days - amounts per day, the earliest first
+	Days []UnlockPeriod `json:"days"`
+	// Months - amounts per month, the earliest first
+	Months []UnlockPeriod `json:"months"`
+	// Projections - stake validators lose next month
+	Projections []StakeDropProjection `json:"projections"`
+}
+
+// UnlockPeriod amounts of delegations reaching the end of their period at the day or within the month
+// swagger:model
+type UnlockPeriod struct {
+	// Start - the day, or the first day of the month
+	Start time.Time `json:"start"`
+	// Undelegated - amount of delegations with undelegation requested, leaving at the end of their period
+	Undelegated string `json:"undelegated"`
+	// PeriodEnding - amount of delegations renewed unless undelegation is requested before their period ends
+	PeriodEnding string `json:"period_ending"`
+	// Delegations - number of delegations
+	Delegations uint64 `json:"delegations"`
+}
+
+// StakeDropProjection stake validator loses at the beginning of the next month
+// swagger:model
+type StakeDropProjection struct {
+	// ValidatorID - the index of validator in SKALE deployed smart contract
+	//
+	// package: math/big
+	ValidatorID *big.Int `json:"validator_id"`
+	// Stake - amount of delegated delegations, undelegation requested included
+	Stake string `json:"stake"`
+	// ExpectedDrop - amount of delegations with undelegation requested which period ends this month
+	ExpectedDrop string `json:"expected_drop"`
+	// ExpectedDropShare - share of the stake leaving
+	ExpectedDropShare float64 `json:"expected_drop_share"`
+	// AtRisk - amount of delegations which period ends this month, leaving if undelegation is requested before its end
+	AtRisk string `json:"at_risk"`
+	// LargestLeaving - the largest of the delegations leaving
+	LargestLeaving string `json:"largest_leaving"`
+	// Leaving - number of delegations leaving
+	Leaving uint64 `json:"leaving"`
+}
+
+// StatisticsSeries values of validator statistic at the end of every bucket
+// swagger:model
+type StatisticsSeries struct {
+	// Type - statistics type
+	Type string `json:"type"`
+	// Interval - length of buckets, day, week or month
+	Interval string `json:"interval"`
+	// Buckets - beginnings of buckets, the earliest first
+	Buckets []time.Time `json:"buckets"`
+	// Validators - values of validators per bucket
+	Validators []ValidatorSeries `json:"validators"`
+	// Network - aggregate of values of all validators per bucket
+	Network []NetworkPoint `json:"network"`
+}
+
+// ValidatorSeries values of validator statistic per bucket
+// swagger:model
+type ValidatorSeries struct {
+	// ValidatorID - the index of validator in SKALE deployed smart contract
+	//
+	// package: math/big
+	ValidatorID *big.Int `json:"validator_id"`
+	// Values - value at the end of the bucket, null until validator has any
+	Values []*string `json:"values"`
+}
+
+// NetworkPoint aggregate of values of all validators in the bucket
+// swagger:model
+type NetworkPoint struct {
+	// Value - sum of values, or their mean for MDR and fee rate
+	Value string `json:"value"`
+	// Validators - number of validators having a value
+	Validators uint64 `json:"validators"`
+}
+
+// NetworkOverview network figures with the ones a month before
+// swagger:model
+type NetworkOverview struct {
+	// Time - the time network is summed up at
+	Time time.Time `json:"time"`
+	// BlockHeight - the last block at the time, 0 for the current state
+	BlockHeight uint64 `json:"block_height"`
+	// Current - figures at the time
+	Current NetworkFigures `json:"current"`
+	// MonthAgoTime - the time a month before
+	MonthAgoTime time.Time `json:"month_ago_time"`
+	// MonthAgoBlockHeight - the last block a month before
+	MonthAgoBlockHeight uint64 `json:"month_ago_block_height"`
+	// MonthAgo - figures a month before, null when no block is indexed that early
+	MonthAgo *NetworkFigures `json:"month_ago"`
+	// Change - change of figures since a month before, null when no block is indexed that early
+	Change *NetworkFigures `json:"change"`
+}
+
+// NetworkFigures state of network
+// swagger:model
+type NetworkFigures struct {
+	// TotalStake - amount of delegated delegations, undelegation requested included
+	TotalStake string `json:"total_stake"`
+	// EffectiveStake - the stake multiplied by multipliers of delegation periods
+	EffectiveStake string `json:"effective_stake"`
+	// Validators - number of registered validators
+	Validators int64 `json:"validators"`
+	// AuthorizedValidators - number of authorized validators
+	AuthorizedValidators int64 `json:"authorized_validators"`
+	// AcceptingValidators - number of validators accepting new delegation requests
+	AcceptingValidators int64 `json:"accepting_validators"`
+	// ActiveNodes - number of active nodes
+	ActiveNodes int64 `json:"active_nodes"`
+	// LeavingNodes - number of leaving nodes
+	LeavingNodes int64 `json:"leaving_nodes"`
+	// LeftNodes - number of nodes which left
+	LeftNodes int64 `json:"left_nodes"`
+	// Delegators - number of unique holders of delegated delegations
+	Delegators int64 `json:"delegators"`
+	// SkaleChains - number of skale chains created and not deleted
+	SkaleChains int64 `json:"skale_chains"`
+	// Slashed - total amount slashed
+	Slashed string `json:"slashed"`
+	// Forgiven - total amount of slashes forgiven
+	Forgiven string `json:"forgiven"`
+	// TokenSupply - amount of token minted less the amount burned
+	TokenSupply string `json:"token_supply"`
+}
+
+// Decentralization metrics of distribution of stake between validators at the beginning of the epoch
+// swagger:model
+type Decentralization struct {
+	// Epoch - the epoch, months since January 2020
+	Epoch uint64 `json:"epoch"`
+	// Time - the beginning of the epoch
+	Time time.Time `json:"time"`
+	// BlockHeight - the last block before the epoch, metrics are computed at
+	BlockHeight uint64 `json:"block_height"`
+	// Validators - number of validators with any stake
+	Validators uint64 `json:"validators"`
+	// TotalStake - stake of all validators
+	TotalStake string `json:"total_stake"`
+	// NakamotoCoefficient - the smallest number of validators controlling more than a third of stake
+	NakamotoCoefficient uint64 `json:"nakamoto_coefficient"`
+	// Gini - Gini coefficient of stakes, 0 when stake is split evenly
+	Gini float64 `json:"gini"`
+	// Top1Share - share of stake of the largest validator
+	Top1Share float64 `json:"top1_share"`
+	// Top5Share - share of stake of the largest 5 validators
+	Top5Share float64 `json:"top5_share"`
+	// Top10Share - share of stake of the largest 10 validators
+	Top10Share float64 `json:"top10_share"`
+	// ValidatorsOver33 - the smallest number of validators controlling more than 33% of stake
+	ValidatorsOver33 uint64 `json:"validators_over_33"`
+	// ValidatorsOver50 - the smallest number of validators controlling more than 50% of stake
+	ValidatorsOver50 uint64 `json:"validators_over_50"`
+	// ValidatorsOver66 - the smallest number of validators controlling more than 66% of stake
+	ValidatorsOver66 uint64 `json:"validators_over_66"`
+}
+
+// ValidatorAddress addresses of validator from the block they were changed at
+// swagger:model
+type ValidatorAddress struct {
+	// ValidatorID - the index of validator in SKALE deployed smart contract
+	//
+	// package: math/big
+	ValidatorID *big.Int `json:"id"`
+	// Address - checksummed validator address
+	Address string `json:"address"`
+	// RequestedAddress - checksummed address requested to replace the validator one, omitted when there is no request
+	RequestedAddress string `json:"requested_address,omitempty"`
+	// BlockHeight - block number at ETH mainnet addresses were changed at
+	BlockHeight uint64 `json:"block_height"`
+	// Time - time of the block addresses were changed at
+	//
+	// package: time
+	Time time.Time `json:"time"`
+	// TransactionHash - transaction addresses were changed in, omitted for changes found by synchronization
+	TransactionHash string `json:"transaction_hash,omitempty"`
+	// EventName - name of the event addresses were changed with
+	EventName string `json:"event_name,omitempty"`
+}
+
 // ValidatorStatistic validator statistic value in given block
 // swagger:model
 type ValidatorStatistic struct {
@@ -245,6 +645,16 @@ type SystemEvent struct {
 	Data        SystemEventData `json:"data"`
 }
 
+// StreamEvent is system or contract event pushed by events stream.
+// Events are pushed as soon as they are persisted, before stores assign them ids, so only resumed ones have it
+type StreamEvent struct {
+	// Type - system_event or contract_event
+	Type          string         `json:"type"`
+	Height        uint64         `json:"height"`
+	SystemEvent   *SystemEvent   `json:"system_event,omitempty"`
+	ContractEvent *ContractEvent `json:"contract_event,omitempty"`
+}
+
 // SystemEventData value for SystemEvent
 type SystemEventData struct {
 	Before big.Int   `json:"before"`
@@ -252,6 +662,118 @@ type SystemEventData struct {
 	Change big.Float `json:"change"`
 }
 
+// Block ethereum block header information
+// swagger:model
+type Block struct {
+	// Number - Block number at ETH mainnet
+	Number uint64 `json:"number"`
+	// Hash - block hash
+	//
+	// package: github.com/ethereum/go-ethereum/common
+	// format: [32]byte
+	Hash common.Hash `json:"hash"`
+	// ParentHash - hash of the parent block
+	//
+	// package: github.com/ethereum/go-ethereum/common
+	// format: [32]byte
+	ParentHash common.Hash `json:"parent_hash"`
+	// Time - block timestamp on ETH mainnet
+	Time time.Time `json:"time"`
+}
+
+// Transaction ethereum transaction with the events emitted in it
+// swagger:model
+type Transaction struct {
+	// Hash - transaction hash
+	//
+	// package: github.com/ethereum/go-ethereum/common
+	// format: [32]byte
+	Hash common.Hash `json:"hash"`
+	// BlockHeight - Block number at ETH mainnet
+	BlockHeight uint64 `json:"block_height"`
+	// BlockHash - hash of the block containing the transaction
+	//
+	// package: github.com/ethereum/go-ethereum/common
+	// format: [32]byte
+	BlockHash common.Hash `json:"block_hash"`
+	// Index - index of the transaction in the block
+	Index uint `json:"index"`
+	// Time - block timestamp on ETH mainnet
+	Time time.Time `json:"time"`
+	// From - transaction sender (Address represents the 20 byte address of an Ethereum account)
+	//
+	// package: github.com/ethereum/go-ethereum/common
+	// format: [20]byte
+	From common.Address `json:"from"`
+	// To - transaction recipient (Address represents the 20 byte address of an Ethereum account)
+	//
+	// package: github.com/ethereum/go-ethereum/common
+	// format: [20]byte
+	To common.Address `json:"to"`
+	// Value - transferred value in wei
+	Value string `json:"value"`
+	// Nonce - sender nonce
+	Nonce uint64 `json:"nonce"`
+	// Gas - gas limit
+	Gas uint64 `json:"gas"`
+	// GasPrice - gas price in wei
+	GasPrice string `json:"gas_price"`
+	// GasUsed - gas used by the transaction
+	GasUsed uint64 `json:"gas_used"`
+	// Fee - effective fee paid in wei
+	Fee string `json:"fee"`
+	// Status - receipt status, 1 for success and 0 for failure
+	Status uint64 `json:"status"`
+	// Events - decoded events emitted in the transaction
+	Events ContractEvents `json:"events"`
+}
+
+// Page list of records requested by cursor
+// swagger:model
+type Page struct {
+	// Items - records of the page
+	Items interface{} `json:"items"`
+	// NextCursor - cursor of the following page, empty at the end of the list
+	NextCursor string `json:"next_cursor,omitempty"`
+	// Total - number of all the records matching the filter, when requested
+	Total *uint64 `json:"total,omitempty"`
+}
+
+// AddressActivity page of records touching the address
+// swagger:model
+type AddressActivity struct {
+	// Address - checksummed address
+	Address string `json:"address"`
+	// AccountType - type of account of the address, omitted when there is none
+	AccountType string `json:"account_type,omitempty"`
+	// Items - records touching the address, the latest first
+	Items []Activity `json:"items"`
+	// NextCursor - cursor of the following page, empty at the end of the list
+	NextCursor string `json:"next_cursor,omitempty"`
+	// Total - number of all the records touching the address, when requested
+	Total *uint64 `json:"total,omitempty"`
+}
+
+// Activity record touching the address, only the record of its kind is set
+// swagger:model
+type Activity struct {
+	// Kind - kind of record
+	//
+	// enum: contract_event,delegation,node,system_event
+	Kind string `json:"kind"`
+	// BlockHeight - block number at ETH mainnet
+	BlockHeight uint64 `json:"block_height"`
+	// Time - time of the block, omitted when the block is not stored
+	//
+	// package: time
+	Time *time.Time `json:"time,omitempty"`
+
+	ContractEvent *ContractEvent `json:"contract_event,omitempty"`
+	Delegation    *Delegation    `json:"delegation,omitempty"`
+	Node          *NodeChange    `json:"node,omitempty"`
+	SystemEvent   *SystemEvent   `json:"system_event,omitempty"`
+}
+
 // ApiError a set of fields to show error
 // swagger:model
 type ApiError struct {
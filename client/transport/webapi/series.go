@@ -0,0 +1,123 @@
+package webapi
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/figment-networks/skale-indexer/scraper/structs"
+)
+
+// GetStatisticsSeries returns values of validator statistic at the end of every day, week or month,
+// carried forward from the last change, with the network aggregate
+//
+// GET /validators/statistics/series (type, interval, validator_id, from, to)
+func (c *Connector) GetStatisticsSeries(w http.ResponseWriter, req *http.Request) {
+	w.Header().Add("Content-Type", "application/json")
+	allowCORSHeaders(w)
+
+	switch req.Method {
+	case http.MethodGet:
+	case http.MethodOptions:
+		return
+	default:
+		w.WriteHeader(http.StatusMethodNotAllowed)
+		w.Write(newApiError(structs.ErrNotAllowedMethod, http.StatusMethodNotAllowed))
+		return
+	}
+
+	query := req.URL.Query()
+	params := structs.StatisticsSeriesParams{Interval: structs.SeriesIntervalDay}
+	var ok bool
+	if params.Type, ok = structs.GetTypeForValidatorStatistics(query.Get("type")); !ok {
+		w.WriteHeader(http.StatusBadRequest)
+		w.Write(newApiError(errors.New("statistic type is wrong"), http.StatusBadRequest))
+		return
+	}
+	if _, ok = structs.SeriesAveraged[params.Type]; !ok {
+		w.WriteHeader(http.StatusBadRequest)
+		w.Write(newApiError(errors.New("statistic type can't be made a series of"), http.StatusBadRequest))
+		return
+	}
+	if interval := query.Get("interval"); interval != "" {
+		if params.Interval, ok = structs.GetSeriesInterval(interval); !ok {
+			w.WriteHeader(http.StatusBadRequest)
+			w.Write(newApiError(errors.New("error parsing 'interval' parameter"), http.StatusBadRequest))
+			return
+		}
+	}
+	if ids := query.Get("validator_id"); ids != "" {
+		for _, id := range strings.Split(ids, ",") {
+			if _, err := strconv.ParseUint(id, 10, 64); err != nil {
+				w.WriteHeader(http.StatusBadRequest)
+				w.Write(newApiError(errors.New("validator id given in wrong format"), http.StatusBadRequest))
+				return
+			}
+			params.ValidatorIDs = append(params.ValidatorIDs, id)
+		}
+	}
+
+	var err error
+	if from := query.Get("from"); from != "" {
+		if params.TimeFrom, err = time.Parse(structs.Layout, from); err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			w.Write(newApiError(errors.New("error parsing 'from' parameter"), http.StatusBadRequest))
+			return
+		}
+	}
+	if to := query.Get("to"); to != "" {
+		if params.TimeTo, err = time.Parse(structs.Layout, to); err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			w.Write(newApiError(errors.New("error parsing 'to' parameter"), http.StatusBadRequest))
+			return
+		}
+	}
+	if !params.TimeFrom.IsZero() && !params.TimeTo.IsZero() && params.TimeTo.Before(params.TimeFrom) {
+		w.WriteHeader(http.StatusBadRequest)
+		w.Write(newApiError(errors.New("time range is empty"), http.StatusBadRequest))
+		return
+	}
+
+	res, err := c.cli.GetStatisticsSeries(req.Context(), params)
+	if err == structs.ErrSeriesTooLong {
+		w.WriteHeader(http.StatusBadRequest)
+		w.Write(newApiError(err, http.StatusBadRequest))
+		return
+	}
+	if err != nil {
+		w.WriteHeader(http.StatusInternalServerError)
+		w.Write(newApiError(err, http.StatusInternalServerError))
+		return
+	}
+
+	series := StatisticsSeries{
+		Type:       res.Type.String(),
+		Interval:   string(res.Interval),
+		Buckets:    res.Buckets,
+		Validators: []ValidatorSeries{},
+		Network:    []NetworkPoint{},
+	}
+	for _, vs := range res.Validators {
+		values := make([]*string, len(vs.Values))
+		for i, v := range vs.Values {
+			if v != nil {
+				s := v.String()
+				values[i] = &s
+			}
+		}
+		series.Validators = append(series.Validators, ValidatorSeries{ValidatorID: vs.ValidatorID, Values: values})
+	}
+	for _, p := range res.Network {
+		series.Network = append(series.Network, NetworkPoint{Value: p.Value.String(), Validators: p.Validators})
+	}
+
+	enc := json.NewEncoder(w)
+	w.WriteHeader(http.StatusOK)
+	if err := enc.Encode(series); err != nil {
+		w.WriteHeader(http.StatusInternalServerError)
+		w.Write(newApiError(err, http.StatusInternalServerError))
+	}
+}
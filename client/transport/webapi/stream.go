@@ -0,0 +1,373 @@
+package webapi
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/big"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/gorilla/websocket"
+	"go.uber.org/zap"
+
+	"github.com/figment-networks/skale-indexer/client/stream"
+	"github.com/figment-networks/skale-indexer/scraper/structs"
+)
+
+const (
+	// maxStreamReplay is the number of stored events stream may be resumed with, the longer gap has to be paged through lists
+	maxStreamReplay = 1000
+	streamKeepAlive = 15 * time.Second
+	streamWriteWait = 10 * time.Second
+)
+
+var errTooManyToReplay = fmt.Errorf("more than %d events to resume from the height, page through lists instead", maxStreamReplay)
+
+var upgrader = websocket.Upgrader{
+	// CORS headers allow any origin as well
+	CheckOrigin: func(*http.Request) bool { return true },
+}
+
+// StreamContractor - method signatures for StreamConnector
+type StreamContractor interface {
+	GetSystemEvents(ctx context.Context, params structs.SystemEventParams) (systemEvents []structs.SystemEvent, err error)
+	GetContractEvents(ctx context.Context, params structs.EventParams) (contractEvents []structs.ContractEvent, err error)
+}
+
+// StreamConnector pushes system and contract events to clients as they are persisted
+type StreamConnector struct {
+	l      *zap.Logger
+	cli    StreamContractor
+	broker *stream.Broker
+}
+
+// NewStreamConnector is StreamConnector constructor
+func NewStreamConnector(l *zap.Logger, cli StreamContractor, broker *stream.Broker) *StreamConnector {
+	return &StreamConnector{l, cli, broker}
+}
+
+// AttachToHandler attaches handlers to http server's mux
+func (sc *StreamConnector) AttachToHandler(mux *http.ServeMux) {
+	mux.HandleFunc("/system_events/stream", sc.Stream)
+}
+
+// Stream pushes events as Server-Sent Events, or over WebSocket when connection asks for upgrade.
+// Stored events from 'from_height' (or the height of SSE 'Last-Event-ID') are sent first, so the client may resume after reconnect
+func (sc *StreamConnector) Stream(w http.ResponseWriter, req *http.Request) {
+	allowCORSHeaders(w)
+	switch req.Method {
+	case http.MethodGet:
+	case http.MethodOptions:
+		return
+	default:
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusMethodNotAllowed)
+		w.Write(newApiError(structs.ErrNotAllowedMethod, http.StatusMethodNotAllowed))
+		return
+	}
+
+	filter, from, resume, err := parseStreamParams(req)
+	if err != nil {
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusBadRequest)
+		w.Write(newApiError(err, http.StatusBadRequest))
+		return
+	}
+
+	// subscribe before reading stored events, so nothing persisted meanwhile is missed
+	sub := sc.broker.Subscribe(filter)
+	defer sub.Close()
+
+	var replay []stream.Event
+	if resume {
+		if replay, err = sc.replay(req.Context(), filter, from); err != nil {
+			w.Header().Add("Content-Type", "application/json")
+			if errors.Is(err, errTooManyToReplay) {
+				w.WriteHeader(http.StatusBadRequest)
+				w.Write(newApiError(err, http.StatusBadRequest))
+				return
+			}
+			sc.l.Error("[STREAM] Error getting events to resume with", zap.Uint64("from_height", from), zap.Error(err))
+			w.WriteHeader(http.StatusInternalServerError)
+			w.Write(newApiError(errors.New("error during server query"), http.StatusInternalServerError))
+			return
+		}
+	}
+
+	var out streamWriter
+	if websocket.IsWebSocketUpgrade(req) {
+		// upgrader responds with error itself
+		if out, err = newWSWriter(w, req); err != nil {
+			return
+		}
+	} else if out, err = newSSEWriter(w, req); err != nil {
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusInternalServerError)
+		w.Write(newApiError(err, http.StatusInternalServerError))
+		return
+	}
+	defer out.close()
+
+	// events persisted while stored ones were read are delivered by subscription as well
+	sent := make(map[string]struct{}, len(replay))
+	for _, e := range replay {
+		sent[e.Key()] = struct{}{}
+		if err := out.write(toStreamEvent(e)); err != nil {
+			return
+		}
+	}
+
+	keepAlive := time.NewTicker(streamKeepAlive)
+	defer keepAlive.Stop()
+	for {
+		select {
+		case <-out.done():
+			return
+		case e, ok := <-sub.Events():
+			if !ok {
+				out.fail(sub.Err())
+				return
+			}
+			if _, ok := sent[e.Key()]; ok {
+				continue
+			}
+			if err := out.write(toStreamEvent(e)); err != nil {
+				return
+			}
+		case <-keepAlive.C:
+			if err := out.ping(); err != nil {
+				return
+			}
+		}
+	}
+}
+
+// replay returns stored events matching filter from the height, the oldest first
+func (sc *StreamConnector) replay(ctx context.Context, filter stream.Filter, from uint64) (events []stream.Event, err error) {
+	seParams := structs.SystemEventParams{Limit: maxStreamReplay + 1}
+	if from > 0 {
+		seParams.After = from - 1
+	}
+	if filter.ValidatorID != nil {
+		seParams.ValidatorID = filter.ValidatorID.String()
+	}
+	if filter.Address != nil {
+		seParams.Address = filter.Address.Hex()
+	}
+	if len(filter.Kinds) == 1 {
+		seParams.Kind = strconv.FormatUint(uint64(filter.Kinds[0]), 10)
+	}
+	systemEvents, err := sc.cli.GetSystemEvents(ctx, seParams)
+	if err != nil {
+		return nil, err
+	}
+	for i := range systemEvents {
+		if e := (stream.Event{SystemEvent: &systemEvents[i]}); filter.Match(e) {
+			events = append(events, e)
+		}
+	}
+
+	for _, name := range filter.EventNames {
+		ceParams := structs.EventParams{EventName: name, HeightFrom: from, Limit: maxStreamReplay + 1}
+		if filter.ValidatorID != nil {
+			ceParams.Type, ceParams.Id = "validator", filter.ValidatorID.Uint64()
+		}
+		if filter.Address != nil {
+			ceParams.BoundAddress = *filter.Address
+		}
+		contractEvents, err := sc.cli.GetContractEvents(ctx, ceParams)
+		if err != nil {
+			return nil, err
+		}
+		// stores don't return what events are bound to, they're filtered by the query instead
+		for i := range contractEvents {
+			events = append(events, stream.Event{ContractEvent: &contractEvents[i]})
+		}
+	}
+
+	if len(systemEvents) > maxStreamReplay || len(events) > maxStreamReplay {
+		return nil, errTooManyToReplay
+	}
+
+	sort.SliceStable(events, func(i, j int) bool {
+		return events[i].Height() < events[j].Height()
+	})
+	return events, nil
+}
+
+func parseStreamParams(req *http.Request) (filter stream.Filter, from uint64, resume bool, err error) {
+	query := req.URL.Query()
+	if k := query.Get("kind"); k != "" {
+		for _, kind := range strings.Split(k, ",") {
+			t, ok := parseSystemEventKind(kind)
+			if !ok {
+				return filter, 0, false, errors.New("error parsing 'kind' parameter")
+			}
+			filter.Kinds = append(filter.Kinds, t)
+		}
+	}
+	if n := query.Get("event_name"); n != "" {
+		filter.EventNames = strings.Split(n, ",")
+	}
+	if v := query.Get("validator_id"); v != "" {
+		id, ok := new(big.Int).SetString(v, 10)
+		if !ok || id.Sign() < 0 || !id.IsUint64() {
+			return filter, 0, false, errors.New("error parsing 'validator_id' parameter")
+		}
+		filter.ValidatorID = id
+	}
+	if a := query.Get("address"); a != "" {
+		if !common.IsHexAddress(a) {
+			return filter, 0, false, errors.New("error parsing 'address' parameter")
+		}
+		address := common.HexToAddress(a)
+		filter.Address = &address
+	}
+
+	h := query.Get("from_height")
+	if h == "" {
+		h = req.Header.Get("Last-Event-ID")
+	}
+	if h != "" {
+		if from, err = strconv.ParseUint(h, 10, 64); err != nil {
+			return filter, 0, false, errors.New("error parsing 'from_height' parameter")
+		}
+		resume = true
+	}
+	return filter, from, resume, nil
+}
+
+// parseSystemEventKind parses kind given either as number, as in system events list, or by its name
+func parseSystemEventKind(kind string) (structs.SysEvtType, bool) {
+	if n, err := strconv.ParseUint(kind, 10, 64); err == nil {
+		_, ok := structs.SysEvtTypes[structs.SysEvtType(n)]
+		return structs.SysEvtType(n), ok
+	}
+	for t, name := range structs.SysEvtTypes {
+		if name == kind {
+			return t, true
+		}
+	}
+	return 0, false
+}
+
+func toStreamEvent(e stream.Event) StreamEvent {
+	if e.SystemEvent != nil {
+		se := toSystemEvent(*e.SystemEvent)
+		return StreamEvent{Type: "system_event", Height: e.Height(), SystemEvent: &se}
+	}
+	ce := toContractEvent(*e.ContractEvent)
+	return StreamEvent{Type: "contract_event", Height: e.Height(), ContractEvent: &ce}
+}
+
+// streamWriter sends events over connection of a kind
+type streamWriter interface {
+	write(e StreamEvent) error
+	ping() error
+	// fail tells the client why the stream ends
+	fail(err error)
+	// done is closed when the client goes away
+	done() <-chan struct{}
+	close()
+}
+
+type sseWriter struct {
+	w   http.ResponseWriter
+	f   http.Flusher
+	ctx context.Context
+}
+
+func newSSEWriter(w http.ResponseWriter, req *http.Request) (*sseWriter, error) {
+	f, ok := w.(http.Flusher)
+	if !ok {
+		return nil, errors.New("streaming is not supported")
+	}
+	w.Header().Set("Content-Type", "text/event-stream")
+	w.Header().Set("Cache-Control", "no-cache")
+	w.Header().Set("Connection", "keep-alive")
+	w.WriteHeader(http.StatusOK)
+	f.Flush()
+	return &sseWriter{w: w, f: f, ctx: req.Context()}, nil
+}
+
+// write sends event with its height as id, so reconnecting client resumes from it with 'Last-Event-ID'
+func (s *sseWriter) write(e StreamEvent) error {
+	b, err := json.Marshal(e)
+	if err != nil {
+		return err
+	}
+	if _, err = fmt.Fprintf(s.w, "id: %d\nevent: %s\ndata: %s\n\n", e.Height, e.Type, b); err != nil {
+		return err
+	}
+	s.f.Flush()
+	return nil
+}
+
+func (s *sseWriter) ping() error {
+	if _, err := fmt.Fprint(s.w, ": ping\n\n"); err != nil {
+		return err
+	}
+	s.f.Flush()
+	return nil
+}
+
+func (s *sseWriter) fail(err error) {
+	fmt.Fprintf(s.w, "event: error\ndata: %s\n\n", newApiError(err, http.StatusServiceUnavailable))
+	s.f.Flush()
+}
+
+func (s *sseWriter) done() <-chan struct{} {
+	return s.ctx.Done()
+}
+
+func (s *sseWriter) close() {}
+
+type wsWriter struct {
+	conn   *websocket.Conn
+	closed chan struct{}
+}
+
+func newWSWriter(w http.ResponseWriter, req *http.Request) (*wsWriter, error) {
+	conn, err := upgrader.Upgrade(w, req, nil)
+	if err != nil {
+		return nil, err
+	}
+	ws := &wsWriter{conn: conn, closed: make(chan struct{})}
+	// messages of the client are not expected, reading just handles control frames and notices closed connection
+	go func() {
+		defer close(ws.closed)
+		for {
+			if _, _, err := conn.ReadMessage(); err != nil {
+				return
+			}
+		}
+	}()
+	return ws, nil
+}
+
+func (ws *wsWriter) write(e StreamEvent) error {
+	ws.conn.SetWriteDeadline(time.Now().Add(streamWriteWait))
+	return ws.conn.WriteJSON(e)
+}
+
+func (ws *wsWriter) ping() error {
+	return ws.conn.WriteControl(websocket.PingMessage, nil, time.Now().Add(streamWriteWait))
+}
+
+func (ws *wsWriter) fail(err error) {
+	ws.conn.WriteControl(websocket.CloseMessage, websocket.FormatCloseMessage(websocket.CloseTryAgainLater, err.Error()), time.Now().Add(streamWriteWait))
+}
+
+func (ws *wsWriter) done() <-chan struct{} {
+	return ws.closed
+}
+
+func (ws *wsWriter) close() {
+	ws.conn.Close()
+}
@@ -0,0 +1,95 @@
+package webapi
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+
+	"github.com/figment-networks/skale-indexer/scraper/structs"
+)
+
+// GetTransaction returns transaction with all the events emitted in it
+func (c *Connector) GetTransaction(w http.ResponseWriter, req *http.Request) {
+	w.Header().Add("Content-Type", "application/json")
+	allowCORSHeaders(w)
+
+	switch req.Method {
+	case http.MethodGet:
+	case http.MethodOptions:
+		return
+	default:
+		w.WriteHeader(http.StatusMethodNotAllowed)
+		w.Write(newApiError(structs.ErrNotAllowedMethod, http.StatusMethodNotAllowed))
+		return
+	}
+
+	h := strings.Trim(strings.TrimPrefix(req.URL.Path, "/transactions/"), "/")
+	b, err := hexutil.Decode(h)
+	if err != nil || len(b) != common.HashLength {
+		w.WriteHeader(http.StatusBadRequest)
+		w.Write(newApiError(errors.New("transaction hash given in wrong format"), http.StatusBadRequest))
+		return
+	}
+	hash := common.BytesToHash(b)
+
+	tx, err := c.cli.GetTransaction(req.Context(), hash)
+	if err != nil {
+		if err == structs.ErrNotFound {
+			w.WriteHeader(http.StatusNotFound)
+			w.Write(newApiError(err, http.StatusNotFound))
+			return
+		}
+		w.WriteHeader(http.StatusInternalServerError)
+		w.Write(newApiError(err, http.StatusInternalServerError))
+		return
+	}
+
+	res, err := c.cli.GetContractEvents(req.Context(), structs.EventParams{TransactionHash: hash})
+	if err != nil {
+		w.WriteHeader(http.StatusInternalServerError)
+		w.Write(newApiError(err, http.StatusInternalServerError))
+		return
+	}
+
+	t := Transaction{
+		Hash:        tx.Hash,
+		BlockHeight: tx.BlockHeight,
+		BlockHash:   tx.BlockHash,
+		Index:       tx.Index,
+		Time:        tx.Time,
+		From:        tx.From,
+		To:          tx.To,
+		Value:       tx.Value.String(),
+		Nonce:       tx.Nonce,
+		Gas:         tx.Gas,
+		GasPrice:    tx.GasPrice.String(),
+		GasUsed:     tx.GasUsed,
+		Fee:         tx.Fee.String(),
+		Status:      tx.Status,
+		Events:      ContractEvents{},
+	}
+	for _, r := range res {
+		t.Events = append(t.Events, ContractEvent{
+			ID:              r.ID,
+			ContractName:    r.ContractName,
+			ContractAddress: r.ContractAddress,
+			EventName:       r.EventName,
+			BlockHeight:     r.BlockHeight,
+			Time:            r.Time,
+			TransactionHash: r.TransactionHash,
+			Params:          structs.FloatEventParams(r.Params),
+			Removed:         r.Removed,
+		})
+	}
+
+	enc := json.NewEncoder(w)
+	w.WriteHeader(http.StatusOK)
+	if err := enc.Encode(t); err != nil {
+		w.WriteHeader(http.StatusInternalServerError)
+		w.Write(newApiError(err, http.StatusInternalServerError))
+	}
+}
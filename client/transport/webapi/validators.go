@@ -0,0 +1,243 @@
+package webapi
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/figment-networks/skale-indexer/scraper/structs"
+)
+
+// GetValidatorAddresses returns changes of validator addresses, the latest first
+//
+// GET /validators/{id}/addresses (limit, offset)
+func (c *Connector) GetValidatorAddresses(w http.ResponseWriter, req *http.Request) {
+	w.Header().Add("Content-Type", "application/json")
+	allowCORSHeaders(w)
+
+	switch req.Method {
+	case http.MethodGet:
+	case http.MethodOptions:
+		return
+	default:
+		w.WriteHeader(http.StatusMethodNotAllowed)
+		w.Write(newApiError(structs.ErrNotAllowedMethod, http.StatusMethodNotAllowed))
+		return
+	}
+
+	parts := strings.Split(strings.Trim(strings.TrimPrefix(req.URL.Path, "/validators"), "/"), "/")
+	if len(parts) != 2 || parts[1] != "addresses" {
+		w.WriteHeader(http.StatusBadRequest)
+		w.Write(newApiError(errors.New("wrong validator addresses path"), http.StatusBadRequest))
+		return
+	}
+	if _, err := strconv.ParseUint(parts[0], 10, 64); err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		w.Write(newApiError(errors.New("validator id given in wrong format"), http.StatusBadRequest))
+		return
+	}
+
+	params := structs.ValidatorAddressParams{ValidatorID: parts[0]}
+	var err error
+	limit := req.URL.Query().Get("limit")
+	if limit != "" {
+		if params.Limit, err = strconv.ParseUint(limit, 10, 64); err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			w.Write(newApiError(errors.New("error parsing 'limit' parameter"), http.StatusBadRequest))
+			return
+		}
+		offset := req.URL.Query().Get("offset")
+		if params.Offset, err = strconv.ParseUint(offset, 10, 64); err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			w.Write(newApiError(errors.New("error parsing 'offset' parameter"), http.StatusBadRequest))
+			return
+		}
+	}
+
+	res, err := c.cli.GetValidatorAddresses(req.Context(), params)
+	if err != nil {
+		w.WriteHeader(http.StatusInternalServerError)
+		w.Write(newApiError(err, http.StatusInternalServerError))
+		return
+	}
+
+	addresses := []ValidatorAddress{}
+	for _, a := range res {
+		va := ValidatorAddress{
+			ValidatorID: a.ValidatorID,
+			Address:     a.Address.Hex(),
+			BlockHeight: a.BlockHeight,
+			Time:        a.Time,
+			EventName:   a.EventName,
+		}
+		if a.RequestedAddress != (common.Address{}) {
+			va.RequestedAddress = a.RequestedAddress.Hex()
+		}
+		if a.TransactionHash != (common.Hash{}) {
+			va.TransactionHash = a.TransactionHash.Hex()
+		}
+		addresses = append(addresses, va)
+	}
+
+	enc := json.NewEncoder(w)
+	w.WriteHeader(http.StatusOK)
+	if err := enc.Encode(addresses); err != nil {
+		w.WriteHeader(http.StatusInternalServerError)
+		w.Write(newApiError(err, http.StatusInternalServerError))
+	}
+}
+
+// GetValidatorRanking returns validators ranked by the weighted score of their metrics.
+// Weights are sent per metric as weight_{metric}, all of the metrics weigh one when none is sent
+//
+// GET /validators/ranking (order_by, weight_{metric}, growth_days, uptime_days, limit, offset)
+func (c *Connector) GetValidatorRanking(w http.ResponseWriter, req *http.Request) {
+	w.Header().Add("Content-Type", "application/json")
+	allowCORSHeaders(w)
+
+	switch req.Method {
+	case http.MethodGet:
+	case http.MethodOptions:
+		return
+	default:
+		w.WriteHeader(http.StatusMethodNotAllowed)
+		w.Write(newApiError(structs.ErrNotAllowedMethod, http.StatusMethodNotAllowed))
+		return
+	}
+
+	params := structs.ValidatorRankingParams{}
+	query := req.URL.Query()
+	for _, m := range structs.RankingMetrics {
+		weight := query.Get("weight_" + string(m))
+		if weight == "" {
+			continue
+		}
+		wg, err := strconv.ParseFloat(weight, 64)
+		if err != nil || wg < 0 {
+			w.WriteHeader(http.StatusBadRequest)
+			w.Write(newApiError(errors.New("error parsing 'weight_"+string(m)+"' parameter"), http.StatusBadRequest))
+			return
+		}
+		if params.Weights == nil {
+			params.Weights = map[structs.RankingMetric]float64{}
+		}
+		params.Weights[m] = wg
+	}
+
+	if orderBy := query.Get("order_by"); orderBy != "" && orderBy != "score" {
+		for _, m := range structs.RankingMetrics {
+			if string(m) == orderBy {
+				params.OrderBy = m
+			}
+		}
+		if params.OrderBy == "" {
+			w.WriteHeader(http.StatusBadRequest)
+			w.Write(newApiError(errors.New("unknown 'order_by' metric"), http.StatusBadRequest))
+			return
+		}
+	}
+
+	for name, window := range map[string]*time.Duration{"growth_days": &params.GrowthWindow, "uptime_days": &params.UptimeWindow} {
+		days := query.Get(name)
+		if days == "" {
+			continue
+		}
+		d, err := strconv.ParseUint(days, 10, 64)
+		if err != nil || d == 0 {
+			w.WriteHeader(http.StatusBadRequest)
+			w.Write(newApiError(errors.New("error parsing '"+name+"' parameter"), http.StatusBadRequest))
+			return
+		}
+		*window = time.Duration(d) * 24 * time.Hour
+	}
+
+	var err error
+	if limit := query.Get("limit"); limit != "" {
+		if params.Limit, err = strconv.ParseUint(limit, 10, 64); err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			w.Write(newApiError(errors.New("error parsing 'limit' parameter"), http.StatusBadRequest))
+			return
+		}
+		if offset := query.Get("offset"); offset != "" {
+			if params.Offset, err = strconv.ParseUint(offset, 10, 64); err != nil {
+				w.WriteHeader(http.StatusBadRequest)
+				w.Write(newApiError(errors.New("error parsing 'offset' parameter"), http.StatusBadRequest))
+				return
+			}
+		}
+	}
+
+	res, err := c.cli.GetValidatorRanking(req.Context(), params)
+	if err != nil {
+		w.WriteHeader(http.StatusInternalServerError)
+		w.Write(newApiError(err, http.StatusInternalServerError))
+		return
+	}
+
+	ranking := []ValidatorRank{}
+	for _, r := range res {
+		scores := map[string]float64{}
+		for m, s := range r.Scores {
+			scores[string(m)] = s
+		}
+		ranking = append(ranking, ValidatorRank{
+			Rank:            r.Rank,
+			ValidatorID:     r.ValidatorID,
+			Name:            r.Name,
+			Score:           r.Score,
+			Scores:          scores,
+			TotalStake:      r.TotalStake.String(),
+			EffectiveStake:  r.EffectiveStake.String(),
+			Delegators:      r.Delegators,
+			FeeRate:         r.FeeRate,
+			ActiveNodes:     r.ActiveNodes,
+			Uptime:          r.Uptime,
+			StakeGrowth:     r.StakeGrowth.String(),
+			StakeGrowthRate: r.StakeGrowthRate,
+		})
+	}
+
+	enc := json.NewEncoder(w)
+	w.WriteHeader(http.StatusOK)
+	if err := enc.Encode(ranking); err != nil {
+		w.WriteHeader(http.StatusInternalServerError)
+		w.Write(newApiError(err, http.StatusInternalServerError))
+	}
+}
+
+// GetValidatorAPR returns the realised APR of validator delegations with the inputs it's computed from
+//
+// GET /validators/{id}/apr (from, to)
+func (c *Connector) GetValidatorAPR(w http.ResponseWriter, req *http.Request) {
+	w.Header().Add("Content-Type", "application/json")
+	allowCORSHeaders(w)
+
+	switch req.Method {
+	case http.MethodGet:
+	case http.MethodOptions:
+		return
+	default:
+		w.WriteHeader(http.StatusMethodNotAllowed)
+		w.Write(newApiError(structs.ErrNotAllowedMethod, http.StatusMethodNotAllowed))
+		return
+	}
+
+	parts := strings.Split(strings.Trim(strings.TrimPrefix(req.URL.Path, "/validators"), "/"), "/")
+	if len(parts) != 2 || parts[1] != "apr" {
+		w.WriteHeader(http.StatusBadRequest)
+		w.Write(newApiError(errors.New("wrong validator apr path"), http.StatusBadRequest))
+		return
+	}
+	if _, err := strconv.ParseUint(parts[0], 10, 64); err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		w.Write(newApiError(errors.New("validator id given in wrong format"), http.StatusBadRequest))
+		return
+	}
+
+	c.writeAPR(w, req, parts[0])
+}
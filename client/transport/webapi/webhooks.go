@@ -0,0 +1,357 @@
+package webapi
+
+import (
+	"encoding/json"
+	"errors"
+	"math/big"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/google/uuid"
+
+	"github.com/figment-networks/skale-indexer/scraper/structs"
+)
+
+// Webhook partner's endpoint system events are pushed to
+type Webhook struct {
+	ID        string    `json:"id"`
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+	URL       string    `json:"url"`
+	// Secret - key payloads are signed with, returned only when webhook is created
+	Secret string `json:"secret,omitempty"`
+	// Kinds - names of system events delivered, all of them when empty
+	Kinds []string `json:"kinds"`
+	// ValidatorID - delivers only events of validator, when set
+	ValidatorID string `json:"validator_id,omitempty"`
+	// Address - delivers only events of address, when set
+	Address *common.Address `json:"address,omitempty"`
+	Active  bool            `json:"active"`
+}
+
+// WebhookRequest body of webhook registration and update
+type WebhookRequest struct {
+	URL string `json:"url"`
+	// Secret - generated when not given on registration, kept when not given on update
+	Secret string `json:"secret"`
+	// Kinds - either names or numbers of system events
+	Kinds       []string `json:"kinds"`
+	ValidatorID string   `json:"validator_id"`
+	Address     string   `json:"address"`
+	// Active - true when not given
+	Active *bool `json:"active"`
+}
+
+// WebhookDelivery system event queued for webhook, with the outcome of the last attempt
+type WebhookDelivery struct {
+	ID           string      `json:"id"`
+	WebhookID    string      `json:"webhook_id"`
+	CreatedAt    time.Time   `json:"created_at"`
+	UpdatedAt    time.Time   `json:"updated_at"`
+	Event        SystemEvent `json:"event"`
+	Status       string      `json:"status"`
+	Attempts     uint64      `json:"attempts"`
+	NextRetry    time.Time   `json:"next_retry"`
+	ResponseCode int         `json:"response_code"`
+	Error        string      `json:"error"`
+	DeliveredAt  *time.Time  `json:"delivered_at,omitempty"`
+}
+
+/*
+ * Registers webhooks system events are pushed to and shows their delivery log
+ *
+ * GET    /admin/webhooks                  - list (limit, offset)
+ * POST   /admin/webhooks                  - register webhook
+ * GET    /admin/webhooks/{id}             - single webhook
+ * PUT    /admin/webhooks/{id}             - update webhook
+ * DELETE /admin/webhooks/{id}             - remove webhook and its deliveries
+ * GET    /admin/webhooks/{id}/deliveries  - delivery log, the latest first (status, limit, offset)
+ */
+func (sc *ScrapeConnector) Webhooks(w http.ResponseWriter, req *http.Request) {
+	w.Header().Add("Content-Type", "application/json")
+
+	path := strings.Trim(strings.TrimPrefix(req.URL.Path, "/admin/webhooks"), "/")
+	if path == "" {
+		switch req.Method {
+		case http.MethodGet:
+			sc.listWebhooks(w, req)
+		case http.MethodPost:
+			sc.createWebhook(w, req)
+		default:
+			w.WriteHeader(http.StatusMethodNotAllowed)
+			w.Write(newApiError(structs.ErrNotAllowedMethod, http.StatusMethodNotAllowed))
+		}
+		return
+	}
+
+	parts := strings.Split(path, "/")
+	if _, err := uuid.Parse(parts[0]); err != nil || len(parts) > 2 || (len(parts) == 2 && parts[1] != "deliveries") {
+		w.WriteHeader(http.StatusBadRequest)
+		w.Write(newApiError(errors.New("wrong webhook path"), http.StatusBadRequest))
+		return
+	}
+	id := parts[0]
+
+	var err error
+	switch {
+	case len(parts) == 2 && req.Method == http.MethodGet:
+		sc.listWebhookDeliveries(w, req, id)
+		return
+	case len(parts) == 1 && req.Method == http.MethodGet:
+		var whs []structs.Webhook
+		if whs, err = sc.cli.GetWebhooks(req.Context(), structs.WebhookParams{ID: id}); err == nil {
+			sc.writeWebhooks(w, http.StatusOK, toWebhook(whs[0]))
+			return
+		}
+	case len(parts) == 1 && req.Method == http.MethodPut:
+		sc.updateWebhook(w, req, id)
+		return
+	case len(parts) == 1 && req.Method == http.MethodDelete:
+		err = sc.cli.DeleteWebhook(req.Context(), id)
+	default:
+		w.WriteHeader(http.StatusMethodNotAllowed)
+		w.Write(newApiError(structs.ErrNotAllowedMethod, http.StatusMethodNotAllowed))
+		return
+	}
+
+	if err != nil {
+		writeWebhookError(w, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+	w.Write([]byte(`{"status":"ok"}`))
+}
+
+func (sc *ScrapeConnector) listWebhooks(w http.ResponseWriter, req *http.Request) {
+	params := structs.WebhookParams{}
+	var err error
+	if params.Limit, params.Offset, err = parseWebhookPage(req); err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		w.Write(newApiError(err, http.StatusBadRequest))
+		return
+	}
+
+	whs, err := sc.cli.GetWebhooks(req.Context(), params)
+	if err != nil {
+		w.WriteHeader(http.StatusInternalServerError)
+		w.Write(newApiError(err, http.StatusInternalServerError))
+		return
+	}
+
+	res := []Webhook{}
+	for _, wh := range whs {
+		res = append(res, toWebhook(wh))
+	}
+	sc.writeWebhooks(w, http.StatusOK, res)
+}
+
+func (sc *ScrapeConnector) createWebhook(w http.ResponseWriter, req *http.Request) {
+	wh, err := parseWebhookRequest(req, structs.Webhook{Active: true})
+	if err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		w.Write(newApiError(err, http.StatusBadRequest))
+		return
+	}
+
+	if wh, err = sc.cli.CreateWebhook(req.Context(), wh); err != nil {
+		w.WriteHeader(http.StatusInternalServerError)
+		w.Write(newApiError(err, http.StatusInternalServerError))
+		return
+	}
+
+	res := toWebhook(wh)
+	res.Secret = wh.Secret
+	sc.writeWebhooks(w, http.StatusCreated, res)
+}
+
+func (sc *ScrapeConnector) updateWebhook(w http.ResponseWriter, req *http.Request, id string) {
+	whs, err := sc.cli.GetWebhooks(req.Context(), structs.WebhookParams{ID: id})
+	if err != nil {
+		writeWebhookError(w, err)
+		return
+	}
+
+	wh, err := parseWebhookRequest(req, whs[0])
+	if err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		w.Write(newApiError(err, http.StatusBadRequest))
+		return
+	}
+
+	if err = sc.cli.UpdateWebhook(req.Context(), wh); err != nil {
+		writeWebhookError(w, err)
+		return
+	}
+
+	if whs, err = sc.cli.GetWebhooks(req.Context(), structs.WebhookParams{ID: id}); err != nil {
+		writeWebhookError(w, err)
+		return
+	}
+	sc.writeWebhooks(w, http.StatusOK, toWebhook(whs[0]))
+}
+
+func (sc *ScrapeConnector) listWebhookDeliveries(w http.ResponseWriter, req *http.Request, id string) {
+	if _, err := sc.cli.GetWebhooks(req.Context(), structs.WebhookParams{ID: id}); err != nil {
+		writeWebhookError(w, err)
+		return
+	}
+
+	params := structs.WebhookDeliveryParams{WebhookID: id}
+	var err error
+	if params.Limit, params.Offset, err = parseWebhookPage(req); err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		w.Write(newApiError(err, http.StatusBadRequest))
+		return
+	}
+
+	switch status := structs.WebhookDeliveryStatus(req.URL.Query().Get("status")); status {
+	case "", structs.WebhookDeliveryPending, structs.WebhookDeliveryDelivered, structs.WebhookDeliveryFailed:
+		params.Status = status
+	default:
+		w.WriteHeader(http.StatusBadRequest)
+		w.Write(newApiError(errors.New("error parsing 'status' parameter"), http.StatusBadRequest))
+		return
+	}
+
+	wds, err := sc.cli.GetWebhookDeliveries(req.Context(), params)
+	if err != nil {
+		w.WriteHeader(http.StatusInternalServerError)
+		w.Write(newApiError(err, http.StatusInternalServerError))
+		return
+	}
+
+	res := []WebhookDelivery{}
+	for _, wd := range wds {
+		res = append(res, toWebhookDelivery(wd))
+	}
+	sc.writeWebhooks(w, http.StatusOK, res)
+}
+
+func (sc *ScrapeConnector) writeWebhooks(w http.ResponseWriter, code int, v interface{}) {
+	enc := json.NewEncoder(w)
+	w.WriteHeader(code)
+	if err := enc.Encode(v); err != nil {
+		w.WriteHeader(http.StatusInternalServerError)
+		w.Write(newApiError(err, http.StatusInternalServerError))
+	}
+}
+
+func writeWebhookError(w http.ResponseWriter, err error) {
+	if err == structs.ErrNotFound {
+		w.WriteHeader(http.StatusNotFound)
+		w.Write(newApiError(err, http.StatusNotFound))
+		return
+	}
+	w.WriteHeader(http.StatusInternalServerError)
+	w.Write(newApiError(err, http.StatusInternalServerError))
+}
+
+func parseWebhookPage(req *http.Request) (limit, offset uint64, err error) {
+	if l := req.URL.Query().Get("limit"); l != "" {
+		if limit, err = strconv.ParseUint(l, 10, 64); err != nil {
+			return 0, 0, errors.New("error parsing 'limit' parameter")
+		}
+	}
+	if o := req.URL.Query().Get("offset"); o != "" {
+		if offset, err = strconv.ParseUint(o, 10, 64); err != nil {
+			return 0, 0, errors.New("error parsing 'offset' parameter")
+		}
+	}
+	return limit, offset, nil
+}
+
+// parseWebhookRequest applies request body to webhook. Filter is replaced as a whole, the secret is kept when not given
+func parseWebhookRequest(req *http.Request, wh structs.Webhook) (structs.Webhook, error) {
+	var wr WebhookRequest
+	if err := json.NewDecoder(req.Body).Decode(&wr); err != nil {
+		return wh, errors.New("error parsing request body")
+	}
+
+	u, err := url.Parse(wr.URL)
+	if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		return wh, errors.New("'url' has to be absolute http or https url")
+	}
+	wh.URL = wr.URL
+
+	if wr.Secret != "" {
+		wh.Secret = wr.Secret
+	}
+
+	wh.Kinds = nil
+	for _, kind := range wr.Kinds {
+		t, ok := parseSystemEventKind(kind)
+		if !ok {
+			return wh, errors.New("error parsing 'kinds' parameter")
+		}
+		wh.Kinds = append(wh.Kinds, t)
+	}
+
+	wh.ValidatorID = nil
+	if wr.ValidatorID != "" {
+		v, ok := new(big.Int).SetString(wr.ValidatorID, 10)
+		if !ok || v.Sign() < 0 {
+			return wh, errors.New("error parsing 'validator_id' parameter")
+		}
+		wh.ValidatorID = v
+	}
+
+	wh.Address = common.Address{}
+	if wr.Address != "" {
+		if !common.IsHexAddress(wr.Address) {
+			return wh, errors.New("error parsing 'address' parameter")
+		}
+		wh.Address = common.HexToAddress(wr.Address)
+	}
+
+	if wr.Active != nil {
+		wh.Active = *wr.Active
+	}
+	return wh, nil
+}
+
+func toWebhook(wh structs.Webhook) Webhook {
+	res := Webhook{
+		ID:        wh.ID,
+		CreatedAt: wh.CreatedAt,
+		UpdatedAt: wh.UpdatedAt,
+		URL:       wh.URL,
+		Kinds:     []string{},
+		Active:    wh.Active,
+	}
+	for _, k := range wh.Kinds {
+		res.Kinds = append(res.Kinds, structs.SysEvtTypes[k])
+	}
+	if wh.ValidatorID != nil {
+		res.ValidatorID = wh.ValidatorID.String()
+	}
+	if wh.Address != (common.Address{}) {
+		a := wh.Address
+		res.Address = &a
+	}
+	return res
+}
+
+func toWebhookDelivery(wd structs.WebhookDelivery) WebhookDelivery {
+	res := WebhookDelivery{
+		ID:           wd.ID,
+		WebhookID:    wd.WebhookID,
+		CreatedAt:    wd.CreatedAt,
+		UpdatedAt:    wd.UpdatedAt,
+		Event:        toSystemEvent(wd.Event),
+		Status:       string(wd.Status),
+		Attempts:     wd.Attempts,
+		NextRetry:    wd.NextRetry,
+		ResponseCode: wd.ResponseCode,
+		Error:        wd.Error,
+	}
+	if !wd.DeliveredAt.IsZero() {
+		t := wd.DeliveredAt
+		res.DeliveredAt = &t
+	}
+	return res
+}
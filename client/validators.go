@@ -0,0 +1,16 @@
+package client
+
+import (
+	"context"
+
+	"github.com/figment-networks/skale-indexer/scraper/structs"
+	"go.uber.org/zap"
+)
+
+func (c *Client) GetValidatorAddresses(ctx context.Context, params structs.ValidatorAddressParams) (addresses []structs.ValidatorAddress, err error) {
+	a, err := c.storeEng.GetValidatorAddresses(ctx, params)
+	if err != nil {
+		c.log.Error("[CLIENT] Error in GetValidatorAddresses", zap.Any("params", params), zap.Error(err))
+	}
+	return a, err
+}
@@ -0,0 +1,246 @@
+package client
+
+import (
+	"bytes"
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/figment-networks/skale-indexer/scraper/structs"
+	"go.uber.org/zap"
+)
+
+const (
+	webhookDeliveryBatch   = 100
+	webhookDeliveryTimeout = 10 * time.Second
+	// webhookErrorBodyLimit is how much of unsuccessful response body is kept in delivery log
+	webhookErrorBodyLimit = 512
+)
+
+// Headers sent with every webhook delivery
+const (
+	WebhookIDHeader        = "X-Webhook-Id"
+	WebhookDeliveryHeader  = "X-Webhook-Delivery"
+	WebhookTimestampHeader = "X-Webhook-Timestamp"
+	WebhookSignatureHeader = "X-Webhook-Signature"
+)
+
+// WebhookPayload is the body POSTed to webhook
+type WebhookPayload struct {
+	DeliveryID string       `json:"delivery_id"`
+	WebhookID  string       `json:"webhook_id"`
+	Event      WebhookEvent `json:"event"`
+}
+
+// WebhookEvent is system event as sent to webhooks, amounts are decimal strings
+type WebhookEvent struct {
+	Height      uint64           `json:"height"`
+	Time        time.Time        `json:"time"`
+	Kind        string           `json:"kind"`
+	SenderID    uint64           `json:"sender_id"`
+	RecipientID uint64           `json:"recipient_id"`
+	Sender      common.Address   `json:"sender"`
+	Recipient   common.Address   `json:"recipient"`
+	Data        WebhookEventData `json:"data"`
+}
+
+type WebhookEventData struct {
+	Before string `json:"before"`
+	After  string `json:"after"`
+	Change string `json:"change"`
+}
+
+// CreateWebhook registers webhook, generating its secret when none is given
+func (c *Client) CreateWebhook(ctx context.Context, wh structs.Webhook) (structs.Webhook, error) {
+	if wh.Secret == "" {
+		secret := make([]byte, 32)
+		if _, err := rand.Read(secret); err != nil {
+			return wh, err
+		}
+		wh.Secret = hex.EncodeToString(secret)
+	}
+
+	id, err := c.storeEng.CreateWebhook(ctx, wh)
+	if err != nil {
+		c.log.Error("[CLIENT] Error in CreateWebhook", zap.String("url", wh.URL), zap.Error(err))
+		return wh, err
+	}
+
+	whs, err := c.storeEng.GetWebhooks(ctx, structs.WebhookParams{ID: id})
+	if err != nil {
+		c.log.Error("[CLIENT] Error in CreateWebhook", zap.String("id", id), zap.Error(err))
+		return wh, err
+	}
+	return whs[0], nil
+}
+
+func (c *Client) UpdateWebhook(ctx context.Context, wh structs.Webhook) error {
+	err := c.storeEng.UpdateWebhook(ctx, wh)
+	if err != nil && err != structs.ErrNotFound {
+		c.log.Error("[CLIENT] Error in UpdateWebhook", zap.String("id", wh.ID), zap.Error(err))
+	}
+	return err
+}
+
+func (c *Client) GetWebhooks(ctx context.Context, params structs.WebhookParams) (webhooks []structs.Webhook, err error) {
+	webhooks, err = c.storeEng.GetWebhooks(ctx, params)
+	if err != nil && err != structs.ErrNotFound {
+		c.log.Error("[CLIENT] Error in GetWebhooks", zap.Any("params", params), zap.Error(err))
+	}
+	return webhooks, err
+}
+
+// DeleteWebhook removes webhook together with its delivery log
+func (c *Client) DeleteWebhook(ctx context.Context, id string) error {
+	err := c.storeEng.DeleteWebhook(ctx, id)
+	if err != nil && err != structs.ErrNotFound {
+		c.log.Error("[CLIENT] Error in DeleteWebhook", zap.String("id", id), zap.Error(err))
+	}
+	return err
+}
+
+func (c *Client) GetWebhookDeliveries(ctx context.Context, params structs.WebhookDeliveryParams) (deliveries []structs.WebhookDelivery, err error) {
+	deliveries, err = c.storeEng.GetWebhookDeliveries(ctx, params)
+	if err != nil && err != structs.ErrNotFound {
+		c.log.Error("[CLIENT] Error in GetWebhookDeliveries", zap.Any("params", params), zap.Error(err))
+	}
+	return deliveries, err
+}
+
+// RunWebhookDeliveries periodically delivers queued system events to webhooks, retrying until they succeed or reach maxAttempts
+func (c *Client) RunWebhookDeliveries(ctx context.Context, interval time.Duration, maxAttempts uint64) {
+	tckr := time.NewTicker(interval)
+	defer tckr.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-tckr.C:
+			c.DeliverWebhooks(ctx, maxAttempts)
+		}
+	}
+}
+
+// DeliverWebhooks delivers pending deliveries which are due
+func (c *Client) DeliverWebhooks(ctx context.Context, maxAttempts uint64) {
+	wds, err := c.storeEng.GetWebhookDeliveries(ctx, structs.WebhookDeliveryParams{
+		Status:      structs.WebhookDeliveryPending,
+		RetryBefore: time.Now(),
+		Limit:       webhookDeliveryBatch,
+	})
+	if err != nil {
+		c.log.Error("[CLIENT] Error getting webhook deliveries", zap.Error(err))
+		return
+	}
+	if len(wds) == 0 {
+		return
+	}
+
+	whs, err := c.storeEng.GetWebhooks(ctx, structs.WebhookParams{Active: true})
+	if err != nil {
+		c.log.Error("[CLIENT] Error getting webhooks", zap.Error(err))
+		return
+	}
+	active := make(map[string]structs.Webhook, len(whs))
+	for _, wh := range whs {
+		active[wh.ID] = wh
+	}
+
+	for _, wd := range wds {
+		select {
+		case <-ctx.Done():
+			return
+		default:
+		}
+		// deliveries of deactivated webhook wait until it's activated again
+		if wh, ok := active[wd.WebhookID]; ok {
+			c.deliverWebhook(ctx, wh, wd, maxAttempts)
+		}
+	}
+}
+
+func (c *Client) deliverWebhook(ctx context.Context, wh structs.Webhook, wd structs.WebhookDelivery, maxAttempts uint64) {
+	code, err := c.postWebhook(ctx, wh, wd)
+	wd.Attempts++
+	wd.ResponseCode = code
+
+	if err == nil {
+		wd.Status = structs.WebhookDeliveryDelivered
+		wd.Error = ""
+		wd.DeliveredAt = time.Now()
+		c.log.Info("[CLIENT] Webhook delivered", zap.String("id", wd.ID), zap.String("webhook_id", wh.ID), zap.Uint64("attempts", wd.Attempts))
+	} else {
+		wd.Error = err.Error()
+		wd.NextRetry = time.Now().Add(structs.WebhookRetryDelay(wd.Attempts))
+		if wd.Attempts >= maxAttempts {
+			wd.Status = structs.WebhookDeliveryFailed
+		}
+		c.log.Warn("[CLIENT] Webhook delivery failed", zap.String("id", wd.ID), zap.String("webhook_id", wh.ID), zap.Uint64("attempts", wd.Attempts), zap.Error(err))
+	}
+
+	if err := c.storeEng.UpdateWebhookDelivery(ctx, wd); err != nil {
+		c.log.Error("[CLIENT] Error updating webhook delivery", zap.String("id", wd.ID), zap.Error(err))
+	}
+}
+
+// postWebhook sends signed event to webhook, any status other than 2xx is an error
+func (c *Client) postWebhook(ctx context.Context, wh structs.Webhook, wd structs.WebhookDelivery) (code int, err error) {
+	body, err := json.Marshal(WebhookPayload{
+		DeliveryID: wd.ID,
+		WebhookID:  wh.ID,
+		Event:      toWebhookEvent(wd.Event),
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, wh.URL, bytes.NewReader(body))
+	if err != nil {
+		return 0, err
+	}
+	now := time.Now()
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set(WebhookIDHeader, wh.ID)
+	req.Header.Set(WebhookDeliveryHeader, wd.ID)
+	req.Header.Set(WebhookTimestampHeader, strconv.FormatInt(now.Unix(), 10))
+	req.Header.Set(WebhookSignatureHeader, "sha256="+wh.Sign(now, body))
+
+	resp, err := c.webhookClient.Do(req)
+	if err != nil {
+		return 0, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		b, _ := ioutil.ReadAll(io.LimitReader(resp.Body, webhookErrorBodyLimit))
+		return resp.StatusCode, fmt.Errorf("unexpected response status %d: %s", resp.StatusCode, b)
+	}
+	io.Copy(ioutil.Discard, resp.Body)
+	return resp.StatusCode, nil
+}
+
+func toWebhookEvent(se structs.SystemEvent) WebhookEvent {
+	return WebhookEvent{
+		Height:      se.Height,
+		Time:        se.Time,
+		Kind:        structs.SysEvtTypes[se.Kind],
+		SenderID:    se.SenderID.Uint64(),
+		RecipientID: se.RecipientID.Uint64(),
+		Sender:      se.Sender,
+		Recipient:   se.Recipient,
+		Data: WebhookEventData{
+			Before: se.Before.String(),
+			After:  se.After.String(),
+			Change: se.Change.Text('f', -1),
+		},
+	}
+}
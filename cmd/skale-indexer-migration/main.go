@@ -6,10 +6,12 @@ import (
 	"fmt"
 	"log"
 	"os"
+	"strings"
 
 	"github.com/figment-networks/skale-indexer/cmd/skale-indexer-migration/config"
 	"github.com/golang-migrate/migrate/v4"
 	_ "github.com/golang-migrate/migrate/v4/database/postgres"
+	_ "github.com/golang-migrate/migrate/v4/database/sqlite3"
 	_ "github.com/golang-migrate/migrate/v4/source/file"
 )
 
@@ -38,11 +40,19 @@ func main() {
 		log.Fatal(fmt.Errorf("error initializing config [ERR: %+v]", err))
 	}
 
-	db, err := sql.Open("postgres", cfg.DatabaseURL)
-	if err != nil {
-		log.Fatal(err)
+	// SQLite has its own set of migrations
+	if strings.HasPrefix(cfg.DatabaseURL, "sqlite3://") {
+		if !pathSet() {
+			configFlags.migrationPath = "./migrations_sqlite"
+		}
+	} else {
+		db, err := sql.Open("postgres", cfg.DatabaseURL)
+		if err != nil {
+			log.Fatal(err)
+		}
+		defer db.Close()
 	}
-	defer db.Close()
+
 	srcPath := fmt.Sprintf("file://%s", configFlags.migrationPath)
 
 	if configFlags.verbose {
@@ -70,6 +80,16 @@ func main() {
 
 }
 
+// pathSet checks whether migration path was given explicitly
+func pathSet() (set bool) {
+	flag.Visit(func(f *flag.Flag) {
+		if f.Name == "path" {
+			set = true
+		}
+	})
+	return set
+}
+
 func initConfig(path string) (config.Config, error) {
 	cfg := &config.Config{}
 
@@ -0,0 +1,10 @@
+CREATE TABLE IF NOT EXISTS blocks
+(
+    number                  DECIMAL(65, 0)           NOT NULL,
+    hash                    NUMERIC(78)              NOT NULL,
+    parent_hash             NUMERIC(78)              NOT NULL,
+    time                    TIMESTAMP WITH TIME ZONE NOT NULL,
+    PRIMARY KEY (number)
+);
+
+CREATE INDEX idx_b_time ON blocks (time);
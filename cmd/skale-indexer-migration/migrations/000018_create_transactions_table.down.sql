@@ -0,0 +1,2 @@
+DROP INDEX IF EXISTS idx_c_ev_tx_hash;
+DROP TABLE IF EXISTS transactions;
@@ -0,0 +1,24 @@
+CREATE TABLE IF NOT EXISTS transactions
+(
+    id                      UUID                     DEFAULT   uuid_generate_v4(),
+    created_at              TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP,
+    hash                    NUMERIC(125)             NOT NULL,
+    block_height            DECIMAL(65, 0)           NOT NULL,
+    block_hash              NUMERIC(78)              NOT NULL,
+    tx_index                INTEGER                  NOT NULL,
+    time                    TIMESTAMP WITH TIME ZONE NOT NULL,
+    sender                  NUMERIC(78)              NOT NULL,
+    recipient               NUMERIC(78)              NOT NULL,
+    value                   DECIMAL(65, 0)           NOT NULL,
+    nonce                   DECIMAL(65, 0)           NOT NULL,
+    gas                     DECIMAL(65, 0)           NOT NULL,
+    gas_price               DECIMAL(65, 0)           NOT NULL,
+    gas_used                DECIMAL(65, 0)           NOT NULL,
+    fee                     DECIMAL(65, 0)           NOT NULL,
+    status                  SMALLINT                 NOT NULL,
+    PRIMARY KEY (id)
+);
+
+CREATE UNIQUE INDEX idx_tx_hash ON transactions (hash);
+CREATE INDEX idx_tx_sender ON transactions (sender);
+CREATE INDEX idx_c_ev_tx_hash ON contract_events (transaction_hash);
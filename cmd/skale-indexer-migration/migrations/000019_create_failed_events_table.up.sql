@@ -0,0 +1,20 @@
+CREATE TABLE IF NOT EXISTS failed_events
+(
+    id                      UUID                     DEFAULT   uuid_generate_v4(),
+    created_at              TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP,
+    updated_at              TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP,
+    block_height            DECIMAL(65, 0)           NOT NULL,
+    transaction_hash        NUMERIC(125)             NOT NULL,
+    log_index               DECIMAL(65, 0)           NOT NULL,
+    contract_name           VARCHAR(100)             NOT NULL,
+    event_name              VARCHAR(50)              NOT NULL,
+    log                     JSONB                    NOT NULL,
+    error                   TEXT                     NOT NULL,
+    attempts                DECIMAL(65, 0)           NOT NULL,
+    next_retry              TIMESTAMP WITH TIME ZONE NOT NULL,
+    PRIMARY KEY (id)
+);
+
+-- Indexes
+CREATE UNIQUE INDEX idx_fe_tx_log ON failed_events (transaction_hash, log_index);
+CREATE INDEX idx_fe_next_retry ON failed_events (next_retry);
@@ -0,0 +1,61 @@
+-- Versions of validators and nodes. Version is valid from the height it was saved at
+-- up to (excluding) valid_to, which is NULL for the current one.
+CREATE TABLE IF NOT EXISTS validators_history
+(
+    id                          UUID DEFAULT   uuid_generate_v4(),
+    created_at                  TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP,
+    validator_id                DECIMAL(65, 0)           NOT NULL,
+    name                        TEXT                     ,
+    validator_address           NUMERIC(78)              NOT NULL,
+    requested_address           NUMERIC(78)              NOT NULL,
+    description                 TEXT                     ,
+    fee_rate                    DECIMAL(65, 0)           NOT NULL DEFAULT 0,
+    registration_time           TIMESTAMP WITH TIME ZONE NOT NULL,
+    minimum_delegation_amount   DECIMAL(65, 0)           NOT NULL DEFAULT 0,
+    accept_new_requests         BOOLEAN                  NOT NULL,
+    authorized                  BOOLEAN                  NOT NULL,
+    valid_from                  DECIMAL(65, 0)           NOT NULL,
+    valid_to                    DECIMAL(65, 0)           ,
+    PRIMARY KEY (id)
+);
+
+CREATE UNIQUE INDEX idx_vh_validator_id_valid_from ON validators_history (validator_id, valid_from);
+CREATE INDEX idx_vh_valid ON validators_history (valid_from, valid_to);
+
+CREATE TABLE IF NOT EXISTS nodes_history
+(
+    id                              UUID DEFAULT   uuid_generate_v4(),
+    created_at                      TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP,
+    node_id                         DECIMAL(65, 0)           NOT NULL,
+    address                         NUMERIC(78)              NOT NULL,
+    name                            VARCHAR(100)             NOT NULL,
+    ip                              cidr                     NOT NULL,
+    public_ip                       cidr                     NOT NULL,
+    port                            SMALLINT                 NOT NULL,
+    start_block                     DECIMAL(65, 0)           NOT NULL,
+    next_reward_date                TIMESTAMP WITH TIME ZONE NOT NULL,
+    last_reward_date                TIMESTAMP WITH TIME ZONE NOT NULL,
+    finish_time                     DECIMAL(65, 0)           NOT NULL,
+    status                          VARCHAR(50)              NOT NULL,
+    validator_id                    DECIMAL(65, 0)           NOT NULL,
+    valid_from                      DECIMAL(65, 0)           NOT NULL,
+    valid_to                        DECIMAL(65, 0)           ,
+    PRIMARY KEY (id)
+);
+
+CREATE UNIQUE INDEX idx_nh_node_id_valid_from ON nodes_history (node_id, valid_from);
+CREATE INDEX idx_nh_validator_id ON nodes_history (validator_id);
+CREATE INDEX idx_nh_valid ON nodes_history (valid_from, valid_to);
+
+-- earlier versions were overwritten, history starts with the current state
+INSERT INTO validators_history (validator_id, name, validator_address, requested_address, description, fee_rate, registration_time,
+        minimum_delegation_amount, accept_new_requests, authorized, valid_from)
+    SELECT validator_id, name, validator_address, requested_address, description, fee_rate, registration_time,
+        minimum_delegation_amount, accept_new_requests, authorized, block_height
+    FROM validators;
+
+INSERT INTO nodes_history (node_id, address, name, ip, public_ip, port, start_block, next_reward_date, last_reward_date,
+        finish_time, status, validator_id, valid_from)
+    SELECT node_id, address, name, ip, public_ip, port, start_block, next_reward_date, last_reward_date,
+        finish_time, status, validator_id, block_height
+    FROM nodes;
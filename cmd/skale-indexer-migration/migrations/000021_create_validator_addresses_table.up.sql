@@ -0,0 +1,30 @@
+-- Changes of validator address and of the address requested to replace it
+CREATE TABLE IF NOT EXISTS validator_addresses
+(
+    id                      UUID DEFAULT   uuid_generate_v4(),
+    created_at              TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP,
+    validator_id            DECIMAL(65, 0)           NOT NULL,
+    address                 NUMERIC(78)              NOT NULL,
+    requested_address       NUMERIC(78)              NOT NULL,
+    block_height            DECIMAL(65, 0)           NOT NULL,
+    time                    TIMESTAMP WITH TIME ZONE NOT NULL,
+    transaction_hash        NUMERIC(125)             NOT NULL DEFAULT 0,
+    event_name              TEXT                     NOT NULL DEFAULT '',
+    PRIMARY KEY (id)
+);
+
+CREATE UNIQUE INDEX idx_va_validator_id_block_height ON validator_addresses (validator_id, block_height);
+CREATE INDEX idx_va_address ON validator_addresses (address);
+CREATE INDEX idx_va_requested_address ON validator_addresses (requested_address);
+
+-- addresses were kept as validator statistics (7 - validator address, 8 - requested address),
+-- transactions they were changed in are unknown
+INSERT INTO validator_addresses (validator_id, address, requested_address, block_height, time)
+    SELECT c.validator_id,
+        COALESCE((SELECT s.amount FROM validator_statistics s WHERE s.validator_id = c.validator_id AND s.statistic_type = 7
+            AND s.block_height <= c.block_height ORDER BY s.block_height DESC LIMIT 1), 0),
+        COALESCE((SELECT s.amount FROM validator_statistics s WHERE s.validator_id = c.validator_id AND s.statistic_type = 8
+            AND s.block_height <= c.block_height ORDER BY s.block_height DESC LIMIT 1), 0),
+        c.block_height, c.time
+    FROM (SELECT validator_id, block_height, MIN(time) AS time FROM validator_statistics
+        WHERE statistic_type IN (7, 8) GROUP BY validator_id, block_height) c;
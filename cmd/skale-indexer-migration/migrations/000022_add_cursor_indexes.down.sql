@@ -0,0 +1,3 @@
+DROP INDEX IF EXISTS idx_del_bl_height_id;
+DROP INDEX IF EXISTS idx_sys_evt_height_id;
+DROP INDEX IF EXISTS idx_c_ev_bl_height_id;
@@ -0,0 +1,3 @@
+DROP INDEX IF EXISTS idx_c_ev_params;
+DROP INDEX IF EXISTS idx_c_ev_event_name;
+DROP INDEX IF EXISTS idx_c_ev_contract_event_name;
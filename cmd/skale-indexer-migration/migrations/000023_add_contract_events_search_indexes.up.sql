@@ -0,0 +1,4 @@
+-- events are searched by contract, event name and decoded params
+CREATE INDEX idx_c_ev_contract_event_name ON contract_events (contract_name, event_name);
+CREATE INDEX idx_c_ev_event_name ON contract_events (event_name);
+CREATE INDEX idx_c_ev_params ON contract_events USING GIN (params jsonb_path_ops);
@@ -0,0 +1,4 @@
+DROP INDEX IF EXISTS idx_nh_address;
+DROP INDEX IF EXISTS idx_sys_evt_recipient;
+DROP INDEX IF EXISTS idx_sys_evt_sender;
+DROP INDEX IF EXISTS idx_c_ev_bound_address;
@@ -0,0 +1,5 @@
+-- activity of address is looked up in every table referring to it
+CREATE INDEX idx_c_ev_bound_address ON contract_events USING GIN (bound_address);
+CREATE INDEX idx_sys_evt_sender ON system_events (sender);
+CREATE INDEX idx_sys_evt_recipient ON system_events (recipient);
+CREATE INDEX idx_nh_address ON nodes_history (address);
@@ -0,0 +1,35 @@
+CREATE TABLE IF NOT EXISTS webhooks
+(
+    id                      UUID                     DEFAULT   uuid_generate_v4(),
+    created_at              TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP,
+    updated_at              TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP,
+    url                     TEXT                     NOT NULL,
+    secret                  TEXT                     NOT NULL,
+    kinds                   SMALLINT[]               NOT NULL,
+    validator_id            DECIMAL(65, 0),
+    address                 DECIMAL(65, 0),
+    active                  BOOLEAN                  NOT NULL,
+    PRIMARY KEY (id)
+);
+
+CREATE TABLE IF NOT EXISTS webhook_deliveries
+(
+    id                      UUID                     DEFAULT   uuid_generate_v4(),
+    created_at              TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP,
+    updated_at              TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP,
+    webhook_id              UUID                     NOT NULL REFERENCES webhooks (id) ON DELETE CASCADE,
+    event_key               TEXT                     NOT NULL,
+    event                   JSONB                    NOT NULL,
+    status                  VARCHAR(20)              NOT NULL,
+    attempts                DECIMAL(65, 0)           NOT NULL,
+    next_retry              TIMESTAMP WITH TIME ZONE NOT NULL,
+    response_code           INTEGER                  NOT NULL,
+    error                   TEXT                     NOT NULL,
+    delivered_at            TIMESTAMP WITH TIME ZONE,
+    PRIMARY KEY (id)
+);
+
+-- Indexes
+CREATE UNIQUE INDEX idx_wd_webhook_event ON webhook_deliveries (webhook_id, event_key);
+CREATE INDEX idx_wd_status_next_retry ON webhook_deliveries (status, next_retry);
+CREATE INDEX idx_wd_webhook_created ON webhook_deliveries (webhook_id, created_at);
@@ -0,0 +1,12 @@
+-- messages waiting to be published to message bus, saved in the same transaction as records they carry
+CREATE TABLE IF NOT EXISTS outbox
+(
+    id                      BIGSERIAL                NOT NULL,
+    message_id              UUID                     NOT NULL,
+    created_at              TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP,
+    topic                   VARCHAR(50)              NOT NULL,
+    key                     TEXT                     NOT NULL,
+    height                  DECIMAL(65, 0)           NOT NULL,
+    payload                 JSONB                    NOT NULL,
+    PRIMARY KEY (id)
+);
@@ -0,0 +1,3 @@
+DROP TRIGGER IF EXISTS trg_v_s_rollup ON validator_statistics;
+DROP FUNCTION IF EXISTS rollup_validator_statistic();
+DROP TABLE IF EXISTS validator_statistics_daily;
@@ -0,0 +1,33 @@
+-- the last value of validator statistic in every day (UTC) it changed, kept up to date by trigger on validator_statistics
+CREATE TABLE IF NOT EXISTS validator_statistics_daily
+(
+    validator_id            DECIMAL(65, 0)           NOT NULL,
+    statistic_type          SMALLINT                 NOT NULL,
+    day                     DATE                     NOT NULL,
+    amount                  NUMERIC(125)             NOT NULL,
+    block_height            DECIMAL(65, 0)           NOT NULL,
+    PRIMARY KEY (statistic_type, validator_id, day)
+);
+
+CREATE INDEX idx_v_s_d_type_day ON validator_statistics_daily (statistic_type, day);
+
+CREATE OR REPLACE FUNCTION rollup_validator_statistic() RETURNS TRIGGER AS $$
+BEGIN
+    INSERT INTO validator_statistics_daily (validator_id, statistic_type, day, amount, block_height)
+        VALUES (NEW.validator_id, NEW.statistic_type, (NEW.time AT TIME ZONE 'UTC')::date, NEW.amount, NEW.block_height)
+    ON CONFLICT (statistic_type, validator_id, day)
+    DO UPDATE SET amount = EXCLUDED.amount, block_height = EXCLUDED.block_height
+        WHERE validator_statistics_daily.block_height <= EXCLUDED.block_height;
+    RETURN NULL;
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE TRIGGER trg_v_s_rollup AFTER INSERT OR UPDATE ON validator_statistics
+    FOR EACH ROW EXECUTE PROCEDURE rollup_validator_statistic();
+
+INSERT INTO validator_statistics_daily (validator_id, statistic_type, day, amount, block_height)
+    SELECT DISTINCT ON (statistic_type, validator_id, (time AT TIME ZONE 'UTC')::date)
+        validator_id, statistic_type, (time AT TIME ZONE 'UTC')::date, amount, block_height
+    FROM validator_statistics
+    ORDER BY statistic_type, validator_id, (time AT TIME ZONE 'UTC')::date, block_height DESC
+ON CONFLICT DO NOTHING;
@@ -0,0 +1,19 @@
+-- stake decentralisation metrics at the beginning of every epoch
+CREATE TABLE IF NOT EXISTS decentralization
+(
+    epoch                   DECIMAL(65, 0)           NOT NULL,
+    created_at              TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP,
+    time                    TIMESTAMP WITH TIME ZONE NOT NULL,
+    block_height            DECIMAL(65, 0)           NOT NULL,
+    validators              INTEGER                  NOT NULL,
+    total_stake             NUMERIC(125)             NOT NULL,
+    nakamoto_coefficient    INTEGER                  NOT NULL,
+    gini                    DOUBLE PRECISION         NOT NULL,
+    top1_share              DOUBLE PRECISION         NOT NULL,
+    top5_share              DOUBLE PRECISION         NOT NULL,
+    top10_share             DOUBLE PRECISION         NOT NULL,
+    validators_over_33      INTEGER                  NOT NULL,
+    validators_over_50      INTEGER                  NOT NULL,
+    validators_over_66      INTEGER                  NOT NULL,
+    PRIMARY KEY (epoch)
+);
@@ -0,0 +1,10 @@
+DROP TABLE IF EXISTS failed_events;
+DROP TABLE IF EXISTS transactions;
+DROP TABLE IF EXISTS blocks;
+DROP TABLE IF EXISTS system_events;
+DROP TABLE IF EXISTS accounts;
+DROP TABLE IF EXISTS validator_statistics;
+DROP TABLE IF EXISTS delegations;
+DROP TABLE IF EXISTS validators;
+DROP TABLE IF EXISTS nodes;
+DROP TABLE IF EXISTS contract_events;
@@ -0,0 +1,204 @@
+-- SQLite schema equivalent to the postgres migrations.
+-- Big numbers are stored as decimal text, addresses and hashes as hex and times as unix microseconds (UTC).
+
+CREATE TABLE IF NOT EXISTS contract_events
+(
+    id                      TEXT                     NOT NULL,
+    created_at              INTEGER                  NOT NULL,
+    contract_name           TEXT                     NOT NULL,
+    event_name              TEXT                     NOT NULL,
+    contract_address        TEXT                     NOT NULL,
+    block_height            INTEGER                  NOT NULL,
+    time                    INTEGER                  NOT NULL,
+    transaction_hash        TEXT                     NOT NULL,
+    params                  TEXT                     NOT NULL,
+    removed                 BOOLEAN                  NOT NULL,
+    bound_type              TEXT                     NOT NULL CHECK (bound_type IN ('none', 'validator', 'delegation', 'node', 'token')),
+    bound_id                TEXT                     NOT NULL,
+    bound_address           TEXT                     NOT NULL,
+    -- there are no arrays, first two bound ids are kept separately for lookups
+    bound_id_1              TEXT,
+    bound_id_2              TEXT,
+    PRIMARY KEY (id)
+);
+
+CREATE INDEX idx_c_ev_time ON contract_events (time);
+CREATE INDEX idx_c_ev_bound_type ON contract_events (bound_type);
+CREATE INDEX idx_c_ev_bound_id_1 ON contract_events (bound_id_1);
+CREATE INDEX idx_c_ev_bound_id_2 ON contract_events (bound_id_2);
+CREATE INDEX idx_c_ev_tx_hash ON contract_events (transaction_hash);
+CREATE UNIQUE INDEX idx_c_ev_unique ON contract_events (contract_address, event_name, block_height, transaction_hash, removed);
+
+CREATE TABLE IF NOT EXISTS nodes
+(
+    id                      TEXT                     NOT NULL,
+    created_at              INTEGER                  NOT NULL,
+    node_id                 INTEGER                  NOT NULL,
+    address                 TEXT                     NOT NULL,
+    name                    TEXT                     NOT NULL,
+    ip                      TEXT                     NOT NULL,
+    public_ip               TEXT                     NOT NULL,
+    port                    INTEGER                  NOT NULL,
+    start_block             TEXT                     NOT NULL,
+    next_reward_date        INTEGER                  NOT NULL,
+    last_reward_date        INTEGER                  NOT NULL,
+    finish_time             TEXT                     NOT NULL,
+    status                  TEXT                     NOT NULL,
+    validator_id            INTEGER                  NOT NULL,
+    block_height            INTEGER                  NOT NULL,
+    PRIMARY KEY (id)
+);
+
+CREATE UNIQUE INDEX idx_n_node_id ON nodes (node_id);
+CREATE INDEX idx_nodes_validator_id ON nodes (validator_id);
+
+CREATE TABLE IF NOT EXISTS validators
+(
+    id                          TEXT                     NOT NULL,
+    created_at                  INTEGER                  NOT NULL,
+    validator_id                INTEGER                  NOT NULL,
+    name                        TEXT,
+    validator_address           TEXT                     NOT NULL,
+    requested_address           TEXT                     NOT NULL,
+    description                 TEXT,
+    fee_rate                    TEXT                     NOT NULL DEFAULT '0',
+    registration_time           INTEGER                  NOT NULL,
+    minimum_delegation_amount   TEXT                     NOT NULL DEFAULT '0',
+    accept_new_requests         BOOLEAN                  NOT NULL,
+    authorized                  BOOLEAN                  NOT NULL,
+    active_nodes                INTEGER                  NOT NULL DEFAULT 0,
+    linked_nodes                INTEGER                  NOT NULL DEFAULT 0,
+    staked                      TEXT                     NOT NULL DEFAULT '0',
+    block_height                INTEGER                  NOT NULL,
+    PRIMARY KEY (id)
+);
+
+CREATE UNIQUE INDEX idx_v_validator_id ON validators (validator_id);
+CREATE INDEX idx_v_h ON validators (block_height);
+CREATE INDEX idx_val_addr ON validators (validator_address);
+
+CREATE TABLE IF NOT EXISTS delegations
+(
+    id                      TEXT                     NOT NULL,
+    created_at              INTEGER                  NOT NULL,
+    delegation_id           INTEGER                  NOT NULL,
+    holder                  TEXT                     NOT NULL,
+    validator_id            INTEGER                  NOT NULL,
+    block_height            INTEGER                  NOT NULL,
+    transaction_hash        TEXT                     NOT NULL,
+    amount                  TEXT                     NOT NULL,
+    delegation_period       INTEGER                  NOT NULL,
+    created                 INTEGER                  NOT NULL,
+    started                 TEXT                     NOT NULL,
+    finished                TEXT                     NOT NULL,
+    info                    TEXT                     NOT NULL,
+    state                   INTEGER                  NOT NULL,
+    until                   INTEGER                  NOT NULL,
+    PRIMARY KEY (id)
+);
+
+CREATE INDEX idx_del_h ON delegations (holder);
+CREATE INDEX idx_del_v_id_bl_height ON delegations (validator_id, block_height);
+CREATE INDEX idx_del_created ON delegations (created);
+CREATE INDEX idx_del_s ON delegations (state);
+CREATE INDEX idx_d_until ON delegations (until);
+CREATE UNIQUE INDEX idx_del_unique ON delegations (delegation_id, transaction_hash);
+
+CREATE TABLE IF NOT EXISTS validator_statistics
+(
+    id                      TEXT                     NOT NULL,
+    created_at              INTEGER                  NOT NULL,
+    validator_id            INTEGER                  NOT NULL,
+    amount                  TEXT                     NOT NULL,
+    block_height            INTEGER                  NOT NULL,
+    time                    INTEGER                  NOT NULL,
+    statistic_type          INTEGER                  NOT NULL,
+    PRIMARY KEY (id)
+);
+
+CREATE UNIQUE INDEX idx_v_s_unique_st_vid_bh ON validator_statistics (validator_id, block_height, statistic_type);
+CREATE INDEX idx_v_s_time ON validator_statistics (time);
+
+CREATE TABLE IF NOT EXISTS accounts
+(
+    id                      TEXT                     NOT NULL,
+    created_at              INTEGER                  NOT NULL,
+    address                 TEXT                     NOT NULL,
+    account_type            TEXT                     NOT NULL DEFAULT 'default' CHECK (account_type IN ('default', 'delegator', 'validator')),
+    PRIMARY KEY (id)
+);
+
+CREATE UNIQUE INDEX idx_a_address ON accounts (address);
+
+CREATE TABLE IF NOT EXISTS system_events
+(
+    id                      TEXT                     NOT NULL,
+    height                  INTEGER                  NOT NULL,
+    kind                    INTEGER                  NOT NULL,
+    time                    INTEGER                  NOT NULL,
+    sender                  TEXT                     NOT NULL,
+    recipient               TEXT                     NOT NULL,
+    sender_id               INTEGER                  NOT NULL,
+    recipient_id            INTEGER                  NOT NULL,
+    before                  TEXT                     NOT NULL,
+    after                   TEXT                     NOT NULL,
+    change                  TEXT                     NOT NULL,
+    PRIMARY KEY (id)
+);
+
+CREATE UNIQUE INDEX idx_sys_evt_unique ON system_events (height, kind, sender, sender_id, recipient, recipient_id);
+
+CREATE TABLE IF NOT EXISTS blocks
+(
+    number                  INTEGER                  NOT NULL,
+    hash                    TEXT                     NOT NULL,
+    parent_hash             TEXT                     NOT NULL,
+    time                    INTEGER                  NOT NULL,
+    PRIMARY KEY (number)
+);
+
+CREATE INDEX idx_b_time ON blocks (time);
+
+CREATE TABLE IF NOT EXISTS transactions
+(
+    id                      TEXT                     NOT NULL,
+    created_at              INTEGER                  NOT NULL,
+    hash                    TEXT                     NOT NULL,
+    block_height            INTEGER                  NOT NULL,
+    block_hash              TEXT                     NOT NULL,
+    tx_index                INTEGER                  NOT NULL,
+    time                    INTEGER                  NOT NULL,
+    sender                  TEXT                     NOT NULL,
+    recipient               TEXT                     NOT NULL,
+    value                   TEXT                     NOT NULL,
+    nonce                   INTEGER                  NOT NULL,
+    gas                     INTEGER                  NOT NULL,
+    gas_price               TEXT                     NOT NULL,
+    gas_used                INTEGER                  NOT NULL,
+    fee                     TEXT                     NOT NULL,
+    status                  INTEGER                  NOT NULL,
+    PRIMARY KEY (id)
+);
+
+CREATE UNIQUE INDEX idx_tx_hash ON transactions (hash);
+CREATE INDEX idx_tx_sender ON transactions (sender);
+
+CREATE TABLE IF NOT EXISTS failed_events
+(
+    id                      TEXT                     NOT NULL,
+    created_at              INTEGER                  NOT NULL,
+    updated_at              INTEGER                  NOT NULL,
+    block_height            INTEGER                  NOT NULL,
+    transaction_hash        TEXT                     NOT NULL,
+    log_index               INTEGER                  NOT NULL,
+    contract_name           TEXT                     NOT NULL,
+    event_name              TEXT                     NOT NULL,
+    log                     TEXT                     NOT NULL,
+    error                   TEXT                     NOT NULL,
+    attempts                INTEGER                  NOT NULL,
+    next_retry              INTEGER                  NOT NULL,
+    PRIMARY KEY (id)
+);
+
+CREATE UNIQUE INDEX idx_fe_tx_log ON failed_events (transaction_hash, log_index);
+CREATE INDEX idx_fe_next_retry ON failed_events (next_retry);
@@ -0,0 +1,2 @@
+DROP TABLE IF EXISTS nodes_history;
+DROP TABLE IF EXISTS validators_history;
@@ -0,0 +1,61 @@
+-- Versions of validators and nodes. Version is valid from the height it was saved at
+-- up to (excluding) valid_to, which is NULL for the current one.
+CREATE TABLE IF NOT EXISTS validators_history
+(
+    id                          TEXT                     NOT NULL,
+    created_at                  INTEGER                  NOT NULL,
+    validator_id                INTEGER                  NOT NULL,
+    name                        TEXT,
+    validator_address           TEXT                     NOT NULL,
+    requested_address           TEXT                     NOT NULL,
+    description                 TEXT,
+    fee_rate                    TEXT                     NOT NULL DEFAULT '0',
+    registration_time           INTEGER                  NOT NULL,
+    minimum_delegation_amount   TEXT                     NOT NULL DEFAULT '0',
+    accept_new_requests         BOOLEAN                  NOT NULL,
+    authorized                  BOOLEAN                  NOT NULL,
+    valid_from                  INTEGER                  NOT NULL,
+    valid_to                    INTEGER,
+    PRIMARY KEY (id)
+);
+
+CREATE UNIQUE INDEX idx_vh_validator_id_valid_from ON validators_history (validator_id, valid_from);
+CREATE INDEX idx_vh_valid ON validators_history (valid_from, valid_to);
+
+CREATE TABLE IF NOT EXISTS nodes_history
+(
+    id                      TEXT                     NOT NULL,
+    created_at              INTEGER                  NOT NULL,
+    node_id                 INTEGER                  NOT NULL,
+    address                 TEXT                     NOT NULL,
+    name                    TEXT                     NOT NULL,
+    ip                      TEXT                     NOT NULL,
+    public_ip               TEXT                     NOT NULL,
+    port                    INTEGER                  NOT NULL,
+    start_block             TEXT                     NOT NULL,
+    next_reward_date        INTEGER                  NOT NULL,
+    last_reward_date        INTEGER                  NOT NULL,
+    finish_time             TEXT                     NOT NULL,
+    status                  TEXT                     NOT NULL,
+    validator_id            INTEGER                  NOT NULL,
+    valid_from              INTEGER                  NOT NULL,
+    valid_to                INTEGER,
+    PRIMARY KEY (id)
+);
+
+CREATE UNIQUE INDEX idx_nh_node_id_valid_from ON nodes_history (node_id, valid_from);
+CREATE INDEX idx_nh_validator_id ON nodes_history (validator_id);
+CREATE INDEX idx_nh_valid ON nodes_history (valid_from, valid_to);
+
+-- earlier versions were overwritten, history starts with the current state
+INSERT INTO validators_history (id, created_at, validator_id, name, validator_address, requested_address, description, fee_rate,
+        registration_time, minimum_delegation_amount, accept_new_requests, authorized, valid_from)
+    SELECT id, created_at, validator_id, name, validator_address, requested_address, description, fee_rate,
+        registration_time, minimum_delegation_amount, accept_new_requests, authorized, block_height
+    FROM validators;
+
+INSERT INTO nodes_history (id, created_at, node_id, address, name, ip, public_ip, port, start_block, next_reward_date,
+        last_reward_date, finish_time, status, validator_id, valid_from)
+    SELECT id, created_at, node_id, address, name, ip, public_ip, port, start_block, next_reward_date,
+        last_reward_date, finish_time, status, validator_id, block_height
+    FROM nodes;
@@ -0,0 +1,18 @@
+-- Changes of validator address and of the address requested to replace it
+CREATE TABLE IF NOT EXISTS validator_addresses
+(
+    id                      TEXT                     NOT NULL,
+    created_at              INTEGER                  NOT NULL,
+    validator_id            INTEGER                  NOT NULL,
+    address                 TEXT                     NOT NULL,
+    requested_address       TEXT                     NOT NULL,
+    block_height            INTEGER                  NOT NULL,
+    time                    INTEGER                  NOT NULL,
+    transaction_hash        TEXT                     NOT NULL DEFAULT '',
+    event_name              TEXT                     NOT NULL DEFAULT '',
+    PRIMARY KEY (id)
+);
+
+CREATE UNIQUE INDEX idx_va_validator_id_block_height ON validator_addresses (validator_id, block_height);
+CREATE INDEX idx_va_address ON validator_addresses (address);
+CREATE INDEX idx_va_requested_address ON validator_addresses (requested_address);
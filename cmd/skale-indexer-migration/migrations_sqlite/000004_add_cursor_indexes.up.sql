@@ -0,0 +1,4 @@
+-- lists are paged by (block height, id) cursor, the latest first
+CREATE INDEX idx_c_ev_bl_height_id ON contract_events (block_height, id);
+CREATE INDEX idx_sys_evt_height_id ON system_events (height, id);
+CREATE INDEX idx_del_bl_height_id ON delegations (block_height, id);
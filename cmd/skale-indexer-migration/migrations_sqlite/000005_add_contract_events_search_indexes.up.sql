@@ -0,0 +1,3 @@
+-- events are searched by contract and event name, params are matched by text without index
+CREATE INDEX idx_c_ev_contract_event_name ON contract_events (contract_name, event_name);
+CREATE INDEX idx_c_ev_event_name ON contract_events (event_name);
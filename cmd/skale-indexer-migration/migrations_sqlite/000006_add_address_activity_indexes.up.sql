@@ -0,0 +1,4 @@
+-- activity of address is looked up in every table referring to it, bound addresses are matched by text without index
+CREATE INDEX idx_sys_evt_sender ON system_events (sender);
+CREATE INDEX idx_sys_evt_recipient ON system_events (recipient);
+CREATE INDEX idx_nh_address ON nodes_history (address);
@@ -0,0 +1,36 @@
+-- kinds are kept as JSON array, unset validator id and address filters are NULL
+CREATE TABLE IF NOT EXISTS webhooks
+(
+    id                      TEXT                     NOT NULL,
+    created_at              INTEGER                  NOT NULL,
+    updated_at              INTEGER                  NOT NULL,
+    url                     TEXT                     NOT NULL,
+    secret                  TEXT                     NOT NULL,
+    kinds                   TEXT                     NOT NULL,
+    validator_id            TEXT,
+    address                 TEXT,
+    active                  BOOLEAN                  NOT NULL,
+    PRIMARY KEY (id)
+);
+
+-- delivered_at is 0 until the event is delivered
+CREATE TABLE IF NOT EXISTS webhook_deliveries
+(
+    id                      TEXT                     NOT NULL,
+    created_at              INTEGER                  NOT NULL,
+    updated_at              INTEGER                  NOT NULL,
+    webhook_id              TEXT                     NOT NULL,
+    event_key               TEXT                     NOT NULL,
+    event                   TEXT                     NOT NULL,
+    status                  TEXT                     NOT NULL,
+    attempts                INTEGER                  NOT NULL,
+    next_retry              INTEGER                  NOT NULL,
+    response_code           INTEGER                  NOT NULL,
+    error                   TEXT                     NOT NULL,
+    delivered_at            INTEGER                  NOT NULL,
+    PRIMARY KEY (id)
+);
+
+CREATE UNIQUE INDEX idx_wd_webhook_event ON webhook_deliveries (webhook_id, event_key);
+CREATE INDEX idx_wd_status_next_retry ON webhook_deliveries (status, next_retry);
+CREATE INDEX idx_wd_webhook_created ON webhook_deliveries (webhook_id, created_at);
@@ -0,0 +1,11 @@
+-- messages waiting to be published to message bus, saved in the same transaction as records they carry
+CREATE TABLE IF NOT EXISTS outbox
+(
+    id                      INTEGER                  NOT NULL PRIMARY KEY AUTOINCREMENT,
+    message_id              TEXT                     NOT NULL,
+    created_at              INTEGER                  NOT NULL,
+    topic                   TEXT                     NOT NULL,
+    key                     TEXT                     NOT NULL,
+    height                  INTEGER                  NOT NULL,
+    payload                 TEXT                     NOT NULL
+);
@@ -0,0 +1,3 @@
+DROP TRIGGER IF EXISTS trg_v_s_rollup_update;
+DROP TRIGGER IF EXISTS trg_v_s_rollup_insert;
+DROP TABLE IF EXISTS validator_statistics_daily;
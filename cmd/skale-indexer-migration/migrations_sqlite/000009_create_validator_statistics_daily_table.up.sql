@@ -0,0 +1,38 @@
+-- the last value of validator statistic in every day (UTC) it changed, kept up to date by triggers on validator_statistics.
+-- day is unix microseconds of the beginning of the day
+CREATE TABLE IF NOT EXISTS validator_statistics_daily
+(
+    validator_id            INTEGER                  NOT NULL,
+    statistic_type          INTEGER                  NOT NULL,
+    day                     INTEGER                  NOT NULL,
+    amount                  TEXT                     NOT NULL,
+    block_height            INTEGER                  NOT NULL,
+    PRIMARY KEY (statistic_type, validator_id, day)
+);
+
+CREATE INDEX idx_v_s_d_type_day ON validator_statistics_daily (statistic_type, day);
+
+CREATE TRIGGER trg_v_s_rollup_insert AFTER INSERT ON validator_statistics
+BEGIN
+    INSERT INTO validator_statistics_daily (validator_id, statistic_type, day, amount, block_height)
+        VALUES (NEW.validator_id, NEW.statistic_type, NEW.time / 86400000000 * 86400000000, NEW.amount, NEW.block_height)
+    ON CONFLICT (statistic_type, validator_id, day)
+    DO UPDATE SET amount = excluded.amount, block_height = excluded.block_height
+        WHERE validator_statistics_daily.block_height <= excluded.block_height;
+END;
+
+CREATE TRIGGER trg_v_s_rollup_update AFTER UPDATE ON validator_statistics
+BEGIN
+    INSERT INTO validator_statistics_daily (validator_id, statistic_type, day, amount, block_height)
+        VALUES (NEW.validator_id, NEW.statistic_type, NEW.time / 86400000000 * 86400000000, NEW.amount, NEW.block_height)
+    ON CONFLICT (statistic_type, validator_id, day)
+    DO UPDATE SET amount = excluded.amount, block_height = excluded.block_height
+        WHERE validator_statistics_daily.block_height <= excluded.block_height;
+END;
+
+INSERT INTO validator_statistics_daily (validator_id, statistic_type, day, amount, block_height)
+    SELECT validator_id, statistic_type, day, amount, block_height FROM (
+        SELECT validator_id, statistic_type, time / 86400000000 * 86400000000 AS day, amount, block_height,
+            ROW_NUMBER() OVER (PARTITION BY statistic_type, validator_id, time / 86400000000 ORDER BY block_height DESC) AS rn
+        FROM validator_statistics)
+    WHERE rn = 1;
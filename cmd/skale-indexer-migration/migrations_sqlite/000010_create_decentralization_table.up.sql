@@ -0,0 +1,19 @@
+-- stake decentralisation metrics at the beginning of every epoch
+CREATE TABLE IF NOT EXISTS decentralization
+(
+    epoch                   INTEGER                  NOT NULL,
+    created_at              INTEGER                  NOT NULL,
+    time                    INTEGER                  NOT NULL,
+    block_height            INTEGER                  NOT NULL,
+    validators              INTEGER                  NOT NULL,
+    total_stake             TEXT                     NOT NULL,
+    nakamoto_coefficient    INTEGER                  NOT NULL,
+    gini                    REAL                     NOT NULL,
+    top1_share              REAL                     NOT NULL,
+    top5_share              REAL                     NOT NULL,
+    top10_share             REAL                     NOT NULL,
+    validators_over_33      INTEGER                  NOT NULL,
+    validators_over_50      INTEGER                  NOT NULL,
+    validators_over_66      INTEGER                  NOT NULL,
+    PRIMARY KEY (epoch)
+);
@@ -30,6 +30,8 @@ type Config struct {
 	Address  string `json:"address" envconfig:"ADDRESS" default:"0.0.0.0"`
 	Port     string `json:"port" envconfig:"PORT" default:"3000"`
 	HTTPPort string `json:"http_port" envconfig:"HTTP_PORT" default:"8087"`
+	// GRPCAddress is the listen address of gRPC query service, it's disabled when empty
+	GRPCAddress string `json:"grpc_address" envconfig:"GRPC_ADDRESS" default:"0.0.0.0:8886"`
 
 	EthereumAddress string `json:"ethereum_address" envconfig:"ETHEREUM_ADDRESS" default:"http://0.0.0.0:8545"`
 
@@ -49,6 +51,24 @@ type Config struct {
 
 	MaxHeightsPerRequest uint64        `json:"max_heights_per_request" envconfig:"MAX_HEIGHTS_PER_REQUEST" default:"100"`
 	ScrapeLatestTimeout  time.Duration `json:"scrape_latest_timeout" envconfig:"SCRAPE_LATEST_TIMEOUT" default:"30s"`
+	BulkHeightsBehind    uint64        `json:"bulk_heights_behind" envconfig:"BULK_HEIGHTS_BEHIND" default:"10000"`
+
+	FailedEventsRetryInterval time.Duration `json:"failed_events_retry_interval" envconfig:"FAILED_EVENTS_RETRY_INTERVAL" default:"1m"`
+	FailedEventsMaxAttempts   uint64        `json:"failed_events_max_attempts" envconfig:"FAILED_EVENTS_MAX_ATTEMPTS" default:"10"`
+	WebhookDeliveryInterval   time.Duration `json:"webhook_delivery_interval" envconfig:"WEBHOOK_DELIVERY_INTERVAL" default:"5s"`
+	WebhookMaxAttempts        uint64        `json:"webhook_max_attempts" envconfig:"WEBHOOK_MAX_ATTEMPTS" default:"10"`
+	DecentralizationInterval  time.Duration `json:"decentralization_interval" envconfig:"DECENTRALIZATION_INTERVAL" default:"1h"`
+
+	// StreamBuffer is the number of events stream subscriber may fall behind before it's disconnected
+	StreamBuffer int `json:"stream_buffer" envconfig:"STREAM_BUFFER" default:"1000"`
+
+	// OutboxPublisher is message bus indexed records are published to, either "file" or "nats". Outbox is disabled when it's empty
+	OutboxPublisher     string        `json:"outbox_publisher" envconfig:"OUTBOX_PUBLISHER"`
+	OutboxFile          string        `json:"outbox_file" envconfig:"OUTBOX_FILE" default:"-"`
+	NATSURL             string        `json:"nats_url" envconfig:"NATS_URL" default:"nats://127.0.0.1:4222"`
+	NATSSubjectPrefix   string        `json:"nats_subject_prefix" envconfig:"NATS_SUBJECT_PREFIX" default:"skale"`
+	OutboxRelayInterval time.Duration `json:"outbox_relay_interval" envconfig:"OUTBOX_RELAY_INTERVAL" default:"1s"`
+	OutboxBatch         uint64        `json:"outbox_batch" envconfig:"OUTBOX_BATCH" default:"500"`
 
 	HealthCheckInterval time.Duration `json:"health_check_interval" envconfig:"HEALTH_CHECK_INTERVAL" default:"10s"`
 }
@@ -5,10 +5,13 @@ import (
 	"crypto/tls"
 	"database/sql"
 	"flag"
+	"fmt"
 	"log"
 	"math/big"
+	"net"
 	"net/http"
 	"os"
+	"strings"
 
 	"github.com/ethereum/go-ethereum/core/types"
 	"github.com/figment-networks/indexing-engine/metrics"
@@ -17,6 +20,10 @@ import (
 	"github.com/figment-networks/skale-indexer/api/skale"
 	"github.com/figment-networks/skale-indexer/client"
 	"github.com/figment-networks/skale-indexer/client/actions"
+	"github.com/figment-networks/skale-indexer/client/outbox"
+	"github.com/figment-networks/skale-indexer/client/stream"
+	"github.com/figment-networks/skale-indexer/client/transport/graphapi"
+	"github.com/figment-networks/skale-indexer/client/transport/grpcapi"
 	"github.com/figment-networks/skale-indexer/client/transport/webapi"
 	"github.com/figment-networks/skale-indexer/cmd/skale-indexer/config"
 	"github.com/figment-networks/skale-indexer/cmd/skale-indexer/logger"
@@ -24,13 +31,16 @@ import (
 	"github.com/figment-networks/skale-indexer/scraper/transport/eth"
 	"github.com/figment-networks/skale-indexer/scraper/transport/eth/contract"
 	"github.com/figment-networks/skale-indexer/store"
+	"github.com/figment-networks/skale-indexer/store/memory"
 	"github.com/figment-networks/skale-indexer/store/postgresql"
+	"github.com/figment-networks/skale-indexer/store/sqlite"
 
 	"github.com/figment-networks/indexing-engine/health"
 	"github.com/figment-networks/indexing-engine/health/database/postgreshealth"
 
 	_ "github.com/lib/pq"
 	"go.uber.org/zap"
+	"google.golang.org/grpc"
 )
 
 var configPath string
@@ -80,24 +90,51 @@ func main() {
 	}
 
 	// connect to database
-	logger.Info("[DB] Connecting to database...")
-	db, err := sql.Open("postgres", cfg.DatabaseURL)
-	if err != nil {
-		logger.Error(err)
-		return
-	}
+	var (
+		db     *sql.DB
+		driver store.DBDriver
+	)
+	switch {
+	case strings.HasPrefix(cfg.DatabaseURL, "memory://"):
+		logger.Info("[DB] Using in-memory database, nothing will be persisted...")
+		driver = memory.NewDriver()
+	case strings.HasPrefix(cfg.DatabaseURL, "sqlite3://"):
+		logger.Info("[DB] Opening SQLite database...")
+		// kept apart from db, which is probed by postgres health monitor
+		liteDB, err := sqlite.Open(strings.TrimPrefix(cfg.DatabaseURL, "sqlite3://"))
+		if err != nil {
+			logger.Error(err)
+			return
+		}
 
-	if err := db.PingContext(ctx); err != nil {
-		logger.Error(err)
-		return
-	}
-	logger.Info("[DB] Ping successfull...")
-	defer db.Close()
+		if err := liteDB.PingContext(ctx); err != nil {
+			logger.Error(err)
+			return
+		}
+		defer liteDB.Close()
+
+		driver = sqlite.NewDriver(ctx, liteDB, logger.GetLogger())
+	default:
+		logger.Info("[DB] Connecting to database...")
+		db, err = sql.Open("postgres", cfg.DatabaseURL)
+		if err != nil {
+			logger.Error(err)
+			return
+		}
 
-	pgsqlDriver := postgresql.NewDriver(ctx, db, logger.GetLogger())
-	storeDB := store.New(pgsqlDriver)
+		if err := db.PingContext(ctx); err != nil {
+			logger.Error(err)
+			return
+		}
+		logger.Info("[DB] Ping successfull...")
+		defer db.Close()
+
+		driver = postgresql.NewDriver(ctx, db, logger.GetLogger())
+	}
+	storeDB := store.New(driver)
 
 	mux := http.NewServeMux()
+	gs := grpc.NewServer()
 
 	if cfg.EnableScraper {
 		logger.GetLogger().Info("Indexer is in scraping mode")
@@ -139,6 +176,19 @@ func main() {
 		}
 		defer tr.Close(ctx)
 		am := actions.NewManager(caller, storeDB, tr, cm, logger.GetLogger())
+		broker := stream.NewBroker(cfg.StreamBuffer)
+		am.SetPublisher(broker)
+
+		if cfg.OutboxPublisher != "" {
+			pub, err := newOutboxPublisher(cfg)
+			if err != nil {
+				logger.Fatal("Error creating outbox publisher", zap.String("publisher", cfg.OutboxPublisher), zap.Error(err))
+				return
+			}
+			defer pub.Close()
+			am.EnableOutbox()
+			go outbox.NewRelay(logger.GetLogger(), storeDB, pub, cfg.OutboxBatch).Run(ctx, cfg.OutboxRelayInterval)
+		}
 		eAPI := scraper.NewEthereumAPI(logger.GetLogger(), tr, types.Header{Number: new(big.Int).SetUint64(cfg.EthereumSmallestBlockNumber), Time: cfg.EthereumSmallestTime}, am)
 
 		cli := client.NewClient(logger.GetLogger(),
@@ -146,24 +196,49 @@ func main() {
 			cm.GetContractsByNames(am.GetImplementedContractNames()),
 			cfg.EthereumSmallestBlockNumber,
 			cfg.MaxHeightsPerRequest)
+		cli.SetBulkHeightsBehind(cfg.BulkHeightsBehind)
 		hCli := webapi.NewClientConnector(cli)
 		hCli.AttachToHandler(mux)
+		graphapi.NewClientConnector(cli).AttachToHandler(mux)
+		grpcapi.NewServer(logger.GetLogger(), cli).Register(gs)
+
+		go cli.RunFailedEventsRetry(ctx, cfg.FailedEventsRetryInterval, cfg.FailedEventsMaxAttempts)
+		go cli.RunWebhookDeliveries(ctx, cfg.WebhookDeliveryInterval, cfg.WebhookMaxAttempts)
+		go cli.RunDecentralization(ctx, cfg.DecentralizationInterval)
 
 		sCli := webapi.NewScrapeConnector(logger.GetLogger(), cli, cfg.ScrapeLatestTimeout)
 		sCli.AttachToHandler(mux)
+		webapi.NewStreamConnector(logger.GetLogger(), cli, broker).AttachToHandler(mux)
 	} else {
 		logger.GetLogger().Info("Indexer is not in scraping mode")
 
 		cli := client.NewClient(logger.GetLogger(), storeDB, nil, nil, cfg.EthereumSmallestBlockNumber, cfg.MaxHeightsPerRequest)
 		hCli := webapi.NewClientConnector(cli)
 		hCli.AttachToHandler(mux)
+		graphapi.NewClientConnector(cli).AttachToHandler(mux)
+		grpcapi.NewServer(logger.GetLogger(), cli).Register(gs)
+	}
+
+	if cfg.GRPCAddress != "" {
+		lis, err := net.Listen("tcp", cfg.GRPCAddress)
+		if err != nil {
+			logger.Fatal("[GRPC] failed to listen", zap.Error(err))
+		}
+		defer gs.GracefulStop()
+		go func() {
+			if err := gs.Serve(lis); err != nil {
+				logger.GetLogger().Error("[GRPC] failed to serve", zap.Error(err))
+			}
+		}()
 	}
 
 	mux.Handle("/metrics", metrics.Handler())
 
-	dbMonitor := postgreshealth.NewPostgresMonitorWithMetrics(db, logger.GetLogger())
 	monitor := &health.Monitor{}
-	monitor.AddProber(ctx, dbMonitor)
+	if db != nil {
+		dbMonitor := postgreshealth.NewPostgresMonitorWithMetrics(db, logger.GetLogger())
+		monitor.AddProber(ctx, dbMonitor)
+	}
 	go monitor.RunChecks(ctx, cfg.HealthCheckInterval)
 	monitor.AttachHttp(mux)
 
@@ -197,3 +272,13 @@ func initConfig(path string) (*config.Config, error) {
 
 	return cfg, nil
 }
+
+func newOutboxPublisher(cfg *config.Config) (outbox.Publisher, error) {
+	switch cfg.OutboxPublisher {
+	case "file":
+		return outbox.NewFilePublisher(cfg.OutboxFile)
+	case "nats":
+		return outbox.NewNATSPublisher(cfg.NATSURL, cfg.NATSSubjectPrefix)
+	}
+	return nil, fmt.Errorf("unknown outbox publisher %q", cfg.OutboxPublisher)
+}
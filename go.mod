@@ -11,10 +11,14 @@ require (
 	github.com/golang-migrate/migrate/v4 v4.14.1
 	github.com/golang/groupcache v0.0.0-20210331224755-41bb18bfe9da
 	github.com/golang/mock v1.5.0
+	github.com/golang/protobuf v1.4.3
 	github.com/google/uuid v1.2.0
-	github.com/hashicorp/golang-lru v0.5.5-0.20210104140557-80c98217689d
+	github.com/gorilla/websocket v1.4.2
+	github.com/graph-gophers/graphql-go v0.0.0-20201113091052-beb923fada29
 	github.com/kelseyhightower/envconfig v1.4.0
 	github.com/lib/pq v1.10.0
+	github.com/mattn/go-sqlite3 v2.0.1+incompatible
+	github.com/nats-io/nats.go v1.11.0
 	github.com/niemeyer/pretty v0.0.0-20200227124842-a10e7caefd8e // indirect
 	github.com/prometheus/client_golang v1.10.0 // indirect
 	github.com/rollbar/rollbar-go v1.2.0
@@ -23,6 +27,11 @@ require (
 	golang.org/x/crypto v0.0.0-20210415154028-4f45737414dc // indirect
 	golang.org/x/time v0.0.0-20210220033141-f8bda1e9f3ba
 	google.golang.org/genproto v0.0.0-20201111145450-ac7456db90a6 // indirect
-	google.golang.org/grpc v1.35.0 // indirect
+	google.golang.org/grpc v1.35.0
+	google.golang.org/protobuf v1.25.0
 	gopkg.in/check.v1 v1.0.0-20200902074654-038fdea0a05b // indirect
 )
+
+// v2.0.x tags of go-sqlite3 were published by mistake and retracted upstream, they're older than v1.14.
+// gorm, pulled in by indexing-engine, still requires v2.0.1, so the driver is pinned to v1.14 by replacement
+replace github.com/mattn/go-sqlite3 => github.com/mattn/go-sqlite3 v1.14.17
@@ -7,8 +7,6 @@ import (
 	"sync"
 	"time"
 
-	lru "github.com/hashicorp/golang-lru"
-
 	"github.com/ethereum/go-ethereum/accounts/abi"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/core/types"
@@ -21,13 +19,19 @@ import (
 const (
 	workerCount            = 5
 	backCheckSlidingWindow = 100
-	cacheSize              = 1000
 )
 
 type ActionManager interface {
+	Atomic(ctx context.Context, fn func(ctx context.Context) error) error
+	Bulk(ctx context.Context, fn func(ctx context.Context) error) error
 	GetImplementedContractNames() []string
-	GetBlockHeader(ctx context.Context, height big.Int) (h *types.Header, err error)
+	GetBlock(ctx context.Context, height uint64) (b structs.Block, err error)
+	GetBlockBefore(ctx context.Context, height uint64) (b structs.Block, err error)
+	PrefetchTransactions(ctx context.Context, logs []types.Log, blockTime time.Time) (context.Context, error)
 	AfterEventLog(ctx context.Context, c contract.ContractsContents, ce structs.ContractEvent) error
+	StoreFailedEvent(ctx context.Context, l types.Log, ce structs.ContractEvent, processErr error) error
+	DeleteFailedEvent(ctx context.Context, id string) error
+	DeleteFailedEventOfLog(ctx context.Context, txHash common.Hash, logIndex uint) error
 	SyncForBeginningOfEpoch(ctx context.Context, contractVersion string, currentBlock uint64, blockTime time.Time) error
 }
 
@@ -35,51 +39,57 @@ type EthereumAPI struct {
 	log                   *zap.Logger
 	transport             transport.EthereumTransport
 	AM                    ActionManager
-	blockLRU              *lru.Cache
 	smallestPossibleBlock types.Header
 
 	slock sync.Mutex
 }
 
 func NewEthereumAPI(log *zap.Logger, transport transport.EthereumTransport, spb types.Header, am ActionManager) *EthereumAPI {
-	cache, _ := lru.New(cacheSize)
 	return &EthereumAPI{
 		log:                   log,
 		transport:             transport,
 		AM:                    am,
 		smallestPossibleBlock: spb,
-		blockLRU:              cache,
 	}
 }
 
-func (eAPI *EthereumAPI) getLastBlockTimeBefore(ctx context.Context, taskID string, fromBlockID uint64, window uint64, addr []common.Address) (blockTime time.Time, err error) {
+// Bulk runs fn, eg. ParseLogs of historical range, with bulk writes of the store
+func (eAPI *EthereumAPI) Bulk(ctx context.Context, fn func(ctx context.Context) error) error {
+	return eAPI.AM.Bulk(ctx, fn)
+}
 
-	f, t := fromBlockID-window, fromBlockID
-	for {
-		eAPI.log.Debug("Running back check ", zap.Uint64("from", f), zap.Uint64("to", t))
+// getLastBlockTimeBefore gets time of the last stored block below fromBlockID. When there is none yet,
+// logs are searched backwards for the last block with events, which is stored on the way
+func (eAPI *EthereumAPI) getLastBlockTimeBefore(ctx context.Context, fromBlockID uint64, window uint64, addr []common.Address) (blockTime time.Time, err error) {
+	b, err := eAPI.AM.GetBlockBefore(ctx, fromBlockID)
+	if err == nil {
+		return b.Time, nil
+	}
+	if err != structs.ErrNotFound {
+		return blockTime, fmt.Errorf("error on getting stored block before :%w", err)
+	}
 
-		if f < eAPI.smallestPossibleBlock.Number.Uint64() {
-			blockTime = time.Unix(int64(eAPI.smallestPossibleBlock.Time), 0)
-			eAPI.blockLRU.Add(taskID, eAPI.smallestPossibleBlock)
-			return
+	smallest := eAPI.smallestPossibleBlock.Number.Uint64()
+	t := fromBlockID
+	for {
+		if t < smallest+window {
+			return time.Unix(int64(eAPI.smallestPossibleBlock.Time), 0), nil
 		}
-		eAPI.log.Debug("Running GetLogs", zap.Uint64("from", f), zap.Uint64("to", t))
+		f := t - window
+		eAPI.log.Debug("Running back check GetLogs", zap.Uint64("from", f), zap.Uint64("to", t))
 		logsBackwards, err := eAPI.transport.GetLogs(ctx, *new(big.Int).SetUint64(f), *new(big.Int).SetUint64(t), addr)
 		if err != nil {
 			return blockTime, fmt.Errorf("error on getting logs for last block before :%w", err)
 		}
 
 		if len(logsBackwards) > 0 {
-			height := logsBackwards[len(logsBackwards)-1].BlockNumber
-			lastLoggedBlockHeader, err := eAPI.AM.GetBlockHeader(ctx, *new(big.Int).SetUint64(height))
+			lastLogged, err := eAPI.AM.GetBlock(ctx, logsBackwards[len(logsBackwards)-1].BlockNumber)
 			if err != nil {
 				return blockTime, fmt.Errorf("error on getting block header for last block before :%w", err)
 			}
-			blockTime = time.Unix(int64(lastLoggedBlockHeader.Time), 0)
-			eAPI.blockLRU.Add(taskID, *lastLoggedBlockHeader)
-			return blockTime, nil
+			return lastLogged.Time, nil
 		}
-		f, t = f-window, t-window
+		t = f
 	}
 }
 
@@ -87,6 +97,45 @@ func (eAPI *EthereumAPI) GetLatestBlockHeight(ctx context.Context) (uint64, erro
 	return eAPI.transport.GetLatestBlockHeight(ctx)
 }
 
+// GetHeightAtTime binary-searches for the last block produced at or before given time,
+// in the inclusive range of heights between from and to. When to is zero, latest height is used.
+func (eAPI *EthereumAPI) GetHeightAtTime(ctx context.Context, t time.Time, from, to uint64) (height uint64, err error) {
+	if to == 0 {
+		if to, err = eAPI.transport.GetLatestBlockHeight(ctx); err != nil {
+			return 0, fmt.Errorf("error getting latest block height :%w", err)
+		}
+	}
+	if smallest := eAPI.smallestPossibleBlock.Number.Uint64(); from < smallest {
+		from = smallest
+	}
+
+	target := t.Unix()
+	var found bool
+	for from <= to {
+		mid := from + (to-from)/2
+		b, err := eAPI.AM.GetBlock(ctx, mid)
+		if err != nil {
+			return 0, fmt.Errorf("error on getting block header :%w", err)
+		}
+
+		if b.Time.Unix() <= target {
+			height, found = mid, true
+			from = mid + 1
+			continue
+		}
+
+		if mid == 0 {
+			break
+		}
+		to = mid - 1
+	}
+
+	if !found {
+		return 0, structs.ErrNotFound
+	}
+	return height, nil
+}
+
 func (eAPI *EthereumAPI) ParseLogs(ctx context.Context, ccs *contract.Contracts, taskID string, from, to big.Int) (err error) {
 	defer eAPI.log.Sync()
 
@@ -98,28 +147,18 @@ func (eAPI *EthereumAPI) ParseLogs(ctx context.Context, ccs *contract.Contracts,
 
 	eAPI.log.Debug("[EthTransport] GetLogs ", zap.Int("len", len(logs)), zap.String("taskID", taskID), zap.Uint64("from", from.Uint64()), zap.Uint64("to", to.Uint64()))
 
-	if len(logs) == 0 { // spot tx block crossing month
-		var lastLoggedBlockTime types.Header
-		llbt, ok := eAPI.blockLRU.Get(taskID)
-		if ok {
-			lastLoggedBlockTime = llbt.(types.Header)
-			if lastLoggedBlockTime.Time == 0 {
-				lbtB, err := eAPI.getLastBlockTimeBefore(ctx, taskID, from.Uint64(), backCheckSlidingWindow, addr)
-				if err != nil {
-					return err
-				}
-				lastLoggedBlockTime.Time = uint64(lbtB.Unix())
+	lastBlockTime, err := eAPI.getLastBlockTimeBefore(ctx, from.Uint64(), backCheckSlidingWindow, addr)
+	if err != nil {
+		return err
+	}
 
-			}
-		}
-		h, err := eAPI.AM.GetBlockHeader(ctx, to)
+	if len(logs) == 0 { // spot tx block crossing month
+		b, err := eAPI.AM.GetBlock(ctx, to.Uint64())
 		if err != nil {
 			return err
 		}
 
-		hTime := time.Unix(int64(h.Time), 0)
-		a := time.Unix(int64(lastLoggedBlockTime.Time), 0)
-		if isInRange(a, hTime) {
+		if isInRange(lastBlockTime, b.Time) {
 			version := "1.7.2"
 			if len(addr) > 0 {
 				if ver, ok := ccs.GetAllVersions(addr[0]); ok {
@@ -129,31 +168,12 @@ func (eAPI *EthereumAPI) ParseLogs(ctx context.Context, ccs *contract.Contracts,
 				}
 			}
 
-			if err = eAPI.AM.SyncForBeginningOfEpoch(ctx, version, h.Number.Uint64(), hTime); err != nil { // latest version?
-				return err
-			}
-			eAPI.blockLRU.Add(taskID, *h)
-			return nil
+			return eAPI.AM.SyncForBeginningOfEpoch(ctx, version, b.Number, b.Time) // latest version?
 		}
-
-		eAPI.blockLRU.Add(taskID, *h)
 		return nil
 	}
 
-	var lastLoggedBlockTime types.Header
-	llbt, _ := eAPI.blockLRU.Get(taskID)
-	if llbt != nil {
-		lastLoggedBlockTime = llbt.(types.Header)
-	}
-
-	if lastLoggedBlockTime.Time == 0 {
-		lbtB, err := eAPI.getLastBlockTimeBefore(ctx, taskID, from.Uint64(), backCheckSlidingWindow, addr)
-		if err != nil {
-			return err
-		}
-		lastLoggedBlockTime.Time = uint64(lbtB.Unix())
-	}
-
+	blocks := groupByBlock(logs)
 	input := make(chan ProcInput, workerCount)
 	output := make(chan ProcOutput, workerCount)
 	defer close(output)
@@ -163,13 +183,13 @@ func (eAPI *EthereumAPI) ParseLogs(ctx context.Context, ccs *contract.Contracts,
 
 	wg := &sync.WaitGroup{}
 
-	go eAPI.populateToWorkers(cCtx, logs, input, time.Unix(int64(lastLoggedBlockTime.Time), 0))
+	go eAPI.populateToWorkers(cCtx, blocks, input, lastBlockTime)
 	for i := 0; i < workerCount; i++ {
 		wg.Add(1)
-		go eAPI.processLogAsync(cCtx, ccs, wg, input, output)
+		go eAPI.processBlockAsync(cCtx, ccs, wg, input, output)
 	}
 
-	processed := make(map[uint64][]ProcOutput, len(logs))
+	processed := make(map[uint64][]structs.ContractEvent, len(blocks))
 	var gotResponses int
 
 OutputLoop:
@@ -187,29 +207,17 @@ OutputLoop:
 				eAPI.log.Error("[ScraperCLient] ParseLogs Error", zap.Error(o.Error))
 				continue
 			}
-			eAPI.log.Debug("[ScraperCLient] Process contract Event", zap.Any("ContractEvent", o.CE))
-			p, ok := processed[o.CE.BlockHeight]
-			if !ok {
-				p = []ProcOutput{}
-			}
-			p = append(p, o)
-			processed[o.CE.BlockHeight] = p
+			eAPI.log.Debug("[ScraperCLient] Processed block", zap.Uint64("height", o.Height), zap.Int("events", len(o.CEs)))
+			processed[o.Height] = o.CEs
 
-			if gotResponses == len(logs) {
+			if gotResponses == len(blocks) {
 				break OutputLoop
 			}
 		}
 	}
 
 	if err == nil {
-		lastBlock := logs[len(logs)-1]
-
-		a := processed[lastBlock.BlockNumber]
-		eAPI.blockLRU.Add(taskID, types.Header{
-			Number: new(big.Int).SetUint64(lastBlock.BlockNumber),
-			Time:   uint64(a[0].CE.Time.Unix()),
-		})
-		eAPI.log.Debug("[ScraperCLient] Processed", zap.Uint64("last_height", lastBlock.BlockNumber))
+		eAPI.log.Debug("[ScraperCLient] Processed", zap.Uint64("last_height", logs[len(logs)-1].BlockNumber))
 	}
 
 	wg.Wait()
@@ -226,42 +234,54 @@ func isInRange(prvTime, crnTime time.Time) bool {
 
 type ProcInput struct {
 	Order             int
-	Log               types.Log
-	Header            types.Header
+	Logs              []types.Log
+	Block             structs.Block
 	PreviousBlockTime time.Time
 
 	Error error
 }
 
 type ProcOutput struct {
-	InID  int
-	CE    structs.ContractEvent
-	Error error
+	InID   int
+	Height uint64
+	CEs    []structs.ContractEvent
+	Error  error
+}
+
+// groupByBlock splits ordered logs into slices of logs emitted in the same block
+func groupByBlock(logs []types.Log) (blocks [][]types.Log) {
+	for i, l := range logs {
+		if i == 0 || logs[i-1].BlockNumber != l.BlockNumber {
+			blocks = append(blocks, []types.Log{})
+		}
+		blocks[len(blocks)-1] = append(blocks[len(blocks)-1], l)
+	}
+	return blocks
 }
 
-func (eAPI *EthereumAPI) populateToWorkers(ctx context.Context, logs []types.Log, populateCh chan ProcInput, lastLoggedBlockTime time.Time) {
+func (eAPI *EthereumAPI) populateToWorkers(ctx context.Context, blocks [][]types.Log, populateCh chan ProcInput, lastLoggedBlockTime time.Time) {
 
 	defer close(populateCh)
 	previousBlockTime := lastLoggedBlockTime
-	for i, l := range logs {
+	for i, logs := range blocks {
 		select {
 		case <-ctx.Done():
 			return
 		default:
 		}
-		h, err := eAPI.AM.GetBlockHeader(ctx, *new(big.Int).SetUint64(l.BlockNumber))
+		b, err := eAPI.AM.GetBlock(ctx, logs[0].BlockNumber)
 		if err != nil {
 			populateCh <- ProcInput{Error: err}
 			break
 		}
 
-		populateCh <- ProcInput{i, l, *h, previousBlockTime, nil}
-		previousBlockTime = time.Unix(int64(h.Time), 0)
+		populateCh <- ProcInput{i, logs, b, previousBlockTime, nil}
+		previousBlockTime = b.Time
 	}
 
 }
 
-func (eAPI *EthereumAPI) processLogAsync(ctx context.Context, ccs *contract.Contracts, wg *sync.WaitGroup, in chan ProcInput, out chan ProcOutput) {
+func (eAPI *EthereumAPI) processBlockAsync(ctx context.Context, ccs *contract.Contracts, wg *sync.WaitGroup, in chan ProcInput, out chan ProcOutput) {
 	defer eAPI.log.Sync()
 	defer wg.Done()
 
@@ -280,24 +300,31 @@ func (eAPI *EthereumAPI) processLogAsync(ctx context.Context, ccs *contract.Cont
 				continue
 			}
 
-			ce, err := processLog(eAPI.log, inp.Log, inp.Header, ccs)
+			// the node is called before the block's unit of work, so it doesn't hold the database meanwhile
+			bctx, err := eAPI.AM.PrefetchTransactions(ctx, inp.Logs, inp.Block.Time)
 			if err != nil {
-				if eAPI.sendIfPossible(ctx, out, ProcOutput{Error: err}) {
+				if eAPI.sendIfPossible(ctx, out, ProcOutput{Error: fmt.Errorf("error fetching transactions of block %d: %w", inp.Block.Number, err)}) {
 					return
 				}
 				continue
 			}
-			c, ok := ccs.GetByAddress(inp.Log.Address)
-			if err = eAPI.AM.AfterEventLog(ctx, c, ce); err != nil {
-				if eAPI.sendIfPossible(ctx, out, ProcOutput{Error: err}) {
+
+			var ces []structs.ContractEvent
+			err = eAPI.AM.Atomic(bctx, func(ctx context.Context) error {
+				ces = nil
+				return eAPI.processBlock(ctx, ccs, inp, &ces)
+			})
+			if err != nil {
+				if eAPI.sendIfPossible(ctx, out, ProcOutput{Error: fmt.Errorf("error processing block %d: %w", inp.Block.Number, err)}) {
 					return
 				}
 				continue
 			}
 
-			hTime := time.Unix(int64(inp.Header.Time), 0)
-			if isInRange(inp.PreviousBlockTime, hTime) {
-				if err = eAPI.AM.SyncForBeginningOfEpoch(ctx, c.Version, inp.Log.BlockNumber, hTime); err != nil {
+			// synchronization runs concurrently, so it cannot share the block's unit of work
+			if isInRange(inp.PreviousBlockTime, inp.Block.Time) {
+				c, _ := ccs.GetByAddress(inp.Logs[0].Address)
+				if err = eAPI.AM.SyncForBeginningOfEpoch(ctx, c.Version, inp.Block.Number, inp.Block.Time); err != nil {
 					eAPI.log.Error("error occurred on synchronization ", zap.Error(err))
 					if eAPI.sendIfPossible(ctx, out, ProcOutput{Error: err}) {
 						return
@@ -305,13 +332,79 @@ func (eAPI *EthereumAPI) processLogAsync(ctx context.Context, ccs *contract.Cont
 					continue
 				}
 			}
-			if eAPI.sendIfPossible(ctx, out, ProcOutput{inp.Order, ce, err}) {
+			if eAPI.sendIfPossible(ctx, out, ProcOutput{inp.Order, inp.Block.Number, ces, nil}) {
 				return
 			}
 		}
 	}
 }
 
+// processBlock processes all the logs of a single block. Every event is processed in its own nested unit of work,
+// so the one that fails is rolled back alone and moved to failed events, without affecting the rest of the block.
+// Logs which cannot be decoded are moved to failed events as well, and the ones processed on re-scrape are removed from there
+func (eAPI *EthereumAPI) processBlock(ctx context.Context, ccs *contract.Contracts, inp ProcInput, ces *[]structs.ContractEvent) error {
+	for _, l := range inp.Logs {
+		c, _ := ccs.GetByAddress(l.Address)
+		ce, err := processLog(eAPI.log, l, inp.Block, ccs)
+		if err != nil {
+			eAPI.log.Warn("[ScraperCLient] Event decoding failed, moving to failed events", zap.String("txHash", l.TxHash.String()), zap.Uint("logIndex", l.Index), zap.Error(err))
+			ce.ContractName = c.Name
+			if sErr := eAPI.AM.StoreFailedEvent(ctx, l, ce, err); sErr != nil {
+				return fmt.Errorf("error storing failed event: %s (decoding error: %w)", sErr.Error(), err)
+			}
+			continue
+		}
+
+		err = eAPI.AM.Atomic(ctx, func(ctx context.Context) error {
+			if err := eAPI.AM.AfterEventLog(ctx, c, ce); err != nil {
+				return err
+			}
+			if err := eAPI.AM.DeleteFailedEventOfLog(ctx, l.TxHash, l.Index); err != nil {
+				return fmt.Errorf("error removing failed event: %w", err)
+			}
+			return nil
+		})
+		if err != nil {
+			eAPI.log.Warn("[ScraperCLient] Event processing failed, moving to failed events", zap.String("txHash", l.TxHash.String()), zap.Uint("logIndex", l.Index), zap.Error(err))
+			if sErr := eAPI.AM.StoreFailedEvent(ctx, l, ce, err); sErr != nil {
+				return fmt.Errorf("error storing failed event: %s (processing error: %w)", sErr.Error(), err)
+			}
+		}
+		*ces = append(*ces, ce)
+	}
+	return nil
+}
+
+// RetryFailedEvent processes event log stored in failed events once again. The failed event is removed
+// in the same unit of work, so a crash in between doesn't apply the event twice
+func (eAPI *EthereumAPI) RetryFailedEvent(ctx context.Context, ccs *contract.Contracts, fe structs.FailedEvent) error {
+	b, err := eAPI.AM.GetBlock(ctx, fe.Log.BlockNumber)
+	if err != nil {
+		return fmt.Errorf("error getting block header: %w", err)
+	}
+
+	ce, err := processLog(eAPI.log, fe.Log, b, ccs)
+	if err != nil {
+		return err
+	}
+
+	ctx, err = eAPI.AM.PrefetchTransactions(ctx, []types.Log{fe.Log}, b.Time)
+	if err != nil {
+		return fmt.Errorf("error fetching transaction: %w", err)
+	}
+
+	c, _ := ccs.GetByAddress(fe.Log.Address)
+	return eAPI.AM.Atomic(ctx, func(ctx context.Context) error {
+		if err := eAPI.AM.AfterEventLog(ctx, c, ce); err != nil {
+			return err
+		}
+		if err := eAPI.AM.DeleteFailedEvent(ctx, fe.ID); err != nil && err != structs.ErrNotFound {
+			return fmt.Errorf("error removing failed event: %w", err)
+		}
+		return nil
+	})
+}
+
 func (eAPI *EthereumAPI) sendIfPossible(ctx context.Context, out chan ProcOutput, po ProcOutput) bool {
 	select {
 	case <-ctx.Done():
@@ -321,7 +414,7 @@ func (eAPI *EthereumAPI) sendIfPossible(ctx context.Context, out chan ProcOutput
 	return false
 }
 
-func processLog(logger *zap.Logger, l types.Log, h types.Header, ccs *contract.Contracts) (ce structs.ContractEvent, err error) {
+func processLog(logger *zap.Logger, l types.Log, b structs.Block, ccs *contract.Contracts) (ce structs.ContractEvent, err error) {
 	c, ok := ccs.GetByAddress(l.Address)
 
 	if !ok {
@@ -355,7 +448,7 @@ func processLog(logger *zap.Logger, l types.Log, h types.Header, ccs *contract.C
 		}
 	}
 
-	logger.Debug("[EthTransport] GetLogs got contract", zap.String("name", c.Name), zap.Uint64("block", l.BlockNumber), zap.Time("blockTime", time.Unix(int64(h.Time), 0)))
+	logger.Debug("[EthTransport] GetLogs got contract", zap.String("name", c.Name), zap.Uint64("block", l.BlockNumber), zap.Time("blockTime", b.Time))
 	mapped := make(map[string]interface{}, len(event.Inputs))
 	if len(l.Data) > 0 {
 		err = event.Inputs.UnpackIntoMap(mapped, l.Data)
@@ -383,90 +476,9 @@ func processLog(logger *zap.Logger, l types.Log, h types.Header, ccs *contract.C
 		EventName:       event.Name,
 		ContractAddress: c.Addr,
 		BlockHeight:     l.BlockNumber,
-		Time:            time.Unix(int64(h.Time), 0),
+		Time:            b.Time,
 		TransactionHash: l.TxHash,
 		Params:          mapped,
 		Removed:         l.Removed,
 	}, nil
 }
-
-type rangeBlockCache struct {
-	c map[rangeInfo]types.Header
-	l sync.RWMutex
-}
-
-type rangeInfo struct {
-	from uint64
-	to   uint64
-}
-
-func newLastBlockCache() rangeBlockCache {
-	return rangeBlockCache{
-		c: make(map[rangeInfo]types.Header),
-	}
-}
-
-func (rbc *rangeBlockCache) Set(r rangeInfo, h types.Header) {
-	rbc.l.Lock()
-	defer rbc.l.Unlock()
-
-	var inMap bool
-	for k := range rbc.c {
-		//inclusive skip
-		if r.from >= k.from && r.to <= k.to {
-			inMap = true
-			if h.Time != 0 {
-				delete(rbc.c, k)
-				rbc.c[rangeInfo{from: h.Number.Uint64(), to: k.to}] = h
-			}
-		} else if k.from == r.to || (k.from == r.to+1) { // left sided - join
-			inMap = true
-			delete(rbc.c, k)
-			if h.Time != 0 {
-				rbc.c[rangeInfo{from: h.Number.Uint64(), to: k.to}] = h
-			} else {
-				rbc.c[rangeInfo{from: r.from, to: k.to}] = h
-			}
-		} else if k.to == r.from || (k.to == r.from-1) { // right sided - join
-			inMap = true
-			delete(rbc.c, k)
-			if h.Time != 0 {
-				rbc.c[rangeInfo{from: h.Number.Uint64(), to: r.to}] = h
-			} else {
-				rbc.c[rangeInfo{from: k.from, to: r.to}] = h
-			}
-		}
-	}
-	if !inMap {
-		if h.Time != 0 {
-			rbc.c[rangeInfo{from: h.Number.Uint64(), to: r.to}] = h
-		} else {
-			rbc.c[rangeInfo{from: r.from, to: r.to}] = h
-		}
-	}
-
-}
-
-func (rbc *rangeBlockCache) Get(bgnBlock uint64) (time.Time, bool) {
-	rbc.l.RLock()
-	defer rbc.l.RUnlock()
-
-	for k, t := range rbc.c {
-		if bgnBlock >= k.from && bgnBlock <= k.to {
-			return time.Unix(int64(t.Time), 0), true
-		}
-	}
-	return time.Time{}, false
-}
-
-func (rbc *rangeBlockCache) GetRange(start, stop uint64) (time.Time, bool) {
-	rbc.l.RLock()
-	defer rbc.l.RUnlock()
-
-	for k, t := range rbc.c {
-		if start >= k.from && stop <= k.to {
-			return time.Unix(int64(t.Time), 0), true
-		}
-	}
-	return time.Time{}, false
-}
@@ -1,145 +1,384 @@
 package scraper
 
 import (
+	"context"
+	"errors"
 	"math/big"
+	"sort"
+	"strings"
 	"testing"
+	"time"
 
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/figment-networks/skale-indexer/scraper/structs"
+	"github.com/figment-networks/skale-indexer/scraper/transport"
+	"github.com/figment-networks/skale-indexer/scraper/transport/eth/contract"
+	"github.com/figment-networks/skale-indexer/store"
+	"github.com/figment-networks/skale-indexer/store/memory"
 	"github.com/stretchr/testify/require"
+	"go.uber.org/zap/zaptest"
 )
 
-func Test_rangeBlockCache_Set(t *testing.T) {
-	type fields struct {
-		c map[rangeInfo]types.Header
+type headersManager struct {
+	blocks map[uint64]structs.Block
+}
+
+func (hm headersManager) Atomic(ctx context.Context, fn func(ctx context.Context) error) error {
+	return fn(ctx)
+}
+
+func (hm headersManager) GetImplementedContractNames() []string {
+	return nil
+}
+
+func (hm headersManager) GetBlock(ctx context.Context, height uint64) (b structs.Block, err error) {
+	b, ok := hm.blocks[height]
+	if !ok {
+		return b, errors.New("header not found")
 	}
-	type args struct {
-		r        rangeInfo
-		h        types.Header
-		expected map[rangeInfo]types.Header
+	return b, nil
+}
+
+func (hm headersManager) GetBlockBefore(ctx context.Context, height uint64) (b structs.Block, err error) {
+	for number, hb := range hm.blocks {
+		if number < height && number >= b.Number {
+			b = hb
+		}
 	}
+	if b.Time.IsZero() {
+		return b, structs.ErrNotFound
+	}
+	return b, nil
+}
+
+func (hm headersManager) Bulk(ctx context.Context, fn func(ctx context.Context) error) error {
+	return fn(ctx)
+}
+
+func (hm headersManager) PrefetchTransactions(ctx context.Context, logs []types.Log, blockTime time.Time) (context.Context, error) {
+	return ctx, nil
+}
+
+func (hm headersManager) AfterEventLog(ctx context.Context, c contract.ContractsContents, ce structs.ContractEvent) error {
+	return nil
+}
+
+func (hm headersManager) StoreFailedEvent(ctx context.Context, l types.Log, ce structs.ContractEvent, processErr error) error {
+	return nil
+}
+
+func (hm headersManager) DeleteFailedEvent(ctx context.Context, id string) error {
+	return nil
+}
+
+func (hm headersManager) DeleteFailedEventOfLog(ctx context.Context, txHash common.Hash, logIndex uint) error {
+	return nil
+}
+
+func (hm headersManager) SyncForBeginningOfEpoch(ctx context.Context, contractVersion string, currentBlock uint64, blockTime time.Time) error {
+	return nil
+}
+
+func TestEthereumAPI_GetHeightAtTime(t *testing.T) {
+	hm := headersManager{blocks: map[uint64]structs.Block{}}
+	for i := uint64(10); i <= 30; i++ {
+		hm.blocks[i] = structs.Block{Number: i, Time: time.Unix(int64(1000+(i-10)*13), 0)}
+	}
+
 	tests := []struct {
-		name   string
-		fields fields
-		args   args
+		name     string
+		time     time.Time
+		from     uint64
+		to       uint64
+		expected uint64
+		err      error
 	}{
 		{
-			name:   "simple add",
-			fields: fields{c: make(map[rangeInfo]types.Header)},
-			args: args{
-				r: rangeInfo{from: 1, to: 20},
-				h: types.Header{},
-				expected: map[rangeInfo]types.Header{
-					{from: 1, to: 20}: types.Header{},
-				},
-			},
+			name:     "exact block time",
+			time:     time.Unix(1000+5*13, 0),
+			from:     10,
+			to:       30,
+			expected: 15,
 		},
 		{
-			name:   "simple add - with header",
-			fields: fields{c: make(map[rangeInfo]types.Header)},
-			args: args{
-				r: rangeInfo{from: 1, to: 20},
-				h: types.Header{Number: big.NewInt(12), Time: 12345},
-				expected: map[rangeInfo]types.Header{
-					{from: 12, to: 20}: types.Header{
-						Number: big.NewInt(12),
-						Time:   12345,
-					},
-				},
-			},
+			name:     "between blocks",
+			time:     time.Unix(1000+7*13+5, 0),
+			from:     10,
+			to:       30,
+			expected: 17,
 		},
 		{
-			name: "join left - empty",
-			fields: fields{c: map[rangeInfo]types.Header{
-				{from: 21, to: 40}: types.Header{},
-			},
-			},
-			args: args{
-				r: rangeInfo{from: 1, to: 20},
-				h: types.Header{},
-				expected: map[rangeInfo]types.Header{
-					{from: 1, to: 40}: types.Header{},
-				},
-			},
+			name:     "after last block",
+			time:     time.Unix(5000, 0),
+			from:     10,
+			to:       30,
+			expected: 30,
 		},
 		{
-			name: "join left - header",
-			fields: fields{c: map[rangeInfo]types.Header{
-				{from: 21, to: 40}: types.Header{},
-			},
-			},
-			args: args{
-				r: rangeInfo{from: 1, to: 20},
-				h: types.Header{Number: big.NewInt(12), Time: 12345},
-				expected: map[rangeInfo]types.Header{
-					{from: 12, to: 40}: types.Header{Number: big.NewInt(12), Time: 12345},
-				},
-			},
+			name:     "narrowed range",
+			time:     time.Unix(1000+12*13, 0),
+			from:     20,
+			to:       25,
+			expected: 22,
 		},
 		{
-			name: "join right - empty",
-			fields: fields{c: map[rangeInfo]types.Header{
-				{from: 1, to: 20}: types.Header{},
-			},
-			},
-			args: args{
-				r: rangeInfo{from: 21, to: 40},
-				h: types.Header{},
-				expected: map[rangeInfo]types.Header{
-					{from: 1, to: 40}: types.Header{},
-				},
-			},
+			name: "before first block",
+			time: time.Unix(999, 0),
+			from: 10,
+			to:   30,
+			err:  structs.ErrNotFound,
 		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			eAPI := NewEthereumAPI(zaptest.NewLogger(t), nil, types.Header{Number: big.NewInt(10), Time: 1000}, hm)
+			height, err := eAPI.GetHeightAtTime(context.Background(), tt.time, tt.from, tt.to)
+			require.Equal(t, tt.err, err)
+			require.Equal(t, tt.expected, height)
+		})
+	}
+}
+
+func TestGroupByBlock(t *testing.T) {
+	tests := []struct {
+		name     string
+		logs     []types.Log
+		expected [][]types.Log
+	}{
 		{
-			name: "join right - header",
-			fields: fields{c: map[rangeInfo]types.Header{
-				{from: 1, to: 20}: {},
-			},
-			},
-			args: args{
-				r: rangeInfo{from: 21, to: 40},
-				h: types.Header{Number: big.NewInt(25), Time: 12345},
-				expected: map[rangeInfo]types.Header{
-					{from: 25, to: 40}: {Number: big.NewInt(25), Time: 12345},
-				},
-			},
+			name: "no logs",
 		},
 		{
-			name: "Intersection",
-			fields: fields{c: map[rangeInfo]types.Header{
-				{from: 1, to: 40}: {},
-			},
-			},
-			args: args{
-				r: rangeInfo{from: 10, to: 30},
-				h: types.Header{},
-				expected: map[rangeInfo]types.Header{
-					{from: 1, to: 40}: {},
-				},
-			},
+			name:     "single block",
+			logs:     []types.Log{{BlockNumber: 10, Index: 1}, {BlockNumber: 10, Index: 2}},
+			expected: [][]types.Log{{{BlockNumber: 10, Index: 1}, {BlockNumber: 10, Index: 2}}},
 		},
 		{
-			name: "Intersection - header",
-			fields: fields{c: map[rangeInfo]types.Header{
-				{from: 1, to: 40}: {},
-			},
-			},
-			args: args{
-				r: rangeInfo{from: 10, to: 30},
-				h: types.Header{Number: big.NewInt(25), Time: 12345},
-				expected: map[rangeInfo]types.Header{
-					{from: 25, to: 40}: {Number: big.NewInt(25), Time: 12345},
-				},
+			name: "many blocks",
+			logs: []types.Log{{BlockNumber: 10, Index: 1}, {BlockNumber: 11, Index: 1}, {BlockNumber: 11, Index: 4}, {BlockNumber: 15, Index: 0}},
+			expected: [][]types.Log{
+				{{BlockNumber: 10, Index: 1}},
+				{{BlockNumber: 11, Index: 1}, {BlockNumber: 11, Index: 4}},
+				{{BlockNumber: 15, Index: 0}},
 			},
 		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			rbc := &rangeBlockCache{
-				c: tt.fields.c,
-			}
-			rbc.Set(tt.args.r, tt.args.h)
-
-			require.Equal(t, tt.args.expected, rbc.c)
+			require.Equal(t, tt.expected, groupByBlock(tt.logs))
 		})
 	}
 }
+
+func TestEthereumAPI_getLastBlockTimeBefore(t *testing.T) {
+	hm := headersManager{blocks: map[uint64]structs.Block{
+		120: {Number: 120, Time: time.Unix(2000, 0)},
+		150: {Number: 150, Time: time.Unix(2300, 0)},
+	}}
+	eAPI := NewEthereumAPI(zaptest.NewLogger(t), nil, types.Header{Number: big.NewInt(100), Time: 1000}, hm)
+
+	blockTime, err := eAPI.getLastBlockTimeBefore(context.Background(), 200, backCheckSlidingWindow, nil)
+	require.NoError(t, err)
+	require.Equal(t, time.Unix(2300, 0), blockTime)
+
+	blockTime, err = eAPI.getLastBlockTimeBefore(context.Background(), 150, backCheckSlidingWindow, nil)
+	require.NoError(t, err)
+	require.Equal(t, time.Unix(2000, 0), blockTime)
+
+	// nothing stored, the range is within the window from the smallest possible block
+	blockTime, err = eAPI.getLastBlockTimeBefore(context.Background(), 110, backCheckSlidingWindow, nil)
+	require.NoError(t, err)
+	require.Equal(t, time.Unix(1000, 0), blockTime)
+}
+
+const pingABI = `[{"anonymous":false,"inputs":[{"indexed":true,"name":"id","type":"uint256"}],"name":"Ping","type":"event"}]`
+
+// logsTransport returns the logs within the requested range, the other calls are not implemented
+type logsTransport struct {
+	transport.EthereumTransport
+	logs []types.Log
+}
+
+func (lt logsTransport) GetLogs(ctx context.Context, from, to big.Int, contracts []common.Address) (logs []types.Log, err error) {
+	for _, l := range lt.logs {
+		if l.BlockNumber >= from.Uint64() && l.BlockNumber <= to.Uint64() {
+			logs = append(logs, l)
+		}
+	}
+	return logs, nil
+}
+
+// storeManager saves processed events to the store, processing of the ones with poisoned ids fails.
+// With failedStoreBroken, failed events cannot be stored either
+type storeManager struct {
+	headersManager
+	ds                *store.Store
+	poison            map[int64]bool
+	failedStoreBroken bool
+}
+
+func (sm storeManager) Atomic(ctx context.Context, fn func(ctx context.Context) error) error {
+	return sm.ds.Atomic(ctx, fn)
+}
+
+func (sm storeManager) Bulk(ctx context.Context, fn func(ctx context.Context) error) error {
+	return sm.ds.Bulk(ctx, fn)
+}
+
+func (sm storeManager) AfterEventLog(ctx context.Context, c contract.ContractsContents, ce structs.ContractEvent) error {
+	if err := sm.ds.SaveContractEvent(ctx, ce); err != nil {
+		return err
+	}
+	if id, ok := ce.Params["id"].(*big.Int); ok && sm.poison[id.Int64()] {
+		return errors.New("reverted")
+	}
+	return nil
+}
+
+func (sm storeManager) StoreFailedEvent(ctx context.Context, l types.Log, ce structs.ContractEvent, processErr error) error {
+	if sm.failedStoreBroken {
+		return errors.New("failed events unavailable")
+	}
+	return sm.ds.SaveFailedEvent(ctx, structs.FailedEvent{
+		BlockHeight:     l.BlockNumber,
+		TransactionHash: l.TxHash,
+		LogIndex:        l.Index,
+		ContractName:    ce.ContractName,
+		EventName:       ce.EventName,
+		Log:             l,
+		Error:           processErr.Error(),
+		Attempts:        1,
+		NextRetry:       time.Now().Add(structs.FailedEventRetryDelay(1)),
+	})
+}
+
+func (sm storeManager) DeleteFailedEventOfLog(ctx context.Context, txHash common.Hash, logIndex uint) error {
+	return sm.ds.DeleteFailedEventOfLog(ctx, txHash, logIndex)
+}
+
+// pingScraper sets up scraper of Ping events, emitted in blocks 10 to 12, backed by memory store
+func pingScraper(t *testing.T, logs []types.Log, poison ...int64) (*EthereumAPI, *contract.Contracts, *store.Store) {
+	a, err := abi.JSON(strings.NewReader(pingABI))
+	require.NoError(t, err)
+	ccs := contract.NewContracts()
+	ccs.SetAllVersions(pingAddress, []contract.ContractsContents{{Name: "ping", Addr: pingAddress, Abi: a, Version: "1.0.0"}})
+
+	sm := storeManager{
+		headersManager: headersManager{blocks: map[uint64]structs.Block{}},
+		ds:             store.New(memory.NewDriver()),
+		poison:         map[int64]bool{},
+	}
+	for i := uint64(10); i <= 12; i++ {
+		sm.blocks[i] = structs.Block{Number: i, Time: time.Unix(int64(1000+i), 0)}
+	}
+	for _, id := range poison {
+		sm.poison[id] = true
+	}
+	return NewEthereumAPI(zaptest.NewLogger(t), logsTransport{logs: logs}, types.Header{Number: big.NewInt(1), Time: 900}, sm), ccs, sm.ds
+}
+
+var pingAddress = common.HexToAddress("0x1f42F0b1aE2aa1d04D4b7c62C2A8A4a8c06D0a6b")
+
+// pingLog is Ping event with the id, emitted in its own transaction
+func pingLog(height uint64, index uint, id int64) types.Log {
+	a, _ := abi.JSON(strings.NewReader(pingABI))
+	return types.Log{
+		Address:     pingAddress,
+		Topics:      []common.Hash{a.Events["Ping"].ID, common.BigToHash(big.NewInt(id))},
+		BlockNumber: height,
+		TxHash:      common.BigToHash(big.NewInt(id)),
+		Index:       index,
+	}
+}
+
+func pingIDs(t *testing.T, ds *store.Store) (ids []int64) {
+	events, err := ds.GetContractEvents(context.Background(), structs.EventParams{ContractName: "ping"})
+	if err == structs.ErrNotFound {
+		return nil
+	}
+	require.NoError(t, err)
+	for _, ce := range events {
+		ids = append(ids, ce.TransactionHash.Big().Int64())
+	}
+	sort.Slice(ids, func(i, j int) bool { return ids[i] < ids[j] })
+	return ids
+}
+
+func TestEthereumAPI_ParseLogsFailedEvent(t *testing.T) {
+	ctx := context.Background()
+	eAPI, ccs, ds := pingScraper(t, []types.Log{pingLog(10, 0, 1), pingLog(10, 1, 2), pingLog(11, 0, 3), pingLog(12, 0, 4)}, 2)
+
+	require.NoError(t, eAPI.ParseLogs(ctx, ccs, "task", *big.NewInt(10), *big.NewInt(12)))
+
+	// the failing event is rolled back alone, the rest of the range is stored
+	require.Equal(t, []int64{1, 3, 4}, pingIDs(t, ds))
+	failed, err := ds.GetFailedEvents(ctx, structs.FailedEventParams{})
+	require.NoError(t, err)
+	require.Len(t, failed, 1)
+	require.Equal(t, uint64(10), failed[0].BlockHeight)
+	require.Equal(t, uint(1), failed[0].LogIndex)
+	require.Equal(t, "reverted", failed[0].Error)
+	require.Equal(t, uint64(1), failed[0].Attempts)
+
+	// scraping the range again counts another attempt
+	require.NoError(t, eAPI.ParseLogs(ctx, ccs, "task", *big.NewInt(10), *big.NewInt(12)))
+	failed, err = ds.GetFailedEvents(ctx, structs.FailedEventParams{})
+	require.NoError(t, err)
+	require.Len(t, failed, 1)
+	require.Equal(t, uint64(2), failed[0].Attempts)
+}
+
+func TestEthereumAPI_ParseLogsFailedEventProcessed(t *testing.T) {
+	ctx := context.Background()
+	eAPI, ccs, ds := pingScraper(t, []types.Log{pingLog(10, 0, 1), pingLog(10, 1, 2), pingLog(11, 0, 3)}, 2)
+
+	require.NoError(t, eAPI.ParseLogs(ctx, ccs, "task", *big.NewInt(10), *big.NewInt(11)))
+	failed, err := ds.GetFailedEvents(ctx, structs.FailedEventParams{})
+	require.NoError(t, err)
+	require.Len(t, failed, 1)
+
+	// once the event succeeds when the range is scraped again, it's not failed anymore
+	delete(eAPI.AM.(storeManager).poison, 2)
+	require.NoError(t, eAPI.ParseLogs(ctx, ccs, "task", *big.NewInt(10), *big.NewInt(11)))
+	require.Equal(t, []int64{1, 2, 3}, pingIDs(t, ds))
+	failed, err = ds.GetFailedEvents(ctx, structs.FailedEventParams{})
+	require.NoError(t, err)
+	require.Empty(t, failed)
+}
+
+func TestEthereumAPI_ParseLogsUndecodableEvent(t *testing.T) {
+	ctx := context.Background()
+	unknown := pingLog(10, 1, 2)
+	unknown.Topics[0] = common.HexToHash("0x01")
+	eAPI, ccs, ds := pingScraper(t, []types.Log{pingLog(10, 0, 1), unknown, pingLog(11, 0, 3)})
+
+	require.NoError(t, eAPI.ParseLogs(ctx, ccs, "task", *big.NewInt(10), *big.NewInt(11)))
+
+	// the log of unknown event is moved to failed events, the rest of the block is stored
+	require.Equal(t, []int64{1, 3}, pingIDs(t, ds))
+	failed, err := ds.GetFailedEvents(ctx, structs.FailedEventParams{})
+	require.NoError(t, err)
+	require.Len(t, failed, 1)
+	require.Equal(t, uint64(10), failed[0].BlockHeight)
+	require.Equal(t, uint(1), failed[0].LogIndex)
+	require.Equal(t, "ping", failed[0].ContractName)
+	require.Equal(t, unknown.Topics, failed[0].Log.Topics)
+}
+
+func TestEthereumAPI_ParseLogsBlockRollback(t *testing.T) {
+	ctx := context.Background()
+	eAPI, ccs, ds := pingScraper(t, []types.Log{pingLog(10, 0, 1), pingLog(10, 1, 2)}, 2)
+	sm := eAPI.AM.(storeManager)
+	sm.failedStoreBroken = true
+	eAPI.AM = sm
+
+	require.Error(t, eAPI.ParseLogs(ctx, ccs, "task", *big.NewInt(10), *big.NewInt(10)))
+
+	// the event processed before the failure in the same block is rolled back with it
+	require.Empty(t, pingIDs(t, ds))
+	failed, err := ds.GetFailedEvents(ctx, structs.FailedEventParams{})
+	require.NoError(t, err)
+	require.Empty(t, failed)
+}
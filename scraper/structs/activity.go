@@ -0,0 +1,37 @@
+package structs
+
+import (
+	"time"
+)
+
+// ActivityKind is the kind of record in address activity
+type ActivityKind string
+
+const (
+	ActivityKindContractEvent ActivityKind = "contract_event"
+	ActivityKindDelegation    ActivityKind = "delegation"
+	ActivityKindNode          ActivityKind = "node"
+	ActivityKindSystemEvent   ActivityKind = "system_event"
+)
+
+// ActivityKinds lists every kind of record in address activity
+var ActivityKinds = []ActivityKind{ActivityKindContractEvent, ActivityKindDelegation, ActivityKindNode, ActivityKindSystemEvent}
+
+// Activity is a record touching the address. Only the record of its kind is set
+type Activity struct {
+	Kind        ActivityKind
+	ID          string
+	BlockHeight uint64
+	// Time is the time of the block, zero when the block is not stored
+	Time time.Time
+
+	ContractEvent *ContractEvent
+	Delegation    *Delegation
+	Node          *NodeChange
+	SystemEvent   *SystemEvent
+}
+
+// Cursor is the position of activity record
+func (a Activity) Cursor() Cursor {
+	return Cursor{BlockHeight: a.BlockHeight, ID: a.ID}
+}
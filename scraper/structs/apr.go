@@ -0,0 +1,60 @@
+package structs
+
+import (
+	"math/big"
+	"time"
+)
+
+const (
+	DefaultAPRWindow = 30 * 24 * time.Hour
+	// EpochsPerYear is the number of monthly epochs bounty is paid for, APY compounds the bounty once per epoch
+	EpochsPerYear = 12
+)
+
+// APRParams selects the time range the realised APR is computed over, the whole network when ValidatorID is empty
+type APRParams struct {
+	ValidatorID string
+
+	TimeFrom time.Time
+	TimeTo   time.Time
+}
+
+// APR is the realised return of delegations within the time range, with the inputs of the formula:
+//
+//	DelegatorsBounty = Bounty - Fee
+//	APR = DelegatorsBounty / EffectiveStake * year / (TimeTo - TimeFrom)
+//	APR of period = APR * period multiplier / 100
+//	APY of period = (1 + APR of period / EpochsPerYear) ^ EpochsPerYear - 1
+type APR struct {
+	// ValidatorID is nil for the network
+	ValidatorID *big.Int  `json:"validator_id"`
+	TimeFrom    time.Time `json:"time_from"`
+	TimeTo      time.Time `json:"time_to"`
+
+	// Bounty is the sum of bounties paid to nodes, NodeEpochs is the number of these payments
+	Bounty             *big.Int `json:"bounty"`
+	NodeEpochs         uint64   `json:"node_epochs"`
+	BountyPerNodeEpoch *big.Int `json:"bounty_per_node_epoch"`
+	// Fee is the part of bounty taken by validators, at the fee rate in effect when bounty was paid. FeeRate is the realised fee, per mille of bounty
+	Fee              *big.Int `json:"fee"`
+	FeeRate          *big.Int `json:"fee_rate"`
+	DelegatorsBounty *big.Int `json:"delegators_bounty"`
+
+	// EffectiveStake is the mean of effective stake at the beginning and at the end of the range,
+	// the one at the end when nothing was indexed by the beginning
+	EffectiveStakeFrom *big.Int `json:"effective_stake_from"`
+	EffectiveStakeTo   *big.Int `json:"effective_stake_to"`
+	EffectiveStake     *big.Int `json:"effective_stake"`
+
+	// APR is the return of the stake with multiplier of 100%
+	APR     float64     `json:"apr"`
+	Periods []PeriodAPR `json:"periods"`
+}
+
+// PeriodAPR is the return of delegation with given period in months
+type PeriodAPR struct {
+	Months     uint64  `json:"months"`
+	Multiplier int64   `json:"multiplier"`
+	APR        float64 `json:"apr"`
+	APY        float64 `json:"apy"`
+}
@@ -0,0 +1,14 @@
+package structs
+
+import (
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+)
+
+type Block struct {
+	Number     uint64      `json:"number"`
+	Hash       common.Hash `json:"hash"`
+	ParentHash common.Hash `json:"parent_hash"`
+	Time       time.Time   `json:"time"`
+}
@@ -0,0 +1,46 @@
+package structs
+
+import (
+	"math/big"
+	"time"
+)
+
+// UnlockCalendarParams filters delegations of the calendar, TimeFrom and TimeTo bound the unlock dates
+type UnlockCalendarParams struct {
+	ValidatorID string
+	Holder      string
+
+	TimeFrom time.Time
+	TimeTo   time.Time
+}
+
+// UnlockCalendar sums up amounts of delegations which reach the end of their period per day and per month
+type UnlockCalendar struct {
+	Days        []UnlockPeriod        `json:"days"`
+	Months      []UnlockPeriod        `json:"months"`
+	Projections []StakeDropProjection `json:"projections"`
+}
+
+// UnlockPeriod are the amounts unlocked at the day or within the month starting at Start.
+// Undelegated are the amounts of delegations with undelegation requested, which leave at the end of their period;
+// PeriodEnding the ones of delegations which are renewed unless undelegation is requested before their period ends
+type UnlockPeriod struct {
+	Start        time.Time `json:"start"`
+	Undelegated  *big.Int  `json:"undelegated"`
+	PeriodEnding *big.Int  `json:"period_ending"`
+	Delegations  uint64    `json:"delegations"`
+}
+
+// StakeDropProjection is the stake validator loses at the beginning of the next month.
+// ExpectedDrop is certain (undelegation is requested), AtRisk may still be undelegated as the period ends this month
+type StakeDropProjection struct {
+	ValidatorID *big.Int `json:"validator_id"`
+	Stake       *big.Int `json:"stake"`
+	// ExpectedDrop is the amount leaving, ExpectedDropShare its share of the stake
+	ExpectedDrop      *big.Int `json:"expected_drop"`
+	ExpectedDropShare float64  `json:"expected_drop_share"`
+	AtRisk            *big.Int `json:"at_risk"`
+	// LargestLeaving is the largest of the delegations leaving
+	LargestLeaving *big.Int `json:"largest_leaving"`
+	Leaving        uint64   `json:"leaving"`
+}
@@ -1,7 +1,11 @@
 package structs
 
 import (
+	"bytes"
+	"encoding/json"
 	"math/big"
+	"strconv"
+	"strings"
 	"time"
 
 	"github.com/ethereum/go-ethereum/common"
@@ -22,3 +26,69 @@ type ContractEvent struct {
 	BoundID         []big.Int              `json:"bound_id"`
 	BoundAddress    []common.Address       `json:"bound_address"`
 }
+
+// Key identifies event the same way stores do when it's saved again
+func (ce ContractEvent) Key() string {
+	return strconv.FormatUint(ce.BlockHeight, 10) + "/" + ce.ContractAddress.Hex() + "/" +
+		ce.EventName + "/" + ce.TransactionHash.Hex() + "/" + strconv.FormatBool(ce.Removed)
+}
+
+// EventParamValues lists JSON encodings the decoded event parameter given as text may be stored with.
+// Parameters are decoded to strings, lower case hex (addresses, hashes, bytes), numbers and booleans,
+// so the text is matched with each of them it can represent
+func EventParamValues(v string) (values []json.RawMessage) {
+	add := func(value interface{}) {
+		b, _ := json.Marshal(value)
+		values = append(values, b)
+	}
+
+	add(v)
+	if lower := strings.ToLower(v); strings.HasPrefix(lower, "0x") && lower != v {
+		add(lower)
+	}
+	if n, ok := new(big.Int).SetString(v, 10); ok {
+		add(n)
+	}
+	if v == "true" || v == "false" {
+		add(v == "true")
+	}
+	return values
+}
+
+// DecodeEventParams decodes stored event parameters. Numbers are kept as json.Number, as amounts exceed the precision of float
+func DecodeEventParams(b []byte) (params map[string]interface{}, err error) {
+	dec := json.NewDecoder(bytes.NewReader(b))
+	dec.UseNumber()
+	err = dec.Decode(&params)
+	return params, err
+}
+
+// FloatEventParams returns parameters with numbers converted to float, the way REST and GraphQL responses carry them
+func FloatEventParams(params map[string]interface{}) map[string]interface{} {
+	if params == nil {
+		return nil
+	}
+	fp := make(map[string]interface{}, len(params))
+	for k, v := range params {
+		fp[k] = floatParam(v)
+	}
+	return fp
+}
+
+func floatParam(v interface{}) interface{} {
+	switch p := v.(type) {
+	case json.Number:
+		if f, err := p.Float64(); err == nil {
+			return f
+		}
+	case []interface{}:
+		fl := make([]interface{}, len(p))
+		for i, e := range p {
+			fl[i] = floatParam(e)
+		}
+		return fl
+	case map[string]interface{}:
+		return FloatEventParams(p)
+	}
+	return v
+}
@@ -0,0 +1,40 @@
+package structs
+
+import (
+	"math/big"
+	"time"
+)
+
+// Decentralization are metrics of distribution of stake between validators at the beginning of the epoch,
+// computed from the validators with any stake
+type Decentralization struct {
+	Epoch       uint64    `json:"epoch"`
+	Time        time.Time `json:"time"`
+	BlockHeight uint64    `json:"block_height"`
+	Validators  uint64    `json:"validators"`
+	TotalStake  *big.Int  `json:"total_stake"`
+
+	// NakamotoCoefficient is the smallest number of validators controlling more than a third of stake, enough to halt consensus
+	NakamotoCoefficient uint64 `json:"nakamoto_coefficient"`
+	// Gini is 0 when stake is split evenly, approaching 1 as one validator holds all of it
+	Gini float64 `json:"gini"`
+
+	// TopNShare are the shares of stake of the largest 1, 5 and 10 validators
+	Top1Share  float64 `json:"top1_share"`
+	Top5Share  float64 `json:"top5_share"`
+	Top10Share float64 `json:"top10_share"`
+
+	// ValidatorsOverN are the smallest numbers of validators controlling more than 33%, 50% and 66% of stake
+	ValidatorsOver33 uint64 `json:"validators_over_33"`
+	ValidatorsOver50 uint64 `json:"validators_over_50"`
+	ValidatorsOver66 uint64 `json:"validators_over_66"`
+}
+
+// DecentralizationParams bound epochs of metrics, zero EpochTo is not bounded
+type DecentralizationParams struct {
+	EpochFrom uint64
+	EpochTo   uint64
+
+	Limit  uint64
+	Offset uint64
+}
@@ -29,6 +29,33 @@ type Delegation struct {
 	ValidatorName string `json:"validator_name"`
 }
 
+// Until is the last day of the month delegation period ends in, the value of `until` column of stores
+func (d Delegation) Until() time.Time {
+	created := d.Created.UTC()
+	var period uint64
+	if d.DelegationPeriod != nil {
+		period = d.DelegationPeriod.Uint64()
+	}
+	return time.Date(created.Year(), created.Month()+time.Month(1+period), 1, 0, 0, 0, 0, time.UTC).AddDate(0, 0, -1)
+}
+
+// epochZeroYear is the year months of TimeHelpers contract are counted from
+const epochZeroYear = 2020
+
+// EpochStart is the beginning of epoch, the month delegations are started and finished at
+func EpochStart(epoch uint64) time.Time {
+	return time.Date(epochZeroYear, time.Month(epoch+1), 1, 0, 0, 0, 0, time.UTC)
+}
+
+// EpochAt is the epoch of the time, zero before the first one
+func EpochAt(t time.Time) uint64 {
+	t = t.UTC()
+	if t.Year() < epochZeroYear {
+		return 0
+	}
+	return uint64((t.Year()-epochZeroYear)*12 + int(t.Month()) - 1)
+}
+
 type DelegationState uint
 
 const (
@@ -85,6 +112,10 @@ func DelegationStateFromString(s string) DelegationState {
 	}
 }
 
+// DelegationPeriodMultipliers are stake multipliers (in percents) of delegation periods in months, as set in DelegationPeriodManager.
+// Bounty is split between delegators in proportion to their effective stake, the amount multiplied by the period multiplier
+var DelegationPeriodMultipliers = map[uint64]int64{2: 100, 3: 100, 6: 150, 12: 200}
+
 type DelegationSummary struct {
 	Count  *big.Int        `json:"count"`
 	Amount *big.Int        `json:"amount"`
@@ -6,4 +6,6 @@ var (
 	ErrNotAllowedMethod = errors.New("method not allowed")
 	ErrMissingParameter = errors.New("missing parameter")
 	ErrNotFound         = errors.New("record not found")
+	ErrSeriesTooLong    = errors.New("too many buckets in the time range")
+	ErrCursorOrder      = errors.New("cursor is supported with the default order only")
 )
@@ -0,0 +1,41 @@
+package structs
+
+import (
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+)
+
+// FailedEvent is an event log which failed post-processing and waits in dead-letter queue
+type FailedEvent struct {
+	ID              string      `json:"id"`
+	CreatedAt       time.Time   `json:"created_at"`
+	UpdatedAt       time.Time   `json:"updated_at"`
+	BlockHeight     uint64      `json:"block_height"`
+	TransactionHash common.Hash `json:"transaction_hash"`
+	LogIndex        uint        `json:"log_index"`
+	ContractName    string      `json:"contract_name"`
+	EventName       string      `json:"event_name"`
+	Log             types.Log   `json:"log"`
+	Error           string      `json:"error"`
+	Attempts        uint64      `json:"attempts"`
+	NextRetry       time.Time   `json:"next_retry"`
+}
+
+const (
+	failedEventRetryBase = time.Minute
+	failedEventRetryMax  = 6 * time.Hour
+)
+
+// FailedEventRetryDelay returns exponential backoff delay before the next retry of event that failed given number of times
+func FailedEventRetryDelay(attempts uint64) time.Duration {
+	d := failedEventRetryBase
+	for i := uint64(1); i < attempts; i++ {
+		d *= 2
+		if d >= failedEventRetryMax {
+			return failedEventRetryMax
+		}
+	}
+	return d
+}
@@ -64,3 +64,22 @@ func (k NodeStatus) String() string {
 		return "unknown"
 	}
 }
+
+// NodeChange is the state of node from the block it was changed at until the following change
+type NodeChange struct {
+	Node
+	// ValidTo is the height of the following change, zero for the current state
+	ValidTo uint64 `json:"valid_to"`
+	// Time is the time of the block node was changed at, zero when the block is not stored
+	Time time.Time `json:"time"`
+}
+
+// NodeMaintenance sums up the time node spent in maintenance
+type NodeMaintenance struct {
+	NodeID      *big.Int `json:"node_id"`
+	ValidatorID *big.Int `json:"validator_id"`
+	// Tracked is the time the state of node is known for
+	Tracked     time.Duration `json:"tracked"`
+	Maintenance time.Duration `json:"maintenance"`
+	Periods     uint64        `json:"periods"`
+}
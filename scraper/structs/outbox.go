@@ -0,0 +1,60 @@
+package structs
+
+import (
+	"encoding/json"
+	"strconv"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// outboxNamespace is the namespace of message ids
+var outboxNamespace = uuid.MustParse("4f8a7c3e-2d61-4b0e-9a57-6c1d3e8b2f90")
+
+// OutboxTopic is the kind of record outbox message carries
+type OutboxTopic string
+
+const (
+	OutboxTopicContractEvent      OutboxTopic = "contract_event"
+	OutboxTopicSystemEvent        OutboxTopic = "system_event"
+	OutboxTopicValidator          OutboxTopic = "validator"
+	OutboxTopicDelegation         OutboxTopic = "delegation"
+	OutboxTopicNode               OutboxTopic = "node"
+	OutboxTopicAccount            OutboxTopic = "account"
+	OutboxTopicValidatorStatistic OutboxTopic = "validator_statistic"
+)
+
+// OutboxMessage is indexed record saved together with it, waiting to be published to message bus.
+// Messages are published at least once, ID is increasing and orders them
+type OutboxMessage struct {
+	ID uint64 `json:"id"`
+	// MessageID identifies the message for deduplication, the same record indexed again keeps it
+	MessageID string      `json:"message_id"`
+	CreatedAt time.Time   `json:"created_at"`
+	Topic     OutboxTopic `json:"topic"`
+	// Key identifies the record within topic, updates of the same record share it
+	Key     string          `json:"key"`
+	Height  uint64          `json:"height"`
+	Payload json.RawMessage `json:"payload"`
+}
+
+// NewOutboxMessage creates message of the record. Its id is derived from topic, key and height of the record
+// and the origin of change (e.g. the event causing it), so the same change indexed again keeps it
+func NewOutboxMessage(topic OutboxTopic, key string, height uint64, origin string, payload json.RawMessage) OutboxMessage {
+	name := make([]byte, 0, len(topic)+len(key)+len(origin)+24)
+	name = append(name, topic...)
+	name = append(name, 0)
+	name = append(name, key...)
+	name = append(name, 0)
+	name = strconv.AppendUint(name, height, 10)
+	name = append(name, 0)
+	name = append(name, origin...)
+
+	return OutboxMessage{
+		MessageID: uuid.NewSHA1(outboxNamespace, name).String(),
+		Topic:     topic,
+		Key:       key,
+		Height:    height,
+		Payload:   payload,
+	}
+}
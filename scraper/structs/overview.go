@@ -0,0 +1,61 @@
+package structs
+
+import (
+	"math/big"
+	"time"
+)
+
+// NetworkOverview are network figures at the time, with the ones a month before and the change since.
+// MonthAgo and Change are nil when there is no block indexed a month before
+type NetworkOverview struct {
+	Time        time.Time      `json:"time"`
+	BlockHeight uint64         `json:"block_height"`
+	Current     NetworkFigures `json:"current"`
+
+	MonthAgoTime        time.Time       `json:"month_ago_time"`
+	MonthAgoBlockHeight uint64          `json:"month_ago_block_height"`
+	MonthAgo            *NetworkFigures `json:"month_ago"`
+	Change              *NetworkFigures `json:"change"`
+}
+
+// NetworkFigures sum up the state of network. Counts are signed, as change of figures is expressed with the same structure.
+// Stake and delegators are taken from delegations which are delegated (undelegation requested included).
+// Token supply is the amount minted less the amount burned, from transfers of token from and to zero address
+type NetworkFigures struct {
+	TotalStake     *big.Int `json:"total_stake"`
+	EffectiveStake *big.Int `json:"effective_stake"`
+
+	Validators           int64 `json:"validators"`
+	AuthorizedValidators int64 `json:"authorized_validators"`
+	AcceptingValidators  int64 `json:"accepting_validators"`
+
+	ActiveNodes  int64 `json:"active_nodes"`
+	LeavingNodes int64 `json:"leaving_nodes"`
+	LeftNodes    int64 `json:"left_nodes"`
+
+	Delegators  int64 `json:"delegators"`
+	SkaleChains int64 `json:"skale_chains"`
+
+	Slashed     *big.Int `json:"slashed"`
+	Forgiven    *big.Int `json:"forgiven"`
+	TokenSupply *big.Int `json:"token_supply"`
+}
+
+// Sub returns the difference of figures
+func (f NetworkFigures) Sub(o NetworkFigures) NetworkFigures {
+	return NetworkFigures{
+		TotalStake:           new(big.Int).Sub(f.TotalStake, o.TotalStake),
+		EffectiveStake:       new(big.Int).Sub(f.EffectiveStake, o.EffectiveStake),
+		Validators:           f.Validators - o.Validators,
+		AuthorizedValidators: f.AuthorizedValidators - o.AuthorizedValidators,
+		AcceptingValidators:  f.AcceptingValidators - o.AcceptingValidators,
+		ActiveNodes:          f.ActiveNodes - o.ActiveNodes,
+		LeavingNodes:         f.LeavingNodes - o.LeavingNodes,
+		LeftNodes:            f.LeftNodes - o.LeftNodes,
+		Delegators:           f.Delegators - o.Delegators,
+		SkaleChains:          f.SkaleChains - o.SkaleChains,
+		Slashed:              new(big.Int).Sub(f.Slashed, o.Slashed),
+		Forgiven:             new(big.Int).Sub(f.Forgiven, o.Forgiven),
+		TokenSupply:          new(big.Int).Sub(f.TokenSupply, o.TokenSupply),
+	}
+}
@@ -0,0 +1,59 @@
+package structs
+
+import (
+	"math/big"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// Portfolio sums up delegations of the holder.
+// Locked are the amounts of delegations which are proposed, accepted, delegated or requested to be undelegated,
+// Unlocked the ones of completed delegations; Pending lists delegations waiting for acceptance or for their first epoch
+type Portfolio struct {
+	Holder   common.Address `json:"holder"`
+	Locked   *big.Int       `json:"locked"`
+	Unlocked *big.Int       `json:"unlocked"`
+	// Earned is estimated from bounty of validator nodes, split in proportion to effective stake of delegations active in the epoch
+	Earned    *big.Int `json:"earned"`
+	Withdrawn *big.Int `json:"withdrawn"`
+
+	Validators []PortfolioValidator `json:"validators"`
+	Pending    []Delegation         `json:"pending"`
+	// Unlocks are the dates delegations which are locked reach the end of their period, the earliest first
+	Unlocks []DelegationUnlock `json:"unlocks"`
+	Epochs  []PortfolioEpoch   `json:"epochs"`
+}
+
+// PortfolioValidator are delegations of the holder to validator grouped by state
+type PortfolioValidator struct {
+	ValidatorID   *big.Int         `json:"validator_id"`
+	ValidatorName string           `json:"validator_name"`
+	Earned        *big.Int         `json:"earned"`
+	Withdrawn     *big.Int         `json:"withdrawn"`
+	States        []PortfolioState `json:"states"`
+}
+
+// PortfolioState are delegations in the same state
+type PortfolioState struct {
+	State       DelegationState `json:"state"`
+	Amount      *big.Int        `json:"amount"`
+	Delegations []Delegation    `json:"delegations"`
+}
+
+// DelegationUnlock is the end of the period of delegation
+type DelegationUnlock struct {
+	DelegationID *big.Int        `json:"delegation_id"`
+	ValidatorID  *big.Int        `json:"validator_id"`
+	Amount       *big.Int        `json:"amount"`
+	State        DelegationState `json:"state"`
+	Until        time.Time       `json:"until"`
+}
+
+// PortfolioEpoch is the amount delegated by the holder in the epoch and the bounty it earned
+type PortfolioEpoch struct {
+	Epoch  uint64    `json:"epoch"`
+	Start  time.Time `json:"start"`
+	Value  *big.Int  `json:"value"`
+	Earned *big.Int  `json:"earned"`
+}
@@ -2,6 +2,8 @@ package structs
 
 import (
 	"time"
+
+	"github.com/ethereum/go-ethereum/common"
 )
 
 const Layout = time.RFC3339
@@ -14,14 +16,62 @@ const (
 	StateFalse
 )
 
+// Cursor is the position of record in list ordered by block height and id, the latest first.
+// Page requested with it starts right after that record, so it's not shifted by the records added meanwhile
+type Cursor struct {
+	BlockHeight uint64
+	ID          string
+}
+
+// IsZero reports whether cursor is not set
+func (c Cursor) IsZero() bool {
+	return c.ID == ""
+}
+
+// Precedes reports whether record at the position is listed before the one at o
+func (c Cursor) Precedes(o Cursor) bool {
+	return c.BlockHeight > o.BlockHeight || (c.BlockHeight == o.BlockHeight && c.ID > o.ID)
+}
+
+// KeyCursor is the position of record in list of the current state, ordered by its key: the id of validator, node
+// or delegation, or the time of creation and address of account.
+// Page requested with it starts right after that record, so it's not shifted by the records added meanwhile
+type KeyCursor struct {
+	Key  string
+	Time time.Time
+}
+
+// IsZero reports whether cursor is not set
+func (c KeyCursor) IsZero() bool {
+	return c.Key == ""
+}
+
 type EventParams struct {
 	Id       uint64
 	Type     string
 	TimeFrom time.Time
 	TimeTo   time.Time
 
+	ContractName string
+	EventName    string
+	// Params filters by decoded parameters, values are matched with any of EventParamValues
+	Params map[string]string
+
+	// HeightFrom and HeightTo are inclusive, zero is not bounded
+	HeightFrom uint64
+	HeightTo   uint64
+
+	// BoundAddress filters events bound to the address (holder, validator, node...)
+	BoundAddress common.Address
+
+	TransactionHash common.Hash
+
+	AtHeight uint64
+
 	Limit  uint64
 	Offset uint64
+	// Cursor takes precedence over offset
+	Cursor Cursor
 }
 
 type DelegationParams struct {
@@ -29,13 +79,22 @@ type DelegationParams struct {
 	DelegationID string
 	Holder       string
 	State        []DelegationState
+	// ValidatorIDs and Holders match any of listed values, they're used by the latest state query only
+	ValidatorIDs []string
+	Holders      []string
 	TimeAt       time.Time
 
 	TimeFrom time.Time
 	TimeTo   time.Time
 
+	AtHeight uint64
+
 	Limit  uint64
 	Offset uint64
+	// Cursor takes precedence over offset, it's supported by timeline only
+	Cursor Cursor
+	// KeyCursor takes precedence over offset, it's supported by the latest state query only
+	KeyCursor KeyCursor
 }
 
 type NodeParams struct {
@@ -44,6 +103,40 @@ type NodeParams struct {
 	Status      string
 	Address     string
 
+	AtHeight uint64
+
+	Limit  uint64
+	Offset uint64
+	// Cursor takes precedence over offset
+	Cursor KeyCursor
+}
+
+// ActivityParams selects records touching the address, all kinds of them when Kinds are empty
+type ActivityParams struct {
+	Address common.Address
+	Kinds   []ActivityKind
+
+	Limit  uint64
+	Cursor Cursor
+}
+
+type NodeMaintenanceParams struct {
+	NodeID      string
+	ValidatorID string
+
+	TimeFrom time.Time
+	TimeTo   time.Time
+}
+
+// ValidatorRankingParams configures the ranking. Validators are ranked by the score, or by a single metric when OrderBy is set;
+// metrics missing from Weights weigh zero, all of them weigh one when Weights are empty
+type ValidatorRankingParams struct {
+	OrderBy RankingMetric
+	Weights map[RankingMetric]float64
+
+	GrowthWindow time.Duration
+	UptimeWindow time.Duration
+
 	Limit  uint64
 	Offset uint64
 }
@@ -54,6 +147,8 @@ type AccountParams struct {
 
 	Limit  uint64
 	Offset uint64
+	// Cursor takes precedence over offset
+	Cursor KeyCursor
 }
 
 type ValidatorParams struct {
@@ -65,6 +160,19 @@ type ValidatorParams struct {
 	TimeTo         time.Time
 	Address        string
 
+	AtHeight uint64
+
+	Limit  uint64
+	Offset uint64
+	// Cursor takes precedence over offset, it's supported with the default order only
+	Cursor KeyCursor
+}
+
+type ValidatorAddressParams struct {
+	ValidatorID string
+	// Address is matched with both the address and the requested one
+	Address string
+
 	Limit  uint64
 	Offset uint64
 }
@@ -77,6 +185,8 @@ type ValidatorStatisticsParams struct {
 	TimeFrom    time.Time
 	TimeTo      time.Time
 
+	AtHeight uint64
+
 	Limit  uint64
 	Offset uint64
 }
@@ -90,6 +200,45 @@ type SystemEventParams struct {
 	SenderID    uint64
 	ReceiverID  uint64
 
+	AtHeight uint64
+
+	Limit  uint64
+	Offset uint64
+	// Cursor takes precedence over offset
+	Cursor Cursor
+}
+
+type FailedEventParams struct {
+	ID          string
+	RetryBefore time.Time
+	MaxAttempts uint64
+
+	Limit  uint64
+	Offset uint64
+}
+
+type WebhookParams struct {
+	ID string
+	// Active lists only webhooks events are delivered to
+	Active bool
+
+	Limit  uint64
+	Offset uint64
+}
+
+type WebhookDeliveryParams struct {
+	ID        string
+	WebhookID string
+	Status    WebhookDeliveryStatus
+	// RetryBefore lists deliveries due at the time, the earliest first. Otherwise the latest created are listed first
+	RetryBefore time.Time
+
 	Limit  uint64
 	Offset uint64
 }
+
+type OutboxParams struct {
+	// After lists messages following the one with the id
+	After uint64
+	Limit uint64
+}
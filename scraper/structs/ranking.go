@@ -0,0 +1,58 @@
+package structs
+
+import (
+	"math/big"
+	"time"
+)
+
+// RankingMetric is a metric validators are ranked by
+type RankingMetric string
+
+const (
+	RankingTotalStake     RankingMetric = "total_stake"
+	RankingEffectiveStake RankingMetric = "effective_stake"
+	RankingDelegators     RankingMetric = "delegators"
+	RankingFee            RankingMetric = "fee"
+	RankingActiveNodes    RankingMetric = "active_nodes"
+	RankingUptime         RankingMetric = "uptime"
+	RankingStakeGrowth    RankingMetric = "stake_growth"
+)
+
+// RankingMetrics lists all of the ranking metrics
+var RankingMetrics = []RankingMetric{
+	RankingTotalStake,
+	RankingEffectiveStake,
+	RankingDelegators,
+	RankingFee,
+	RankingActiveNodes,
+	RankingUptime,
+	RankingStakeGrowth,
+}
+
+const (
+	DefaultRankingGrowthWindow = 30 * 24 * time.Hour
+	DefaultRankingUptimeWindow = 30 * 24 * time.Hour
+)
+
+// ValidatorRank is the position of validator in the ranking with the metrics it is computed from
+type ValidatorRank struct {
+	Rank        uint64   `json:"rank"`
+	ValidatorID *big.Int `json:"validator_id"`
+	Name        string   `json:"name"`
+	// Score is the weighted mean of normalized metrics, between 0 and 1
+	Score float64 `json:"score"`
+	// Scores are the normalized metrics, between 0 and 1, higher being better (the lowest fee scores 1)
+	Scores map[RankingMetric]float64 `json:"scores"`
+
+	TotalStake *big.Int `json:"total_stake"`
+	// EffectiveStake is the stake of active delegations multiplied by the bonus of their delegation period
+	EffectiveStake *big.Int `json:"effective_stake"`
+	Delegators     uint64   `json:"delegators"`
+	FeeRate        *big.Int `json:"fee_rate"`
+	ActiveNodes    uint     `json:"active_nodes"`
+	// Uptime is the share of time validator nodes were not in maintenance within the uptime window, zero without tracked nodes
+	Uptime float64 `json:"uptime"`
+	// StakeGrowth is the change of total stake within the growth window, StakeGrowthRate relative to the stake at its beginning
+	StakeGrowth     *big.Int `json:"stake_growth"`
+	StakeGrowthRate float64  `json:"stake_growth_rate"`
+}
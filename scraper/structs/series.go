@@ -0,0 +1,99 @@
+package structs
+
+import (
+	"math/big"
+	"time"
+)
+
+// SeriesInterval is the length of buckets of statistics series
+type SeriesInterval string
+
+const (
+	SeriesIntervalDay   SeriesInterval = "day"
+	SeriesIntervalWeek  SeriesInterval = "week"
+	SeriesIntervalMonth SeriesInterval = "month"
+)
+
+const (
+	// DefaultSeriesWindow is the range of series when its beginning is not set
+	DefaultSeriesWindow = 365 * 24 * time.Hour
+	// MaxSeriesBuckets limits the length of series
+	MaxSeriesBuckets = 1500
+)
+
+// SeriesAveraged are the statistic types series can be made of. Network series of the ones set to true
+// is the mean of validator values, the sum for the others
+var SeriesAveraged = map[StatisticTypeVS]bool{
+	ValidatorStatisticsTypeTotalStake:  false,
+	ValidatorStatisticsTypeActiveNodes: false,
+	ValidatorStatisticsTypeLinkedNodes: false,
+	ValidatorStatisticsTypeAuthorized:  false,
+	ValidatorStatisticsTypeMDR:         true,
+	ValidatorStatisticsTypeFee:         true,
+}
+
+// GetSeriesInterval returns interval by its name
+func GetSeriesInterval(s string) (SeriesInterval, bool) {
+	switch i := SeriesInterval(s); i {
+	case SeriesIntervalDay, SeriesIntervalWeek, SeriesIntervalMonth:
+		return i, true
+	}
+	return "", false
+}
+
+// BucketStart is the beginning of the bucket containing the time, in UTC. Weeks start on Monday
+func (i SeriesInterval) BucketStart(t time.Time) time.Time {
+	t = t.UTC()
+	day := time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.UTC)
+	switch i {
+	case SeriesIntervalWeek:
+		return day.AddDate(0, 0, -(int(day.Weekday())+6)%7)
+	case SeriesIntervalMonth:
+		return time.Date(t.Year(), t.Month(), 1, 0, 0, 0, 0, time.UTC)
+	}
+	return day
+}
+
+// Next is the beginning of the bucket following the one starting at the time
+func (i SeriesInterval) Next(start time.Time) time.Time {
+	switch i {
+	case SeriesIntervalWeek:
+		return start.AddDate(0, 0, 7)
+	case SeriesIntervalMonth:
+		return start.AddDate(0, 1, 0)
+	}
+	return start.AddDate(0, 0, 1)
+}
+
+// StatisticsSeriesParams selects statistic series, all validators are returned when ValidatorIDs are empty.
+// The range ends now and starts DefaultSeriesWindow before its end when not set
+type StatisticsSeriesParams struct {
+	Type         StatisticTypeVS
+	Interval     SeriesInterval
+	ValidatorIDs []string
+
+	TimeFrom time.Time
+	TimeTo   time.Time
+}
+
+// StatisticsSeries are the values of statistic at the end of every bucket, carried forward from the last change before it.
+// Network aggregates values of all validators - sums stake and nodes, averages rates
+type StatisticsSeries struct {
+	Type       StatisticTypeVS   `json:"type"`
+	Interval   SeriesInterval    `json:"interval"`
+	Buckets    []time.Time       `json:"buckets"`
+	Validators []ValidatorSeries `json:"validators"`
+	Network    []NetworkPoint    `json:"network"`
+}
+
+// ValidatorSeries are values of validator per bucket, Values are nil before validator's first value
+type ValidatorSeries struct {
+	ValidatorID *big.Int   `json:"validator_id"`
+	Values      []*big.Int `json:"values"`
+}
+
+// NetworkPoint is the aggregate of values of Validators having any in the bucket
+type NetworkPoint struct {
+	Value      *big.Int `json:"value"`
+	Validators uint64   `json:"validators"`
+}
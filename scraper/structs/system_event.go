@@ -2,6 +2,7 @@ package structs
 
 import (
 	"math/big"
+	"strconv"
 	"time"
 
 	"github.com/ethereum/go-ethereum/common"
@@ -22,6 +23,13 @@ type SystemEvent struct {
 	Change big.Float `json:"change"`
 }
 
+// Key identifies event the same way stores do when it's saved again
+func (se SystemEvent) Key() string {
+	return strconv.FormatUint(se.Height, 10) + "/" + strconv.FormatUint(uint64(se.Kind), 10) + "/" +
+		se.Sender.Hex() + "/" + new(big.Int).Abs(&se.SenderID).String() + "/" +
+		se.Recipient.Hex() + "/" + new(big.Int).Abs(&se.RecipientID).String()
+}
+
 type SysEvtType uint64
 
 const (
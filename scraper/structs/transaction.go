@@ -0,0 +1,25 @@
+package structs
+
+import (
+	"math/big"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+)
+
+type Transaction struct {
+	Hash        common.Hash    `json:"hash"`
+	BlockHeight uint64         `json:"block_height"`
+	BlockHash   common.Hash    `json:"block_hash"`
+	Index       uint           `json:"index"`
+	Time        time.Time      `json:"time"`
+	From        common.Address `json:"from"`
+	To          common.Address `json:"to"`
+	Value       *big.Int       `json:"value"`
+	Nonce       uint64         `json:"nonce"`
+	Gas         uint64         `json:"gas"`
+	GasPrice    *big.Int       `json:"gas_price"`
+	GasUsed     uint64         `json:"gas_used"`
+	Fee         *big.Int       `json:"fee"`
+	Status      uint64         `json:"status"`
+}
@@ -25,3 +25,17 @@ type Validator struct {
 	Staked                  *big.Int       `json:"staked"`
 	BlockHeight             uint64         `json:"block_height"`
 }
+
+// ValidatorAddress is a change of validator address or of the address requested to replace it
+type ValidatorAddress struct {
+	ID               string         `json:"id"`
+	CreatedAt        time.Time      `json:"created_at"`
+	ValidatorID      *big.Int       `json:"validator_id"`
+	Address          common.Address `json:"address"`
+	RequestedAddress common.Address `json:"requested_address"`
+	BlockHeight      uint64         `json:"block_height"`
+	Time             time.Time      `json:"time"`
+	// TransactionHash and EventName are empty for changes found by synchronization
+	TransactionHash common.Hash `json:"transaction_hash"`
+	EventName       string      `json:"event_name"`
+}
@@ -0,0 +1,102 @@
+package structs
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"math/big"
+	"strconv"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// Webhook is partner's endpoint system events matching its filter are delivered to
+type Webhook struct {
+	ID        string    `json:"id"`
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+	URL       string    `json:"url"`
+	// Secret is the key payloads are signed with
+	Secret string `json:"-"`
+
+	// Kinds, ValidatorID and Address filter events, the one not set matches any
+	Kinds       []SysEvtType   `json:"kinds"`
+	ValidatorID *big.Int       `json:"validator_id"`
+	Address     common.Address `json:"address"`
+
+	Active bool `json:"active"`
+}
+
+// Match reports whether system event passes webhook filter.
+// Validator is either sender or recipient of event, as well as address
+func (wh Webhook) Match(se SystemEvent) bool {
+	if len(wh.Kinds) > 0 {
+		found := false
+		for _, k := range wh.Kinds {
+			if k == se.Kind {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return false
+		}
+	}
+	if wh.ValidatorID != nil && se.SenderID.Cmp(wh.ValidatorID) != 0 && se.RecipientID.Cmp(wh.ValidatorID) != 0 {
+		return false
+	}
+	return wh.Address == (common.Address{}) || se.Sender == wh.Address || se.Recipient == wh.Address
+}
+
+// Sign returns hex encoded HMAC-SHA256 of the timestamp (unix seconds) and payload joined with dot
+func (wh Webhook) Sign(timestamp time.Time, payload []byte) string {
+	mac := hmac.New(sha256.New, []byte(wh.Secret))
+	mac.Write([]byte(strconv.FormatInt(timestamp.Unix(), 10)))
+	mac.Write([]byte("."))
+	mac.Write(payload)
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+type WebhookDeliveryStatus string
+
+const (
+	WebhookDeliveryPending   WebhookDeliveryStatus = "pending"
+	WebhookDeliveryDelivered WebhookDeliveryStatus = "delivered"
+	// WebhookDeliveryFailed is delivery given up on after reaching the maximum number of attempts
+	WebhookDeliveryFailed WebhookDeliveryStatus = "failed"
+)
+
+// WebhookDelivery is system event queued for delivery to webhook, with the outcome of the last attempt
+type WebhookDelivery struct {
+	ID        string    `json:"id"`
+	WebhookID string    `json:"webhook_id"`
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+
+	Event SystemEvent `json:"event"`
+
+	Status       WebhookDeliveryStatus `json:"status"`
+	Attempts     uint64                `json:"attempts"`
+	NextRetry    time.Time             `json:"next_retry"`
+	ResponseCode int                   `json:"response_code"`
+	Error        string                `json:"error"`
+	DeliveredAt  time.Time             `json:"delivered_at"`
+}
+
+const (
+	webhookRetryBase = 30 * time.Second
+	webhookRetryMax  = time.Hour
+)
+
+// WebhookRetryDelay returns exponential backoff delay before the next attempt of delivery that failed given number of times
+func WebhookRetryDelay(attempts uint64) time.Duration {
+	d := webhookRetryBase
+	for i := uint64(1); i < attempts; i++ {
+		d *= 2
+		if d >= webhookRetryMax {
+			return webhookRetryMax
+		}
+	}
+	return d
+}
@@ -10,13 +10,16 @@ import (
 	"github.com/ethereum/go-ethereum/accounts/abi"
 	"github.com/ethereum/go-ethereum/accounts/abi/bind"
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
 	"github.com/ethereum/go-ethereum/core/types"
 	"github.com/ethereum/go-ethereum/ethclient"
+	"github.com/ethereum/go-ethereum/rpc"
 	"github.com/figment-networks/skale-indexer/scraper/transport"
 )
 
 type EthTransport struct {
 	C   *ethclient.Client
+	R   *rpc.Client
 	Url string
 }
 
@@ -25,8 +28,11 @@ func NewEthTransport(url string) *EthTransport {
 }
 
 func (et *EthTransport) Dial(ctx context.Context) (err error) {
-	et.C, err = ethclient.DialContext(ctx, et.Url)
-	return err
+	if et.R, err = rpc.DialContext(ctx, et.Url); err != nil {
+		return err
+	}
+	et.C = ethclient.NewClient(et.R)
+	return nil
 }
 
 func (et *EthTransport) Close(ctx context.Context) {
@@ -58,9 +64,36 @@ func (et *EthTransport) GetLogs(ctx context.Context, from, to big.Int, contracts
 	return et.C.FilterLogs(ctx, fq)
 }
 
-func (et *EthTransport) GetBlockHeader(ctx context.Context, height *big.Int) (h *types.Header, err error) {
-	h, err = et.C.HeaderByNumber(ctx, height)
-	return h, err
+// rpcHeader are the fields of block header read as the node returns them
+type rpcHeader struct {
+	Number     hexutil.Uint64 `json:"number"`
+	Hash       common.Hash    `json:"hash"`
+	ParentHash common.Hash    `json:"parentHash"`
+	Time       hexutil.Uint64 `json:"timestamp"`
+	BaseFee    *hexutil.Big   `json:"baseFeePerGas"`
+}
+
+// GetBlockHeader gets header of block at the height, of the latest one when height is nil
+func (et *EthTransport) GetBlockHeader(ctx context.Context, height *big.Int) (h *transport.Header, err error) {
+	number := "latest"
+	if height != nil {
+		number = hexutil.EncodeBig(height)
+	}
+
+	var raw *rpcHeader
+	if err = et.R.CallContext(ctx, &raw, "eth_getBlockByNumber", number, false); err != nil {
+		return nil, err
+	}
+	if raw == nil {
+		return nil, ethereum.NotFound
+	}
+	return &transport.Header{
+		Number:     uint64(raw.Number),
+		Hash:       raw.Hash,
+		ParentHash: raw.ParentHash,
+		Time:       uint64(raw.Time),
+		BaseFee:    (*big.Int)(raw.BaseFee),
+	}, nil
 }
 
 func (et *EthTransport) GetLatestBlockHeight(ctx context.Context) (uint64, error) {
@@ -68,6 +101,48 @@ func (et *EthTransport) GetLatestBlockHeight(ctx context.Context) (uint64, error
 	return blockNumber, err
 }
 
+// rpcTransaction are the fields of transaction read as the node returns them, for all transaction types
+type rpcTransaction struct {
+	Hash      common.Hash     `json:"hash"`
+	Type      hexutil.Uint64  `json:"type"`
+	From      common.Address  `json:"from"`
+	To        *common.Address `json:"to"`
+	Value     *hexutil.Big    `json:"value"`
+	Nonce     hexutil.Uint64  `json:"nonce"`
+	Gas       hexutil.Uint64  `json:"gas"`
+	GasPrice  *hexutil.Big    `json:"gasPrice"`
+	GasFeeCap *hexutil.Big    `json:"maxFeePerGas"`
+	GasTipCap *hexutil.Big    `json:"maxPriorityFeePerGas"`
+}
+
+// GetTransaction gets transaction by its hash. It's decoded from the raw response, as types.Transaction
+// of go-ethereum v1.10.2 rejects dynamic fee transactions
+func (et *EthTransport) GetTransaction(ctx context.Context, hash common.Hash) (tx *transport.Transaction, err error) {
+	var raw *rpcTransaction
+	if err = et.R.CallContext(ctx, &raw, "eth_getTransactionByHash", hash); err != nil {
+		return nil, err
+	}
+	if raw == nil {
+		return nil, ethereum.NotFound
+	}
+	return &transport.Transaction{
+		Hash:      raw.Hash,
+		Type:      uint8(raw.Type),
+		From:      raw.From,
+		To:        raw.To,
+		Value:     (*big.Int)(raw.Value),
+		Nonce:     uint64(raw.Nonce),
+		Gas:       uint64(raw.Gas),
+		GasPrice:  (*big.Int)(raw.GasPrice),
+		GasFeeCap: (*big.Int)(raw.GasFeeCap),
+		GasTipCap: (*big.Int)(raw.GasTipCap),
+	}, nil
+}
+
+func (et *EthTransport) GetTransactionReceipt(ctx context.Context, hash common.Hash) (receipt *types.Receipt, err error) {
+	return et.C.TransactionReceipt(ctx, hash)
+}
+
 type jsonError struct {
 	Code    int         `json:"code"`
 	Message string      `json:"message"`
@@ -0,0 +1,99 @@
+package eth
+
+import (
+	"context"
+	"encoding/json"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/figment-networks/skale-indexer/scraper/transport"
+	"github.com/stretchr/testify/require"
+)
+
+// rpcServer answers JSON-RPC requests with results of the methods, null for the others
+func rpcServer(t *testing.T, results map[string]string) *EthTransport {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		var call struct {
+			ID     json.RawMessage `json:"id"`
+			Method string          `json:"method"`
+		}
+		require.NoError(t, json.NewDecoder(req.Body).Decode(&call))
+		result, ok := results[call.Method]
+		if !ok {
+			result = "null"
+		}
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"jsonrpc":"2.0","id":` + string(call.ID) + `,"result":` + result + `}`))
+	}))
+	t.Cleanup(srv.Close)
+
+	et := NewEthTransport(srv.URL)
+	require.NoError(t, et.Dial(context.Background()))
+	t.Cleanup(func() { et.Close(context.Background()) })
+	return et
+}
+
+func TestEthTransport_GetBlockHeader(t *testing.T) {
+	// London header, hash of which go-ethereum v1.10.2 can't recompute as it doesn't decode baseFeePerGas
+	et := rpcServer(t, map[string]string{"eth_getBlockByNumber": `{
+		"number": "0xc5d488",
+		"hash": "0x9b83c12c69edb74f6c8dd5d052765c1adf940e320bd1291696e6fa07829eee71",
+		"parentHash": "0x3d4c7a73fe6b5c7b5ab7a6e5e8b0b3c9f7a1e6d48b5e40a6c1d0c3a4c5f8a2b1",
+		"timestamp": "0x610bdaa3",
+		"baseFeePerGas": "0x7",
+		"miner": "0x0000000000000000000000000000000000000000",
+		"transactions": []
+	}`})
+
+	h, err := et.GetBlockHeader(context.Background(), big.NewInt(12965000))
+	require.NoError(t, err)
+	require.Equal(t, uint64(12965000), h.Number)
+	require.Equal(t, common.HexToHash("0x9b83c12c69edb74f6c8dd5d052765c1adf940e320bd1291696e6fa07829eee71"), h.Hash)
+	require.Equal(t, common.HexToHash("0x3d4c7a73fe6b5c7b5ab7a6e5e8b0b3c9f7a1e6d48b5e40a6c1d0c3a4c5f8a2b1"), h.ParentHash)
+	require.Equal(t, uint64(0x610bdaa3), h.Time)
+	require.Equal(t, big.NewInt(7), h.BaseFee)
+}
+
+func TestEthTransport_GetBlockHeaderNotFound(t *testing.T) {
+	et := rpcServer(t, nil)
+	_, err := et.GetBlockHeader(context.Background(), big.NewInt(1))
+	require.Error(t, err)
+}
+
+func TestEthTransport_GetTransaction(t *testing.T) {
+	// dynamic fee transaction, which types.Transaction of go-ethereum v1.10.2 fails to decode
+	et := rpcServer(t, map[string]string{"eth_getTransactionByHash": `{
+		"hash": "0x5c504ed432cb51138bcf09aa5e8a410dd4a1e204ef84bfed1be16dfba1b22060",
+		"type": "0x2",
+		"from": "0x06dd71dab27c1a3e0b172d53735f00bf1a66eb79",
+		"to": "0x1f42f0b1ae2aa1d04d4b7c62c2a8a4a8c06d0a6b",
+		"value": "0x0",
+		"nonce": "0x15",
+		"gas": "0x186a0",
+		"gasPrice": "0x3b9aca0e",
+		"maxFeePerGas": "0x4a817c800",
+		"maxPriorityFeePerGas": "0x3b9aca00",
+		"chainId": "0x1",
+		"accessList": [],
+		"input": "0x",
+		"v": "0x1",
+		"r": "0x1",
+		"s": "0x1"
+	}`})
+
+	tx, err := et.GetTransaction(context.Background(), common.HexToHash("0x5c504ed432cb51138bcf09aa5e8a410dd4a1e204ef84bfed1be16dfba1b22060"))
+	require.NoError(t, err)
+	require.Equal(t, uint8(transport.DynamicFeeTxType), tx.Type)
+	require.Equal(t, common.HexToAddress("0x06dd71dab27c1a3e0b172d53735f00bf1a66eb79"), tx.From)
+	require.Equal(t, common.HexToAddress("0x1f42f0b1ae2aa1d04d4b7c62c2a8a4a8c06d0a6b"), *tx.To)
+	require.Equal(t, uint64(0x15), tx.Nonce)
+	require.Equal(t, uint64(100000), tx.Gas)
+	require.Equal(t, big.NewInt(20000000000), tx.GasFeeCap)
+	require.Equal(t, big.NewInt(1000000000), tx.GasTipCap)
+
+	_, err = rpcServer(t, nil).GetTransaction(context.Background(), common.Hash{})
+	require.Error(t, err)
+}
@@ -19,11 +19,58 @@ type BoundContractCaller interface {
 	AbiUnpack(method string, data []byte) (res []interface{}, err error)
 }
 
+// Header is block header with the hash reported by the node, as headers decoded by go-ethereum
+// may lack fields added by later forks, which their Hash() depends on
+type Header struct {
+	Number     uint64
+	Hash       common.Hash
+	ParentHash common.Hash
+	Time       uint64
+	// BaseFee is nil before London
+	BaseFee *big.Int
+}
+
+// DynamicFeeTxType is the type of EIP-1559 transactions, which go-ethereum v1.10.2 can't decode
+const DynamicFeeTxType = 2
+
+// Transaction is transaction as the node returns it, with fee fields of every transaction type
+type Transaction struct {
+	Hash     common.Hash
+	Type     uint8
+	From     common.Address
+	To       *common.Address
+	Value    *big.Int
+	Nonce    uint64
+	Gas      uint64
+	GasPrice *big.Int
+	// GasFeeCap and GasTipCap are set for dynamic fee transactions only
+	GasFeeCap *big.Int
+	GasTipCap *big.Int
+}
+
+// EffectiveGasPrice is the price transaction paid per gas in block with the base fee.
+// Dynamic fee transaction pays the base fee with its tip, up to its fee cap, the others their gas price
+func (tx Transaction) EffectiveGasPrice(baseFee *big.Int) *big.Int {
+	if tx.Type != DynamicFeeTxType || tx.GasFeeCap == nil || tx.GasTipCap == nil || baseFee == nil {
+		if tx.GasPrice == nil {
+			return new(big.Int)
+		}
+		return new(big.Int).Set(tx.GasPrice)
+	}
+	price := new(big.Int).Add(baseFee, tx.GasTipCap)
+	if price.Cmp(tx.GasFeeCap) > 0 {
+		price.Set(tx.GasFeeCap)
+	}
+	return price
+}
+
 type EthereumTransport interface {
 	Dial(ctx context.Context) (err error)
 	Close(ctx context.Context)
 	GetLogs(ctx context.Context, from, to big.Int, contracts []common.Address) (logs []types.Log, err error)
-	GetBlockHeader(ctx context.Context, height *big.Int) (h *types.Header, err error)
+	GetBlockHeader(ctx context.Context, height *big.Int) (h *Header, err error)
 	GetBoundContractCaller(ctx context.Context, address common.Address, a abi.ABI) BoundContractCaller
 	GetLatestBlockHeight(ctx context.Context) (uint64, error)
+	GetTransaction(ctx context.Context, hash common.Hash) (tx *Transaction, err error)
+	GetTransactionReceipt(ctx context.Context, hash common.Hash) (receipt *types.Receipt, err error)
 }
@@ -0,0 +1,50 @@
+package transport
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestTransaction_EffectiveGasPrice(t *testing.T) {
+	tests := []struct {
+		name     string
+		tx       Transaction
+		baseFee  *big.Int
+		expected int64
+	}{
+		{
+			name:     "legacy",
+			tx:       Transaction{Type: 0, GasPrice: big.NewInt(50)},
+			baseFee:  big.NewInt(30),
+			expected: 50,
+		},
+		{
+			name:     "dynamic fee below cap",
+			tx:       Transaction{Type: DynamicFeeTxType, GasPrice: big.NewInt(100), GasFeeCap: big.NewInt(100), GasTipCap: big.NewInt(2)},
+			baseFee:  big.NewInt(30),
+			expected: 32,
+		},
+		{
+			name:     "dynamic fee capped",
+			tx:       Transaction{Type: DynamicFeeTxType, GasPrice: big.NewInt(100), GasFeeCap: big.NewInt(100), GasTipCap: big.NewInt(5)},
+			baseFee:  big.NewInt(98),
+			expected: 100,
+		},
+		{
+			name:     "dynamic fee without base fee",
+			tx:       Transaction{Type: DynamicFeeTxType, GasPrice: big.NewInt(40), GasFeeCap: big.NewInt(100), GasTipCap: big.NewInt(5)},
+			expected: 40,
+		},
+		{
+			name: "no gas price",
+			tx:   Transaction{},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			require.Equal(t, big.NewInt(tt.expected).String(), tt.tx.EffectiveGasPrice(tt.baseFee).String())
+		})
+	}
+}
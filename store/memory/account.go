@@ -0,0 +1,88 @@
+package memory
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"sort"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/google/uuid"
+
+	"github.com/figment-networks/skale-indexer/scraper/structs"
+)
+
+// accountTypes orders account types the way they are declared in the database enum
+var accountTypes = map[structs.AccountType]int{
+	structs.AccountTypeDefault:   0,
+	structs.AccountTypeDelegator: 1,
+	structs.AccountTypeValidator: 2,
+}
+
+// SaveAccount saves account
+func (d *Driver) SaveAccount(ctx context.Context, a structs.Account) error {
+	if d.deferWrite(ctx, func(ctx context.Context) error { return d.SaveAccount(ctx, a) }) {
+		return nil
+	}
+
+	rank, ok := accountTypes[a.Type]
+	if !ok {
+		return fmt.Errorf("invalid account type: %q", a.Type)
+	}
+
+	return d.write(ctx, func(s *state) error {
+		stored, ok := s.accounts[a.Address]
+		if !ok {
+			s.accounts[a.Address] = structs.Account{ID: uuid.New(), CreatedAt: time.Now(), Address: a.Address, Type: a.Type}
+			return nil
+		}
+		if accountTypes[stored.Type] < rank {
+			stored.Type = a.Type
+			s.accounts[a.Address] = stored
+		}
+		return nil
+	})
+}
+
+// GetAccounts gets accounts
+func (d *Driver) GetAccounts(ctx context.Context, params structs.AccountParams) (accounts []structs.Account, err error) {
+	d.read(func(s *state) error {
+		for _, a := range s.accounts {
+			if params.Address != "" && a.Address != common.HexToAddress(params.Address) {
+				continue
+			}
+			if params.Type != "" && string(a.Type) != params.Type {
+				continue
+			}
+			accounts = append(accounts, a)
+		}
+		return nil
+	})
+
+	sort.Slice(accounts, func(i, j int) bool {
+		if !accounts[i].CreatedAt.Equal(accounts[j].CreatedAt) {
+			return accounts[i].CreatedAt.After(accounts[j].CreatedAt)
+		}
+		return accounts[i].Address.Hash().Big().Cmp(accounts[j].Address.Hash().Big()) < 0
+	})
+
+	var after *big.Int
+	if !params.Cursor.IsZero() {
+		after = common.HexToAddress(params.Cursor.Key).Hash().Big()
+	}
+	from, to := pageAfterKey(len(accounts), params.Cursor, params.Limit, params.Offset, func(i int) bool {
+		if !accounts[i].CreatedAt.Equal(params.Cursor.Time) {
+			return accounts[i].CreatedAt.Before(params.Cursor.Time)
+		}
+		return accounts[i].Address.Hash().Big().Cmp(after) > 0
+	})
+	return accounts[from:to], nil
+}
+
+// CountAccounts counts accounts matching params, regardless of paging
+func (d *Driver) CountAccounts(ctx context.Context, params structs.AccountParams) (count uint64, err error) {
+	params.Limit, params.Offset, params.Cursor = 0, 0, structs.KeyCursor{}
+	accounts, err := d.GetAccounts(ctx, params)
+	return uint64(len(accounts)), err
+}
@@ -0,0 +1,66 @@
+package memory
+
+import (
+	"context"
+	"time"
+
+	"github.com/figment-networks/skale-indexer/scraper/structs"
+)
+
+// SaveBlock saves block header
+func (d *Driver) SaveBlock(ctx context.Context, b structs.Block) error {
+	return d.write(ctx, func(s *state) error {
+		s.blocks[b.Number] = b
+		return nil
+	})
+}
+
+// GetBlock gets block header of given height
+func (d *Driver) GetBlock(ctx context.Context, height uint64) (b structs.Block, err error) {
+	err = d.read(func(s *state) error {
+		var ok bool
+		if b, ok = s.blocks[height]; !ok {
+			return structs.ErrNotFound
+		}
+		return nil
+	})
+	return b, err
+}
+
+// GetBlockBefore gets the highest stored block below given height
+func (d *Driver) GetBlockBefore(ctx context.Context, height uint64) (b structs.Block, err error) {
+	err = d.read(func(s *state) error {
+		var found bool
+		for number, sb := range s.blocks {
+			if number < height && (!found || number > b.Number) {
+				b, found = sb, true
+			}
+		}
+		if !found {
+			return structs.ErrNotFound
+		}
+		return nil
+	})
+	return b, err
+}
+
+// GetBlockBounds gets the closest stored blocks produced at or before and after given time.
+// Zero value is returned for the missing bound.
+func (d *Driver) GetBlockBounds(ctx context.Context, t time.Time) (before, after structs.Block, err error) {
+	var hasBefore, hasAfter bool
+	d.read(func(s *state) error {
+		for _, b := range s.blocks {
+			if !b.Time.After(t) {
+				if !hasBefore || b.Time.After(before.Time) || (b.Time.Equal(before.Time) && b.Number > before.Number) {
+					before, hasBefore = b, true
+				}
+				continue
+			}
+			if !hasAfter || b.Time.Before(after.Time) || (b.Time.Equal(after.Time) && b.Number < after.Number) {
+				after, hasAfter = b, true
+			}
+		}
+		return nil
+	})
+	return before, after, nil
+}
@@ -0,0 +1,183 @@
+package memory
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/big"
+	"sort"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/google/uuid"
+
+	"github.com/figment-networks/skale-indexer/scraper/structs"
+)
+
+type contractEvent struct {
+	structs.ContractEvent
+	// params are kept marshaled, so reads get the same types as from jsonb column
+	params []byte
+}
+
+// SaveContractEvent saves contract events
+func (d *Driver) SaveContractEvent(ctx context.Context, ce structs.ContractEvent) error {
+	if d.deferWrite(ctx, func(ctx context.Context) error { return d.SaveContractEvent(ctx, ce) }) {
+		return nil
+	}
+
+	params, err := json.Marshal(ce.Params)
+	if err != nil {
+		return err
+	}
+
+	e := contractEvent{ContractEvent: ce, params: params}
+	e.Params = nil
+	e.BoundID = append([]big.Int(nil), ce.BoundID...)
+	e.BoundAddress = append([]common.Address(nil), ce.BoundAddress...)
+
+	return d.write(ctx, func(s *state) error {
+		for i, stored := range s.contractEvents {
+			if stored.ContractAddress == ce.ContractAddress &&
+				stored.EventName == ce.EventName &&
+				stored.BlockHeight == ce.BlockHeight &&
+				stored.TransactionHash == ce.TransactionHash &&
+				stored.Removed == ce.Removed {
+				e.ID = stored.ID
+				s.contractEvents[i] = e
+				return nil
+			}
+		}
+		e.ID = uuid.New()
+		s.contractEvents = append(s.contractEvents, e)
+		return nil
+	})
+}
+
+// GetContractEvents gets contract events, the latest first
+func (d *Driver) GetContractEvents(ctx context.Context, params structs.EventParams) (contractEvents []structs.ContractEvent, err error) {
+	found, err := d.contractEvents(params)
+	if err != nil {
+		return nil, err
+	}
+
+	from, to := pageAfter(len(found), params.Cursor, params.Limit, params.Offset, func(i int) (uint64, string) {
+		return found[i].BlockHeight, found[i].ID.String()
+	})
+	for _, e := range found[from:to] {
+		ce := e.ContractEvent
+		ce.BoundType, ce.BoundID, ce.BoundAddress = "", nil, nil
+
+		a, err := structs.DecodeEventParams(e.params)
+		if err != nil {
+			return nil, fmt.Errorf("unmarshal error error: %w", err)
+		}
+		ce.Params = a
+		contractEvents = append(contractEvents, ce)
+	}
+
+	return contractEvents, nil
+}
+
+// CountContractEvents counts contract events matching params, regardless of paging
+func (d *Driver) CountContractEvents(ctx context.Context, params structs.EventParams) (count uint64, err error) {
+	found, err := d.contractEvents(params)
+	return uint64(len(found)), err
+}
+
+// contractEvents returns contract events matching params, the latest first
+func (d *Driver) contractEvents(params structs.EventParams) (found []contractEvent, err error) {
+	var match func(e contractEvent) bool
+	switch params.Type {
+	case "":
+		match = func(e contractEvent) bool { return true }
+	case "validator":
+		match = func(e contractEvent) bool {
+			return (e.BoundType == "validator" && boundID(e, 0, params.Id)) ||
+				(e.BoundType == "delegation" && boundID(e, 1, params.Id))
+		}
+	case "delegation", "node":
+		match = func(e contractEvent) bool { return e.BoundType == params.Type && boundID(e, 0, params.Id) }
+	case "token":
+		match = func(e contractEvent) bool { return e.BoundType == "token" }
+	default:
+		return nil, errors.New("unknown type")
+	}
+
+	d.read(func(s *state) error {
+		for _, e := range s.contractEvents {
+			if (!params.TimeFrom.IsZero() || !params.TimeTo.IsZero()) && !between(e.Time, params.TimeFrom, params.TimeTo) {
+				continue
+			}
+			if params.ContractName != "" && e.ContractName != params.ContractName {
+				continue
+			}
+			if params.EventName != "" && e.EventName != params.EventName {
+				continue
+			}
+			if len(params.Params) > 0 && !paramsMatch(e.params, params.Params) {
+				continue
+			}
+			if (params.HeightFrom > 0 && e.BlockHeight < params.HeightFrom) || (params.HeightTo > 0 && e.BlockHeight > params.HeightTo) {
+				continue
+			}
+			if params.BoundAddress != (common.Address{}) && !boundAddress(e, params.BoundAddress) {
+				continue
+			}
+			if params.TransactionHash != (common.Hash{}) && e.TransactionHash != params.TransactionHash {
+				continue
+			}
+			if params.AtHeight > 0 && e.BlockHeight > params.AtHeight {
+				continue
+			}
+			if match(e) {
+				found = append(found, e)
+			}
+		}
+		return nil
+	})
+
+	sort.Slice(found, func(i, j int) bool {
+		return latestFirst(found[i].BlockHeight, found[i].ID.String(), found[j].BlockHeight, found[j].ID.String())
+	})
+	return found, nil
+}
+
+// paramsMatch checks every filtered parameter is saved with one of the values its text may represent
+func paramsMatch(saved []byte, filter map[string]string) bool {
+	var decoded map[string]json.RawMessage
+	if err := json.Unmarshal(saved, &decoded); err != nil {
+		return false
+	}
+	for key, value := range filter {
+		v, ok := decoded[key]
+		if !ok {
+			return false
+		}
+		found := false
+		for _, candidate := range structs.EventParamValues(value) {
+			if bytes.Equal(v, candidate) {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return false
+		}
+	}
+	return true
+}
+
+func boundAddress(e contractEvent, address common.Address) bool {
+	for _, a := range e.BoundAddress {
+		if a == address {
+			return true
+		}
+	}
+	return false
+}
+
+func boundID(e contractEvent, i int, id uint64) bool {
+	return len(e.BoundID) > i && e.BoundID[i].IsUint64() && e.BoundID[i].Uint64() == id
+}
@@ -0,0 +1,41 @@
+package memory
+
+import (
+	"context"
+	"sort"
+
+	"github.com/figment-networks/skale-indexer/scraper/structs"
+)
+
+// SaveDecentralization saves metrics of the epoch, replacing the ones computed before
+func (d *Driver) SaveDecentralization(ctx context.Context, dc structs.Decentralization) error {
+	return d.write(ctx, func(s *state) error {
+		dc.TotalStake = copyBig(dc.TotalStake)
+		for i, saved := range s.decentralization {
+			if saved.Epoch == dc.Epoch {
+				s.decentralization[i] = dc
+				return nil
+			}
+		}
+		s.decentralization = append(s.decentralization, dc)
+		return nil
+	})
+}
+
+// GetDecentralization gets metrics of epochs, the latest first
+func (d *Driver) GetDecentralization(ctx context.Context, params structs.DecentralizationParams) (dcs []structs.Decentralization, err error) {
+	d.read(func(s *state) error {
+		for _, dc := range s.decentralization {
+			if dc.Epoch < params.EpochFrom || (params.EpochTo > 0 && dc.Epoch > params.EpochTo) {
+				continue
+			}
+			dc.TotalStake = copyBig(dc.TotalStake)
+			dcs = append(dcs, dc)
+		}
+		return nil
+	})
+
+	sort.Slice(dcs, func(i, j int) bool { return dcs[i].Epoch > dcs[j].Epoch })
+	from, to := page(len(dcs), params.Limit, params.Offset)
+	return dcs[from:to], nil
+}
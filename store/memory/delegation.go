@@ -0,0 +1,269 @@
+package memory
+
+import (
+	"context"
+	"math/big"
+	"sort"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/google/uuid"
+
+	"github.com/figment-networks/skale-indexer/scraper/structs"
+)
+
+type delegation struct {
+	structs.Delegation
+	until time.Time
+}
+
+// SaveDelegation saves delegation
+func (d *Driver) SaveDelegation(ctx context.Context, dl structs.Delegation) error {
+	sd := dl
+	sd.DelegationID = abs(dl.DelegationID)
+	sd.ValidatorID = abs(dl.ValidatorID)
+	sd.Amount = abs(dl.Amount)
+	sd.DelegationPeriod = abs(dl.DelegationPeriod)
+	sd.Started = abs(dl.Started)
+	sd.Finished = abs(dl.Finished)
+	sd.ValidatorName = ""
+
+	until := sd.Until()
+
+	return d.write(ctx, func(s *state) error {
+		for i, stored := range s.delegations {
+			if stored.DelegationID.Cmp(sd.DelegationID) == 0 && stored.TransactionHash == sd.TransactionHash {
+				sd.ID, sd.CreatedAt = stored.ID, stored.CreatedAt
+				s.delegations[i] = delegation{sd, until}
+				return nil
+			}
+		}
+		sd.ID, sd.CreatedAt = uuid.New(), time.Now()
+		s.delegations = append(s.delegations, delegation{sd, until})
+		return nil
+	})
+}
+
+// GetDelegationTimeline gets all delegation information over time
+func (d *Driver) GetDelegationTimeline(ctx context.Context, params structs.DelegationParams) (delegations []structs.Delegation, err error) {
+	if delegations, err = d.delegationTimeline(params); err != nil {
+		return nil, err
+	}
+
+	from, to := pageAfter(len(delegations), params.Cursor, params.Limit, params.Offset, func(i int) (uint64, string) {
+		return delegations[i].BlockHeight, delegations[i].ID.String()
+	})
+	return delegations[from:to], nil
+}
+
+// CountDelegationTimeline counts delegation changes matching params, regardless of paging
+func (d *Driver) CountDelegationTimeline(ctx context.Context, params structs.DelegationParams) (count uint64, err error) {
+	delegations, err := d.delegationTimeline(params)
+	return uint64(len(delegations)), err
+}
+
+// delegationTimeline returns delegation changes matching params, the latest first
+func (d *Driver) delegationTimeline(params structs.DelegationParams) (delegations []structs.Delegation, err error) {
+	match, err := delegationsFilter(params, true)
+	if err != nil {
+		return nil, err
+	}
+
+	d.read(func(s *state) error {
+		for _, dl := range s.delegations {
+			v, ok := s.validators[dl.ValidatorID.String()]
+			if !ok || !match(dl) {
+				continue
+			}
+			c := copyDelegation(dl.Delegation)
+			c.ValidatorName = v.Name
+			delegations = append(delegations, c)
+		}
+		return nil
+	})
+
+	sort.Slice(delegations, func(i, j int) bool {
+		return latestFirst(delegations[i].BlockHeight, delegations[i].ID.String(), delegations[j].BlockHeight, delegations[j].ID.String())
+	})
+	return delegations, nil
+}
+
+// GetDelegations gets the latest state of delegations
+func (d *Driver) GetDelegations(ctx context.Context, params structs.DelegationParams) (delegations []structs.Delegation, err error) {
+	match, err := delegationsFilter(params, true)
+	if err != nil {
+		return nil, err
+	}
+	var after *big.Int
+	if !params.KeyCursor.IsZero() {
+		if after, err = parseBig(params.KeyCursor.Key); err != nil {
+			return nil, err
+		}
+	}
+
+	d.read(func(s *state) error {
+		for _, dl := range latestDelegations(s.delegations, match) {
+			v, ok := s.validators[dl.ValidatorID.String()]
+			if !ok {
+				continue
+			}
+			c := copyDelegation(dl.Delegation)
+			c.ValidatorName = v.Name
+			delegations = append(delegations, c)
+		}
+		return nil
+	})
+
+	sort.Slice(delegations, func(i, j int) bool { return delegations[i].DelegationID.Cmp(delegations[j].DelegationID) > 0 })
+
+	from, to := pageAfterKey(len(delegations), params.KeyCursor, params.Limit, params.Offset, func(i int) bool {
+		return delegations[i].DelegationID.Cmp(after) < 0
+	})
+	return delegations[from:to], nil
+}
+
+// CountDelegations counts the latest state of delegations matching params, regardless of paging
+func (d *Driver) CountDelegations(ctx context.Context, params structs.DelegationParams) (count uint64, err error) {
+	params.Limit, params.Offset, params.KeyCursor = 0, 0, structs.KeyCursor{}
+	delegations, err := d.GetDelegations(ctx, params)
+	return uint64(len(delegations)), err
+}
+
+// GetTypesSummaryDelegations sums up the latest state of delegations by state
+func (d *Driver) GetTypesSummaryDelegations(ctx context.Context, params structs.DelegationParams) (delegations []structs.DelegationSummary, err error) {
+	match, err := delegationsFilter(structs.DelegationParams{
+		ValidatorID: params.ValidatorID,
+		AtHeight:    params.AtHeight,
+		TimeAt:      params.TimeAt,
+		TimeFrom:    params.TimeFrom,
+		TimeTo:      params.TimeTo,
+	}, false)
+	if err != nil {
+		return nil, err
+	}
+
+	summaries := map[structs.DelegationState]*structs.DelegationSummary{}
+	d.read(func(s *state) error {
+		for _, dl := range latestDelegations(s.delegations, match) {
+			sum, ok := summaries[dl.State]
+			if !ok {
+				sum = &structs.DelegationSummary{State: dl.State, Count: new(big.Int), Amount: new(big.Int)}
+				summaries[dl.State] = sum
+			}
+			sum.Count.Add(sum.Count, big.NewInt(1))
+			sum.Amount.Add(sum.Amount, dl.Amount)
+		}
+		return nil
+	})
+
+	for _, sum := range summaries {
+		delegations = append(delegations, *sum)
+	}
+	sort.Slice(delegations, func(i, j int) bool { return delegations[i].State < delegations[j].State })
+	return delegations, nil
+}
+
+// latestDelegations returns the matching record of the highest block height for every delegation
+func latestDelegations(dls []delegation, match func(dl delegation) bool) []delegation {
+	latest := map[string]delegation{}
+	for _, dl := range dls {
+		if !match(dl) {
+			continue
+		}
+		key := dl.DelegationID.String()
+		if l, ok := latest[key]; !ok || l.BlockHeight < dl.BlockHeight {
+			latest[key] = dl
+		}
+	}
+
+	list := make([]delegation, 0, len(latest))
+	for _, dl := range latest {
+		list = append(list, dl)
+	}
+	return list
+}
+
+// delegationsFilter builds the filter of delegations for given params.
+// Holder and state are used only for the queries which allow them
+func delegationsFilter(params structs.DelegationParams, full bool) (func(dl delegation) bool, error) {
+	var (
+		delegationID, validatorID *big.Int
+		err                       error
+	)
+	if params.DelegationID != "" {
+		if delegationID, err = parseBig(params.DelegationID); err != nil {
+			return nil, err
+		}
+	}
+	if params.ValidatorID != "" {
+		if validatorID, err = parseBig(params.ValidatorID); err != nil {
+			return nil, err
+		}
+	}
+	var holder common.Address
+	if params.Holder != "" {
+		holder = common.HexToAddress(params.Holder)
+	}
+	validatorIDs := map[string]bool{}
+	for _, id := range params.ValidatorIDs {
+		v, err := parseBig(id)
+		if err != nil {
+			return nil, err
+		}
+		validatorIDs[v.String()] = true
+	}
+	holders := map[common.Address]bool{}
+	for _, h := range params.Holders {
+		holders[common.HexToAddress(h)] = true
+	}
+
+	return func(dl delegation) bool {
+		if delegationID != nil && dl.DelegationID.Cmp(delegationID) != 0 {
+			return false
+		}
+		if validatorID != nil && dl.ValidatorID.Cmp(validatorID) != 0 {
+			return false
+		}
+		if params.AtHeight > 0 && dl.BlockHeight > params.AtHeight {
+			return false
+		}
+		if full && params.Holder != "" && dl.Holder != holder {
+			return false
+		}
+		if full && len(params.State) > 0 && !hasState(params.State, dl.State) {
+			return false
+		}
+		if full && len(validatorIDs) > 0 && !validatorIDs[dl.ValidatorID.String()] {
+			return false
+		}
+		if full && len(holders) > 0 && !holders[dl.Holder] {
+			return false
+		}
+		if !params.TimeAt.IsZero() {
+			return between(params.TimeAt, dl.Created, dl.until)
+		}
+		if !params.TimeFrom.IsZero() && !params.TimeTo.IsZero() {
+			return between(dl.Created, params.TimeFrom, params.TimeTo)
+		}
+		return true
+	}, nil
+}
+
+func hasState(states []structs.DelegationState, s structs.DelegationState) bool {
+	for _, st := range states {
+		if st == s {
+			return true
+		}
+	}
+	return false
+}
+
+func copyDelegation(dl structs.Delegation) structs.Delegation {
+	dl.DelegationID = copyBig(dl.DelegationID)
+	dl.ValidatorID = copyBig(dl.ValidatorID)
+	dl.Amount = copyBig(dl.Amount)
+	dl.DelegationPeriod = copyBig(dl.DelegationPeriod)
+	dl.Started = copyBig(dl.Started)
+	dl.Finished = copyBig(dl.Finished)
+	return dl
+}
@@ -0,0 +1,104 @@
+package memory
+
+import (
+	"context"
+	"encoding/json"
+	"sort"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/google/uuid"
+
+	"github.com/figment-networks/skale-indexer/scraper/structs"
+)
+
+// SaveFailedEvent saves event log which failed processing. Already stored one gets another attempt counted,
+// and its next retry is never moved earlier, so re-scraping the block doesn't reset the backoff
+func (d *Driver) SaveFailedEvent(ctx context.Context, fe structs.FailedEvent) error {
+	// round trip the log, the same as through jsonb column
+	l, err := json.Marshal(fe.Log)
+	if err != nil {
+		return err
+	}
+	sf := fe
+	if err = json.Unmarshal(l, &sf.Log); err != nil {
+		return err
+	}
+
+	return d.write(ctx, func(s *state) error {
+		now := time.Now()
+		for i, stored := range s.failedEvents {
+			if stored.TransactionHash == fe.TransactionHash && stored.LogIndex == fe.LogIndex {
+				sf.ID, sf.CreatedAt, sf.UpdatedAt, sf.BlockHeight = stored.ID, stored.CreatedAt, now, stored.BlockHeight
+				sf.Attempts = stored.Attempts + 1
+				if stored.NextRetry.After(sf.NextRetry) {
+					sf.NextRetry = stored.NextRetry
+				}
+				s.failedEvents[i] = sf
+				return nil
+			}
+		}
+		sf.ID, sf.CreatedAt, sf.UpdatedAt = uuid.New().String(), now, now
+		s.failedEvents = append(s.failedEvents, sf)
+		return nil
+	})
+}
+
+// GetFailedEvents gets failed events
+func (d *Driver) GetFailedEvents(ctx context.Context, params structs.FailedEventParams) (failedEvents []structs.FailedEvent, err error) {
+	d.read(func(s *state) error {
+		for _, fe := range s.failedEvents {
+			if params.ID != "" && fe.ID != params.ID {
+				continue
+			}
+			if !params.RetryBefore.IsZero() && fe.NextRetry.After(params.RetryBefore) {
+				continue
+			}
+			if params.MaxAttempts > 0 && fe.Attempts >= params.MaxAttempts {
+				continue
+			}
+			failedEvents = append(failedEvents, fe)
+		}
+		return nil
+	})
+
+	if len(failedEvents) == 0 && params.ID != "" {
+		return nil, structs.ErrNotFound
+	}
+
+	sort.Slice(failedEvents, func(i, j int) bool {
+		if failedEvents[i].BlockHeight != failedEvents[j].BlockHeight {
+			return failedEvents[i].BlockHeight < failedEvents[j].BlockHeight
+		}
+		return failedEvents[i].LogIndex < failedEvents[j].LogIndex
+	})
+
+	from, to := page(len(failedEvents), params.Limit, params.Offset)
+	return failedEvents[from:to], nil
+}
+
+// DeleteFailedEvent removes failed event from the queue
+func (d *Driver) DeleteFailedEvent(ctx context.Context, id string) error {
+	return d.write(ctx, func(s *state) error {
+		for i, fe := range s.failedEvents {
+			if fe.ID == id {
+				s.failedEvents = append(s.failedEvents[:i:i], s.failedEvents[i+1:]...)
+				return nil
+			}
+		}
+		return structs.ErrNotFound
+	})
+}
+
+// DeleteFailedEventOfLog removes failed event of the log, if there is one
+func (d *Driver) DeleteFailedEventOfLog(ctx context.Context, txHash common.Hash, logIndex uint) error {
+	return d.write(ctx, func(s *state) error {
+		for i, fe := range s.failedEvents {
+			if fe.TransactionHash == txHash && fe.LogIndex == logIndex {
+				s.failedEvents = append(s.failedEvents[:i:i], s.failedEvents[i+1:]...)
+				return nil
+			}
+		}
+		return nil
+	})
+}
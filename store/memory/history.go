@@ -0,0 +1,98 @@
+package memory
+
+import (
+	"math/big"
+	"sort"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/google/uuid"
+
+	"github.com/figment-networks/skale-indexer/scraper/structs"
+)
+
+// saveValidatorVersion records validator as valid from its block height until the following version, if there is any
+func (s *state) saveValidatorVersion(v structs.Validator) {
+	key := v.ValidatorID.String()
+	versions := s.validatorHistory[key]
+	i := sort.Search(len(versions), func(i int) bool { return versions[i].BlockHeight >= v.BlockHeight })
+	if i < len(versions) && versions[i].BlockHeight == v.BlockHeight {
+		v.ID, v.CreatedAt = versions[i].ID, versions[i].CreatedAt
+		versions[i] = v
+		return
+	}
+
+	v.ID, v.CreatedAt = uuid.New().String(), time.Now()
+	versions = append(versions, structs.Validator{})
+	copy(versions[i+1:], versions[i:])
+	versions[i] = v
+	s.validatorHistory[key] = versions
+}
+
+// validatorsAt returns versions of validators valid at given height
+func (s *state) validatorsAt(height uint64) (validators []structs.Validator) {
+	for _, versions := range s.validatorHistory {
+		i := sort.Search(len(versions), func(i int) bool { return versions[i].BlockHeight > height })
+		if i == 0 {
+			continue
+		}
+		v := versions[i-1]
+		v.ActiveNodes = uint(s.latestStatistic(v.ValidatorID, structs.ValidatorStatisticsTypeActiveNodes, height).Uint64())
+		v.LinkedNodes = uint(s.latestStatistic(v.ValidatorID, structs.ValidatorStatisticsTypeLinkedNodes, height).Uint64())
+		v.Staked = s.latestStatistic(v.ValidatorID, structs.ValidatorStatisticsTypeTotalStake, height)
+		validators = append(validators, v)
+	}
+	return validators
+}
+
+// saveNodeVersion records node as valid from its block height until the following version, if there is any
+func (s *state) saveNodeVersion(n structs.Node) {
+	key := n.NodeID.String()
+	versions := s.nodeHistory[key]
+	i := sort.Search(len(versions), func(i int) bool { return versions[i].BlockHeight >= n.BlockHeight })
+	// nodes are saved again on every event of their validator, state which is already valid at the height is not a change
+	if (i == len(versions) || versions[i].BlockHeight > n.BlockHeight) && i > 0 && sameNode(versions[i-1], n) {
+		return
+	}
+	if i < len(versions) && versions[i].BlockHeight == n.BlockHeight {
+		n.ID, n.CreatedAt = versions[i].ID, versions[i].CreatedAt
+		versions[i] = n
+		return
+	}
+
+	n.ID, n.CreatedAt = uuid.New().String(), time.Now()
+	versions = append(versions, structs.Node{})
+	copy(versions[i+1:], versions[i:])
+	versions[i] = n
+	s.nodeHistory[key] = versions
+}
+
+// sameNode tells whether nodes are in the same state
+func sameNode(a, b structs.Node) bool {
+	return a.Address == b.Address && a.Name == b.Name && a.IP.Equal(b.IP) && a.PublicIP.Equal(b.PublicIP) && a.Port == b.Port &&
+		a.StartBlock.Cmp(b.StartBlock) == 0 && a.NextRewardDate.Equal(b.NextRewardDate) && a.LastRewardDate.Equal(b.LastRewardDate) &&
+		a.FinishTime.Cmp(b.FinishTime) == 0 && a.Status == b.Status && a.ValidatorID.Cmp(b.ValidatorID) == 0
+}
+
+// nodesAt returns versions of nodes valid at given height
+func (s *state) nodesAt(height uint64) (nodes []structs.Node) {
+	for _, versions := range s.nodeHistory {
+		i := sort.Search(len(versions), func(i int) bool { return versions[i].BlockHeight > height })
+		if i > 0 {
+			nodes = append(nodes, versions[i-1])
+		}
+	}
+	return nodes
+}
+
+// removeNodeAddressVersion records that nodes of validator, which had the removed address at given height, have no address since
+func (s *state) removeNodeAddressVersion(validatorID *big.Int, height uint64, removed common.Address) {
+	for _, n := range s.nodesAt(height) {
+		if n.ValidatorID.Cmp(validatorID) != 0 || n.Address != removed {
+			continue
+		}
+		n.Address = common.Address{}
+		n.BlockHeight = height
+		s.saveNodeVersion(n)
+	}
+}
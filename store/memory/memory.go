@@ -0,0 +1,318 @@
+// Package memory is in-memory store driver, following the semantics of the postgres one.
+// It's meant for development and hermetic tests; nothing is persisted.
+package memory
+
+import (
+	"context"
+	"math/big"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/figment-networks/skale-indexer/scraper/structs"
+)
+
+// Driver is in-memory database driver implementation
+type Driver struct {
+	// scopeLock serializes writes made outside of Atomic with the Atomic scopes, so rollback never discards them
+	scopeLock sync.Mutex
+
+	lock sync.RWMutex
+	s    *state
+}
+
+// NewDriver is Driver constructor
+func NewDriver() *Driver {
+	return &Driver{s: newState()}
+}
+
+type state struct {
+	contractEvents []contractEvent
+	systemEvents   []structs.SystemEvent
+	nodes          map[string]structs.Node
+	validators     map[string]structs.Validator
+	// versions ordered by height, each valid until the following one
+	nodeHistory      map[string][]structs.Node
+	validatorHistory map[string][]structs.Validator
+	// ordered by height
+	validatorAddresses []structs.ValidatorAddress
+	delegations        []delegation
+	accounts           map[common.Address]structs.Account
+	statistics         []structs.ValidatorStatistics
+	dailyStatistics    map[dailyStatisticKey]structs.ValidatorStatistics
+	blocks             map[uint64]structs.Block
+	transactions       map[common.Hash]structs.Transaction
+	failedEvents       []structs.FailedEvent
+	webhooks           []structs.Webhook
+	webhookDeliveries  []webhookDelivery
+	outbox             []structs.OutboxMessage
+	outboxSeq          uint64
+	decentralization   []structs.Decentralization
+}
+
+func newState() *state {
+	return &state{
+		nodes:            map[string]structs.Node{},
+		validators:       map[string]structs.Validator{},
+		nodeHistory:      map[string][]structs.Node{},
+		validatorHistory: map[string][]structs.Validator{},
+		accounts:         map[common.Address]structs.Account{},
+		blocks:           map[uint64]structs.Block{},
+		transactions:     map[common.Hash]structs.Transaction{},
+		dailyStatistics:  map[dailyStatisticKey]structs.ValidatorStatistics{},
+	}
+}
+
+// clone copies the state. Stored records are never modified in place, so it's enough to copy containers
+func (s *state) clone() *state {
+	c := &state{
+		contractEvents:     append([]contractEvent(nil), s.contractEvents...),
+		systemEvents:       append([]structs.SystemEvent(nil), s.systemEvents...),
+		nodes:              make(map[string]structs.Node, len(s.nodes)),
+		validators:         make(map[string]structs.Validator, len(s.validators)),
+		nodeHistory:        make(map[string][]structs.Node, len(s.nodeHistory)),
+		validatorHistory:   make(map[string][]structs.Validator, len(s.validatorHistory)),
+		validatorAddresses: append([]structs.ValidatorAddress(nil), s.validatorAddresses...),
+		delegations:        append([]delegation(nil), s.delegations...),
+		accounts:           make(map[common.Address]structs.Account, len(s.accounts)),
+		statistics:         append([]structs.ValidatorStatistics(nil), s.statistics...),
+		dailyStatistics:    make(map[dailyStatisticKey]structs.ValidatorStatistics, len(s.dailyStatistics)),
+		blocks:             make(map[uint64]structs.Block, len(s.blocks)),
+		transactions:       make(map[common.Hash]structs.Transaction, len(s.transactions)),
+		failedEvents:       append([]structs.FailedEvent(nil), s.failedEvents...),
+		webhooks:           append([]structs.Webhook(nil), s.webhooks...),
+		webhookDeliveries:  append([]webhookDelivery(nil), s.webhookDeliveries...),
+		outbox:             append([]structs.OutboxMessage(nil), s.outbox...),
+		outboxSeq:          s.outboxSeq,
+		decentralization:   append([]structs.Decentralization(nil), s.decentralization...),
+	}
+	for k, v := range s.nodes {
+		c.nodes[k] = v
+	}
+	for k, v := range s.validators {
+		c.validators[k] = v
+	}
+	for k, v := range s.nodeHistory {
+		c.nodeHistory[k] = append([]structs.Node(nil), v...)
+	}
+	for k, v := range s.validatorHistory {
+		c.validatorHistory[k] = append([]structs.Validator(nil), v...)
+	}
+	for k, v := range s.accounts {
+		c.accounts[k] = v
+	}
+	for k, v := range s.blocks {
+		c.blocks[k] = v
+	}
+	for k, v := range s.transactions {
+		c.transactions[k] = v
+	}
+	for k, v := range s.dailyStatistics {
+		c.dailyStatistics[k] = v
+	}
+	return c
+}
+
+type scopeKey struct{}
+
+// scope marks context of the Atomic call of given driver
+type scope struct {
+	d *Driver
+}
+
+func (d *Driver) inScope(ctx context.Context) bool {
+	s, ok := ctx.Value(scopeKey{}).(*scope)
+	return ok && s.d == d
+}
+
+// write applies fn to the state under the write lock
+func (d *Driver) write(ctx context.Context, fn func(s *state) error) error {
+	if !d.inScope(ctx) {
+		d.scopeLock.Lock()
+		defer d.scopeLock.Unlock()
+	}
+
+	d.lock.Lock()
+	defer d.lock.Unlock()
+	return fn(d.s)
+}
+
+// read applies fn to the state under the read lock
+func (d *Driver) read(fn func(s *state) error) error {
+	d.lock.RLock()
+	defer d.lock.RUnlock()
+	return fn(d.s)
+}
+
+// Atomic runs fn restoring the state from before the call when it fails.
+// Scopes are run one at a time; nested calls act as savepoints
+func (d *Driver) Atomic(ctx context.Context, fn func(ctx context.Context) error) (err error) {
+	if !d.inScope(ctx) {
+		d.scopeLock.Lock()
+		defer d.scopeLock.Unlock()
+		ctx = context.WithValue(ctx, scopeKey{}, &scope{d})
+	}
+
+	d.lock.RLock()
+	snapshot := d.s.clone()
+	d.lock.RUnlock()
+
+	defer func() {
+		if p := recover(); p != nil {
+			d.restore(snapshot)
+			panic(p)
+		}
+	}()
+
+	if err = fn(ctx); err != nil {
+		d.restore(snapshot)
+	}
+	return err
+}
+
+func (d *Driver) restore(s *state) {
+	d.lock.Lock()
+	d.s = s
+	d.lock.Unlock()
+}
+
+type bulkKey struct{}
+
+// bulk holds writes made outside of Atomic during the Bulk call, shared by all the goroutines using its context
+type bulk struct {
+	lock   sync.Mutex
+	writes []func(ctx context.Context) error
+}
+
+// deferWrite adds write to the Bulk of ctx unless it's made within Atomic, reporting whether it did
+func (d *Driver) deferWrite(ctx context.Context, write func(ctx context.Context) error) bool {
+	bk, ok := ctx.Value(bulkKey{}).(*bulk)
+	if !ok || d.inScope(ctx) {
+		return false
+	}
+
+	bk.lock.Lock()
+	bk.writes = append(bk.writes, write)
+	bk.lock.Unlock()
+	return true
+}
+
+// Bulk runs fn. Writes made within Atomic are applied with it, as there is nothing to gain from buffering them in memory.
+// Contract events, accounts and validator statistics written outside of Atomic are applied at once when fn succeeds
+// and discarded when it fails, the same as in postgres
+func (d *Driver) Bulk(ctx context.Context, fn func(ctx context.Context) error) error {
+	if _, ok := ctx.Value(bulkKey{}).(*bulk); ok {
+		return fn(ctx)
+	}
+	if d.inScope(ctx) {
+		return fn(ctx)
+	}
+
+	bk := &bulk{}
+	if err := fn(context.WithValue(ctx, bulkKey{}, bk)); err != nil {
+		return err
+	}
+
+	if len(bk.writes) == 0 {
+		return nil
+	}
+	return d.Atomic(ctx, func(ctx context.Context) error {
+		for _, write := range bk.writes {
+			if err := write(ctx); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+func copyBig(i *big.Int) *big.Int {
+	if i == nil {
+		return nil
+	}
+	return new(big.Int).Set(i)
+}
+
+// abs mimics reading unsigned value stored as a decimal, as done by the postgres driver
+func abs(i *big.Int) *big.Int {
+	if i == nil {
+		return new(big.Int)
+	}
+	return new(big.Int).Abs(i)
+}
+
+// parseBig parses decimal parameter, the way postgres casts it to numeric
+func parseBig(s string) (*big.Int, error) {
+	i, ok := new(big.Int).SetString(s, 10)
+	if !ok {
+		return nil, errInvalidNumber(s)
+	}
+	return i, nil
+}
+
+type errInvalidNumber string
+
+func (e errInvalidNumber) Error() string {
+	return "invalid input syntax for type numeric: " + string(e)
+}
+
+// page applies limit and offset the same way postgres driver does - offset only with limit
+func page(n int, limit, offset uint64) (from, to int) {
+	if limit == 0 {
+		return 0, n
+	}
+	from = int(offset)
+	if from > n {
+		from = n
+	}
+	to = from + int(limit)
+	if to > n {
+		to = n
+	}
+	return from, to
+}
+
+// latestFirst orders records by height and id, the latest first, as cursor paging of the postgres driver does
+func latestFirst(heightI uint64, idI string, heightJ uint64, idJ string) bool {
+	if heightI != heightJ {
+		return heightI > heightJ
+	}
+	return idI > idJ
+}
+
+// pageAfter applies cursor when it's set, limit and offset otherwise, to records ordered latest first
+func pageAfter(n int, c structs.Cursor, limit, offset uint64, position func(i int) (height uint64, id string)) (from, to int) {
+	if c.IsZero() {
+		return page(n, limit, offset)
+	}
+	from = sort.Search(n, func(i int) bool {
+		h, id := position(i)
+		return latestFirst(c.BlockHeight, c.ID, h, id)
+	})
+	to = n
+	if limit > 0 && from+int(limit) < n {
+		to = from + int(limit)
+	}
+	return from, to
+}
+
+// pageAfterKey applies cursor when it's set, limit and offset otherwise. past reports whether the record at i
+// is listed after the cursor
+func pageAfterKey(n int, c structs.KeyCursor, limit, offset uint64, past func(i int) bool) (from, to int) {
+	if c.IsZero() {
+		return page(n, limit, offset)
+	}
+	from = sort.Search(n, past)
+	to = n
+	if limit > 0 && from+int(limit) < n {
+		to = from + int(limit)
+	}
+	return from, to
+}
+
+// between is inclusive on both ends, as sql BETWEEN
+func between(t, from, to time.Time) bool {
+	return !t.Before(from) && !t.After(to)
+}
@@ -0,0 +1,14 @@
+package memory
+
+import (
+	"testing"
+
+	"github.com/figment-networks/skale-indexer/store"
+	"github.com/figment-networks/skale-indexer/store/storetest"
+)
+
+func TestDriver(t *testing.T) {
+	storetest.Run(t, func(t *testing.T) store.DBDriver {
+		return NewDriver()
+	})
+}
@@ -0,0 +1,175 @@
+package memory
+
+import (
+	"context"
+	"math/big"
+	"net"
+	"sort"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/google/uuid"
+
+	"github.com/figment-networks/skale-indexer/scraper/structs"
+)
+
+// SaveNodes saves nodes
+func (d *Driver) SaveNodes(ctx context.Context, nodes []structs.Node, removedNodeAddress common.Address) error {
+	return d.write(ctx, func(s *state) error {
+		now := time.Now()
+		for _, n := range nodes {
+			sn := n
+			sn.NodeID = abs(n.NodeID)
+			sn.ValidatorID = abs(n.ValidatorID)
+			sn.StartBlock = abs(n.StartBlock)
+			sn.FinishTime = abs(n.FinishTime)
+			sn.IP = net.ParseIP(n.IP.String())
+			sn.PublicIP = net.ParseIP(n.PublicIP.String())
+			sn.Status, _ = structs.GetTypeForNode(n.Status.String())
+			s.saveNodeVersion(sn)
+
+			key := n.NodeID.String()
+			stored, ok := s.nodes[key]
+			if ok && stored.BlockHeight > n.BlockHeight {
+				continue
+			}
+			sn.ID, sn.CreatedAt = stored.ID, stored.CreatedAt
+			if !ok {
+				sn.ID, sn.CreatedAt = uuid.New().String(), now
+			}
+			s.nodes[key] = sn
+		}
+
+		// update removed node
+		if len(nodes) > 0 {
+			for k, n := range s.nodes {
+				if n.ValidatorID.Cmp(nodes[0].ValidatorID) == 0 && n.Address == removedNodeAddress {
+					n.Address = common.Address{}
+					s.nodes[k] = n
+				}
+			}
+			if removedNodeAddress != (common.Address{}) {
+				s.removeNodeAddressVersion(nodes[0].ValidatorID, nodes[0].BlockHeight, removedNodeAddress)
+			}
+		}
+		return nil
+	})
+}
+
+// GetNodes gets nodes
+func (d *Driver) GetNodes(ctx context.Context, params structs.NodeParams) (nodes []structs.Node, err error) {
+	var nodeID, validatorID *big.Int
+	if params.NodeID != "" {
+		if nodeID, err = parseBig(params.NodeID); err != nil {
+			return nil, err
+		}
+	}
+	if params.ValidatorID != "" {
+		if validatorID, err = parseBig(params.ValidatorID); err != nil {
+			return nil, err
+		}
+	}
+	var after *big.Int
+	if !params.Cursor.IsZero() {
+		if after, err = parseBig(params.Cursor.Key); err != nil {
+			return nil, err
+		}
+	}
+
+	d.read(func(s *state) error {
+		latest := make([]structs.Node, 0, len(s.nodes))
+		if params.AtHeight > 0 {
+			latest = s.nodesAt(params.AtHeight)
+		} else {
+			for _, n := range s.nodes {
+				latest = append(latest, n)
+			}
+		}
+
+		for _, n := range latest {
+			if nodeID != nil && n.NodeID.Cmp(nodeID) != 0 {
+				continue
+			}
+			if validatorID != nil && n.ValidatorID.Cmp(validatorID) != 0 {
+				continue
+			}
+			if params.Status != "" && n.Status.String() != params.Status {
+				continue
+			}
+			if params.Address != "" && n.Address != common.HexToAddress(params.Address) {
+				continue
+			}
+			nodes = append(nodes, copyNode(n))
+		}
+		return nil
+	})
+
+	sort.Slice(nodes, func(i, j int) bool { return nodes[i].NodeID.Cmp(nodes[j].NodeID) < 0 })
+
+	from, to := pageAfterKey(len(nodes), params.Cursor, params.Limit, params.Offset, func(i int) bool {
+		return nodes[i].NodeID.Cmp(after) > 0
+	})
+	return nodes[from:to], nil
+}
+
+// CountNodes counts nodes matching params, regardless of paging
+func (d *Driver) CountNodes(ctx context.Context, params structs.NodeParams) (count uint64, err error) {
+	params.Limit, params.Offset, params.Cursor = 0, 0, structs.KeyCursor{}
+	nodes, err := d.GetNodes(ctx, params)
+	return uint64(len(nodes)), err
+}
+
+// GetNodeTimeline gets changes of nodes, the latest first
+func (d *Driver) GetNodeTimeline(ctx context.Context, params structs.NodeParams) (changes []structs.NodeChange, err error) {
+	var nodeID, validatorID *big.Int
+	if params.NodeID != "" {
+		if nodeID, err = parseBig(params.NodeID); err != nil {
+			return nil, err
+		}
+	}
+	if params.ValidatorID != "" {
+		if validatorID, err = parseBig(params.ValidatorID); err != nil {
+			return nil, err
+		}
+	}
+
+	d.read(func(s *state) error {
+		for _, versions := range s.nodeHistory {
+			for i, n := range versions {
+				if nodeID != nil && n.NodeID.Cmp(nodeID) != 0 {
+					break
+				}
+				if validatorID != nil && n.ValidatorID.Cmp(validatorID) != 0 {
+					continue
+				}
+				if params.Address != "" && n.Address != common.HexToAddress(params.Address) {
+					continue
+				}
+				c := structs.NodeChange{Node: copyNode(n), Time: s.blocks[n.BlockHeight].Time}
+				if i+1 < len(versions) {
+					c.ValidTo = versions[i+1].BlockHeight
+				}
+				changes = append(changes, c)
+			}
+		}
+		return nil
+	})
+
+	sort.Slice(changes, func(i, j int) bool {
+		if changes[i].BlockHeight != changes[j].BlockHeight {
+			return changes[i].BlockHeight > changes[j].BlockHeight
+		}
+		return changes[i].NodeID.Cmp(changes[j].NodeID) < 0
+	})
+
+	from, to := page(len(changes), params.Limit, params.Offset)
+	return changes[from:to], nil
+}
+
+func copyNode(n structs.Node) structs.Node {
+	n.NodeID = copyBig(n.NodeID)
+	n.ValidatorID = copyBig(n.ValidatorID)
+	n.StartBlock = copyBig(n.StartBlock)
+	n.FinishTime = copyBig(n.FinishTime)
+	return n
+}
@@ -0,0 +1,61 @@
+package memory
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	"github.com/figment-networks/skale-indexer/scraper/structs"
+)
+
+// SaveOutboxMessages saves messages, numbering them in order
+func (d *Driver) SaveOutboxMessages(ctx context.Context, msgs []structs.OutboxMessage) error {
+	return d.write(ctx, func(s *state) error {
+		now := time.Now()
+		for _, m := range msgs {
+			s.outboxSeq++
+			m.ID, m.CreatedAt = s.outboxSeq, now
+			m.Payload = append(json.RawMessage(nil), m.Payload...)
+			s.outbox = append(s.outbox, m)
+		}
+		return nil
+	})
+}
+
+// GetOutboxMessages gets messages in order they were saved in
+func (d *Driver) GetOutboxMessages(ctx context.Context, params structs.OutboxParams) (msgs []structs.OutboxMessage, err error) {
+	d.read(func(s *state) error {
+		for _, m := range s.outbox {
+			if m.ID <= params.After {
+				continue
+			}
+			msgs = append(msgs, m)
+		}
+		return nil
+	})
+
+	from, to := page(len(msgs), params.Limit, 0)
+	return msgs[from:to], nil
+}
+
+// DeleteOutboxMessages removes published messages
+func (d *Driver) DeleteOutboxMessages(ctx context.Context, ids []uint64) error {
+	if len(ids) == 0 {
+		return nil
+	}
+	published := make(map[uint64]bool, len(ids))
+	for _, id := range ids {
+		published[id] = true
+	}
+
+	return d.write(ctx, func(s *state) error {
+		var outbox []structs.OutboxMessage
+		for _, m := range s.outbox {
+			if !published[m.ID] {
+				outbox = append(outbox, m)
+			}
+		}
+		s.outbox = outbox
+		return nil
+	})
+}
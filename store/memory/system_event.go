@@ -0,0 +1,125 @@
+package memory
+
+import (
+	"context"
+	"math/big"
+	"sort"
+	"strconv"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/google/uuid"
+
+	"github.com/figment-networks/skale-indexer/scraper/structs"
+)
+
+// SaveSystemEvent saves system events
+func (d *Driver) SaveSystemEvent(ctx context.Context, se structs.SystemEvent) error {
+	e := se
+	e.SenderID = *abs(&se.SenderID)
+	e.RecipientID = *abs(&se.RecipientID)
+	e.Before = *abs(&se.Before)
+	e.After = *abs(&se.After)
+	e.Change = decimal(&se.Change)
+
+	return d.write(ctx, func(s *state) error {
+		for i, stored := range s.systemEvents {
+			if stored.Height == se.Height &&
+				stored.Kind == se.Kind &&
+				stored.Sender == se.Sender &&
+				stored.SenderID.Cmp(&e.SenderID) == 0 &&
+				stored.Recipient == se.Recipient &&
+				stored.RecipientID.Cmp(&e.RecipientID) == 0 {
+				stored.Before, stored.After, stored.Change = e.Before, e.After, e.Change
+				s.systemEvents[i] = stored
+				return nil
+			}
+		}
+		e.ID = uuid.New().String()
+		s.systemEvents = append(s.systemEvents, e)
+		return nil
+	})
+}
+
+// GetSystemEvents gets contract events
+func (d *Driver) GetSystemEvents(ctx context.Context, params structs.SystemEventParams) (systemEvents []structs.SystemEvent, err error) {
+	if systemEvents, err = d.systemEvents(params); err != nil {
+		return nil, err
+	}
+
+	from, to := pageAfter(len(systemEvents), params.Cursor, params.Limit, params.Offset, func(i int) (uint64, string) {
+		return systemEvents[i].Height, systemEvents[i].ID
+	})
+	return systemEvents[from:to], nil
+}
+
+// CountSystemEvents counts system events matching params, regardless of paging
+func (d *Driver) CountSystemEvents(ctx context.Context, params structs.SystemEventParams) (count uint64, err error) {
+	systemEvents, err := d.systemEvents(params)
+	return uint64(len(systemEvents)), err
+}
+
+// systemEvents returns system events matching params, the latest first
+func (d *Driver) systemEvents(params structs.SystemEventParams) (systemEvents []structs.SystemEvent, err error) {
+	var (
+		address     common.Address
+		validatorID *big.Int
+		kind        uint64
+	)
+	if params.Address != "" {
+		address = common.HexToAddress(params.Address)
+	}
+	if params.ValidatorID != "" {
+		if validatorID, err = parseBig(params.ValidatorID); err != nil {
+			return nil, err
+		}
+	}
+	if params.Kind != "" {
+		if kind, err = strconv.ParseUint(params.Kind, 10, 16); err != nil {
+			return nil, errInvalidNumber(params.Kind)
+		}
+	}
+
+	d.read(func(s *state) error {
+		for _, e := range s.systemEvents {
+			if params.Address != "" && e.Sender != address && e.Recipient != address {
+				continue
+			}
+			if params.ID != "" && e.ID != params.ID {
+				continue
+			}
+			if validatorID != nil && e.SenderID.Cmp(validatorID) != 0 && e.RecipientID.Cmp(validatorID) != 0 {
+				continue
+			}
+			if params.ReceiverID > 0 && !(e.RecipientID.IsUint64() && e.RecipientID.Uint64() == params.ReceiverID) {
+				continue
+			}
+			if params.SenderID > 0 && !(e.SenderID.IsUint64() && e.SenderID.Uint64() == params.SenderID) {
+				continue
+			}
+			if params.Kind != "" && uint64(e.Kind) != kind {
+				continue
+			}
+			if params.After > 0 && e.Height <= params.After {
+				continue
+			}
+			if params.AtHeight > 0 && e.Height > params.AtHeight {
+				continue
+			}
+			systemEvents = append(systemEvents, e)
+		}
+		return nil
+	})
+
+	sort.Slice(systemEvents, func(i, j int) bool {
+		return latestFirst(systemEvents[i].Height, systemEvents[i].ID, systemEvents[j].Height, systemEvents[j].ID)
+	})
+	return systemEvents, nil
+}
+
+// decimal mimics storing float in DECIMAL(65,0) column
+func decimal(f *big.Float) big.Float {
+	p := new(big.Float)
+	p.SetString(f.String())
+	p.SetString(p.Text('f', 0))
+	return *p
+}
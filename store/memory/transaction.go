@@ -0,0 +1,41 @@
+package memory
+
+import (
+	"context"
+
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/figment-networks/skale-indexer/scraper/structs"
+)
+
+// SaveTransaction saves transaction
+func (d *Driver) SaveTransaction(ctx context.Context, tx structs.Transaction) error {
+	st := tx
+	st.Value = abs(tx.Value)
+	st.GasPrice = abs(tx.GasPrice)
+	st.Fee = abs(tx.Fee)
+
+	return d.write(ctx, func(s *state) error {
+		if stored, ok := s.transactions[tx.Hash]; ok {
+			// values given when transaction was sent never change
+			st.From, st.To, st.Value, st.Nonce, st.Gas, st.GasPrice = stored.From, stored.To, stored.Value, stored.Nonce, stored.Gas, stored.GasPrice
+		}
+		s.transactions[tx.Hash] = st
+		return nil
+	})
+}
+
+// GetTransaction gets transaction by hash
+func (d *Driver) GetTransaction(ctx context.Context, hash common.Hash) (tx structs.Transaction, err error) {
+	err = d.read(func(s *state) error {
+		var ok bool
+		if tx, ok = s.transactions[hash]; !ok {
+			return structs.ErrNotFound
+		}
+		return nil
+	})
+	tx.Value = copyBig(tx.Value)
+	tx.GasPrice = copyBig(tx.GasPrice)
+	tx.Fee = copyBig(tx.Fee)
+	return tx, err
+}
@@ -0,0 +1,201 @@
+package memory
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/google/uuid"
+
+	"github.com/figment-networks/skale-indexer/scraper/structs"
+)
+
+// SaveValidator saves validator
+func (d *Driver) SaveValidator(ctx context.Context, v structs.Validator) error {
+	return d.write(ctx, func(s *state) error {
+		key := v.ValidatorID.String()
+		sv := v
+		sv.ValidatorID = abs(v.ValidatorID)
+		sv.FeeRate = abs(v.FeeRate)
+		sv.MinimumDelegationAmount = abs(v.MinimumDelegationAmount)
+
+		version := sv
+		version.ActiveNodes, version.LinkedNodes, version.Staked = 0, 0, nil
+		s.saveValidatorVersion(version)
+
+		stored, ok := s.validators[key]
+		if ok && stored.BlockHeight > v.BlockHeight {
+			return nil
+		}
+		if ok {
+			// counts are maintained by UpdateCountsOfValidator
+			sv.ID, sv.CreatedAt = stored.ID, stored.CreatedAt
+			sv.ActiveNodes, sv.LinkedNodes, sv.Staked = stored.ActiveNodes, stored.LinkedNodes, stored.Staked
+		} else {
+			sv.ID, sv.CreatedAt = uuid.New().String(), time.Now()
+			sv.Staked = abs(v.Staked)
+		}
+		s.validators[key] = sv
+		return nil
+	})
+}
+
+// GetValidators gets validators by params
+func (d *Driver) GetValidators(ctx context.Context, params structs.ValidatorParams) (validators []structs.Validator, err error) {
+	var validatorID *big.Int
+	if params.ValidatorID != "" {
+		if validatorID, err = parseBig(params.ValidatorID); err != nil {
+			return nil, err
+		}
+	}
+
+	less, err := validatorsOrder(params.OrderBy, params.OrderDirection)
+	if err != nil {
+		return nil, err
+	}
+
+	var after *big.Int
+	if !params.Cursor.IsZero() {
+		if params.OrderBy != "" {
+			return nil, structs.ErrCursorOrder
+		}
+		if after, err = parseBig(params.Cursor.Key); err != nil {
+			return nil, err
+		}
+	}
+
+	d.read(func(s *state) error {
+		latest := make([]structs.Validator, 0, len(s.validators))
+		if params.AtHeight > 0 {
+			latest = s.validatorsAt(params.AtHeight)
+		} else {
+			for _, v := range s.validators {
+				latest = append(latest, v)
+			}
+		}
+
+		for _, v := range latest {
+			if validatorID != nil && v.ValidatorID.Cmp(validatorID) != 0 {
+				continue
+			}
+			if !params.TimeFrom.IsZero() && !params.TimeTo.IsZero() && !between(v.RegistrationTime, params.TimeFrom, params.TimeTo) {
+				continue
+			}
+			if params.Authorized > 0 && v.Authorized != (params.Authorized == structs.StateTrue) {
+				continue
+			}
+			if params.Address != "" && v.ValidatorAddress != common.HexToAddress(params.Address) {
+				continue
+			}
+			validators = append(validators, copyValidator(v))
+		}
+		return nil
+	})
+
+	sort.Slice(validators, func(i, j int) bool {
+		if c := less(validators[i], validators[j]); c != 0 {
+			return c < 0
+		}
+		return validators[i].ValidatorID.Cmp(validators[j].ValidatorID) < 0
+	})
+
+	from, to := pageAfterKey(len(validators), params.Cursor, params.Limit, params.Offset, func(i int) bool {
+		return validators[i].ValidatorID.Cmp(after) > 0
+	})
+	return validators[from:to], nil
+}
+
+// CountValidators counts validators matching params, regardless of paging
+func (d *Driver) CountValidators(ctx context.Context, params structs.ValidatorParams) (count uint64, err error) {
+	params.Limit, params.Offset, params.Cursor = 0, 0, structs.KeyCursor{}
+	validators, err := d.GetValidators(ctx, params)
+	return uint64(len(validators)), err
+}
+
+// validatorsOrder returns comparison for the column validators are ordered by
+func validatorsOrder(orderBy, direction string) (func(a, b structs.Validator) int, error) {
+	var cmp func(a, b structs.Validator) int
+	switch strings.TrimSpace(orderBy) {
+	case "", "validator_id":
+		cmp = func(a, b structs.Validator) int { return a.ValidatorID.Cmp(b.ValidatorID) }
+	case "name":
+		cmp = func(a, b structs.Validator) int { return strings.Compare(a.Name, b.Name) }
+	case "fee_rate":
+		cmp = func(a, b structs.Validator) int { return a.FeeRate.Cmp(b.FeeRate) }
+	case "registration_time":
+		cmp = func(a, b structs.Validator) int { return compareTime(a.RegistrationTime, b.RegistrationTime) }
+	case "minimum_delegation_amount":
+		cmp = func(a, b structs.Validator) int { return a.MinimumDelegationAmount.Cmp(b.MinimumDelegationAmount) }
+	case "active_nodes":
+		cmp = func(a, b structs.Validator) int { return compareUint(uint64(a.ActiveNodes), uint64(b.ActiveNodes)) }
+	case "linked_nodes":
+		cmp = func(a, b structs.Validator) int { return compareUint(uint64(a.LinkedNodes), uint64(b.LinkedNodes)) }
+	case "staked":
+		cmp = func(a, b structs.Validator) int { return a.Staked.Cmp(b.Staked) }
+	case "block_height":
+		cmp = func(a, b structs.Validator) int { return compareUint(a.BlockHeight, b.BlockHeight) }
+	default:
+		return nil, fmt.Errorf("unknown order column: %s", orderBy)
+	}
+
+	switch strings.ToUpper(strings.TrimSpace(direction)) {
+	case "", "ASC":
+		return cmp, nil
+	case "DESC":
+		return func(a, b structs.Validator) int { return -cmp(a, b) }, nil
+	default:
+		return nil, fmt.Errorf("unknown order direction: %s", direction)
+	}
+}
+
+// UpdateCountsOfValidator updates linked ,active  node count as well as total stake information of validator
+func (d *Driver) UpdateCountsOfValidator(ctx context.Context, validatorID *big.Int) error {
+	if d.deferWrite(ctx, func(ctx context.Context) error { return d.UpdateCountsOfValidator(ctx, validatorID) }) {
+		return nil
+	}
+
+	return d.write(ctx, func(s *state) error {
+		key := validatorID.String()
+		v, ok := s.validators[key]
+		if !ok {
+			return nil
+		}
+		v.ActiveNodes = uint(s.latestStatistic(validatorID, structs.ValidatorStatisticsTypeActiveNodes, 0).Uint64())
+		v.LinkedNodes = uint(s.latestStatistic(validatorID, structs.ValidatorStatisticsTypeLinkedNodes, 0).Uint64())
+		v.Staked = s.latestStatistic(validatorID, structs.ValidatorStatisticsTypeTotalStake, 0)
+		s.validators[key] = v
+		return nil
+	})
+}
+
+func copyValidator(v structs.Validator) structs.Validator {
+	v.ValidatorID = copyBig(v.ValidatorID)
+	v.FeeRate = copyBig(v.FeeRate)
+	v.MinimumDelegationAmount = copyBig(v.MinimumDelegationAmount)
+	v.Staked = copyBig(v.Staked)
+	return v
+}
+
+func compareUint(a, b uint64) int {
+	switch {
+	case a < b:
+		return -1
+	case a > b:
+		return 1
+	}
+	return 0
+}
+
+func compareTime(a, b time.Time) int {
+	switch {
+	case a.Before(b):
+		return -1
+	case a.After(b):
+		return 1
+	}
+	return 0
+}
@@ -0,0 +1,79 @@
+package memory
+
+import (
+	"context"
+	"math/big"
+	"sort"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/google/uuid"
+
+	"github.com/figment-networks/skale-indexer/scraper/structs"
+)
+
+// SaveValidatorAddress saves change of validator addresses, unless they are the same as in the previous change
+func (d *Driver) SaveValidatorAddress(ctx context.Context, va structs.ValidatorAddress) error {
+	va.ValidatorID = abs(va.ValidatorID)
+	return d.write(ctx, func(s *state) error {
+		var previous *structs.ValidatorAddress
+		for i, a := range s.validatorAddresses {
+			if a.ValidatorID.Cmp(va.ValidatorID) != 0 {
+				continue
+			}
+			if a.BlockHeight == va.BlockHeight {
+				va.ID, va.CreatedAt = a.ID, a.CreatedAt
+				s.validatorAddresses[i] = va
+				return nil
+			}
+			if a.BlockHeight < va.BlockHeight {
+				previous = &s.validatorAddresses[i]
+			}
+		}
+		if previous != nil && previous.Address == va.Address && previous.RequestedAddress == va.RequestedAddress {
+			return nil
+		}
+
+		va.ID, va.CreatedAt = uuid.New().String(), time.Now()
+		s.validatorAddresses = append(s.validatorAddresses, va)
+		sort.SliceStable(s.validatorAddresses, func(i, j int) bool {
+			return s.validatorAddresses[i].BlockHeight < s.validatorAddresses[j].BlockHeight
+		})
+		return nil
+	})
+}
+
+// GetValidatorAddresses gets changes of validator addresses, the latest first
+func (d *Driver) GetValidatorAddresses(ctx context.Context, params structs.ValidatorAddressParams) (addresses []structs.ValidatorAddress, err error) {
+	var validatorID *big.Int
+	if params.ValidatorID != "" {
+		if validatorID, err = parseBig(params.ValidatorID); err != nil {
+			return nil, err
+		}
+	}
+	address := common.HexToAddress(params.Address)
+
+	d.read(func(s *state) error {
+		for _, a := range s.validatorAddresses {
+			if validatorID != nil && a.ValidatorID.Cmp(validatorID) != 0 {
+				continue
+			}
+			if params.Address != "" && a.Address != address && a.RequestedAddress != address {
+				continue
+			}
+			a.ValidatorID = copyBig(a.ValidatorID)
+			addresses = append(addresses, a)
+		}
+		return nil
+	})
+
+	sort.SliceStable(addresses, func(i, j int) bool {
+		if addresses[i].BlockHeight != addresses[j].BlockHeight {
+			return addresses[i].BlockHeight > addresses[j].BlockHeight
+		}
+		return addresses[i].ValidatorID.Cmp(addresses[j].ValidatorID) < 0
+	})
+
+	from, to := page(len(addresses), params.Limit, params.Offset)
+	return addresses[from:to], nil
+}
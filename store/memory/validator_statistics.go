@@ -0,0 +1,211 @@
+package memory
+
+import (
+	"context"
+	"math/big"
+	"sort"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/figment-networks/skale-indexer/scraper/structs"
+)
+
+func (d *Driver) SaveValidatorStatistic(ctx context.Context, validatorID *big.Int, blockHeight uint64, blockTime time.Time, statisticsType structs.StatisticTypeVS, amount *big.Int) (err error) {
+	if d.deferWrite(ctx, func(ctx context.Context) error {
+		return d.SaveValidatorStatistic(ctx, validatorID, blockHeight, blockTime, statisticsType, amount)
+	}) {
+		return nil
+	}
+
+	return d.write(ctx, func(s *state) error {
+		// Update value in validator_statistics unless the value already exists
+		var previous *structs.ValidatorStatistics
+		for i, vs := range s.statistics {
+			if vs.ValidatorID.Cmp(validatorID) == 0 && vs.Type == statisticsType && vs.BlockHeight < blockHeight &&
+				(previous == nil || previous.BlockHeight < vs.BlockHeight) {
+				previous = &s.statistics[i]
+			}
+		}
+		if previous != nil && previous.Amount.Cmp(amount) == 0 {
+			return nil
+		}
+
+		for i, vs := range s.statistics {
+			if vs.ValidatorID.Cmp(validatorID) == 0 && vs.Type == statisticsType && vs.BlockHeight == blockHeight {
+				vs.Amount = copyBig(amount)
+				s.statistics[i] = vs
+				s.rollupStatistic(vs)
+				return nil
+			}
+		}
+
+		vs := structs.ValidatorStatistics{
+			ID:          uuid.New().String(),
+			CreatedAt:   time.Now(),
+			ValidatorID: copyBig(validatorID),
+			Amount:      copyBig(amount),
+			BlockHeight: blockHeight,
+			Time:        blockTime,
+			Type:        statisticsType,
+		}
+		s.statistics = append(s.statistics, vs)
+		s.rollupStatistic(vs)
+		return nil
+	})
+}
+
+// dailyStatisticKey identifies the value of statistic in a day
+type dailyStatisticKey struct {
+	validatorID string
+	statType    structs.StatisticTypeVS
+	day         time.Time
+}
+
+// rollupStatistic keeps the last value of statistic in its day, as the trigger on validator_statistics does in databases
+func (s *state) rollupStatistic(vs structs.ValidatorStatistics) {
+	t := vs.Time.UTC()
+	k := dailyStatisticKey{vs.ValidatorID.String(), vs.Type, time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.UTC)}
+	if d, ok := s.dailyStatistics[k]; ok && d.BlockHeight > vs.BlockHeight {
+		return
+	}
+	s.dailyStatistics[k] = structs.ValidatorStatistics{ValidatorID: vs.ValidatorID, Amount: vs.Amount, BlockHeight: vs.BlockHeight, Time: k.day, Type: vs.Type}
+}
+
+// GetValidatorStatisticsDaily gets the last value of statistic in every day (UTC) it changed within the time range,
+// with the last value before the range of every validator
+func (d *Driver) GetValidatorStatisticsDaily(ctx context.Context, params structs.ValidatorStatisticsParams) (validatorStatistics []structs.ValidatorStatistics, err error) {
+	var validatorID *big.Int
+	if params.ValidatorID != "" {
+		if validatorID, err = parseBig(params.ValidatorID); err != nil {
+			return nil, err
+		}
+	}
+	from, to := params.TimeFrom.UTC(), params.TimeTo.UTC()
+	from = time.Date(from.Year(), from.Month(), from.Day(), 0, 0, 0, 0, time.UTC)
+	to = time.Date(to.Year(), to.Month(), to.Day(), 0, 0, 0, 0, time.UTC)
+
+	before := map[string]structs.ValidatorStatistics{}
+	d.read(func(s *state) error {
+		for _, vs := range s.dailyStatistics {
+			if vs.Type != params.Type || (validatorID != nil && vs.ValidatorID.Cmp(validatorID) != 0) || vs.Time.After(to) {
+				continue
+			}
+			if !vs.Time.Before(from) {
+				validatorStatistics = append(validatorStatistics, copyStatistic(vs))
+				continue
+			}
+			if b, ok := before[vs.ValidatorID.String()]; !ok || b.Time.Before(vs.Time) {
+				before[vs.ValidatorID.String()] = vs
+			}
+		}
+		return nil
+	})
+	for _, vs := range before {
+		validatorStatistics = append(validatorStatistics, copyStatistic(vs))
+	}
+
+	sort.Slice(validatorStatistics, func(i, j int) bool {
+		if c := validatorStatistics[i].ValidatorID.Cmp(validatorStatistics[j].ValidatorID); c != 0 {
+			return c < 0
+		}
+		return validatorStatistics[i].Time.Before(validatorStatistics[j].Time)
+	})
+	return validatorStatistics, nil
+}
+
+// GetValidatorStatistics gets the latest statistics of every validator and type
+func (d *Driver) GetValidatorStatistics(ctx context.Context, params structs.ValidatorStatisticsParams) (validatorStatistics []structs.ValidatorStatistics, err error) {
+	var validatorID *big.Int
+	if params.ValidatorID != "" {
+		if validatorID, err = parseBig(params.ValidatorID); err != nil {
+			return nil, err
+		}
+	}
+
+	type key struct {
+		validatorID string
+		statType    structs.StatisticTypeVS
+	}
+	latest := map[key]structs.ValidatorStatistics{}
+
+	d.read(func(s *state) error {
+		for _, vs := range s.statistics {
+			if validatorID != nil && vs.ValidatorID.Cmp(validatorID) != 0 {
+				continue
+			}
+			if params.Type > 0 && vs.Type != params.Type {
+				continue
+			}
+			if params.AtHeight > 0 && vs.BlockHeight > params.AtHeight {
+				continue
+			}
+			if !params.TimeFrom.IsZero() && !params.TimeTo.IsZero() && !between(vs.Time, params.TimeFrom, params.TimeTo) {
+				continue
+			}
+			k := key{vs.ValidatorID.String(), vs.Type}
+			if l, ok := latest[k]; !ok || l.BlockHeight < vs.BlockHeight {
+				latest[k] = vs
+			}
+		}
+		return nil
+	})
+
+	for _, vs := range latest {
+		validatorStatistics = append(validatorStatistics, copyStatistic(vs))
+	}
+	sort.Slice(validatorStatistics, func(i, j int) bool {
+		if c := validatorStatistics[i].ValidatorID.Cmp(validatorStatistics[j].ValidatorID); c != 0 {
+			return c < 0
+		}
+		return validatorStatistics[i].Type < validatorStatistics[j].Type
+	})
+
+	from, to := page(len(validatorStatistics), params.Limit, params.Offset)
+	return validatorStatistics[from:to], nil
+}
+
+// GetValidatorStatisticsTimeline gets all values of validator statistic in given time range
+func (d *Driver) GetValidatorStatisticsTimeline(ctx context.Context, params structs.ValidatorStatisticsParams) (validatorStatistics []structs.ValidatorStatistics, err error) {
+	validatorID, err := parseBig(params.ValidatorID)
+	if err != nil {
+		return nil, err
+	}
+
+	d.read(func(s *state) error {
+		for _, vs := range s.statistics {
+			if vs.ValidatorID.Cmp(validatorID) == 0 && vs.Type == params.Type && between(vs.Time, params.TimeFrom, params.TimeTo) {
+				validatorStatistics = append(validatorStatistics, copyStatistic(vs))
+			}
+		}
+		return nil
+	})
+
+	sort.SliceStable(validatorStatistics, func(i, j int) bool {
+		return validatorStatistics[i].BlockHeight > validatorStatistics[j].BlockHeight
+	})
+	return validatorStatistics, nil
+}
+
+// latestStatistic returns the most recent value of validator statistic at given height (if non zero), zero if there is none
+func (s *state) latestStatistic(validatorID *big.Int, statisticsType structs.StatisticTypeVS, atHeight uint64) *big.Int {
+	var latest *structs.ValidatorStatistics
+	for i, vs := range s.statistics {
+		if atHeight > 0 && vs.BlockHeight > atHeight {
+			continue
+		}
+		if vs.ValidatorID.Cmp(validatorID) == 0 && vs.Type == statisticsType && (latest == nil || latest.BlockHeight < vs.BlockHeight) {
+			latest = &s.statistics[i]
+		}
+	}
+	if latest == nil {
+		return new(big.Int)
+	}
+	return copyBig(latest.Amount)
+}
+
+func copyStatistic(vs structs.ValidatorStatistics) structs.ValidatorStatistics {
+	vs.ValidatorID = copyBig(vs.ValidatorID)
+	vs.Amount = copyBig(vs.Amount)
+	return vs
+}
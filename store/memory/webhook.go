@@ -0,0 +1,185 @@
+package memory
+
+import (
+	"context"
+	"encoding/json"
+	"math/big"
+	"sort"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/figment-networks/skale-indexer/scraper/structs"
+)
+
+type webhookDelivery struct {
+	structs.WebhookDelivery
+	eventKey string
+}
+
+// CreateWebhook saves new webhook
+func (d *Driver) CreateWebhook(ctx context.Context, wh structs.Webhook) (id string, err error) {
+	sw := copyWebhook(wh)
+	err = d.write(ctx, func(s *state) error {
+		now := time.Now()
+		sw.ID, sw.CreatedAt, sw.UpdatedAt = uuid.New().String(), now, now
+		s.webhooks = append(s.webhooks, sw)
+		return nil
+	})
+	return sw.ID, err
+}
+
+// UpdateWebhook updates url, secret, filter and activity of webhook
+func (d *Driver) UpdateWebhook(ctx context.Context, wh structs.Webhook) error {
+	sw := copyWebhook(wh)
+	return d.write(ctx, func(s *state) error {
+		for i, stored := range s.webhooks {
+			if stored.ID == wh.ID {
+				sw.CreatedAt, sw.UpdatedAt = stored.CreatedAt, time.Now()
+				s.webhooks[i] = sw
+				return nil
+			}
+		}
+		return structs.ErrNotFound
+	})
+}
+
+// GetWebhooks gets webhooks, the oldest first
+func (d *Driver) GetWebhooks(ctx context.Context, params structs.WebhookParams) (webhooks []structs.Webhook, err error) {
+	d.read(func(s *state) error {
+		for _, wh := range s.webhooks {
+			if params.ID != "" && wh.ID != params.ID {
+				continue
+			}
+			if params.Active && !wh.Active {
+				continue
+			}
+			webhooks = append(webhooks, copyWebhook(wh))
+		}
+		return nil
+	})
+
+	if len(webhooks) == 0 && params.ID != "" {
+		return nil, structs.ErrNotFound
+	}
+
+	from, to := page(len(webhooks), params.Limit, params.Offset)
+	return webhooks[from:to], nil
+}
+
+// DeleteWebhook removes webhook together with its deliveries
+func (d *Driver) DeleteWebhook(ctx context.Context, id string) error {
+	return d.write(ctx, func(s *state) error {
+		for i, wh := range s.webhooks {
+			if wh.ID != id {
+				continue
+			}
+			s.webhooks = append(s.webhooks[:i:i], s.webhooks[i+1:]...)
+
+			var deliveries []webhookDelivery
+			for _, wd := range s.webhookDeliveries {
+				if wd.WebhookID != id {
+					deliveries = append(deliveries, wd)
+				}
+			}
+			s.webhookDeliveries = deliveries
+			return nil
+		}
+		return structs.ErrNotFound
+	})
+}
+
+// CreateWebhookDelivery queues delivery, unless the event is already queued for the webhook
+func (d *Driver) CreateWebhookDelivery(ctx context.Context, wd structs.WebhookDelivery) error {
+	sd, err := roundTripEvent(wd)
+	if err != nil {
+		return err
+	}
+	key := wd.Event.Key()
+
+	return d.write(ctx, func(s *state) error {
+		for _, stored := range s.webhookDeliveries {
+			if stored.WebhookID == wd.WebhookID && stored.eventKey == key {
+				return nil
+			}
+		}
+		now := time.Now()
+		sd.ID, sd.CreatedAt, sd.UpdatedAt = uuid.New().String(), now, now
+		s.webhookDeliveries = append(s.webhookDeliveries, webhookDelivery{WebhookDelivery: sd, eventKey: key})
+		return nil
+	})
+}
+
+// UpdateWebhookDelivery records the outcome of delivery attempt
+func (d *Driver) UpdateWebhookDelivery(ctx context.Context, wd structs.WebhookDelivery) error {
+	return d.write(ctx, func(s *state) error {
+		for i, stored := range s.webhookDeliveries {
+			if stored.ID == wd.ID {
+				sd := stored
+				sd.UpdatedAt = time.Now()
+				sd.Status, sd.Attempts, sd.NextRetry = wd.Status, wd.Attempts, wd.NextRetry
+				sd.ResponseCode, sd.Error, sd.DeliveredAt = wd.ResponseCode, wd.Error, wd.DeliveredAt
+				s.webhookDeliveries[i] = sd
+				return nil
+			}
+		}
+		return structs.ErrNotFound
+	})
+}
+
+// GetWebhookDeliveries gets deliveries, the earliest due first when they're listed for retry, the latest created first otherwise
+func (d *Driver) GetWebhookDeliveries(ctx context.Context, params structs.WebhookDeliveryParams) (deliveries []structs.WebhookDelivery, err error) {
+	d.read(func(s *state) error {
+		for _, wd := range s.webhookDeliveries {
+			if params.ID != "" && wd.ID != params.ID {
+				continue
+			}
+			if params.WebhookID != "" && wd.WebhookID != params.WebhookID {
+				continue
+			}
+			if params.Status != "" && wd.Status != params.Status {
+				continue
+			}
+			if !params.RetryBefore.IsZero() && wd.NextRetry.After(params.RetryBefore) {
+				continue
+			}
+			deliveries = append(deliveries, wd.WebhookDelivery)
+		}
+		return nil
+	})
+
+	if len(deliveries) == 0 && params.ID != "" {
+		return nil, structs.ErrNotFound
+	}
+
+	sort.SliceStable(deliveries, func(i, j int) bool {
+		if !params.RetryBefore.IsZero() {
+			return deliveries[i].NextRetry.Before(deliveries[j].NextRetry)
+		}
+		return deliveries[i].CreatedAt.After(deliveries[j].CreatedAt)
+	})
+
+	from, to := page(len(deliveries), params.Limit, params.Offset)
+	return deliveries[from:to], nil
+}
+
+func copyWebhook(wh structs.Webhook) structs.Webhook {
+	c := wh
+	c.Kinds = append([]structs.SysEvtType(nil), wh.Kinds...)
+	if wh.ValidatorID != nil {
+		c.ValidatorID = new(big.Int).Set(wh.ValidatorID)
+	}
+	return c
+}
+
+// roundTripEvent passes event through JSON, the same as through jsonb column
+func roundTripEvent(wd structs.WebhookDelivery) (structs.WebhookDelivery, error) {
+	e, err := json.Marshal(&wd.Event)
+	if err != nil {
+		return wd, err
+	}
+	sd := wd
+	sd.Event = structs.SystemEvent{}
+	err = json.Unmarshal(e, &sd.Event)
+	return sd, err
+}
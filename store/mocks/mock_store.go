@@ -6,38 +6,257 @@ package mocks
 
 import (
 	context "context"
-	common "github.com/ethereum/go-ethereum/common"
-	structs "github.com/figment-networks/skale-indexer/scraper/structs"
-	gomock "github.com/golang/mock/gomock"
 	big "math/big"
 	reflect "reflect"
 	time "time"
+
+	common "github.com/ethereum/go-ethereum/common"
+	structs "github.com/figment-networks/skale-indexer/scraper/structs"
+	gomock "github.com/golang/mock/gomock"
 )
 
-// MockDataStore is a mock of DataStore interface
+// MockDataStore is a mock of DataStore interface.
 type MockDataStore struct {
 	ctrl     *gomock.Controller
 	recorder *MockDataStoreMockRecorder
 }
 
-// MockDataStoreMockRecorder is the mock recorder for MockDataStore
+// MockDataStoreMockRecorder is the mock recorder for MockDataStore.
 type MockDataStoreMockRecorder struct {
 	mock *MockDataStore
 }
 
-// NewMockDataStore creates a new mock instance
+// NewMockDataStore creates a new mock instance.
 func NewMockDataStore(ctrl *gomock.Controller) *MockDataStore {
 	mock := &MockDataStore{ctrl: ctrl}
 	mock.recorder = &MockDataStoreMockRecorder{mock}
 	return mock
 }
 
-// EXPECT returns an object that allows the caller to indicate expected use
+// EXPECT returns an object that allows the caller to indicate expected use.
 func (m *MockDataStore) EXPECT() *MockDataStoreMockRecorder {
 	return m.recorder
 }
 
-// GetAccounts mocks base method
+// Atomic mocks base method.
+func (m *MockDataStore) Atomic(arg0 context.Context, arg1 func(context.Context) error) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Atomic", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Atomic indicates an expected call of Atomic.
+func (mr *MockDataStoreMockRecorder) Atomic(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Atomic", reflect.TypeOf((*MockDataStore)(nil).Atomic), arg0, arg1)
+}
+
+// Bulk mocks base method.
+func (m *MockDataStore) Bulk(arg0 context.Context, arg1 func(context.Context) error) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Bulk", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Bulk indicates an expected call of Bulk.
+func (mr *MockDataStoreMockRecorder) Bulk(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Bulk", reflect.TypeOf((*MockDataStore)(nil).Bulk), arg0, arg1)
+}
+
+// CountAccounts mocks base method.
+func (m *MockDataStore) CountAccounts(arg0 context.Context, arg1 structs.AccountParams) (uint64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CountAccounts", arg0, arg1)
+	ret0, _ := ret[0].(uint64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CountAccounts indicates an expected call of CountAccounts.
+func (mr *MockDataStoreMockRecorder) CountAccounts(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CountAccounts", reflect.TypeOf((*MockDataStore)(nil).CountAccounts), arg0, arg1)
+}
+
+// CountContractEvents mocks base method.
+func (m *MockDataStore) CountContractEvents(arg0 context.Context, arg1 structs.EventParams) (uint64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CountContractEvents", arg0, arg1)
+	ret0, _ := ret[0].(uint64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CountContractEvents indicates an expected call of CountContractEvents.
+func (mr *MockDataStoreMockRecorder) CountContractEvents(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CountContractEvents", reflect.TypeOf((*MockDataStore)(nil).CountContractEvents), arg0, arg1)
+}
+
+// CountDelegationTimeline mocks base method.
+func (m *MockDataStore) CountDelegationTimeline(arg0 context.Context, arg1 structs.DelegationParams) (uint64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CountDelegationTimeline", arg0, arg1)
+	ret0, _ := ret[0].(uint64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CountDelegationTimeline indicates an expected call of CountDelegationTimeline.
+func (mr *MockDataStoreMockRecorder) CountDelegationTimeline(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CountDelegationTimeline", reflect.TypeOf((*MockDataStore)(nil).CountDelegationTimeline), arg0, arg1)
+}
+
+// CountDelegations mocks base method.
+func (m *MockDataStore) CountDelegations(arg0 context.Context, arg1 structs.DelegationParams) (uint64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CountDelegations", arg0, arg1)
+	ret0, _ := ret[0].(uint64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CountDelegations indicates an expected call of CountDelegations.
+func (mr *MockDataStoreMockRecorder) CountDelegations(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CountDelegations", reflect.TypeOf((*MockDataStore)(nil).CountDelegations), arg0, arg1)
+}
+
+// CountNodes mocks base method.
+func (m *MockDataStore) CountNodes(arg0 context.Context, arg1 structs.NodeParams) (uint64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CountNodes", arg0, arg1)
+	ret0, _ := ret[0].(uint64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CountNodes indicates an expected call of CountNodes.
+func (mr *MockDataStoreMockRecorder) CountNodes(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CountNodes", reflect.TypeOf((*MockDataStore)(nil).CountNodes), arg0, arg1)
+}
+
+// CountSystemEvents mocks base method.
+func (m *MockDataStore) CountSystemEvents(arg0 context.Context, arg1 structs.SystemEventParams) (uint64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CountSystemEvents", arg0, arg1)
+	ret0, _ := ret[0].(uint64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CountSystemEvents indicates an expected call of CountSystemEvents.
+func (mr *MockDataStoreMockRecorder) CountSystemEvents(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CountSystemEvents", reflect.TypeOf((*MockDataStore)(nil).CountSystemEvents), arg0, arg1)
+}
+
+// CountValidators mocks base method.
+func (m *MockDataStore) CountValidators(arg0 context.Context, arg1 structs.ValidatorParams) (uint64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CountValidators", arg0, arg1)
+	ret0, _ := ret[0].(uint64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CountValidators indicates an expected call of CountValidators.
+func (mr *MockDataStoreMockRecorder) CountValidators(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CountValidators", reflect.TypeOf((*MockDataStore)(nil).CountValidators), arg0, arg1)
+}
+
+// CreateWebhook mocks base method.
+func (m *MockDataStore) CreateWebhook(arg0 context.Context, arg1 structs.Webhook) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateWebhook", arg0, arg1)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateWebhook indicates an expected call of CreateWebhook.
+func (mr *MockDataStoreMockRecorder) CreateWebhook(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateWebhook", reflect.TypeOf((*MockDataStore)(nil).CreateWebhook), arg0, arg1)
+}
+
+// CreateWebhookDelivery mocks base method.
+func (m *MockDataStore) CreateWebhookDelivery(arg0 context.Context, arg1 structs.WebhookDelivery) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateWebhookDelivery", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateWebhookDelivery indicates an expected call of CreateWebhookDelivery.
+func (mr *MockDataStoreMockRecorder) CreateWebhookDelivery(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateWebhookDelivery", reflect.TypeOf((*MockDataStore)(nil).CreateWebhookDelivery), arg0, arg1)
+}
+
+// DeleteFailedEvent mocks base method.
+func (m *MockDataStore) DeleteFailedEvent(arg0 context.Context, arg1 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteFailedEvent", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteFailedEvent indicates an expected call of DeleteFailedEvent.
+func (mr *MockDataStoreMockRecorder) DeleteFailedEvent(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteFailedEvent", reflect.TypeOf((*MockDataStore)(nil).DeleteFailedEvent), arg0, arg1)
+}
+
+// DeleteFailedEventOfLog mocks base method.
+func (m *MockDataStore) DeleteFailedEventOfLog(arg0 context.Context, arg1 common.Hash, arg2 uint) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteFailedEventOfLog", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteFailedEventOfLog indicates an expected call of DeleteFailedEventOfLog.
+func (mr *MockDataStoreMockRecorder) DeleteFailedEventOfLog(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteFailedEventOfLog", reflect.TypeOf((*MockDataStore)(nil).DeleteFailedEventOfLog), arg0, arg1, arg2)
+}
+
+// DeleteOutboxMessages mocks base method.
+func (m *MockDataStore) DeleteOutboxMessages(arg0 context.Context, arg1 []uint64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteOutboxMessages", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteOutboxMessages indicates an expected call of DeleteOutboxMessages.
+func (mr *MockDataStoreMockRecorder) DeleteOutboxMessages(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteOutboxMessages", reflect.TypeOf((*MockDataStore)(nil).DeleteOutboxMessages), arg0, arg1)
+}
+
+// DeleteWebhook mocks base method.
+func (m *MockDataStore) DeleteWebhook(arg0 context.Context, arg1 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteWebhook", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteWebhook indicates an expected call of DeleteWebhook.
+func (mr *MockDataStoreMockRecorder) DeleteWebhook(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteWebhook", reflect.TypeOf((*MockDataStore)(nil).DeleteWebhook), arg0, arg1)
+}
+
+// GetAccounts mocks base method.
 func (m *MockDataStore) GetAccounts(arg0 context.Context, arg1 structs.AccountParams) ([]structs.Account, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "GetAccounts", arg0, arg1)
@@ -46,13 +265,59 @@ func (m *MockDataStore) GetAccounts(arg0 context.Context, arg1 structs.AccountPa
 	return ret0, ret1
 }
 
-// GetAccounts indicates an expected call of GetAccounts
+// GetAccounts indicates an expected call of GetAccounts.
 func (mr *MockDataStoreMockRecorder) GetAccounts(arg0, arg1 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAccounts", reflect.TypeOf((*MockDataStore)(nil).GetAccounts), arg0, arg1)
 }
 
-// GetContractEvents mocks base method
+// GetBlock mocks base method.
+func (m *MockDataStore) GetBlock(arg0 context.Context, arg1 uint64) (structs.Block, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetBlock", arg0, arg1)
+	ret0, _ := ret[0].(structs.Block)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetBlock indicates an expected call of GetBlock.
+func (mr *MockDataStoreMockRecorder) GetBlock(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBlock", reflect.TypeOf((*MockDataStore)(nil).GetBlock), arg0, arg1)
+}
+
+// GetBlockBefore mocks base method.
+func (m *MockDataStore) GetBlockBefore(arg0 context.Context, arg1 uint64) (structs.Block, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetBlockBefore", arg0, arg1)
+	ret0, _ := ret[0].(structs.Block)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetBlockBefore indicates an expected call of GetBlockBefore.
+func (mr *MockDataStoreMockRecorder) GetBlockBefore(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBlockBefore", reflect.TypeOf((*MockDataStore)(nil).GetBlockBefore), arg0, arg1)
+}
+
+// GetBlockBounds mocks base method.
+func (m *MockDataStore) GetBlockBounds(arg0 context.Context, arg1 time.Time) (structs.Block, structs.Block, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetBlockBounds", arg0, arg1)
+	ret0, _ := ret[0].(structs.Block)
+	ret1, _ := ret[1].(structs.Block)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetBlockBounds indicates an expected call of GetBlockBounds.
+func (mr *MockDataStoreMockRecorder) GetBlockBounds(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBlockBounds", reflect.TypeOf((*MockDataStore)(nil).GetBlockBounds), arg0, arg1)
+}
+
+// GetContractEvents mocks base method.
 func (m *MockDataStore) GetContractEvents(arg0 context.Context, arg1 structs.EventParams) ([]structs.ContractEvent, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "GetContractEvents", arg0, arg1)
@@ -61,13 +326,28 @@ func (m *MockDataStore) GetContractEvents(arg0 context.Context, arg1 structs.Eve
 	return ret0, ret1
 }
 
-// GetContractEvents indicates an expected call of GetContractEvents
+// GetContractEvents indicates an expected call of GetContractEvents.
 func (mr *MockDataStoreMockRecorder) GetContractEvents(arg0, arg1 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetContractEvents", reflect.TypeOf((*MockDataStore)(nil).GetContractEvents), arg0, arg1)
 }
 
-// GetDelegationTimeline mocks base method
+// GetDecentralization mocks base method.
+func (m *MockDataStore) GetDecentralization(arg0 context.Context, arg1 structs.DecentralizationParams) ([]structs.Decentralization, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetDecentralization", arg0, arg1)
+	ret0, _ := ret[0].([]structs.Decentralization)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetDecentralization indicates an expected call of GetDecentralization.
+func (mr *MockDataStoreMockRecorder) GetDecentralization(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDecentralization", reflect.TypeOf((*MockDataStore)(nil).GetDecentralization), arg0, arg1)
+}
+
+// GetDelegationTimeline mocks base method.
 func (m *MockDataStore) GetDelegationTimeline(arg0 context.Context, arg1 structs.DelegationParams) ([]structs.Delegation, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "GetDelegationTimeline", arg0, arg1)
@@ -76,13 +356,13 @@ func (m *MockDataStore) GetDelegationTimeline(arg0 context.Context, arg1 structs
 	return ret0, ret1
 }
 
-// GetDelegationTimeline indicates an expected call of GetDelegationTimeline
+// GetDelegationTimeline indicates an expected call of GetDelegationTimeline.
 func (mr *MockDataStoreMockRecorder) GetDelegationTimeline(arg0, arg1 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDelegationTimeline", reflect.TypeOf((*MockDataStore)(nil).GetDelegationTimeline), arg0, arg1)
 }
 
-// GetDelegations mocks base method
+// GetDelegations mocks base method.
 func (m *MockDataStore) GetDelegations(arg0 context.Context, arg1 structs.DelegationParams) ([]structs.Delegation, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "GetDelegations", arg0, arg1)
@@ -91,13 +371,43 @@ func (m *MockDataStore) GetDelegations(arg0 context.Context, arg1 structs.Delega
 	return ret0, ret1
 }
 
-// GetDelegations indicates an expected call of GetDelegations
+// GetDelegations indicates an expected call of GetDelegations.
 func (mr *MockDataStoreMockRecorder) GetDelegations(arg0, arg1 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDelegations", reflect.TypeOf((*MockDataStore)(nil).GetDelegations), arg0, arg1)
 }
 
-// GetNodes mocks base method
+// GetFailedEvents mocks base method.
+func (m *MockDataStore) GetFailedEvents(arg0 context.Context, arg1 structs.FailedEventParams) ([]structs.FailedEvent, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetFailedEvents", arg0, arg1)
+	ret0, _ := ret[0].([]structs.FailedEvent)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetFailedEvents indicates an expected call of GetFailedEvents.
+func (mr *MockDataStoreMockRecorder) GetFailedEvents(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetFailedEvents", reflect.TypeOf((*MockDataStore)(nil).GetFailedEvents), arg0, arg1)
+}
+
+// GetNodeTimeline mocks base method.
+func (m *MockDataStore) GetNodeTimeline(arg0 context.Context, arg1 structs.NodeParams) ([]structs.NodeChange, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetNodeTimeline", arg0, arg1)
+	ret0, _ := ret[0].([]structs.NodeChange)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetNodeTimeline indicates an expected call of GetNodeTimeline.
+func (mr *MockDataStoreMockRecorder) GetNodeTimeline(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetNodeTimeline", reflect.TypeOf((*MockDataStore)(nil).GetNodeTimeline), arg0, arg1)
+}
+
+// GetNodes mocks base method.
 func (m *MockDataStore) GetNodes(arg0 context.Context, arg1 structs.NodeParams) ([]structs.Node, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "GetNodes", arg0, arg1)
@@ -106,13 +416,28 @@ func (m *MockDataStore) GetNodes(arg0 context.Context, arg1 structs.NodeParams)
 	return ret0, ret1
 }
 
-// GetNodes indicates an expected call of GetNodes
+// GetNodes indicates an expected call of GetNodes.
 func (mr *MockDataStoreMockRecorder) GetNodes(arg0, arg1 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetNodes", reflect.TypeOf((*MockDataStore)(nil).GetNodes), arg0, arg1)
 }
 
-// GetSystemEvents mocks base method
+// GetOutboxMessages mocks base method.
+func (m *MockDataStore) GetOutboxMessages(arg0 context.Context, arg1 structs.OutboxParams) ([]structs.OutboxMessage, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetOutboxMessages", arg0, arg1)
+	ret0, _ := ret[0].([]structs.OutboxMessage)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetOutboxMessages indicates an expected call of GetOutboxMessages.
+func (mr *MockDataStoreMockRecorder) GetOutboxMessages(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOutboxMessages", reflect.TypeOf((*MockDataStore)(nil).GetOutboxMessages), arg0, arg1)
+}
+
+// GetSystemEvents mocks base method.
 func (m *MockDataStore) GetSystemEvents(arg0 context.Context, arg1 structs.SystemEventParams) ([]structs.SystemEvent, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "GetSystemEvents", arg0, arg1)
@@ -121,13 +446,28 @@ func (m *MockDataStore) GetSystemEvents(arg0 context.Context, arg1 structs.Syste
 	return ret0, ret1
 }
 
-// GetSystemEvents indicates an expected call of GetSystemEvents
+// GetSystemEvents indicates an expected call of GetSystemEvents.
 func (mr *MockDataStoreMockRecorder) GetSystemEvents(arg0, arg1 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSystemEvents", reflect.TypeOf((*MockDataStore)(nil).GetSystemEvents), arg0, arg1)
 }
 
-// GetTypesSummaryDelegations mocks base method
+// GetTransaction mocks base method.
+func (m *MockDataStore) GetTransaction(arg0 context.Context, arg1 common.Hash) (structs.Transaction, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTransaction", arg0, arg1)
+	ret0, _ := ret[0].(structs.Transaction)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTransaction indicates an expected call of GetTransaction.
+func (mr *MockDataStoreMockRecorder) GetTransaction(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTransaction", reflect.TypeOf((*MockDataStore)(nil).GetTransaction), arg0, arg1)
+}
+
+// GetTypesSummaryDelegations mocks base method.
 func (m *MockDataStore) GetTypesSummaryDelegations(arg0 context.Context, arg1 structs.DelegationParams) ([]structs.DelegationSummary, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "GetTypesSummaryDelegations", arg0, arg1)
@@ -136,13 +476,28 @@ func (m *MockDataStore) GetTypesSummaryDelegations(arg0 context.Context, arg1 st
 	return ret0, ret1
 }
 
-// GetTypesSummaryDelegations indicates an expected call of GetTypesSummaryDelegations
+// GetTypesSummaryDelegations indicates an expected call of GetTypesSummaryDelegations.
 func (mr *MockDataStoreMockRecorder) GetTypesSummaryDelegations(arg0, arg1 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTypesSummaryDelegations", reflect.TypeOf((*MockDataStore)(nil).GetTypesSummaryDelegations), arg0, arg1)
 }
 
-// GetValidatorStatistics mocks base method
+// GetValidatorAddresses mocks base method.
+func (m *MockDataStore) GetValidatorAddresses(arg0 context.Context, arg1 structs.ValidatorAddressParams) ([]structs.ValidatorAddress, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetValidatorAddresses", arg0, arg1)
+	ret0, _ := ret[0].([]structs.ValidatorAddress)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetValidatorAddresses indicates an expected call of GetValidatorAddresses.
+func (mr *MockDataStoreMockRecorder) GetValidatorAddresses(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetValidatorAddresses", reflect.TypeOf((*MockDataStore)(nil).GetValidatorAddresses), arg0, arg1)
+}
+
+// GetValidatorStatistics mocks base method.
 func (m *MockDataStore) GetValidatorStatistics(arg0 context.Context, arg1 structs.ValidatorStatisticsParams) ([]structs.ValidatorStatistics, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "GetValidatorStatistics", arg0, arg1)
@@ -151,13 +506,28 @@ func (m *MockDataStore) GetValidatorStatistics(arg0 context.Context, arg1 struct
 	return ret0, ret1
 }
 
-// GetValidatorStatistics indicates an expected call of GetValidatorStatistics
+// GetValidatorStatistics indicates an expected call of GetValidatorStatistics.
 func (mr *MockDataStoreMockRecorder) GetValidatorStatistics(arg0, arg1 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetValidatorStatistics", reflect.TypeOf((*MockDataStore)(nil).GetValidatorStatistics), arg0, arg1)
 }
 
-// GetValidatorStatisticsTimeline mocks base method
+// GetValidatorStatisticsDaily mocks base method.
+func (m *MockDataStore) GetValidatorStatisticsDaily(arg0 context.Context, arg1 structs.ValidatorStatisticsParams) ([]structs.ValidatorStatistics, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetValidatorStatisticsDaily", arg0, arg1)
+	ret0, _ := ret[0].([]structs.ValidatorStatistics)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetValidatorStatisticsDaily indicates an expected call of GetValidatorStatisticsDaily.
+func (mr *MockDataStoreMockRecorder) GetValidatorStatisticsDaily(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetValidatorStatisticsDaily", reflect.TypeOf((*MockDataStore)(nil).GetValidatorStatisticsDaily), arg0, arg1)
+}
+
+// GetValidatorStatisticsTimeline mocks base method.
 func (m *MockDataStore) GetValidatorStatisticsTimeline(arg0 context.Context, arg1 structs.ValidatorStatisticsParams) ([]structs.ValidatorStatistics, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "GetValidatorStatisticsTimeline", arg0, arg1)
@@ -166,13 +536,13 @@ func (m *MockDataStore) GetValidatorStatisticsTimeline(arg0 context.Context, arg
 	return ret0, ret1
 }
 
-// GetValidatorStatisticsTimeline indicates an expected call of GetValidatorStatisticsTimeline
+// GetValidatorStatisticsTimeline indicates an expected call of GetValidatorStatisticsTimeline.
 func (mr *MockDataStoreMockRecorder) GetValidatorStatisticsTimeline(arg0, arg1 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetValidatorStatisticsTimeline", reflect.TypeOf((*MockDataStore)(nil).GetValidatorStatisticsTimeline), arg0, arg1)
 }
 
-// GetValidators mocks base method
+// GetValidators mocks base method.
 func (m *MockDataStore) GetValidators(arg0 context.Context, arg1 structs.ValidatorParams) ([]structs.Validator, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "GetValidators", arg0, arg1)
@@ -181,13 +551,43 @@ func (m *MockDataStore) GetValidators(arg0 context.Context, arg1 structs.Validat
 	return ret0, ret1
 }
 
-// GetValidators indicates an expected call of GetValidators
+// GetValidators indicates an expected call of GetValidators.
 func (mr *MockDataStoreMockRecorder) GetValidators(arg0, arg1 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetValidators", reflect.TypeOf((*MockDataStore)(nil).GetValidators), arg0, arg1)
 }
 
-// SaveAccount mocks base method
+// GetWebhookDeliveries mocks base method.
+func (m *MockDataStore) GetWebhookDeliveries(arg0 context.Context, arg1 structs.WebhookDeliveryParams) ([]structs.WebhookDelivery, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetWebhookDeliveries", arg0, arg1)
+	ret0, _ := ret[0].([]structs.WebhookDelivery)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetWebhookDeliveries indicates an expected call of GetWebhookDeliveries.
+func (mr *MockDataStoreMockRecorder) GetWebhookDeliveries(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetWebhookDeliveries", reflect.TypeOf((*MockDataStore)(nil).GetWebhookDeliveries), arg0, arg1)
+}
+
+// GetWebhooks mocks base method.
+func (m *MockDataStore) GetWebhooks(arg0 context.Context, arg1 structs.WebhookParams) ([]structs.Webhook, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetWebhooks", arg0, arg1)
+	ret0, _ := ret[0].([]structs.Webhook)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetWebhooks indicates an expected call of GetWebhooks.
+func (mr *MockDataStoreMockRecorder) GetWebhooks(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetWebhooks", reflect.TypeOf((*MockDataStore)(nil).GetWebhooks), arg0, arg1)
+}
+
+// SaveAccount mocks base method.
 func (m *MockDataStore) SaveAccount(arg0 context.Context, arg1 structs.Account) error {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "SaveAccount", arg0, arg1)
@@ -195,13 +595,27 @@ func (m *MockDataStore) SaveAccount(arg0 context.Context, arg1 structs.Account)
 	return ret0
 }
 
-// SaveAccount indicates an expected call of SaveAccount
+// SaveAccount indicates an expected call of SaveAccount.
 func (mr *MockDataStoreMockRecorder) SaveAccount(arg0, arg1 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SaveAccount", reflect.TypeOf((*MockDataStore)(nil).SaveAccount), arg0, arg1)
 }
 
-// SaveContractEvent mocks base method
+// SaveBlock mocks base method.
+func (m *MockDataStore) SaveBlock(arg0 context.Context, arg1 structs.Block) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SaveBlock", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SaveBlock indicates an expected call of SaveBlock.
+func (mr *MockDataStoreMockRecorder) SaveBlock(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SaveBlock", reflect.TypeOf((*MockDataStore)(nil).SaveBlock), arg0, arg1)
+}
+
+// SaveContractEvent mocks base method.
 func (m *MockDataStore) SaveContractEvent(arg0 context.Context, arg1 structs.ContractEvent) error {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "SaveContractEvent", arg0, arg1)
@@ -209,13 +623,27 @@ func (m *MockDataStore) SaveContractEvent(arg0 context.Context, arg1 structs.Con
 	return ret0
 }
 
-// SaveContractEvent indicates an expected call of SaveContractEvent
+// SaveContractEvent indicates an expected call of SaveContractEvent.
 func (mr *MockDataStoreMockRecorder) SaveContractEvent(arg0, arg1 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SaveContractEvent", reflect.TypeOf((*MockDataStore)(nil).SaveContractEvent), arg0, arg1)
 }
 
-// SaveDelegation mocks base method
+// SaveDecentralization mocks base method.
+func (m *MockDataStore) SaveDecentralization(arg0 context.Context, arg1 structs.Decentralization) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SaveDecentralization", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SaveDecentralization indicates an expected call of SaveDecentralization.
+func (mr *MockDataStoreMockRecorder) SaveDecentralization(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SaveDecentralization", reflect.TypeOf((*MockDataStore)(nil).SaveDecentralization), arg0, arg1)
+}
+
+// SaveDelegation mocks base method.
 func (m *MockDataStore) SaveDelegation(arg0 context.Context, arg1 structs.Delegation) error {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "SaveDelegation", arg0, arg1)
@@ -223,13 +651,27 @@ func (m *MockDataStore) SaveDelegation(arg0 context.Context, arg1 structs.Delega
 	return ret0
 }
 
-// SaveDelegation indicates an expected call of SaveDelegation
+// SaveDelegation indicates an expected call of SaveDelegation.
 func (mr *MockDataStoreMockRecorder) SaveDelegation(arg0, arg1 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SaveDelegation", reflect.TypeOf((*MockDataStore)(nil).SaveDelegation), arg0, arg1)
 }
 
-// SaveNodes mocks base method
+// SaveFailedEvent mocks base method.
+func (m *MockDataStore) SaveFailedEvent(arg0 context.Context, arg1 structs.FailedEvent) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SaveFailedEvent", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SaveFailedEvent indicates an expected call of SaveFailedEvent.
+func (mr *MockDataStoreMockRecorder) SaveFailedEvent(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SaveFailedEvent", reflect.TypeOf((*MockDataStore)(nil).SaveFailedEvent), arg0, arg1)
+}
+
+// SaveNodes mocks base method.
 func (m *MockDataStore) SaveNodes(arg0 context.Context, arg1 []structs.Node, arg2 common.Address) error {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "SaveNodes", arg0, arg1, arg2)
@@ -237,13 +679,27 @@ func (m *MockDataStore) SaveNodes(arg0 context.Context, arg1 []structs.Node, arg
 	return ret0
 }
 
-// SaveNodes indicates an expected call of SaveNodes
+// SaveNodes indicates an expected call of SaveNodes.
 func (mr *MockDataStoreMockRecorder) SaveNodes(arg0, arg1, arg2 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SaveNodes", reflect.TypeOf((*MockDataStore)(nil).SaveNodes), arg0, arg1, arg2)
 }
 
-// SaveSystemEvent mocks base method
+// SaveOutboxMessages mocks base method.
+func (m *MockDataStore) SaveOutboxMessages(arg0 context.Context, arg1 []structs.OutboxMessage) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SaveOutboxMessages", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SaveOutboxMessages indicates an expected call of SaveOutboxMessages.
+func (mr *MockDataStoreMockRecorder) SaveOutboxMessages(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SaveOutboxMessages", reflect.TypeOf((*MockDataStore)(nil).SaveOutboxMessages), arg0, arg1)
+}
+
+// SaveSystemEvent mocks base method.
 func (m *MockDataStore) SaveSystemEvent(arg0 context.Context, arg1 structs.SystemEvent) error {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "SaveSystemEvent", arg0, arg1)
@@ -251,13 +707,27 @@ func (m *MockDataStore) SaveSystemEvent(arg0 context.Context, arg1 structs.Syste
 	return ret0
 }
 
-// SaveSystemEvent indicates an expected call of SaveSystemEvent
+// SaveSystemEvent indicates an expected call of SaveSystemEvent.
 func (mr *MockDataStoreMockRecorder) SaveSystemEvent(arg0, arg1 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SaveSystemEvent", reflect.TypeOf((*MockDataStore)(nil).SaveSystemEvent), arg0, arg1)
 }
 
-// SaveValidator mocks base method
+// SaveTransaction mocks base method.
+func (m *MockDataStore) SaveTransaction(arg0 context.Context, arg1 structs.Transaction) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SaveTransaction", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SaveTransaction indicates an expected call of SaveTransaction.
+func (mr *MockDataStoreMockRecorder) SaveTransaction(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SaveTransaction", reflect.TypeOf((*MockDataStore)(nil).SaveTransaction), arg0, arg1)
+}
+
+// SaveValidator mocks base method.
 func (m *MockDataStore) SaveValidator(arg0 context.Context, arg1 structs.Validator) error {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "SaveValidator", arg0, arg1)
@@ -265,13 +735,27 @@ func (m *MockDataStore) SaveValidator(arg0 context.Context, arg1 structs.Validat
 	return ret0
 }
 
-// SaveValidator indicates an expected call of SaveValidator
+// SaveValidator indicates an expected call of SaveValidator.
 func (mr *MockDataStoreMockRecorder) SaveValidator(arg0, arg1 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SaveValidator", reflect.TypeOf((*MockDataStore)(nil).SaveValidator), arg0, arg1)
 }
 
-// SaveValidatorStatistic mocks base method
+// SaveValidatorAddress mocks base method.
+func (m *MockDataStore) SaveValidatorAddress(arg0 context.Context, arg1 structs.ValidatorAddress) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SaveValidatorAddress", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SaveValidatorAddress indicates an expected call of SaveValidatorAddress.
+func (mr *MockDataStoreMockRecorder) SaveValidatorAddress(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SaveValidatorAddress", reflect.TypeOf((*MockDataStore)(nil).SaveValidatorAddress), arg0, arg1)
+}
+
+// SaveValidatorStatistic mocks base method.
 func (m *MockDataStore) SaveValidatorStatistic(arg0 context.Context, arg1 *big.Int, arg2 uint64, arg3 time.Time, arg4 structs.StatisticTypeVS, arg5 *big.Int) error {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "SaveValidatorStatistic", arg0, arg1, arg2, arg3, arg4, arg5)
@@ -279,13 +763,13 @@ func (m *MockDataStore) SaveValidatorStatistic(arg0 context.Context, arg1 *big.I
 	return ret0
 }
 
-// SaveValidatorStatistic indicates an expected call of SaveValidatorStatistic
+// SaveValidatorStatistic indicates an expected call of SaveValidatorStatistic.
 func (mr *MockDataStoreMockRecorder) SaveValidatorStatistic(arg0, arg1, arg2, arg3, arg4, arg5 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SaveValidatorStatistic", reflect.TypeOf((*MockDataStore)(nil).SaveValidatorStatistic), arg0, arg1, arg2, arg3, arg4, arg5)
 }
 
-// UpdateCountsOfValidator mocks base method
+// UpdateCountsOfValidator mocks base method.
 func (m *MockDataStore) UpdateCountsOfValidator(arg0 context.Context, arg1 *big.Int) error {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "UpdateCountsOfValidator", arg0, arg1)
@@ -293,8 +777,36 @@ func (m *MockDataStore) UpdateCountsOfValidator(arg0 context.Context, arg1 *big.
 	return ret0
 }
 
-// UpdateCountsOfValidator indicates an expected call of UpdateCountsOfValidator
+// UpdateCountsOfValidator indicates an expected call of UpdateCountsOfValidator.
 func (mr *MockDataStoreMockRecorder) UpdateCountsOfValidator(arg0, arg1 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateCountsOfValidator", reflect.TypeOf((*MockDataStore)(nil).UpdateCountsOfValidator), arg0, arg1)
 }
+
+// UpdateWebhook mocks base method.
+func (m *MockDataStore) UpdateWebhook(arg0 context.Context, arg1 structs.Webhook) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateWebhook", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateWebhook indicates an expected call of UpdateWebhook.
+func (mr *MockDataStoreMockRecorder) UpdateWebhook(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateWebhook", reflect.TypeOf((*MockDataStore)(nil).UpdateWebhook), arg0, arg1)
+}
+
+// UpdateWebhookDelivery mocks base method.
+func (m *MockDataStore) UpdateWebhookDelivery(arg0 context.Context, arg1 structs.WebhookDelivery) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateWebhookDelivery", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateWebhookDelivery indicates an expected call of UpdateWebhookDelivery.
+func (mr *MockDataStoreMockRecorder) UpdateWebhookDelivery(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateWebhookDelivery", reflect.TypeOf((*MockDataStore)(nil).UpdateWebhookDelivery), arg0, arg1)
+}
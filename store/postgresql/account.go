@@ -12,7 +12,11 @@ import (
 
 // SaveAccount saves account
 func (d *Driver) SaveAccount(ctx context.Context, a structs.Account) error {
-	_, err := d.db.Exec(`INSERT INTO accounts ("address", "account_type")
+	if buffer(ctx, func(b *bulkBuffer) { b.accounts = append(b.accounts, a) }) {
+		return nil
+	}
+
+	_, err := d.conn(ctx).ExecContext(ctx, `INSERT INTO accounts ("address", "account_type")
 			VALUES ($1, $2)
 			ON CONFLICT (address)
 			DO UPDATE SET
@@ -25,9 +29,42 @@ func (d *Driver) SaveAccount(ctx context.Context, a structs.Account) error {
 
 // GetAccounts gets accounts
 func (d *Driver) GetAccounts(ctx context.Context, params structs.AccountParams) (accounts []structs.Account, err error) {
-	q := `SELECT id, created_at, address, account_type FROM accounts `
+	q, args := accountsQuery(params)
+	rows, err := d.conn(ctx).QueryContext(ctx, q, args...)
+	if err != nil {
+		return nil, err
+	}
+
+	defer rows.Close()
+
+	for rows.Next() {
+		a := structs.Account{}
+		var addr []byte
+		if err = rows.Scan(&a.ID, &a.CreatedAt, &addr, &a.Type); err != nil {
+			return nil, err
+		}
+		p := new(big.Int)
+		p.SetString(string(addr), 10)
+		a.Address.SetBytes(p.Bytes())
+
+		accounts = append(accounts, a)
+	}
+
+	return accounts, nil
+}
+
+// CountAccounts counts accounts matching params, regardless of paging
+func (d *Driver) CountAccounts(ctx context.Context, params structs.AccountParams) (count uint64, err error) {
+	params.Limit, params.Offset, params.Cursor = 0, 0, structs.KeyCursor{}
+	q, args := accountsQuery(params)
+	err = d.conn(ctx).QueryRowContext(ctx, `SELECT COUNT(*) FROM (`+q+`) c`, args...).Scan(&count)
+	return count, err
+}
+
+// accountsQuery builds query of accounts for params
+func accountsQuery(params structs.AccountParams) (q string, args []interface{}) {
+	q = `SELECT id, created_at, address, account_type FROM accounts `
 	var (
-		args   []interface{}
 		wherec []string
 		i      = 1
 	)
@@ -42,38 +79,22 @@ func (d *Driver) GetAccounts(ctx context.Context, params structs.AccountParams)
 		args = append(args, params.Type)
 		i++
 	}
+	if !params.Cursor.IsZero() {
+		wherec = append(wherec, ` (created_at < $`+strconv.Itoa(i)+` OR (created_at = $`+strconv.Itoa(i)+` AND address > $`+strconv.Itoa(i+1)+`))`)
+		args = append(args, params.Cursor.Time, common.HexToAddress(params.Cursor.Key).Hash().Big().String())
+		i += 2
+	}
 	if len(args) > 0 {
 		q += ` WHERE `
 	}
 	q += strings.Join(wherec, " AND ")
-	q += ` ORDER BY created_at DESC`
+	q += ` ORDER BY created_at DESC, address`
 
 	if params.Limit > 0 {
 		q += " LIMIT " + strconv.FormatUint(uint64(params.Limit), 10)
-		if params.Offset > 0 {
+		if params.Offset > 0 && params.Cursor.IsZero() {
 			q += " OFFSET " + strconv.FormatUint(uint64(params.Offset), 10)
 		}
 	}
-
-	rows, err := d.db.QueryContext(ctx, q, args...)
-	if err != nil {
-		return nil, err
-	}
-
-	defer rows.Close()
-
-	for rows.Next() {
-		a := structs.Account{}
-		var addr []byte
-		if err = rows.Scan(&a.ID, &a.CreatedAt, &addr, &a.Type); err != nil {
-			return nil, err
-		}
-		p := new(big.Int)
-		p.SetString(string(addr), 10)
-		a.Address.SetBytes(p.Bytes())
-
-		accounts = append(accounts, a)
-	}
-
-	return accounts, nil
+	return q, args
 }
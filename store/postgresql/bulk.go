@@ -0,0 +1,390 @@
+package postgresql
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/lib/pq"
+
+	"github.com/figment-networks/skale-indexer/scraper/structs"
+)
+
+type bulkKey struct{}
+
+// bulk is transaction of the Bulk call, shared by its Atomic scopes one at a time,
+// with buffer of writes made outside of them, shared by all the goroutines using its context
+type bulk struct {
+	scopeLock sync.Mutex
+	scope     *scope
+
+	lock sync.Mutex
+	buf  bulkBuffer
+}
+
+type validatorStatistic struct {
+	ValidatorID    string
+	BlockHeight    uint64
+	BlockTime      time.Time
+	StatisticsType structs.StatisticTypeVS
+	Amount         string
+}
+
+// bulkBuffer holds writes deferred until commit of their scope or the end of Bulk
+type bulkBuffer struct {
+	contractEvents []structs.ContractEvent
+	accounts       []structs.Account
+	statistics     []validatorStatistic
+	validators     []string
+}
+
+type bulkMark struct {
+	contractEvents, accounts, statistics, validators int
+}
+
+func (b *bulkBuffer) mark() bulkMark {
+	return bulkMark{len(b.contractEvents), len(b.accounts), len(b.statistics), len(b.validators)}
+}
+
+// truncate drops everything buffered after mark was taken
+func (b *bulkBuffer) truncate(m bulkMark) {
+	b.contractEvents = b.contractEvents[:m.contractEvents]
+	b.accounts = b.accounts[:m.accounts]
+	b.statistics = b.statistics[:m.statistics]
+	b.validators = b.validators[:m.validators]
+}
+
+// add appends everything buffered in o
+func (b *bulkBuffer) add(o *bulkBuffer) {
+	b.contractEvents = append(b.contractEvents, o.contractEvents...)
+	b.accounts = append(b.accounts, o.accounts...)
+	b.statistics = append(b.statistics, o.statistics...)
+	b.validators = append(b.validators, o.validators...)
+}
+
+func (b *bulkBuffer) empty() bool {
+	return len(b.contractEvents) == 0 && len(b.accounts) == 0 && len(b.statistics) == 0 && len(b.validators) == 0
+}
+
+// buffer adds write to the buffer when ctx is within Bulk, reporting whether it did.
+// Writes made inside of Atomic are kept in the scope of Bulk, and dropped when their savepoint is rolled back
+func buffer(ctx context.Context, add func(b *bulkBuffer)) bool {
+	bk, ok := ctx.Value(bulkKey{}).(*bulk)
+	if !ok {
+		return false
+	}
+
+	if s, ok := ctx.Value(scopeKey{}).(*scope); ok {
+		add(&s.buf)
+		return true
+	}
+
+	bk.lock.Lock()
+	add(&bk.buf)
+	bk.lock.Unlock()
+	return true
+}
+
+// Bulk runs fn in a single database transaction, buffering contract events, accounts and validator statistics written with its context.
+// Atomic scopes run within fn are savepoints of the transaction, so the failed one is undone alone, its buffered writes included.
+// Buffered rows are loaded once fn returns, using COPY into staging tables and merged with set-based queries,
+// and the transaction is committed. When fn fails, only writes of the scopes which succeeded are kept.
+// Validator counts are updated once per validator after the rows are merged.
+// Writes made within fn are not visible for reads with context other than the one of a scope until Bulk ends.
+func (d *Driver) Bulk(ctx context.Context, fn func(ctx context.Context) error) (err error) {
+	if _, ok := ctx.Value(bulkKey{}).(*bulk); ok {
+		return fn(ctx)
+	}
+	if _, ok := ctx.Value(scopeKey{}).(*scope); ok {
+		return fn(ctx)
+	}
+
+	tx, err := d.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+
+	defer func() {
+		if p := recover(); p != nil {
+			tx.Rollback()
+			panic(p)
+		}
+	}()
+
+	bk := &bulk{scope: &scope{tx: tx}}
+	fnErr := fn(context.WithValue(ctx, bulkKey{}, bk))
+	if fnErr == nil {
+		bk.scope.buf.add(&bk.buf)
+	}
+
+	if err = merge(ctx, tx, &bk.scope.buf); err != nil {
+		if rollbackErr := tx.Rollback(); rollbackErr != nil {
+			err = fmt.Errorf("error rolling back (%s): %w", rollbackErr.Error(), err)
+		}
+	} else {
+		err = tx.Commit()
+	}
+	if err != nil && fnErr != nil {
+		return fmt.Errorf("error committing (%s): %w", err.Error(), fnErr)
+	}
+	if err != nil {
+		return err
+	}
+	return fnErr
+}
+
+// merge loads buffered rows within tx
+func merge(ctx context.Context, tx *sql.Tx, buf *bulkBuffer) error {
+	if buf.empty() {
+		return nil
+	}
+
+	if err := mergeContractEvents(ctx, tx, dedupContractEvents(buf.contractEvents)); err != nil {
+		return err
+	}
+	if err := mergeAccounts(ctx, tx, dedupAccounts(buf.accounts)); err != nil {
+		return err
+	}
+	if err := mergeValidatorStatistics(ctx, tx, dedupValidatorStatistics(buf.statistics)); err != nil {
+		return err
+	}
+
+	updated := map[string]bool{}
+	for _, vID := range buf.validators {
+		if updated[vID] {
+			continue
+		}
+		updated[vID] = true
+		if err := updateCountsOfValidator(ctx, tx, vID); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// copyIn loads rows into table using COPY
+func copyIn(ctx context.Context, tx *sql.Tx, table string, columns []string, rows [][]interface{}) error {
+	stmt, err := tx.PrepareContext(ctx, pq.CopyIn(table, columns...))
+	if err != nil {
+		return err
+	}
+
+	for _, r := range rows {
+		if _, err = stmt.ExecContext(ctx, r...); err != nil {
+			stmt.Close()
+			return err
+		}
+	}
+
+	if _, err = stmt.ExecContext(ctx); err != nil {
+		stmt.Close()
+		return err
+	}
+	return stmt.Close()
+}
+
+func dedupContractEvents(ces []structs.ContractEvent) []structs.ContractEvent {
+	type key struct {
+		addr, event, tx string
+		height          uint64
+		removed         bool
+	}
+
+	idx := make(map[key]int, len(ces))
+	out := make([]structs.ContractEvent, 0, len(ces))
+	for _, ce := range ces {
+		k := key{ce.ContractAddress.String(), ce.EventName, ce.TransactionHash.String(), ce.BlockHeight, ce.Removed}
+		if i, ok := idx[k]; ok {
+			out[i] = ce
+			continue
+		}
+		idx[k] = len(out)
+		out = append(out, ce)
+	}
+	return out
+}
+
+var accountTypeRank = map[structs.AccountType]int{
+	structs.AccountTypeDefault:   0,
+	structs.AccountTypeDelegator: 1,
+	structs.AccountTypeValidator: 2,
+}
+
+// dedupAccounts leaves single account per address, with the highest type
+func dedupAccounts(accs []structs.Account) []structs.Account {
+	idx := make(map[string]int, len(accs))
+	out := make([]structs.Account, 0, len(accs))
+	for _, a := range accs {
+		if a.Type == "" {
+			a.Type = structs.AccountTypeDefault
+		}
+		k := a.Address.String()
+		if i, ok := idx[k]; ok {
+			if accountTypeRank[a.Type] > accountTypeRank[out[i].Type] {
+				out[i].Type = a.Type
+			}
+			continue
+		}
+		idx[k] = len(out)
+		out = append(out, a)
+	}
+	return out
+}
+
+func dedupValidatorStatistics(stats []validatorStatistic) []validatorStatistic {
+	type key struct {
+		vID    string
+		height uint64
+		typ    structs.StatisticTypeVS
+	}
+
+	idx := make(map[key]int, len(stats))
+	out := make([]validatorStatistic, 0, len(stats))
+	for _, s := range stats {
+		k := key{s.ValidatorID, s.BlockHeight, s.StatisticsType}
+		if i, ok := idx[k]; ok {
+			out[i] = s
+			continue
+		}
+		idx[k] = len(out)
+		out = append(out, s)
+	}
+	return out
+}
+
+func mergeContractEvents(ctx context.Context, tx *sql.Tx, ces []structs.ContractEvent) error {
+	if len(ces) == 0 {
+		return nil
+	}
+
+	if _, err := tx.ExecContext(ctx, `CREATE TEMP TABLE staging_contract_events (LIKE contract_events INCLUDING DEFAULTS) ON COMMIT DROP`); err != nil {
+		return err
+	}
+
+	rows := make([][]interface{}, 0, len(ces))
+	for _, ce := range ces {
+		params, err := json.Marshal(ce.Params)
+		if err != nil {
+			return err
+		}
+
+		var bIDs, bAddrs []string
+		for _, bid := range ce.BoundID {
+			bIDs = append(bIDs, bid.String())
+		}
+		for _, baddr := range ce.BoundAddress {
+			bAddrs = append(bAddrs, baddr.Hash().Big().String())
+		}
+
+		rows = append(rows, []interface{}{
+			ce.ContractName,
+			ce.EventName,
+			ce.ContractAddress.Hash().Big().String(),
+			ce.BlockHeight,
+			ce.Time,
+			ce.TransactionHash.Big().String(),
+			string(params), // []byte would be encoded as bytea
+			ce.Removed,
+			ce.BoundType,
+			pq.Array(bIDs),
+			pq.Array(bAddrs),
+		})
+	}
+
+	if err := copyIn(ctx, tx, "staging_contract_events", []string{
+		"contract_name", "event_name", "contract_address", "block_height", "time", "transaction_hash",
+		"params", "removed", "bound_type", "bound_id", "bound_address"}, rows); err != nil {
+		return err
+	}
+
+	_, err := tx.ExecContext(ctx, `INSERT INTO contract_events(
+			"contract_name", "event_name", "contract_address", "block_height", "time", "transaction_hash",
+			"params", "removed", "bound_type", "bound_id", "bound_address")
+		SELECT contract_name, event_name, contract_address, block_height, time, transaction_hash,
+			params, removed, bound_type, bound_id, bound_address
+		FROM staging_contract_events
+		ON CONFLICT (contract_address, event_name, block_height, transaction_hash, removed)
+		DO UPDATE SET
+			contract_name = EXCLUDED.contract_name,
+			time = EXCLUDED.time,
+			params = EXCLUDED.params,
+			bound_type = EXCLUDED.bound_type,
+			bound_id = EXCLUDED.bound_id,
+			bound_address = EXCLUDED.bound_address`)
+	return err
+}
+
+func mergeAccounts(ctx context.Context, tx *sql.Tx, accs []structs.Account) error {
+	if len(accs) == 0 {
+		return nil
+	}
+
+	if _, err := tx.ExecContext(ctx, `CREATE TEMP TABLE staging_accounts (LIKE accounts INCLUDING DEFAULTS) ON COMMIT DROP`); err != nil {
+		return err
+	}
+
+	rows := make([][]interface{}, 0, len(accs))
+	for _, a := range accs {
+		rows = append(rows, []interface{}{a.Address.Hash().Big().String(), a.Type})
+	}
+
+	if err := copyIn(ctx, tx, "staging_accounts", []string{"address", "account_type"}, rows); err != nil {
+		return err
+	}
+
+	_, err := tx.ExecContext(ctx, `INSERT INTO accounts ("address", "account_type")
+		SELECT address, account_type FROM staging_accounts
+		ON CONFLICT (address)
+		DO UPDATE SET
+			account_type = EXCLUDED.account_type
+			WHERE accounts.account_type < EXCLUDED.account_type`)
+	return err
+}
+
+func mergeValidatorStatistics(ctx context.Context, tx *sql.Tx, stats []validatorStatistic) error {
+	if len(stats) == 0 {
+		return nil
+	}
+
+	if _, err := tx.ExecContext(ctx, `CREATE TEMP TABLE staging_validator_statistics (LIKE validator_statistics INCLUDING DEFAULTS) ON COMMIT DROP`); err != nil {
+		return err
+	}
+
+	rows := make([][]interface{}, 0, len(stats))
+	for _, s := range stats {
+		rows = append(rows, []interface{}{s.ValidatorID, s.BlockHeight, s.BlockTime, s.StatisticsType, s.Amount})
+	}
+
+	if err := copyIn(ctx, tx, "staging_validator_statistics", []string{"validator_id", "block_height", "time", "statistic_type", "amount"}, rows); err != nil {
+		return err
+	}
+
+	if _, err := tx.ExecContext(ctx, `CREATE INDEX ON staging_validator_statistics (validator_id, statistic_type, block_height)`); err != nil {
+		return err
+	}
+	if _, err := tx.ExecContext(ctx, `ANALYZE staging_validator_statistics`); err != nil {
+		return err
+	}
+
+	// Same as in SaveValidatorStatistic - value is stored unless it equals the previous one.
+	// Previous value is taken from both stored and staged rows, staged taking precedence on the same height
+	_, err := tx.ExecContext(ctx, `INSERT INTO validator_statistics (validator_id, block_height, time, statistic_type, amount)
+		SELECT s.validator_id, s.block_height, s.time, s.statistic_type, s.amount
+		FROM staging_validator_statistics s
+		WHERE s.amount IS DISTINCT FROM (
+			SELECT u.amount FROM (
+				(SELECT vs.block_height, vs.amount, 0 AS staged FROM validator_statistics vs
+					WHERE vs.validator_id = s.validator_id AND vs.statistic_type = s.statistic_type AND vs.block_height < s.block_height
+					ORDER BY vs.block_height DESC LIMIT 1)
+				UNION ALL
+				(SELECT s2.block_height, s2.amount, 1 AS staged FROM staging_validator_statistics s2
+					WHERE s2.validator_id = s.validator_id AND s2.statistic_type = s.statistic_type AND s2.block_height < s.block_height
+					ORDER BY s2.block_height DESC LIMIT 1)
+			) u ORDER BY u.block_height DESC, u.staged DESC LIMIT 1)
+		ON CONFLICT (validator_id, block_height, statistic_type)
+		DO UPDATE SET amount = EXCLUDED.amount`)
+	return err
+}
@@ -0,0 +1,163 @@
+package postgresql
+
+import (
+	"context"
+	"database/sql"
+	"math/big"
+	"os"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	_ "github.com/lib/pq"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap/zaptest"
+
+	"github.com/figment-networks/skale-indexer/scraper/structs"
+)
+
+func TestBulkBuffer_Truncate(t *testing.T) {
+	b := bulkBuffer{}
+	b.contractEvents = append(b.contractEvents, structs.ContractEvent{EventName: "first"})
+	b.validators = append(b.validators, "1")
+
+	m := b.mark()
+	b.contractEvents = append(b.contractEvents, structs.ContractEvent{EventName: "second"})
+	b.accounts = append(b.accounts, structs.Account{})
+	b.statistics = append(b.statistics, validatorStatistic{ValidatorID: "1"})
+	b.truncate(m)
+
+	require.Equal(t, []structs.ContractEvent{{EventName: "first"}}, b.contractEvents)
+	require.Empty(t, b.accounts)
+	require.Empty(t, b.statistics)
+	require.Equal(t, []string{"1"}, b.validators)
+}
+
+func TestDedupAccounts(t *testing.T) {
+	a1 := common.HexToAddress("0x1")
+	a2 := common.HexToAddress("0x2")
+
+	got := dedupAccounts([]structs.Account{
+		{Address: a1},
+		{Address: a2, Type: structs.AccountTypeValidator},
+		{Address: a1, Type: structs.AccountTypeDelegator},
+		{Address: a2, Type: structs.AccountTypeDelegator},
+	})
+
+	require.Equal(t, []structs.Account{
+		{Address: a1, Type: structs.AccountTypeDelegator},
+		{Address: a2, Type: structs.AccountTypeValidator},
+	}, got)
+}
+
+func TestDedupValidatorStatistics(t *testing.T) {
+	got := dedupValidatorStatistics([]validatorStatistic{
+		{ValidatorID: "1", BlockHeight: 10, StatisticsType: structs.ValidatorStatisticsTypeFee, Amount: "1"},
+		{ValidatorID: "1", BlockHeight: 10, StatisticsType: structs.ValidatorStatisticsTypeMDR, Amount: "2"},
+		{ValidatorID: "1", BlockHeight: 10, StatisticsType: structs.ValidatorStatisticsTypeFee, Amount: "3"},
+	})
+
+	require.Equal(t, []validatorStatistic{
+		{ValidatorID: "1", BlockHeight: 10, StatisticsType: structs.ValidatorStatisticsTypeFee, Amount: "3"},
+		{ValidatorID: "1", BlockHeight: 10, StatisticsType: structs.ValidatorStatisticsTypeMDR, Amount: "2"},
+	}, got)
+}
+
+// Benchmarks and conformance tests need migrated database given in TEST_DATABASE_URL, eg.
+// TEST_DATABASE_URL=postgres://localhost/skale_test?sslmode=disable go test -run none -bench ScrapeRange ./store/postgresql/
+func testDriver(tb testing.TB) *Driver {
+	dbURL := os.Getenv("TEST_DATABASE_URL")
+	if dbURL == "" {
+		tb.Skip("TEST_DATABASE_URL is not set")
+	}
+
+	db, err := sql.Open("postgres", dbURL)
+	require.NoError(tb, err)
+	tb.Cleanup(func() { db.Close() })
+
+	return NewDriver(context.Background(), db, zaptest.NewLogger(tb))
+}
+
+func benchmarkEvents(offset, n int) []structs.ContractEvent {
+	ces := make([]structs.ContractEvent, n)
+	for i := range ces {
+		h := uint64(offset + i)
+		ces[i] = structs.ContractEvent{
+			ContractName:    "delegation_controller",
+			EventName:       "DelegationProposed",
+			ContractAddress: common.HexToAddress("0x06dD71dAb27C1A3e0B172d53735f00Bf1a66Eb79"),
+			BlockHeight:     h,
+			Time:            time.Unix(int64(h), 0),
+			TransactionHash: common.BigToHash(new(big.Int).SetUint64(h)),
+			Params:          map[string]interface{}{"delegationId": strconv.FormatUint(h, 10)},
+			BoundType:       "delegation",
+			BoundID:         []big.Int{*new(big.Int).SetUint64(h)},
+			BoundAddress:    []common.Address{common.BigToAddress(new(big.Int).SetUint64(h))},
+		}
+	}
+	return ces
+}
+
+const (
+	benchmarkRange  = 1000
+	benchmarkBlock  = 10
+	benchmarkStakes = 50
+)
+
+// scrapeRange writes events the way the scraper does, every block in its own scope and every event
+// in the nested one, with a validator statistic and an account next to each event
+func scrapeRange(ctx context.Context, d *Driver, ces []structs.ContractEvent) error {
+	for i := 0; i < len(ces); i += benchmarkBlock {
+		block := ces[i : i+benchmarkBlock]
+		err := d.Atomic(ctx, func(ctx context.Context) error {
+			for _, ce := range block {
+				err := d.Atomic(ctx, func(ctx context.Context) error {
+					if err := d.SaveContractEvent(ctx, ce); err != nil {
+						return err
+					}
+					h := ce.BlockHeight
+					if err := d.SaveValidatorStatistic(ctx, big.NewInt(int64(h%benchmarkStakes)), h, ce.Time, structs.ValidatorStatisticsTypeTotalStake, new(big.Int).SetUint64(h)); err != nil {
+						return err
+					}
+					return d.SaveAccount(ctx, structs.Account{Address: ce.BoundAddress[0], Type: structs.AccountTypeDelegator})
+				})
+				if err != nil {
+					return err
+				}
+			}
+			return nil
+		})
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func BenchmarkScrapeRange_RowByRow(b *testing.B) {
+	d := testDriver(b)
+	ctx := context.Background()
+
+	b.ResetTimer()
+	start := time.Now()
+	for i := 0; i < b.N; i++ {
+		require.NoError(b, scrapeRange(ctx, d, benchmarkEvents(i*benchmarkRange, benchmarkRange)))
+	}
+	b.ReportMetric(float64(b.N*benchmarkRange)/time.Since(start).Seconds(), "events/s")
+}
+
+func BenchmarkScrapeRange_Bulk(b *testing.B) {
+	d := testDriver(b)
+	ctx := context.Background()
+
+	b.ResetTimer()
+	start := time.Now()
+	for i := 0; i < b.N; i++ {
+		err := d.Bulk(ctx, func(ctx context.Context) error {
+			return scrapeRange(ctx, d, benchmarkEvents(i*benchmarkRange, benchmarkRange))
+		})
+		require.NoError(b, err)
+	}
+	b.ReportMetric(float64(b.N*benchmarkRange)/time.Since(start).Seconds(), "events/s")
+}
@@ -0,0 +1,21 @@
+package postgresql
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/figment-networks/skale-indexer/store"
+	"github.com/figment-networks/skale-indexer/store/storetest"
+)
+
+func TestDriver(t *testing.T) {
+	storetest.Run(t, func(t *testing.T) store.DBDriver {
+		d := testDriver(t)
+		_, err := d.db.ExecContext(context.Background(), `TRUNCATE contract_events, system_events, failed_events, nodes, validators, nodes_history, validators_history,
+			validator_addresses, delegations, accounts, validator_statistics, blocks, transactions`)
+		require.NoError(t, err)
+		return d
+	})
+}
@@ -2,19 +2,25 @@ package postgresql
 
 import (
 	"context"
-	"database/sql"
 	"encoding/json"
 	"errors"
 	"fmt"
 	"math/big"
+	"sort"
 	"strconv"
+	"strings"
 
+	"github.com/ethereum/go-ethereum/common"
 	"github.com/figment-networks/skale-indexer/scraper/structs"
 	"github.com/lib/pq"
 )
 
 // SaveEvent saves contract events
 func (d *Driver) SaveContractEvent(ctx context.Context, ce structs.ContractEvent) error {
+	if buffer(ctx, func(b *bulkBuffer) { b.contractEvents = append(b.contractEvents, ce) }) {
+		return nil
+	}
+
 	params, err := json.Marshal(ce.Params)
 	if err != nil {
 		return err
@@ -32,7 +38,7 @@ func (d *Driver) SaveContractEvent(ctx context.Context, ce structs.ContractEvent
 		bAddrs = append(bAddrs, baddr.Hash().Big().String())
 	}
 
-	_, err = d.db.Exec(
+	_, err = d.conn(ctx).ExecContext(ctx,
 		`INSERT INTO contract_events(
 			"contract_name",
 			"event_name",
@@ -70,43 +76,37 @@ func (d *Driver) SaveContractEvent(ctx context.Context, ce structs.ContractEvent
 	return err
 }
 
-// GetContractEvents gets contract events
+// GetContractEvents gets contract events, the latest first
 func (d *Driver) GetContractEvents(ctx context.Context, params structs.EventParams) (contractEvents []structs.ContractEvent, err error) {
 
 	q := `SELECT id, contract_name, event_name, contract_address, block_height, time, transaction_hash, params, removed
-		FROM contract_events WHERE time BETWEEN $1 AND $2 `
+		FROM contract_events `
 
-	if params.Type != "" {
-		switch params.Type {
-		case "validator":
-			q += ` AND ( (bound_type = 'validator' AND bound_id[1] = $3 ) OR
-				   ( bound_type = 'delegation' AND bound_id[2] = $3) ) `
-		case "delegation":
-			q += ` AND bound_id[1] = $3 AND bound_type = 'delegation' `
-		case "node":
-			q += ` AND bound_id[1] = $3 AND bound_type = 'node' `
-		case "token":
-			q += ` AND bound_type = 'token' ` // TODO(l): Add address maybe?
-		default:
-			return nil, errors.New("unknown type")
-		}
+	whereC, args, err := contractEventsFilter(params)
+	if err != nil {
+		return nil, err
 	}
 
-	q += ` ORDER BY time DESC `
+	if !params.Cursor.IsZero() {
+		i := len(args) + 1
+		whereC = append(whereC, ` (block_height, id) < ($`+strconv.Itoa(i)+`, $`+strconv.Itoa(i+1)+`)`)
+		args = append(args, params.Cursor.BlockHeight, params.Cursor.ID)
+	}
+
+	if len(whereC) > 0 {
+		q += " WHERE "
+	}
+	q += strings.Join(whereC, " AND ")
+	q += ` ORDER BY block_height DESC, id DESC `
 
 	if params.Limit > 0 {
 		q += " LIMIT " + strconv.FormatUint(uint64(params.Limit), 10)
-		if params.Offset > 0 {
+		if params.Offset > 0 && params.Cursor.IsZero() {
 			q += " OFFSET " + strconv.FormatUint(uint64(params.Offset), 10)
 		}
 	}
-	var rows *sql.Rows
-	if params.Id > 0 {
-		rows, err = d.db.QueryContext(ctx, q, params.TimeFrom, params.TimeTo, params.Id)
-	} else {
-		rows, err = d.db.QueryContext(ctx, q, params.TimeFrom, params.TimeTo)
-	}
 
+	rows, err := d.conn(ctx).QueryContext(ctx, q, args...)
 	if err != nil {
 		return nil, fmt.Errorf("query error: %w", err)
 	}
@@ -127,8 +127,8 @@ func (d *Driver) GetContractEvents(ctx context.Context, params structs.EventPara
 		p.SetString(string(th), 10)
 		e.TransactionHash.SetBytes(p.Bytes())
 
-		a := make(map[string]interface{})
-		if err := json.Unmarshal(params, &a); err != nil {
+		a, err := structs.DecodeEventParams(params)
+		if err != nil {
 			return nil, fmt.Errorf("unmarshal error error: %w", err)
 		}
 
@@ -138,3 +138,117 @@ func (d *Driver) GetContractEvents(ctx context.Context, params structs.EventPara
 
 	return contractEvents, nil
 }
+
+// CountContractEvents counts contract events matching params, regardless of paging
+func (d *Driver) CountContractEvents(ctx context.Context, params structs.EventParams) (count uint64, err error) {
+	q := `SELECT COUNT(*) FROM contract_events `
+
+	whereC, args, err := contractEventsFilter(params)
+	if err != nil {
+		return 0, err
+	}
+	if len(whereC) > 0 {
+		q += " WHERE " + strings.Join(whereC, " AND ")
+	}
+
+	err = d.conn(ctx).QueryRowContext(ctx, q, args...).Scan(&count)
+	return count, err
+}
+
+// contractEventsFilter builds conditions of contract events query for params, paging excluded
+func contractEventsFilter(params structs.EventParams) (whereC []string, args []interface{}, err error) {
+	i := 1
+
+	if !params.TimeFrom.IsZero() || !params.TimeTo.IsZero() {
+		whereC = append(whereC, ` time BETWEEN $`+strconv.Itoa(i)+` AND $`+strconv.Itoa(i+1))
+		args = append(args, params.TimeFrom, params.TimeTo)
+		i += 2
+	}
+
+	if params.Type != "" {
+		switch params.Type {
+		case "validator":
+			whereC = append(whereC, ` ( (bound_type = 'validator' AND bound_id[1] = $`+strconv.Itoa(i)+` ) OR
+				   ( bound_type = 'delegation' AND bound_id[2] = $`+strconv.Itoa(i)+`) ) `)
+		case "delegation":
+			whereC = append(whereC, ` bound_id[1] = $`+strconv.Itoa(i)+` AND bound_type = 'delegation' `)
+		case "node":
+			whereC = append(whereC, ` bound_id[1] = $`+strconv.Itoa(i)+` AND bound_type = 'node' `)
+		case "token":
+			whereC = append(whereC, ` bound_type = 'token' `) // TODO(l): Add address maybe?
+		default:
+			return nil, nil, errors.New("unknown type")
+		}
+		if params.Type != "token" {
+			args = append(args, params.Id)
+			i++
+		}
+	}
+
+	if params.ContractName != "" {
+		whereC = append(whereC, ` contract_name = $`+strconv.Itoa(i))
+		args = append(args, params.ContractName)
+		i++
+	}
+
+	if params.EventName != "" {
+		whereC = append(whereC, ` event_name = $`+strconv.Itoa(i))
+		args = append(args, params.EventName)
+		i++
+	}
+
+	// containment of every candidate value is looked up in GIN index of params
+	for _, key := range sortedKeys(params.Params) {
+		var or []string
+		for _, v := range structs.EventParamValues(params.Params[key]) {
+			contained, err := json.Marshal(map[string]json.RawMessage{key: v})
+			if err != nil {
+				return nil, nil, err
+			}
+			or = append(or, ` params @> $`+strconv.Itoa(i)+`::jsonb`)
+			args = append(args, string(contained))
+			i++
+		}
+		whereC = append(whereC, ` (`+strings.Join(or, " OR ")+`)`)
+	}
+
+	if params.HeightFrom > 0 {
+		whereC = append(whereC, ` block_height >= $`+strconv.Itoa(i))
+		args = append(args, params.HeightFrom)
+		i++
+	}
+
+	if params.HeightTo > 0 {
+		whereC = append(whereC, ` block_height <= $`+strconv.Itoa(i))
+		args = append(args, params.HeightTo)
+		i++
+	}
+
+	if params.BoundAddress != (common.Address{}) {
+		whereC = append(whereC, ` bound_address @> ARRAY[$`+strconv.Itoa(i)+`::NUMERIC]`)
+		args = append(args, params.BoundAddress.Hash().Big().String())
+		i++
+	}
+
+	if params.TransactionHash != (common.Hash{}) {
+		whereC = append(whereC, ` transaction_hash = $`+strconv.Itoa(i))
+		args = append(args, params.TransactionHash.Big().String())
+		i++
+	}
+
+	if params.AtHeight > 0 {
+		whereC = append(whereC, ` block_height <= $`+strconv.Itoa(i))
+		args = append(args, params.AtHeight)
+	}
+
+	return whereC, args, nil
+}
+
+// sortedKeys returns keys of parameters filter in stable order, so the same filter makes the same query
+func sortedKeys(m map[string]string) (keys []string) {
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
@@ -0,0 +1,84 @@
+package postgresql
+
+import (
+	"context"
+	"math/big"
+	"strconv"
+	"strings"
+
+	"github.com/figment-networks/skale-indexer/scraper/structs"
+)
+
+// SaveDecentralization saves metrics of the epoch, replacing the ones computed before
+func (d *Driver) SaveDecentralization(ctx context.Context, dc structs.Decentralization) error {
+	_, err := d.conn(ctx).ExecContext(ctx, `INSERT INTO decentralization
+			("epoch", "time", "block_height", "validators", "total_stake", "nakamoto_coefficient", "gini",
+			"top1_share", "top5_share", "top10_share", "validators_over_33", "validators_over_50", "validators_over_66")
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13)
+		ON CONFLICT (epoch)
+		DO UPDATE SET
+			time = EXCLUDED.time,
+			block_height = EXCLUDED.block_height,
+			validators = EXCLUDED.validators,
+			total_stake = EXCLUDED.total_stake,
+			nakamoto_coefficient = EXCLUDED.nakamoto_coefficient,
+			gini = EXCLUDED.gini,
+			top1_share = EXCLUDED.top1_share,
+			top5_share = EXCLUDED.top5_share,
+			top10_share = EXCLUDED.top10_share,
+			validators_over_33 = EXCLUDED.validators_over_33,
+			validators_over_50 = EXCLUDED.validators_over_50,
+			validators_over_66 = EXCLUDED.validators_over_66`,
+		dc.Epoch, dc.Time, dc.BlockHeight, dc.Validators, dc.TotalStake.String(), dc.NakamotoCoefficient, dc.Gini,
+		dc.Top1Share, dc.Top5Share, dc.Top10Share, dc.ValidatorsOver33, dc.ValidatorsOver50, dc.ValidatorsOver66)
+	return err
+}
+
+// GetDecentralization gets metrics of epochs, the latest first
+func (d *Driver) GetDecentralization(ctx context.Context, params structs.DecentralizationParams) (dcs []structs.Decentralization, err error) {
+	q := `SELECT epoch, time, block_height, validators, total_stake, nakamoto_coefficient, gini,
+			top1_share, top5_share, top10_share, validators_over_33, validators_over_50, validators_over_66
+		FROM decentralization`
+	var (
+		args   []interface{}
+		wherec []string
+	)
+	if params.EpochFrom > 0 {
+		args = append(args, params.EpochFrom)
+		wherec = append(wherec, ` epoch >= $`+strconv.Itoa(len(args)))
+	}
+	if params.EpochTo > 0 {
+		args = append(args, params.EpochTo)
+		wherec = append(wherec, ` epoch <= $`+strconv.Itoa(len(args)))
+	}
+	if len(wherec) > 0 {
+		q += ` WHERE ` + strings.Join(wherec, " AND ")
+	}
+	q += ` ORDER BY epoch DESC`
+	if params.Limit > 0 {
+		q += " LIMIT " + strconv.FormatUint(params.Limit, 10)
+		if params.Offset > 0 {
+			q += " OFFSET " + strconv.FormatUint(params.Offset, 10)
+		}
+	}
+
+	rows, err := d.conn(ctx).QueryContext(ctx, q, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var (
+			dc         structs.Decentralization
+			totalStake string
+		)
+		if err = rows.Scan(&dc.Epoch, &dc.Time, &dc.BlockHeight, &dc.Validators, &totalStake, &dc.NakamotoCoefficient, &dc.Gini,
+			&dc.Top1Share, &dc.Top5Share, &dc.Top10Share, &dc.ValidatorsOver33, &dc.ValidatorsOver50, &dc.ValidatorsOver66); err != nil {
+			return nil, err
+		}
+		dc.TotalStake, _ = new(big.Int).SetString(totalStake, 10)
+		dcs = append(dcs, dc)
+	}
+	return dcs, rows.Err()
+}
@@ -17,7 +17,7 @@ import (
 // SaveDelegation saves delegation
 func (d *Driver) SaveDelegation(ctx context.Context, dl structs.Delegation) error {
 
-	_, err := d.db.Exec(`INSERT INTO delegations (
+	_, err := d.conn(ctx).ExecContext(ctx, `INSERT INTO delegations (
 				"delegation_id","holder","validator_id", "block_height","transaction_hash","amount",
 				"delegation_period","created","started","finished","info","state","until")
 		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, (date_trunc('month', $14::timestamp ) + make_interval(MONTHS => 1+$13::INTEGER) - interval '1 day')::TIMESTAMP )
@@ -58,53 +58,23 @@ func (d *Driver) GetDelegationTimeline(ctx context.Context, params structs.Deleg
 	q := `SELECT delegations.id, delegation_id, holder, delegations.validator_id, delegations.block_height, transaction_hash, amount, delegation_period, created, started, finished, info, state, name
 			FROM delegations INNER JOIN validators ON delegations.validator_id = validators.validator_id `
 
-	var (
-		args   []interface{}
-		whereC []string
-		i      = 1
-	)
-
-	if params.DelegationID != "" {
-		whereC = append(whereC, ` delegation_id = $`+strconv.Itoa(i))
-		args = append(args, params.DelegationID)
-		i++
-	}
-	if params.ValidatorID != "" {
-		whereC = append(whereC, ` validators.validator_id = $`+strconv.Itoa(i))
-		args = append(args, params.ValidatorID)
-		i++
-	}
-	if params.Holder != "" {
-		whereC = append(whereC, ` holder =  $`+strconv.Itoa(i))
-		args = append(args, common.HexToAddress(params.Holder).Hash().Big().String())
-		i++
-	}
+	whereC, args := delegationTimelineFilter(params)
 
-	if !params.TimeAt.IsZero() {
-		whereC = append(whereC, ` $`+strconv.Itoa(i)+` BETWEEN created AND until`)
-		args = append(args, params.TimeAt)
-		i += 1
-	} else if !params.TimeFrom.IsZero() && !params.TimeTo.IsZero() {
-		whereC = append(whereC, ` created BETWEEN $`+strconv.Itoa(i)+` AND $`+strconv.Itoa(i+1))
-		args = append(args, params.TimeFrom)
-		args = append(args, params.TimeTo)
-		i += 2
-	}
-	if len(params.State) > 0 {
-		whereC = append(whereC, "state @> $"+strconv.Itoa(i))
-		args = append(args, pq.Array(params.State))
-		i++
+	if !params.Cursor.IsZero() {
+		i := len(args) + 1
+		whereC = append(whereC, ` (delegations.block_height, delegations.id) < ($`+strconv.Itoa(i)+`, $`+strconv.Itoa(i+1)+`)`)
+		args = append(args, params.Cursor.BlockHeight, params.Cursor.ID)
 	}
 
 	if len(whereC) > 0 {
 		q += " WHERE "
 	}
 	q += strings.Join(whereC, " AND ")
-	q += `ORDER BY block_height DESC`
+	q += ` ORDER BY delegations.block_height DESC, delegations.id DESC`
 
 	if params.Limit > 0 {
 		q += " LIMIT " + strconv.FormatUint(uint64(params.Limit), 10)
-		if params.Offset > 0 {
+		if params.Offset > 0 && params.Cursor.IsZero() {
 			q += " OFFSET " + strconv.FormatUint(uint64(params.Offset), 10)
 		}
 	}
@@ -112,7 +82,7 @@ func (d *Driver) GetDelegationTimeline(ctx context.Context, params structs.Deleg
 		return nil, fmt.Errorf("query error: %w", err)
 	}
 	var rows *sql.Rows
-	rows, err = d.db.QueryContext(ctx, q, args...)
+	rows, err = d.conn(ctx).QueryContext(ctx, q, args...)
 	if err != nil {
 		return nil, err
 	}
@@ -155,17 +125,22 @@ func (d *Driver) GetDelegationTimeline(ctx context.Context, params structs.Deleg
 	return delegations, nil
 }
 
-func (d *Driver) GetDelegations(ctx context.Context, params structs.DelegationParams) (delegations []structs.Delegation, err error) {
-	q := `SELECT
-			DISTINCT ON (delegation_id)
-				delegation_id, delegations.id, holder, delegations.validator_id, delegations.block_height, transaction_hash, amount, delegation_period, created, started, finished, info, state, name
-			FROM delegations INNER JOIN validators ON delegations.validator_id = validators.validator_id `
+// CountDelegationTimeline counts delegation changes matching params, regardless of paging
+func (d *Driver) CountDelegationTimeline(ctx context.Context, params structs.DelegationParams) (count uint64, err error) {
+	q := `SELECT COUNT(*) FROM delegations INNER JOIN validators ON delegations.validator_id = validators.validator_id `
 
-	var (
-		args   []interface{}
-		whereC []string
-		i      = 1
-	)
+	whereC, args := delegationTimelineFilter(params)
+	if len(whereC) > 0 {
+		q += " WHERE " + strings.Join(whereC, " AND ")
+	}
+
+	err = d.conn(ctx).QueryRowContext(ctx, q, args...).Scan(&count)
+	return count, err
+}
+
+// delegationTimelineFilter builds conditions of delegation timeline query for params, paging excluded
+func delegationTimelineFilter(params structs.DelegationParams) (whereC []string, args []interface{}) {
+	i := 1
 
 	if params.DelegationID != "" {
 		whereC = append(whereC, ` delegation_id = $`+strconv.Itoa(i))
@@ -182,10 +157,9 @@ func (d *Driver) GetDelegations(ctx context.Context, params structs.DelegationPa
 		args = append(args, common.HexToAddress(params.Holder).Hash().Big().String())
 		i++
 	}
-
-	if len(params.State) > 0 {
-		whereC = append(whereC, " state @> $"+strconv.Itoa(i))
-		args = append(args, pq.Array(params.State))
+	if params.AtHeight > 0 {
+		whereC = append(whereC, ` delegations.block_height <= $`+strconv.Itoa(i))
+		args = append(args, params.AtHeight)
 		i++
 	}
 
@@ -199,22 +173,18 @@ func (d *Driver) GetDelegations(ctx context.Context, params structs.DelegationPa
 		args = append(args, params.TimeTo)
 		i += 2
 	}
-
-	if len(whereC) > 0 {
-		q += " WHERE "
+	if len(params.State) > 0 {
+		whereC = append(whereC, " state = ANY($"+strconv.Itoa(i)+")")
+		args = append(args, pq.Array(params.State))
 	}
-	q += strings.Join(whereC, " AND ")
-	q += ` ORDER BY delegation_id DESC, block_height DESC`
 
-	if params.Limit > 0 {
-		q += " LIMIT " + strconv.FormatUint(uint64(params.Limit), 10)
-		if params.Offset > 0 {
-			q += " OFFSET " + strconv.FormatUint(uint64(params.Offset), 10)
-		}
-	}
+	return whereC, args
+}
 
+func (d *Driver) GetDelegations(ctx context.Context, params structs.DelegationParams) (delegations []structs.Delegation, err error) {
+	q, args := delegationsQuery(params)
 	var rows *sql.Rows
-	rows, err = d.db.QueryContext(ctx, q, args...)
+	rows, err = d.conn(ctx).QueryContext(ctx, q, args...)
 	if err != nil {
 		return nil, err
 	}
@@ -257,6 +227,99 @@ func (d *Driver) GetDelegations(ctx context.Context, params structs.DelegationPa
 	return delegations, nil
 }
 
+// CountDelegations counts the latest state of delegations matching params, regardless of paging
+func (d *Driver) CountDelegations(ctx context.Context, params structs.DelegationParams) (count uint64, err error) {
+	params.Limit, params.Offset, params.KeyCursor = 0, 0, structs.KeyCursor{}
+	q, args := delegationsQuery(params)
+	err = d.conn(ctx).QueryRowContext(ctx, `SELECT COUNT(*) FROM (`+q+`) c`, args...).Scan(&count)
+	return count, err
+}
+
+// delegationsQuery builds query of the latest state of delegations for params
+func delegationsQuery(params structs.DelegationParams) (q string, args []interface{}) {
+	q = `SELECT
+			DISTINCT ON (delegation_id)
+				delegation_id, delegations.id, holder, delegations.validator_id, delegations.block_height, transaction_hash, amount, delegation_period, created, started, finished, info, state, name
+			FROM delegations INNER JOIN validators ON delegations.validator_id = validators.validator_id `
+
+	var (
+		whereC []string
+		i      = 1
+	)
+
+	if params.DelegationID != "" {
+		whereC = append(whereC, ` delegation_id = $`+strconv.Itoa(i))
+		args = append(args, params.DelegationID)
+		i++
+	}
+	if params.ValidatorID != "" {
+		whereC = append(whereC, ` validators.validator_id = $`+strconv.Itoa(i))
+		args = append(args, params.ValidatorID)
+		i++
+	}
+	if params.Holder != "" {
+		whereC = append(whereC, ` holder =  $`+strconv.Itoa(i))
+		args = append(args, common.HexToAddress(params.Holder).Hash().Big().String())
+		i++
+	}
+	if len(params.ValidatorIDs) > 0 {
+		whereC = append(whereC, ` validators.validator_id = ANY($`+strconv.Itoa(i)+`::NUMERIC[])`)
+		args = append(args, pq.Array(params.ValidatorIDs))
+		i++
+	}
+	if len(params.Holders) > 0 {
+		holders := make([]string, len(params.Holders))
+		for j, h := range params.Holders {
+			holders[j] = common.HexToAddress(h).Hash().Big().String()
+		}
+		whereC = append(whereC, ` holder = ANY($`+strconv.Itoa(i)+`::NUMERIC[])`)
+		args = append(args, pq.Array(holders))
+		i++
+	}
+	if params.AtHeight > 0 {
+		whereC = append(whereC, ` delegations.block_height <= $`+strconv.Itoa(i))
+		args = append(args, params.AtHeight)
+		i++
+	}
+
+	if len(params.State) > 0 {
+		whereC = append(whereC, " state = ANY($"+strconv.Itoa(i)+")")
+		args = append(args, pq.Array(params.State))
+		i++
+	}
+
+	if !params.TimeAt.IsZero() {
+		whereC = append(whereC, ` $`+strconv.Itoa(i)+` BETWEEN created AND until`)
+		args = append(args, params.TimeAt)
+		i += 1
+	} else if !params.TimeFrom.IsZero() && !params.TimeTo.IsZero() {
+		whereC = append(whereC, ` created BETWEEN $`+strconv.Itoa(i)+` AND $`+strconv.Itoa(i+1))
+		args = append(args, params.TimeFrom)
+		args = append(args, params.TimeTo)
+		i += 2
+	}
+
+	if !params.KeyCursor.IsZero() {
+		whereC = append(whereC, ` delegation_id < $`+strconv.Itoa(i))
+		args = append(args, params.KeyCursor.Key)
+		i++
+	}
+
+	if len(whereC) > 0 {
+		q += " WHERE "
+	}
+	q += strings.Join(whereC, " AND ")
+	q += ` ORDER BY delegation_id DESC, block_height DESC`
+
+	if params.Limit > 0 {
+		q += " LIMIT " + strconv.FormatUint(uint64(params.Limit), 10)
+		if params.Offset > 0 && params.KeyCursor.IsZero() {
+			q += " OFFSET " + strconv.FormatUint(uint64(params.Offset), 10)
+		}
+	}
+	return q, args
+}
+
 func (d *Driver) GetTypesSummaryDelegations(ctx context.Context, params structs.DelegationParams) (delegations []structs.DelegationSummary, err error) {
 	q := `SELECT DISTINCT ON (delegation_id) delegation_id, amount, state
 				FROM delegations `
@@ -272,6 +335,11 @@ func (d *Driver) GetTypesSummaryDelegations(ctx context.Context, params structs.
 		args = append(args, params.ValidatorID)
 		i++
 	}
+	if params.AtHeight > 0 {
+		whereC = append(whereC, ` block_height <= $`+strconv.Itoa(i))
+		args = append(args, params.AtHeight)
+		i++
+	}
 
 	if !params.TimeAt.IsZero() {
 		whereC = append(whereC, `$`+strconv.Itoa(i)+` BETWEEN created AND until`)
@@ -291,7 +359,7 @@ func (d *Driver) GetTypesSummaryDelegations(ctx context.Context, params structs.
 
 	var rows *sql.Rows
 
-	rows, err = d.db.QueryContext(ctx, `SELECT d.state, SUM(d.amount) as amount, COUNT(d.delegation_id) as count FROM (`+q+`) AS d GROUP BY d.state;`, args...)
+	rows, err = d.conn(ctx).QueryContext(ctx, `SELECT d.state, SUM(d.amount) as amount, COUNT(d.delegation_id) as count FROM (`+q+`) AS d GROUP BY d.state;`, args...)
 	if err != nil {
 		return nil, err
 	}
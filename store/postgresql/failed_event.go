@@ -0,0 +1,143 @@
+package postgresql
+
+import (
+	"context"
+	"encoding/json"
+	"math/big"
+	"strconv"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/figment-networks/skale-indexer/scraper/structs"
+)
+
+// SaveFailedEvent saves event log which failed processing. Already stored one gets another attempt counted,
+// and its next retry is never moved earlier, so re-scraping the block doesn't reset the backoff
+func (d *Driver) SaveFailedEvent(ctx context.Context, fe structs.FailedEvent) error {
+	l, err := json.Marshal(fe.Log)
+	if err != nil {
+		return err
+	}
+
+	_, err = d.conn(ctx).ExecContext(ctx, `INSERT INTO failed_events (
+			"block_height", "transaction_hash", "log_index", "contract_name", "event_name", "log", "error", "attempts", "next_retry")
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9)
+		ON CONFLICT (transaction_hash, log_index)
+		DO UPDATE SET
+			updated_at = NOW(),
+			contract_name = EXCLUDED.contract_name,
+			event_name = EXCLUDED.event_name,
+			log = EXCLUDED.log,
+			error = EXCLUDED.error,
+			attempts = failed_events.attempts + 1,
+			next_retry = GREATEST(failed_events.next_retry, EXCLUDED.next_retry)`,
+		fe.BlockHeight,
+		fe.TransactionHash.Big().String(),
+		fe.LogIndex,
+		fe.ContractName,
+		fe.EventName,
+		l,
+		fe.Error,
+		fe.Attempts,
+		fe.NextRetry)
+	return err
+}
+
+// GetFailedEvents gets failed events
+func (d *Driver) GetFailedEvents(ctx context.Context, params structs.FailedEventParams) (failedEvents []structs.FailedEvent, err error) {
+	q := `SELECT id, created_at, updated_at, block_height, transaction_hash, log_index, contract_name, event_name, log, error, attempts, next_retry FROM failed_events `
+
+	var (
+		args   []interface{}
+		whereC []string
+		i      = 1
+	)
+
+	if params.ID != "" {
+		whereC = append(whereC, ` id = $`+strconv.Itoa(i))
+		args = append(args, params.ID)
+		i++
+	}
+
+	if !params.RetryBefore.IsZero() {
+		whereC = append(whereC, ` next_retry <= $`+strconv.Itoa(i))
+		args = append(args, params.RetryBefore)
+		i++
+	}
+
+	if params.MaxAttempts > 0 {
+		whereC = append(whereC, ` attempts < $`+strconv.Itoa(i))
+		args = append(args, params.MaxAttempts)
+		i++
+	}
+
+	if len(whereC) > 0 {
+		q += " WHERE "
+	}
+
+	q += strings.Join(whereC, " AND ")
+	q += ` ORDER BY block_height ASC, log_index ASC `
+
+	if params.Limit > 0 {
+		q += " LIMIT " + strconv.FormatUint(params.Limit, 10)
+		if params.Offset > 0 {
+			q += " OFFSET " + strconv.FormatUint(params.Offset, 10)
+		}
+	}
+
+	rows, err := d.conn(ctx).QueryContext(ctx, q, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var (
+		txHash []byte
+		l      []byte
+	)
+	for rows.Next() {
+		fe := structs.FailedEvent{}
+		if err = rows.Scan(&fe.ID, &fe.CreatedAt, &fe.UpdatedAt, &fe.BlockHeight, &txHash, &fe.LogIndex, &fe.ContractName, &fe.EventName, &l, &fe.Error, &fe.Attempts, &fe.NextRetry); err != nil {
+			return nil, err
+		}
+
+		p := new(big.Int)
+		p.SetString(string(txHash), 10)
+		fe.TransactionHash = common.BigToHash(p)
+
+		if err = json.Unmarshal(l, &fe.Log); err != nil {
+			return nil, err
+		}
+		failedEvents = append(failedEvents, fe)
+	}
+
+	if len(failedEvents) == 0 && params.ID != "" {
+		return nil, structs.ErrNotFound
+	}
+
+	return failedEvents, rows.Err()
+}
+
+// DeleteFailedEvent removes failed event from the queue
+func (d *Driver) DeleteFailedEvent(ctx context.Context, id string) error {
+	res, err := d.conn(ctx).ExecContext(ctx, `DELETE FROM failed_events WHERE id = $1`, id)
+	if err != nil {
+		return err
+	}
+
+	n, err := res.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if n == 0 {
+		return structs.ErrNotFound
+	}
+	return nil
+}
+
+// DeleteFailedEventOfLog removes failed event of the log, if there is one
+func (d *Driver) DeleteFailedEventOfLog(ctx context.Context, txHash common.Hash, logIndex uint) error {
+	_, err := d.conn(ctx).ExecContext(ctx, `DELETE FROM failed_events WHERE transaction_hash = $1 AND log_index = $2`, txHash.Big().String(), logIndex)
+	return err
+}
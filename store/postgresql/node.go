@@ -2,6 +2,7 @@ package postgresql
 
 import (
 	"context"
+	"database/sql"
 	"fmt"
 	"math/big"
 	"net"
@@ -17,13 +18,10 @@ const zero = 0
 
 // SaveNodes saves nodes
 func (d *Driver) SaveNodes(ctx context.Context, nodes []structs.Node, removedNodeAddress common.Address) error {
-	tx, err := d.db.BeginTx(ctx, nil)
-	if err != nil {
-		return err
-	}
-
-	for _, n := range nodes {
-		_, err = tx.ExecContext(ctx, `INSERT INTO nodes
+	return d.Atomic(ctx, func(ctx context.Context) error {
+		tx := d.conn(ctx)
+		for _, n := range nodes {
+			_, err := tx.ExecContext(ctx, `INSERT INTO nodes
 			("node_id", "address", "name",  "ip", "public_ip", "port", "start_block", "next_reward_date", "last_reward_date", "finish_time", "status", "validator_id", "block_height")
 			SELECT $1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13
 				WHERE NOT EXISTS (SELECT 1 FROM nodes n2 WHERE n2.node_id = $1 AND n2.block_height > $13 ORDER BY n2.block_height DESC LIMIT 1 )
@@ -41,59 +39,193 @@ func (d *Driver) SaveNodes(ctx context.Context, nodes []structs.Node, removedNod
 				status = EXCLUDED.status,
 				validator_id = EXCLUDED.validator_id,
 				block_height = EXCLUDED.block_height`,
-			n.NodeID.String(),
-			n.Address.Hash().Big().String(),
-			n.Name,
-			n.IP.String(),
-			n.PublicIP.String(),
-			n.Port,
-			n.StartBlock.String(),
-			n.NextRewardDate,
-			n.LastRewardDate,
-			n.FinishTime.String(),
-			n.Status.String(),
-			n.ValidatorID.String(),
-			n.BlockHeight)
+				n.NodeID.String(),
+				n.Address.Hash().Big().String(),
+				n.Name,
+				n.IP.String(),
+				n.PublicIP.String(),
+				n.Port,
+				n.StartBlock.String(),
+				n.NextRewardDate,
+				n.LastRewardDate,
+				n.FinishTime.String(),
+				n.Status.String(),
+				n.ValidatorID.String(),
+				n.BlockHeight)
+			if err != nil {
+				return err
+			}
 
-		if err != nil {
-			if rollbackErr := tx.Rollback(); rollbackErr != nil {
-				return rollbackErr
+			if err := d.saveNodeVersion(ctx, n); err != nil {
+				return err
 			}
-			return err
 		}
-	}
 
-	// update removed node
-	if removedNodeAddress.Hash().Big().String() != string(zero) && len(nodes) > 0 {
-		_, err = tx.ExecContext(ctx, `UPDATE nodes SET address = $1
+		// update removed node
+		if removedNodeAddress.Hash().Big().String() != string(zero) && len(nodes) > 0 {
+			_, err := tx.ExecContext(ctx, `UPDATE nodes SET address = $1
 				WHERE validator_id = $2 AND address = $3 AND node_id
 					  NOT IN (SELECT n2.node_id FROM nodes n2 WHERE n2.address != $3 AND n2.validator_id = $2 ORDER BY n2.block_height)`,
-			zero,
-			nodes[0].ValidatorID.Int64(),
-			removedNodeAddress.Hash().Big().String())
-		if err != nil {
-			if rollbackErr := tx.Rollback(); rollbackErr != nil {
-				return rollbackErr
+				zero,
+				nodes[0].ValidatorID.Int64(),
+				removedNodeAddress.Hash().Big().String())
+			if err != nil || removedNodeAddress == (common.Address{}) {
+				return err
 			}
-			return err
+			return d.removeNodeAddressVersion(ctx, nodes[0].ValidatorID, nodes[0].BlockHeight, removedNodeAddress)
 		}
+		return nil
+	})
+}
+
+// saveNodeVersion records node as valid from its block height until the following version, if there is any
+func (d *Driver) saveNodeVersion(ctx context.Context, n structs.Node) error {
+	tx := d.conn(ctx)
+
+	// nodes are saved again on every event of their validator, state which is already valid at the height is not a change
+	var unchanged bool
+	err := tx.QueryRowContext(ctx, `SELECT valid_from < $2 AND address = $3 AND name = $4 AND ip = $5 AND public_ip = $6 AND port = $7 AND start_block = $8
+			AND next_reward_date = $9 AND last_reward_date = $10 AND finish_time = $11 AND status = $12 AND validator_id = $13
+		FROM nodes_history
+		WHERE node_id = $1 AND valid_from <= $2
+		ORDER BY valid_from DESC
+		LIMIT 1`,
+		n.NodeID.String(),
+		n.BlockHeight,
+		n.Address.Hash().Big().String(),
+		n.Name,
+		n.IP.String(),
+		n.PublicIP.String(),
+		n.Port,
+		n.StartBlock.String(),
+		n.NextRewardDate,
+		n.LastRewardDate,
+		n.FinishTime.String(),
+		n.Status.String(),
+		n.ValidatorID.String()).Scan(&unchanged)
+	if err != nil && err != sql.ErrNoRows {
+		return err
+	}
+	if unchanged {
+		return nil
+	}
+
+	_, err = tx.ExecContext(ctx, `UPDATE nodes_history SET valid_to = $2
+		WHERE node_id = $1 AND valid_from < $2 AND (valid_to IS NULL OR valid_to > $2)`,
+		n.NodeID.String(), n.BlockHeight)
+	if err != nil {
+		return err
 	}
 
-	return tx.Commit()
+	_, err = tx.ExecContext(ctx, `INSERT INTO nodes_history
+			("node_id", "address", "name",  "ip", "public_ip", "port", "start_block", "next_reward_date", "last_reward_date", "finish_time", "status", "validator_id", "valid_from", "valid_to")
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13,
+			(SELECT MIN(valid_from) FROM nodes_history WHERE node_id = $1 AND valid_from > $13))
+		ON CONFLICT (node_id, valid_from)
+		DO UPDATE SET
+			name = EXCLUDED.name,
+			address = EXCLUDED.address,
+			ip = EXCLUDED.ip,
+			public_ip = EXCLUDED.public_ip,
+			port = EXCLUDED.port,
+			start_block = EXCLUDED.start_block,
+			next_reward_date = EXCLUDED.next_reward_date,
+			last_reward_date = EXCLUDED.last_reward_date,
+			finish_time = EXCLUDED.finish_time,
+			status = EXCLUDED.status,
+			validator_id = EXCLUDED.validator_id`,
+		n.NodeID.String(),
+		n.Address.Hash().Big().String(),
+		n.Name,
+		n.IP.String(),
+		n.PublicIP.String(),
+		n.Port,
+		n.StartBlock.String(),
+		n.NextRewardDate,
+		n.LastRewardDate,
+		n.FinishTime.String(),
+		n.Status.String(),
+		n.ValidatorID.String(),
+		n.BlockHeight)
+	return err
+}
+
+// removeNodeAddressVersion records that nodes of validator, which had the removed address at given height, have no address since
+func (d *Driver) removeNodeAddressVersion(ctx context.Context, validatorID *big.Int, height uint64, removed common.Address) error {
+	tx := d.conn(ctx)
+	_, err := tx.ExecContext(ctx, `INSERT INTO nodes_history
+			("node_id", "address", "name",  "ip", "public_ip", "port", "start_block", "next_reward_date", "last_reward_date", "finish_time", "status", "validator_id", "valid_from", "valid_to")
+		SELECT node_id, 0, name, ip, public_ip, port, start_block, next_reward_date, last_reward_date, finish_time, status, validator_id, $2, valid_to
+			FROM nodes_history
+			WHERE validator_id = $1 AND address = $3 AND valid_from < $2 AND (valid_to IS NULL OR valid_to > $2)`,
+		validatorID.String(), height, removed.Hash().Big().String())
+	if err != nil {
+		return err
+	}
+
+	_, err = tx.ExecContext(ctx, `UPDATE nodes_history SET valid_to = $2
+		WHERE validator_id = $1 AND address = $3 AND valid_from < $2 AND (valid_to IS NULL OR valid_to > $2)`,
+		validatorID.String(), height, removed.Hash().Big().String())
+	if err != nil {
+		return err
+	}
+
+	_, err = tx.ExecContext(ctx, `UPDATE nodes_history SET address = 0 WHERE validator_id = $1 AND address = $3 AND valid_from = $2`,
+		validatorID.String(), height, removed.Hash().Big().String())
+	return err
 }
 
 // GetNodes gets nodes
 func (d *Driver) GetNodes(ctx context.Context, params structs.NodeParams) (nodes []structs.Node, err error) {
-	q := `SELECT
+	q, args := nodesQuery(params)
+	rows, err := d.conn(ctx).QueryContext(ctx, q, args...)
+	if err != nil {
+		return nil, fmt.Errorf("query error: %w", err)
+	}
+
+	defer rows.Close()
+	for rows.Next() {
+		var height uint64
+		n, err := scanNode(rows, &height)
+		if err != nil {
+			return nil, err
+		}
+		n.BlockHeight = height
+		nodes = append(nodes, n)
+	}
+	return nodes, nil
+}
+
+// CountNodes counts nodes matching params, regardless of paging
+func (d *Driver) CountNodes(ctx context.Context, params structs.NodeParams) (count uint64, err error) {
+	params.Limit, params.Offset, params.Cursor = 0, 0, structs.KeyCursor{}
+	q, args := nodesQuery(params)
+	err = d.conn(ctx).QueryRowContext(ctx, `SELECT COUNT(*) FROM (`+q+`) c`, args...).Scan(&count)
+	return count, err
+}
+
+// nodesQuery builds query of nodes for params
+func nodesQuery(params structs.NodeParams) (q string, args []interface{}) {
+	q = `SELECT
 			id, created_at, node_id, address, name, ip, public_ip, port, start_block, next_reward_date, last_reward_date, finish_time, status, validator_id, block_height
-		FROM nodes `
+		FROM `
 
 	var (
-		args   []interface{}
 		wherec []string
 		i      = 1
 	)
 
+	if params.AtHeight > 0 {
+		q += `(SELECT
+				id, created_at, node_id, address, name, ip, public_ip, port, start_block, next_reward_date, last_reward_date, finish_time, status, validator_id, valid_from AS block_height
+			FROM nodes_history
+			WHERE valid_from <= $1 AND (valid_to IS NULL OR valid_to > $1)) nodes `
+		args = append(args, params.AtHeight)
+		i++
+	} else {
+		q += `nodes `
+	}
+
 	if params.NodeID != "" {
 		wherec = append(wherec, ` node_id =  $`+strconv.Itoa(i))
 		args = append(args, params.NodeID)
@@ -114,7 +246,12 @@ func (d *Driver) GetNodes(ctx context.Context, params structs.NodeParams) (nodes
 		args = append(args, common.HexToAddress(params.Address).Hash().Big().String())
 		i++
 	}
-	if len(args) > 0 {
+	if !params.Cursor.IsZero() {
+		wherec = append(wherec, ` node_id > $`+strconv.Itoa(i))
+		args = append(args, params.Cursor.Key)
+		i++
+	}
+	if len(wherec) > 0 {
 		q += ` WHERE `
 		q += strings.Join(wherec, " AND ")
 	}
@@ -123,43 +260,100 @@ func (d *Driver) GetNodes(ctx context.Context, params structs.NodeParams) (nodes
 
 	if params.Limit > 0 {
 		q += " LIMIT " + strconv.FormatUint(uint64(params.Limit), 10)
-		if params.Offset > 0 {
+		if params.Offset > 0 && params.Cursor.IsZero() {
 			q += " OFFSET " + strconv.FormatUint(uint64(params.Offset), 10)
 		}
 	}
-	rows, err := d.db.QueryContext(ctx, q, args...)
+	return q, args
+}
+
+// GetNodeTimeline gets changes of nodes, the latest first
+func (d *Driver) GetNodeTimeline(ctx context.Context, params structs.NodeParams) (changes []structs.NodeChange, err error) {
+	q := `SELECT
+			h.id, h.created_at, h.node_id, h.address, h.name, h.ip, h.public_ip, h.port, h.start_block, h.next_reward_date, h.last_reward_date, h.finish_time, h.status, h.validator_id,
+			h.valid_from, COALESCE(h.valid_to, 0), b.time
+		FROM nodes_history h
+		LEFT JOIN blocks b ON b.number = h.valid_from`
+
+	var (
+		args   []interface{}
+		wherec []string
+		i      = 1
+	)
+	if params.NodeID != "" {
+		wherec = append(wherec, ` h.node_id = $`+strconv.Itoa(i))
+		args = append(args, params.NodeID)
+		i++
+	}
+	if params.ValidatorID != "" {
+		wherec = append(wherec, ` h.validator_id = $`+strconv.Itoa(i))
+		args = append(args, params.ValidatorID)
+		i++
+	}
+	if params.Address != "" {
+		wherec = append(wherec, ` h.address = $`+strconv.Itoa(i))
+		args = append(args, common.HexToAddress(params.Address).Hash().Big().String())
+		i++
+	}
+	if len(wherec) > 0 {
+		q += ` WHERE ` + strings.Join(wherec, " AND ")
+	}
+
+	q += ` ORDER BY h.valid_from DESC, h.node_id`
+
+	if params.Limit > 0 {
+		q += " LIMIT " + strconv.FormatUint(params.Limit, 10)
+		if params.Offset > 0 {
+			q += " OFFSET " + strconv.FormatUint(params.Offset, 10)
+		}
+	}
+
+	rows, err := d.conn(ctx).QueryContext(ctx, q, args...)
 	if err != nil {
 		return nil, fmt.Errorf("query error: %w", err)
 	}
 
 	defer rows.Close()
 	for rows.Next() {
-		n := structs.Node{}
-		var nodeId uint64
-		var address []byte
-		var startBlock uint64
-		var finishTime uint64
-		var validatorId uint64
-		var IP string
-		var publicIP string
-		var status string
-		err = rows.Scan(&n.ID, &n.CreatedAt, &nodeId, &address, &n.Name, &IP, &publicIP, &n.Port, &startBlock, &n.NextRewardDate, &n.LastRewardDate, &finishTime, &status, &validatorId, &n.BlockHeight)
-		if err != nil {
+		var (
+			c         structs.NodeChange
+			height    uint64
+			blockTime sql.NullTime
+		)
+		if c.Node, err = scanNode(rows, &height, &c.ValidTo, &blockTime); err != nil {
 			return nil, err
 		}
+		c.BlockHeight = height
+		c.Time = blockTime.Time
+		changes = append(changes, c)
+	}
+	return changes, rows.Err()
+}
 
-		n.NodeID = new(big.Int).SetUint64(nodeId)
-		a := new(big.Int)
-		a.SetString(string(address), 10)
-		n.Address.SetBytes(a.Bytes())
-		n.StartBlock = new(big.Int).SetUint64(startBlock)
-		n.FinishTime = new(big.Int).SetUint64(finishTime)
-		n.ValidatorID = new(big.Int).SetUint64(validatorId)
-		n.IP, _, _ = net.ParseCIDR(IP)
-		n.PublicIP, _, _ = net.ParseCIDR(publicIP)
-		s, _ := structs.GetTypeForNode(status)
-		n.Status = s
-		nodes = append(nodes, n)
+// scanNode scans columns of node, followed by the extra ones
+func scanNode(rows *sql.Rows, extra ...interface{}) (n structs.Node, err error) {
+	var nodeId uint64
+	var address []byte
+	var startBlock uint64
+	var finishTime uint64
+	var validatorId uint64
+	var IP string
+	var publicIP string
+	var status string
+	dest := []interface{}{&n.ID, &n.CreatedAt, &nodeId, &address, &n.Name, &IP, &publicIP, &n.Port, &startBlock, &n.NextRewardDate, &n.LastRewardDate, &finishTime, &status, &validatorId}
+	if err = rows.Scan(append(dest, extra...)...); err != nil {
+		return n, err
 	}
-	return nodes, nil
+
+	n.NodeID = new(big.Int).SetUint64(nodeId)
+	a := new(big.Int)
+	a.SetString(string(address), 10)
+	n.Address.SetBytes(a.Bytes())
+	n.StartBlock = new(big.Int).SetUint64(startBlock)
+	n.FinishTime = new(big.Int).SetUint64(finishTime)
+	n.ValidatorID = new(big.Int).SetUint64(validatorId)
+	n.IP, _, _ = net.ParseCIDR(IP)
+	n.PublicIP, _, _ = net.ParseCIDR(publicIP)
+	n.Status, _ = structs.GetTypeForNode(status)
+	return n, nil
 }
@@ -0,0 +1,80 @@
+package postgresql
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/lib/pq"
+
+	"github.com/figment-networks/skale-indexer/scraper/structs"
+)
+
+// outboxInsertBatch keeps the number of parameters of single insert below postgres limit
+const outboxInsertBatch = 1000
+
+// SaveOutboxMessages saves messages, numbering them in order
+func (d *Driver) SaveOutboxMessages(ctx context.Context, msgs []structs.OutboxMessage) error {
+	for len(msgs) > outboxInsertBatch {
+		if err := d.SaveOutboxMessages(ctx, msgs[:outboxInsertBatch]); err != nil {
+			return err
+		}
+		msgs = msgs[outboxInsertBatch:]
+	}
+	if len(msgs) == 0 {
+		return nil
+	}
+
+	q := `INSERT INTO outbox ("message_id", "topic", "key", "height", "payload") VALUES `
+	args := make([]interface{}, 0, len(msgs)*5)
+	for i, m := range msgs {
+		if i > 0 {
+			q += ", "
+		}
+		n := i * 5
+		q += `($` + strconv.Itoa(n+1) + `, $` + strconv.Itoa(n+2) + `, $` + strconv.Itoa(n+3) + `, $` + strconv.Itoa(n+4) + `, $` + strconv.Itoa(n+5) + `)`
+		args = append(args, m.MessageID, m.Topic, m.Key, m.Height, []byte(m.Payload))
+	}
+
+	_, err := d.conn(ctx).ExecContext(ctx, q, args...)
+	return err
+}
+
+// GetOutboxMessages gets messages in order they were saved in
+func (d *Driver) GetOutboxMessages(ctx context.Context, params structs.OutboxParams) (msgs []structs.OutboxMessage, err error) {
+	q := `SELECT id, message_id, created_at, topic, key, height, payload FROM outbox WHERE id > $1 ORDER BY id ASC`
+	if params.Limit > 0 {
+		q += " LIMIT " + strconv.FormatUint(params.Limit, 10)
+	}
+
+	rows, err := d.conn(ctx).QueryContext(ctx, q, params.After)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var (
+			m       structs.OutboxMessage
+			payload []byte
+		)
+		if err = rows.Scan(&m.ID, &m.MessageID, &m.CreatedAt, &m.Topic, &m.Key, &m.Height, &payload); err != nil {
+			return nil, err
+		}
+		m.Payload = payload
+		msgs = append(msgs, m)
+	}
+	return msgs, rows.Err()
+}
+
+// DeleteOutboxMessages removes published messages
+func (d *Driver) DeleteOutboxMessages(ctx context.Context, ids []uint64) error {
+	if len(ids) == 0 {
+		return nil
+	}
+	ids64 := make([]int64, len(ids))
+	for i, id := range ids {
+		ids64[i] = int64(id)
+	}
+	_, err := d.conn(ctx).ExecContext(ctx, `DELETE FROM outbox WHERE id = ANY($1)`, pq.Array(ids64))
+	return err
+}
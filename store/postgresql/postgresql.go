@@ -4,6 +4,8 @@ import (
 	"context"
 	"database/sql"
 	"errors"
+	"fmt"
+	"strconv"
 
 	"go.uber.org/zap"
 )
@@ -26,3 +28,94 @@ func NewDriver(ctx context.Context, db *sql.DB, l *zap.Logger) *Driver {
 		l:  l,
 	}
 }
+
+// queryer is a set of methods shared by *sql.DB and *sql.Tx
+type queryer interface {
+	ExecContext(ctx context.Context, query string, args ...interface{}) (sql.Result, error)
+	QueryContext(ctx context.Context, query string, args ...interface{}) (*sql.Rows, error)
+	QueryRowContext(ctx context.Context, query string, args ...interface{}) *sql.Row
+}
+
+type scopeKey struct{}
+
+// scope is database transaction carried in context by Atomic. Within Bulk, it's the transaction of Bulk,
+// which buffers writes of its scopes until it ends
+type scope struct {
+	tx         *sql.Tx
+	savepoints int
+	buf        bulkBuffer
+}
+
+// conn returns transaction of the scope started by Atomic or database handle when there is none
+func (d *Driver) conn(ctx context.Context) queryer {
+	if s, ok := ctx.Value(scopeKey{}).(*scope); ok {
+		return s.tx
+	}
+	return d.db
+}
+
+// Atomic runs fn in a single database transaction. Every driver call made with the context given to fn
+// is part of it; it's committed when fn returns nil and rolled back otherwise.
+// Nested calls are run in savepoints, so that inner failure may be handled without discarding the outer scope.
+// Within Bulk, scopes are savepoints of its transaction, run one at a time, and committed when Bulk ends.
+// Context of the scope must not be used concurrently.
+func (d *Driver) Atomic(ctx context.Context, fn func(ctx context.Context) error) (err error) {
+	if s, ok := ctx.Value(scopeKey{}).(*scope); ok {
+		return s.savepoint(ctx, fn)
+	}
+	if bk, ok := ctx.Value(bulkKey{}).(*bulk); ok {
+		bk.scopeLock.Lock()
+		defer bk.scopeLock.Unlock()
+		return bk.scope.savepoint(context.WithValue(ctx, scopeKey{}, bk.scope), fn)
+	}
+
+	tx, err := d.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+
+	defer func() {
+		if p := recover(); p != nil {
+			tx.Rollback()
+			panic(p)
+		}
+	}()
+
+	if err = fn(context.WithValue(ctx, scopeKey{}, &scope{tx: tx})); err != nil {
+		if rollbackErr := tx.Rollback(); rollbackErr != nil {
+			return fmt.Errorf("error rolling back (%s): %w", rollbackErr.Error(), err)
+		}
+		return err
+	}
+
+	return tx.Commit()
+}
+
+func (s *scope) savepoint(ctx context.Context, fn func(ctx context.Context) error) (err error) {
+	s.savepoints++
+	name := "sp_" + strconv.Itoa(s.savepoints)
+
+	if _, err = s.tx.ExecContext(ctx, "SAVEPOINT "+name); err != nil {
+		return err
+	}
+
+	m := s.buf.mark()
+	defer func() {
+		if p := recover(); p != nil {
+			s.tx.ExecContext(ctx, "ROLLBACK TO SAVEPOINT "+name)
+			s.buf.truncate(m)
+			panic(p)
+		}
+	}()
+
+	if err = fn(ctx); err != nil {
+		s.buf.truncate(m)
+		if _, rollbackErr := s.tx.ExecContext(ctx, "ROLLBACK TO SAVEPOINT "+name); rollbackErr != nil {
+			return fmt.Errorf("error rolling back to savepoint (%s): %w", rollbackErr.Error(), err)
+		}
+		return err
+	}
+
+	_, err = s.tx.ExecContext(ctx, "RELEASE SAVEPOINT "+name)
+	return err
+}
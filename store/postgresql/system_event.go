@@ -15,7 +15,7 @@ import (
 // SaveSystemEvent saves system events
 func (d *Driver) SaveSystemEvent(ctx context.Context, se structs.SystemEvent) error {
 
-	_, err := d.db.Exec(
+	_, err := d.conn(ctx).ExecContext(ctx,
 		`INSERT INTO system_events(
 			"height",
 			"kind",
@@ -54,52 +54,12 @@ func (d *Driver) GetSystemEvents(ctx context.Context, params structs.SystemEvent
 
 	q := `SELECT id, height, kind, time, sender, sender_id, recipient, recipient_id, before, after, change FROM system_events `
 
-	var (
-		args   []interface{}
-		whereC []string
-		i      = 1
-	)
-
-	if params.Address != "" {
-		whereC = append(whereC, `( sender = $`+strconv.Itoa(i)+` OR recipient =  $`+strconv.Itoa(i)+` )`)
-		args = append(args, common.HexToAddress(params.Address).Hash().Big().String())
-		i++
-	}
-
-	if params.ID != "" {
-		whereC = append(whereC, ` id =  $`+strconv.Itoa(i))
-		args = append(args, params.ID)
-		i++
-	}
-
-	if params.ValidatorID != "" {
-		whereC = append(whereC, `( sender_id = $`+strconv.Itoa(i)+` OR recipient_id = $`+strconv.Itoa(i)+` )`)
-		args = append(args, params.ValidatorID)
-		i++
-	}
-
-	if params.ReceiverID > 0 {
-		whereC = append(whereC, ` recipient_id = $`+strconv.Itoa(i))
-		args = append(args, params.ReceiverID)
-		i++
-	}
-
-	if params.SenderID > 0 {
-		whereC = append(whereC, ` sender_id = $`+strconv.Itoa(i))
-		args = append(args, params.SenderID)
-		i++
-	}
-
-	if params.Kind != "" {
-		whereC = append(whereC, ` kind = $`+strconv.Itoa(i))
-		args = append(args, params.Kind)
-		i++
-	}
+	whereC, args := systemEventsFilter(params)
 
-	if params.After > 0 {
-		whereC = append(whereC, ` height > $`+strconv.Itoa(i))
-		args = append(args, params.After)
-		i++
+	if !params.Cursor.IsZero() {
+		i := len(args) + 1
+		whereC = append(whereC, ` (height, id) < ($`+strconv.Itoa(i)+`, $`+strconv.Itoa(i+1)+`)`)
+		args = append(args, params.Cursor.BlockHeight, params.Cursor.ID)
 	}
 
 	if len(whereC) > 0 {
@@ -107,11 +67,11 @@ func (d *Driver) GetSystemEvents(ctx context.Context, params structs.SystemEvent
 	}
 
 	q += strings.Join(whereC, " AND ")
-	q += ` ORDER BY height DESC `
+	q += ` ORDER BY height DESC, id DESC `
 
 	if params.Limit > 0 {
 		q += " LIMIT " + strconv.FormatUint(uint64(params.Limit), 10)
-		if params.Offset > 0 {
+		if params.Offset > 0 && params.Cursor.IsZero() {
 			q += " OFFSET " + strconv.FormatUint(uint64(params.Offset), 10)
 		}
 	}
@@ -120,7 +80,7 @@ func (d *Driver) GetSystemEvents(ctx context.Context, params structs.SystemEvent
 		return nil, fmt.Errorf("query error: %w", err)
 	}
 	var rows *sql.Rows
-	rows, err = d.db.QueryContext(ctx, q, args...)
+	rows, err = d.conn(ctx).QueryContext(ctx, q, args...)
 	if err != nil {
 		return nil, err
 	}
@@ -180,3 +140,70 @@ func (d *Driver) GetSystemEvents(ctx context.Context, params structs.SystemEvent
 
 	return systemEvents, nil
 }
+
+// CountSystemEvents counts system events matching params, regardless of paging
+func (d *Driver) CountSystemEvents(ctx context.Context, params structs.SystemEventParams) (count uint64, err error) {
+	q := `SELECT COUNT(*) FROM system_events `
+
+	whereC, args := systemEventsFilter(params)
+	if len(whereC) > 0 {
+		q += " WHERE " + strings.Join(whereC, " AND ")
+	}
+
+	err = d.conn(ctx).QueryRowContext(ctx, q, args...).Scan(&count)
+	return count, err
+}
+
+// systemEventsFilter builds conditions of system events query for params, paging excluded
+func systemEventsFilter(params structs.SystemEventParams) (whereC []string, args []interface{}) {
+	i := 1
+
+	if params.Address != "" {
+		whereC = append(whereC, `( sender = $`+strconv.Itoa(i)+` OR recipient =  $`+strconv.Itoa(i)+` )`)
+		args = append(args, common.HexToAddress(params.Address).Hash().Big().String())
+		i++
+	}
+
+	if params.ID != "" {
+		whereC = append(whereC, ` id =  $`+strconv.Itoa(i))
+		args = append(args, params.ID)
+		i++
+	}
+
+	if params.ValidatorID != "" {
+		whereC = append(whereC, `( sender_id = $`+strconv.Itoa(i)+` OR recipient_id = $`+strconv.Itoa(i)+` )`)
+		args = append(args, params.ValidatorID)
+		i++
+	}
+
+	if params.ReceiverID > 0 {
+		whereC = append(whereC, ` recipient_id = $`+strconv.Itoa(i))
+		args = append(args, params.ReceiverID)
+		i++
+	}
+
+	if params.SenderID > 0 {
+		whereC = append(whereC, ` sender_id = $`+strconv.Itoa(i))
+		args = append(args, params.SenderID)
+		i++
+	}
+
+	if params.Kind != "" {
+		whereC = append(whereC, ` kind = $`+strconv.Itoa(i))
+		args = append(args, params.Kind)
+		i++
+	}
+
+	if params.After > 0 {
+		whereC = append(whereC, ` height > $`+strconv.Itoa(i))
+		args = append(args, params.After)
+		i++
+	}
+
+	if params.AtHeight > 0 {
+		whereC = append(whereC, ` height <= $`+strconv.Itoa(i))
+		args = append(args, params.AtHeight)
+	}
+
+	return whereC, args
+}
@@ -0,0 +1,80 @@
+package postgresql
+
+import (
+	"context"
+	"database/sql"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/figment-networks/skale-indexer/scraper/structs"
+)
+
+// SaveTransaction saves transaction
+func (d *Driver) SaveTransaction(ctx context.Context, tx structs.Transaction) error {
+	_, err := d.conn(ctx).ExecContext(ctx, `INSERT INTO transactions (
+			"hash", "block_height", "block_hash", "tx_index", "time", "sender", "recipient",
+			"value", "nonce", "gas", "gas_price", "gas_used", "fee", "status")
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14)
+		ON CONFLICT (hash)
+		DO UPDATE SET
+			block_height = EXCLUDED.block_height,
+			block_hash = EXCLUDED.block_hash,
+			tx_index = EXCLUDED.tx_index,
+			time = EXCLUDED.time,
+			gas_used = EXCLUDED.gas_used,
+			fee = EXCLUDED.fee,
+			status = EXCLUDED.status`,
+		tx.Hash.Big().String(),
+		tx.BlockHeight,
+		tx.BlockHash.Big().String(),
+		tx.Index,
+		tx.Time,
+		tx.From.Hash().Big().String(),
+		tx.To.Hash().Big().String(),
+		tx.Value.String(),
+		tx.Nonce,
+		tx.Gas,
+		tx.GasPrice.String(),
+		tx.GasUsed,
+		tx.Fee.String(),
+		tx.Status)
+	return err
+}
+
+// GetTransaction gets transaction by hash
+func (d *Driver) GetTransaction(ctx context.Context, hash common.Hash) (tx structs.Transaction, err error) {
+	var (
+		txHash, blockHash, sender, recipient []byte
+		value, gasPrice, fee                 string
+	)
+
+	err = d.conn(ctx).QueryRowContext(ctx, `SELECT hash, block_height, block_hash, tx_index, time, sender, recipient, value, nonce, gas, gas_price, gas_used, fee, status
+			FROM transactions WHERE hash = $1`, hash.Big().String()).
+		Scan(&txHash, &tx.BlockHeight, &blockHash, &tx.Index, &tx.Time, &sender, &recipient, &value, &tx.Nonce, &tx.Gas, &gasPrice, &tx.GasUsed, &fee, &tx.Status)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return tx, structs.ErrNotFound
+		}
+		return tx, err
+	}
+
+	p := new(big.Int)
+	p.SetString(string(txHash), 10)
+	tx.Hash = common.BigToHash(p)
+
+	p.SetString(string(blockHash), 10)
+	tx.BlockHash = common.BigToHash(p)
+
+	p.SetString(string(sender), 10)
+	tx.From.SetBytes(p.Bytes())
+
+	p.SetString(string(recipient), 10)
+	tx.To.SetBytes(p.Bytes())
+
+	tx.Value, _ = new(big.Int).SetString(value, 10)
+	tx.GasPrice, _ = new(big.Int).SetString(gasPrice, 10)
+	tx.Fee, _ = new(big.Int).SetString(fee, 10)
+
+	return tx, nil
+}
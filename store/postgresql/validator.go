@@ -13,6 +13,33 @@ import (
 
 var zerobig = big.NewInt(0)
 
+// validatorsAtHeight selects versions of validators valid at height $1, with counts taken from statistics of that time
+const validatorsAtHeight = `(SELECT
+				id,
+				created_at,
+				validator_id,
+				name,
+				validator_address,
+				requested_address,
+				description,
+				fee_rate,
+				registration_time,
+				minimum_delegation_amount,
+				accept_new_requests,
+				authorized,
+				COALESCE((SELECT amount FROM validator_statistics s
+					WHERE s.validator_id = h.validator_id AND s.statistic_type = $2 AND s.block_height <= $1
+					ORDER BY s.block_height DESC LIMIT 1), 0) AS active_nodes,
+				COALESCE((SELECT amount FROM validator_statistics s
+					WHERE s.validator_id = h.validator_id AND s.statistic_type = $3 AND s.block_height <= $1
+					ORDER BY s.block_height DESC LIMIT 1), 0) AS linked_nodes,
+				COALESCE((SELECT amount FROM validator_statistics s
+					WHERE s.validator_id = h.validator_id AND s.statistic_type = $4 AND s.block_height <= $1
+					ORDER BY s.block_height DESC LIMIT 1), 0) AS staked,
+				valid_from AS block_height
+			FROM validators_history h
+			WHERE valid_from <= $1 AND (valid_to IS NULL OR valid_to > $1)) validators`
+
 // SaveValidator saves validator
 func (d *Driver) SaveValidator(ctx context.Context, v structs.Validator) error {
 
@@ -26,7 +53,8 @@ func (d *Driver) SaveValidator(ctx context.Context, v structs.Validator) error {
 		v.MinimumDelegationAmount = zerobig
 	}
 
-	_, err := d.db.Exec(`INSERT INTO validators (
+	return d.Atomic(ctx, func(ctx context.Context) error {
+		_, err := d.conn(ctx).ExecContext(ctx, `INSERT INTO validators (
 			"validator_id",
 			"name",
 			"validator_address",
@@ -56,6 +84,64 @@ func (d *Driver) SaveValidator(ctx context.Context, v structs.Validator) error {
 			authorized = EXCLUDED.authorized,
 			block_height = EXCLUDED.block_height
 		`,
+			v.ValidatorID.String(),
+			v.Name,
+			v.ValidatorAddress.Hash().Big().String(),
+			v.RequestedAddress.Hash().Big().String(),
+			v.Description,
+			v.FeeRate.String(),
+			v.RegistrationTime,
+			v.MinimumDelegationAmount.String(),
+			v.AcceptNewRequests,
+			v.Authorized,
+			v.ActiveNodes,
+			v.LinkedNodes,
+			v.Staked.String(),
+			v.BlockHeight)
+		if err != nil {
+			return err
+		}
+
+		return d.saveValidatorVersion(ctx, v)
+	})
+}
+
+// saveValidatorVersion records validator as valid from its block height until the following version, if there is any
+func (d *Driver) saveValidatorVersion(ctx context.Context, v structs.Validator) error {
+	tx := d.conn(ctx)
+	_, err := tx.ExecContext(ctx, `UPDATE validators_history SET valid_to = $2
+		WHERE validator_id = $1 AND valid_from < $2 AND (valid_to IS NULL OR valid_to > $2)`,
+		v.ValidatorID.String(), v.BlockHeight)
+	if err != nil {
+		return err
+	}
+
+	_, err = tx.ExecContext(ctx, `INSERT INTO validators_history (
+			"validator_id",
+			"name",
+			"validator_address",
+			"requested_address",
+			"description",
+			"fee_rate",
+			"registration_time",
+			"minimum_delegation_amount",
+			"accept_new_requests",
+			"authorized",
+			"valid_from",
+			"valid_to")
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11,
+			(SELECT MIN(valid_from) FROM validators_history WHERE validator_id = $1 AND valid_from > $11))
+		ON CONFLICT (validator_id, valid_from)
+		DO UPDATE SET
+			name = EXCLUDED.name,
+			validator_address = EXCLUDED.validator_address,
+			requested_address = EXCLUDED.requested_address,
+			description = EXCLUDED.description,
+			fee_rate = EXCLUDED.fee_rate,
+			registration_time = EXCLUDED.registration_time,
+			minimum_delegation_amount = EXCLUDED.minimum_delegation_amount,
+			accept_new_requests = EXCLUDED.accept_new_requests,
+			authorized = EXCLUDED.authorized`,
 		v.ValidatorID.String(),
 		v.Name,
 		v.ValidatorAddress.Hash().Big().String(),
@@ -66,17 +152,84 @@ func (d *Driver) SaveValidator(ctx context.Context, v structs.Validator) error {
 		v.MinimumDelegationAmount.String(),
 		v.AcceptNewRequests,
 		v.Authorized,
-		v.ActiveNodes,
-		v.LinkedNodes,
-		v.Staked.String(),
 		v.BlockHeight)
-
 	return err
 }
 
 // GetValidators gets validators by params
 func (d *Driver) GetValidators(ctx context.Context, params structs.ValidatorParams) (validators []structs.Validator, err error) {
-	q := `SELECT
+	q, args, err := validatorsQuery(params)
+	if err != nil {
+		return nil, err
+	}
+
+	var rows *sql.Rows
+	rows, err = d.conn(ctx).QueryContext(ctx, q, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var (
+		vldID        uint64
+		feeRate      string
+		mnmDlgAmount string
+		staked       string
+	)
+	for rows.Next() {
+		vld := structs.Validator{}
+		var (
+			validatorAddress []byte
+			requestedAddress []byte
+		)
+		err = rows.Scan(&vld.ID,
+			&vld.CreatedAt,
+			&vldID,
+			&vld.Name,
+			&validatorAddress,
+			&requestedAddress,
+			&vld.Description,
+			&feeRate,
+			&vld.RegistrationTime,
+			&mnmDlgAmount,
+			&vld.AcceptNewRequests,
+			&vld.Authorized,
+			&vld.ActiveNodes,
+			&vld.LinkedNodes,
+			&staked,
+			&vld.BlockHeight)
+		if err != nil {
+			return nil, err
+		}
+		vld.ValidatorID = new(big.Int).SetUint64(vldID)
+		vInt := new(big.Int)
+		vInt.SetString(string(requestedAddress), 10)
+		vld.RequestedAddress.SetBytes(vInt.Bytes())
+		vInt.SetString(string(validatorAddress), 10)
+		vld.ValidatorAddress.SetBytes(vInt.Bytes())
+
+		vld.FeeRate, _ = new(big.Int).SetString(feeRate, 10)
+		vld.MinimumDelegationAmount, _ = new(big.Int).SetString(mnmDlgAmount, 10)
+		vld.Staked, _ = new(big.Int).SetString(staked, 10)
+		validators = append(validators, vld)
+	}
+	return validators, nil
+}
+
+// CountValidators counts validators matching params, regardless of paging
+func (d *Driver) CountValidators(ctx context.Context, params structs.ValidatorParams) (count uint64, err error) {
+	params.Limit, params.Offset, params.Cursor = 0, 0, structs.KeyCursor{}
+	q, args, err := validatorsQuery(params)
+	if err != nil {
+		return 0, err
+	}
+	err = d.conn(ctx).QueryRowContext(ctx, `SELECT COUNT(*) FROM (`+q+`) c`, args...).Scan(&count)
+	return count, err
+}
+
+// validatorsQuery builds query of validators for params
+func validatorsQuery(params structs.ValidatorParams) (q string, args []interface{}, err error) {
+	q = `SELECT
 				id,
 				created_at,
 				validator_id,
@@ -93,14 +246,21 @@ func (d *Driver) GetValidators(ctx context.Context, params structs.ValidatorPara
 				linked_nodes,
 				staked,
 				block_height
-			FROM validators`
+			FROM `
 
 	var (
-		args   []interface{}
 		whereC []string
 		i      = 1
 	)
 
+	if params.AtHeight > 0 {
+		q += validatorsAtHeight
+		args = append(args, params.AtHeight, structs.ValidatorStatisticsTypeActiveNodes, structs.ValidatorStatisticsTypeLinkedNodes, structs.ValidatorStatisticsTypeTotalStake)
+		i += 4
+	} else {
+		q += `validators`
+	}
+
 	if params.ValidatorID != "" {
 		whereC = append(whereC, ` validator_id = $`+strconv.Itoa(i))
 		args = append(args, params.ValidatorID)
@@ -126,7 +286,16 @@ func (d *Driver) GetValidators(ctx context.Context, params structs.ValidatorPara
 		i++
 	}
 
-	if len(args) > 0 {
+	if !params.Cursor.IsZero() {
+		if params.OrderBy != "" {
+			return "", nil, structs.ErrCursorOrder
+		}
+		whereC = append(whereC, ` validator_id > $`+strconv.Itoa(i))
+		args = append(args, params.Cursor.Key)
+		i++
+	}
+
+	if len(whereC) > 0 {
 		q += ` WHERE `
 	}
 	q += strings.Join(whereC, " AND ")
@@ -142,68 +311,24 @@ func (d *Driver) GetValidators(ctx context.Context, params structs.ValidatorPara
 
 	if params.Limit > 0 {
 		q += " LIMIT " + strconv.FormatUint(uint64(params.Limit), 10)
-		if params.Offset > 0 {
+		if params.Offset > 0 && params.Cursor.IsZero() {
 			q += " OFFSET " + strconv.FormatUint(uint64(params.Offset), 10)
 		}
 	}
-
-	var rows *sql.Rows
-	rows, err = d.db.QueryContext(ctx, q, args...)
-	if err != nil {
-		return nil, err
-	}
-	defer rows.Close()
-
-	var (
-		vldID        uint64
-		feeRate      string
-		mnmDlgAmount string
-		staked       string
-	)
-	for rows.Next() {
-		vld := structs.Validator{}
-		var (
-			validatorAddress []byte
-			requestedAddress []byte
-		)
-		err = rows.Scan(&vld.ID,
-			&vld.CreatedAt,
-			&vldID,
-			&vld.Name,
-			&validatorAddress,
-			&requestedAddress,
-			&vld.Description,
-			&feeRate,
-			&vld.RegistrationTime,
-			&mnmDlgAmount,
-			&vld.AcceptNewRequests,
-			&vld.Authorized,
-			&vld.ActiveNodes,
-			&vld.LinkedNodes,
-			&staked,
-			&vld.BlockHeight)
-		if err != nil {
-			return nil, err
-		}
-		vld.ValidatorID = new(big.Int).SetUint64(vldID)
-		vInt := new(big.Int)
-		vInt.SetString(string(requestedAddress), 10)
-		vld.RequestedAddress.SetBytes(vInt.Bytes())
-		vInt.SetString(string(validatorAddress), 10)
-		vld.ValidatorAddress.SetBytes(vInt.Bytes())
-
-		vld.FeeRate, _ = new(big.Int).SetString(feeRate, 10)
-		vld.MinimumDelegationAmount, _ = new(big.Int).SetString(mnmDlgAmount, 10)
-		vld.Staked, _ = new(big.Int).SetString(staked, 10)
-		validators = append(validators, vld)
-	}
-	return validators, nil
+	return q, args, nil
 }
 
 // UpdateCountsOfValidator updates linked ,active  node count as well as total stake information of validator
 func (d *Driver) UpdateCountsOfValidator(ctx context.Context, validatorID *big.Int) error {
+	// counts are calculated from statistics, so in Bulk they have to wait until these are flushed
+	if buffer(ctx, func(b *bulkBuffer) { b.validators = append(b.validators, validatorID.String()) }) {
+		return nil
+	}
+	return updateCountsOfValidator(ctx, d.conn(ctx), validatorID.String())
+}
 
-	_, err := d.db.Exec(`UPDATE validators
+func updateCountsOfValidator(ctx context.Context, q queryer, validatorID string) error {
+	_, err := q.ExecContext(ctx, `UPDATE validators
 						SET
 							active_nodes =  (
 							 	SELECT COALESCE((SELECT amount
@@ -221,7 +346,7 @@ func (d *Driver) UpdateCountsOfValidator(ctx context.Context, validatorID *big.I
 								WHERE validator_id = $1 AND statistic_type = $4
 								ORDER BY block_height DESC LIMIT 1 ), 0))
 						WHERE validator_id = $1`,
-		validatorID.String(),
+		validatorID,
 		structs.ValidatorStatisticsTypeActiveNodes,
 		structs.ValidatorStatisticsTypeLinkedNodes,
 		structs.ValidatorStatisticsTypeTotalStake)
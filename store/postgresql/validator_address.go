@@ -0,0 +1,99 @@
+package postgresql
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"strconv"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/figment-networks/skale-indexer/scraper/structs"
+)
+
+// SaveValidatorAddress saves change of validator addresses, unless they are the same as in the previous change
+func (d *Driver) SaveValidatorAddress(ctx context.Context, va structs.ValidatorAddress) error {
+	_, err := d.conn(ctx).ExecContext(ctx, `INSERT INTO validator_addresses
+			("validator_id", "address", "requested_address", "block_height", "time", "transaction_hash", "event_name")
+		SELECT $1, $2, $3, $4, $5, $6, $7
+			WHERE NOT EXISTS (SELECT 1 FROM
+				(SELECT address, requested_address FROM validator_addresses WHERE validator_id = $1 AND block_height < $4 ORDER BY block_height DESC LIMIT 1) p
+				WHERE p.address = $2 AND p.requested_address = $3)
+		ON CONFLICT (validator_id, block_height)
+		DO UPDATE SET
+			address = EXCLUDED.address,
+			requested_address = EXCLUDED.requested_address,
+			time = EXCLUDED.time,
+			transaction_hash = EXCLUDED.transaction_hash,
+			event_name = EXCLUDED.event_name`,
+		va.ValidatorID.String(),
+		va.Address.Hash().Big().String(),
+		va.RequestedAddress.Hash().Big().String(),
+		va.BlockHeight,
+		va.Time,
+		va.TransactionHash.Big().String(),
+		va.EventName)
+	return err
+}
+
+// GetValidatorAddresses gets changes of validator addresses, the latest first
+func (d *Driver) GetValidatorAddresses(ctx context.Context, params structs.ValidatorAddressParams) (addresses []structs.ValidatorAddress, err error) {
+	q := `SELECT id, created_at, validator_id, address, requested_address, block_height, time, transaction_hash, event_name
+		FROM validator_addresses`
+
+	var (
+		args   []interface{}
+		wherec []string
+		i      = 1
+	)
+	if params.ValidatorID != "" {
+		wherec = append(wherec, ` validator_id = $`+strconv.Itoa(i))
+		args = append(args, params.ValidatorID)
+		i++
+	}
+	if params.Address != "" {
+		wherec = append(wherec, ` (address = $`+strconv.Itoa(i)+` OR requested_address = $`+strconv.Itoa(i)+`)`)
+		args = append(args, common.HexToAddress(params.Address).Hash().Big().String())
+		i++
+	}
+	if len(wherec) > 0 {
+		q += ` WHERE ` + strings.Join(wherec, " AND ")
+	}
+
+	q += ` ORDER BY block_height DESC, validator_id`
+
+	if params.Limit > 0 {
+		q += " LIMIT " + strconv.FormatUint(params.Limit, 10)
+		if params.Offset > 0 {
+			q += " OFFSET " + strconv.FormatUint(params.Offset, 10)
+		}
+	}
+
+	rows, err := d.conn(ctx).QueryContext(ctx, q, args...)
+	if err != nil {
+		return nil, fmt.Errorf("query error: %w", err)
+	}
+
+	defer rows.Close()
+	for rows.Next() {
+		var (
+			va                      structs.ValidatorAddress
+			validatorID             uint64
+			address, requested, txh []byte
+		)
+		if err := rows.Scan(&va.ID, &va.CreatedAt, &validatorID, &address, &requested, &va.BlockHeight, &va.Time, &txh, &va.EventName); err != nil {
+			return nil, err
+		}
+		va.ValidatorID = new(big.Int).SetUint64(validatorID)
+		a := new(big.Int)
+		a.SetString(string(address), 10)
+		va.Address.SetBytes(a.Bytes())
+		a.SetString(string(requested), 10)
+		va.RequestedAddress.SetBytes(a.Bytes())
+		a.SetString(string(txh), 10)
+		va.TransactionHash = common.BigToHash(a)
+		addresses = append(addresses, va)
+	}
+	return addresses, rows.Err()
+}
@@ -12,8 +12,14 @@ import (
 )
 
 func (d *Driver) SaveValidatorStatistic(ctx context.Context, validatorID *big.Int, blockHeight uint64, blockTime time.Time, statisticsType structs.StatisticTypeVS, amount *big.Int) (err error) {
+	if buffer(ctx, func(b *bulkBuffer) {
+		b.statistics = append(b.statistics, validatorStatistic{validatorID.String(), blockHeight, blockTime, statisticsType, amount.String()})
+	}) {
+		return nil
+	}
+
 	// (lukanus): Update value in validator_statistics unless the value already exists
-	_, err = d.db.ExecContext(ctx, `
+	_, err = d.conn(ctx).ExecContext(ctx, `
 	INSERT INTO validator_statistics (validator_id, block_height, time, statistic_type, amount)
 		( SELECT $1, $2, $3, $4, $5	WHERE $5 NOT IN ( SELECT amount FROM validator_statistics WHERE validator_id = $1 AND statistic_type = $4 AND block_height < $2 ORDER BY block_height DESC LIMIT 1))
 		ON CONFLICT (validator_id, block_height, statistic_type)
@@ -41,6 +47,11 @@ func (d *Driver) GetValidatorStatistics(ctx context.Context, params structs.Vali
 		args = append(args, params.Type)
 		i++
 	}
+	if params.AtHeight > 0 {
+		wherec = append(wherec, ` block_height <= $`+strconv.Itoa(i))
+		args = append(args, params.AtHeight)
+		i++
+	}
 	if !params.TimeFrom.IsZero() && !params.TimeTo.IsZero() {
 		wherec = append(wherec, ` time BETWEEN $`+strconv.Itoa(i)+` AND $`+strconv.Itoa(i+1))
 		args = append(args, params.TimeFrom)
@@ -61,7 +72,7 @@ func (d *Driver) GetValidatorStatistics(ctx context.Context, params structs.Vali
 	}
 
 	var rows *sql.Rows
-	rows, err = d.db.QueryContext(ctx, q, args...)
+	rows, err = d.conn(ctx).QueryContext(ctx, q, args...)
 	if err != nil {
 		return nil, err
 	}
@@ -90,7 +101,7 @@ func (d *Driver) GetValidatorStatistics(ctx context.Context, params structs.Vali
 func (d *Driver) GetValidatorStatisticsTimeline(ctx context.Context, params structs.ValidatorStatisticsParams) (validatorStatistics []structs.ValidatorStatistics, err error) {
 
 	var rows *sql.Rows
-	rows, err = d.db.QueryContext(ctx,
+	rows, err = d.conn(ctx).QueryContext(ctx,
 		`SELECT id, created_at, validator_id, amount, block_height, time, statistic_type
 			FROM validator_statistics
 			WHERE
@@ -121,6 +132,51 @@ func (d *Driver) GetValidatorStatisticsTimeline(ctx context.Context, params stru
 	return validatorStatistics, nil
 }
 
+// GetValidatorStatisticsDaily gets the last value of statistic in every day (UTC) it changed within the time range, from the rollup table.
+// The last value before the range is included for every validator, so values can be carried forward from its beginning
+func (d *Driver) GetValidatorStatisticsDaily(ctx context.Context, params structs.ValidatorStatisticsParams) (validatorStatistics []structs.ValidatorStatistics, err error) {
+	args := []interface{}{params.Type, params.TimeFrom.UTC().Format("2006-01-02"), params.TimeTo.UTC().Format("2006-01-02")}
+	var byValidator string
+	if params.ValidatorID != "" {
+		byValidator = ` AND validator_id = $4`
+		args = append(args, params.ValidatorID)
+	}
+
+	var rows *sql.Rows
+	rows, err = d.conn(ctx).QueryContext(ctx,
+		`SELECT validator_id, amount, block_height, day, statistic_type FROM (
+			(SELECT validator_id, amount, block_height, day, statistic_type
+				FROM validator_statistics_daily
+				WHERE statistic_type = $1 AND day BETWEEN $2::date AND $3::date`+byValidator+`)
+			UNION ALL
+			(SELECT DISTINCT ON (validator_id) validator_id, amount, block_height, day, statistic_type
+				FROM validator_statistics_daily
+				WHERE statistic_type = $1 AND day < $2::date`+byValidator+`
+				ORDER BY validator_id, day DESC)
+		) d ORDER BY validator_id ASC, day ASC`, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var (
+		vldId  uint64
+		amount string
+	)
+
+	for rows.Next() {
+		vs := structs.ValidatorStatistics{}
+		if err = rows.Scan(&vldId, &amount, &vs.BlockHeight, &vs.Time, &vs.Type); err != nil {
+			return nil, err
+		}
+		vs.ValidatorID = new(big.Int).SetUint64(vldId)
+		vs.Amount, _ = new(big.Int).SetString(amount, 10)
+		vs.Time = vs.Time.UTC()
+		validatorStatistics = append(validatorStatistics, vs)
+	}
+	return validatorStatistics, rows.Err()
+}
+
 /*
 func (d *Driver) CalculateTotalStake(ctx context.Context, params structs.ValidatorStatisticsParams) error {
 	tx, err := d.db.BeginTx(ctx, nil)
@@ -0,0 +1,299 @@
+package postgresql
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"math/big"
+	"strconv"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/lib/pq"
+
+	"github.com/figment-networks/skale-indexer/scraper/structs"
+)
+
+// CreateWebhook saves new webhook
+func (d *Driver) CreateWebhook(ctx context.Context, wh structs.Webhook) (id string, err error) {
+	validatorID, address, kinds := webhookFilter(wh)
+	err = d.conn(ctx).QueryRowContext(ctx, `INSERT INTO webhooks ("url", "secret", "kinds", "validator_id", "address", "active")
+		VALUES ($1, $2, $3, $4, $5, $6)
+		RETURNING id`,
+		wh.URL,
+		wh.Secret,
+		pq.Array(kinds),
+		validatorID,
+		address,
+		wh.Active).Scan(&id)
+	return id, err
+}
+
+// UpdateWebhook updates url, secret, filter and activity of webhook
+func (d *Driver) UpdateWebhook(ctx context.Context, wh structs.Webhook) error {
+	validatorID, address, kinds := webhookFilter(wh)
+	res, err := d.conn(ctx).ExecContext(ctx, `UPDATE webhooks
+		SET updated_at = NOW(), url = $2, secret = $3, kinds = $4, validator_id = $5, address = $6, active = $7
+		WHERE id = $1`,
+		wh.ID,
+		wh.URL,
+		wh.Secret,
+		pq.Array(kinds),
+		validatorID,
+		address,
+		wh.Active)
+	if err != nil {
+		return err
+	}
+
+	n, err := res.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if n == 0 {
+		return structs.ErrNotFound
+	}
+	return nil
+}
+
+// GetWebhooks gets webhooks, the oldest first
+func (d *Driver) GetWebhooks(ctx context.Context, params structs.WebhookParams) (webhooks []structs.Webhook, err error) {
+	q := `SELECT id, created_at, updated_at, url, secret, kinds, validator_id, address, active FROM webhooks `
+
+	var (
+		args   []interface{}
+		whereC []string
+		i      = 1
+	)
+
+	if params.ID != "" {
+		whereC = append(whereC, ` id = $`+strconv.Itoa(i))
+		args = append(args, params.ID)
+		i++
+	}
+
+	if params.Active {
+		whereC = append(whereC, ` active = TRUE`)
+	}
+
+	if len(whereC) > 0 {
+		q += " WHERE "
+	}
+
+	q += strings.Join(whereC, " AND ")
+	q += ` ORDER BY created_at ASC, id ASC `
+
+	if params.Limit > 0 {
+		q += " LIMIT " + strconv.FormatUint(params.Limit, 10)
+		if params.Offset > 0 {
+			q += " OFFSET " + strconv.FormatUint(params.Offset, 10)
+		}
+	}
+
+	rows, err := d.conn(ctx).QueryContext(ctx, q, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var (
+			wh                   structs.Webhook
+			kinds                []int64
+			validatorID, address sql.NullString
+		)
+		if err = rows.Scan(&wh.ID, &wh.CreatedAt, &wh.UpdatedAt, &wh.URL, &wh.Secret, pq.Array(&kinds), &validatorID, &address, &wh.Active); err != nil {
+			return nil, err
+		}
+
+		for _, k := range kinds {
+			wh.Kinds = append(wh.Kinds, structs.SysEvtType(k))
+		}
+		if validatorID.Valid {
+			wh.ValidatorID, _ = new(big.Int).SetString(validatorID.String, 10)
+		}
+		if address.Valid {
+			a, _ := new(big.Int).SetString(address.String, 10)
+			wh.Address = common.BigToAddress(a)
+		}
+		webhooks = append(webhooks, wh)
+	}
+	if err = rows.Err(); err != nil {
+		return nil, err
+	}
+
+	if len(webhooks) == 0 && params.ID != "" {
+		return nil, structs.ErrNotFound
+	}
+
+	return webhooks, nil
+}
+
+// DeleteWebhook removes webhook, its deliveries are removed by the foreign key
+func (d *Driver) DeleteWebhook(ctx context.Context, id string) error {
+	res, err := d.conn(ctx).ExecContext(ctx, `DELETE FROM webhooks WHERE id = $1`, id)
+	if err != nil {
+		return err
+	}
+
+	n, err := res.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if n == 0 {
+		return structs.ErrNotFound
+	}
+	return nil
+}
+
+// CreateWebhookDelivery queues delivery, unless the event is already queued for the webhook
+func (d *Driver) CreateWebhookDelivery(ctx context.Context, wd structs.WebhookDelivery) error {
+	e, err := json.Marshal(&wd.Event)
+	if err != nil {
+		return err
+	}
+
+	_, err = d.conn(ctx).ExecContext(ctx, `INSERT INTO webhook_deliveries (
+			"webhook_id", "event_key", "event", "status", "attempts", "next_retry", "response_code", "error")
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8)
+		ON CONFLICT (webhook_id, event_key) DO NOTHING`,
+		wd.WebhookID,
+		wd.Event.Key(),
+		e,
+		wd.Status,
+		wd.Attempts,
+		wd.NextRetry,
+		wd.ResponseCode,
+		wd.Error)
+	return err
+}
+
+// UpdateWebhookDelivery records the outcome of delivery attempt
+func (d *Driver) UpdateWebhookDelivery(ctx context.Context, wd structs.WebhookDelivery) error {
+	var deliveredAt interface{}
+	if !wd.DeliveredAt.IsZero() {
+		deliveredAt = wd.DeliveredAt
+	}
+
+	res, err := d.conn(ctx).ExecContext(ctx, `UPDATE webhook_deliveries
+		SET updated_at = NOW(), status = $2, attempts = $3, next_retry = $4, response_code = $5, error = $6, delivered_at = $7
+		WHERE id = $1`,
+		wd.ID,
+		wd.Status,
+		wd.Attempts,
+		wd.NextRetry,
+		wd.ResponseCode,
+		wd.Error,
+		deliveredAt)
+	if err != nil {
+		return err
+	}
+
+	n, err := res.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if n == 0 {
+		return structs.ErrNotFound
+	}
+	return nil
+}
+
+// GetWebhookDeliveries gets deliveries, the earliest due first when they're listed for retry, the latest created first otherwise
+func (d *Driver) GetWebhookDeliveries(ctx context.Context, params structs.WebhookDeliveryParams) (deliveries []structs.WebhookDelivery, err error) {
+	q := `SELECT id, webhook_id, created_at, updated_at, event, status, attempts, next_retry, response_code, error, delivered_at FROM webhook_deliveries `
+
+	var (
+		args   []interface{}
+		whereC []string
+		i      = 1
+	)
+
+	if params.ID != "" {
+		whereC = append(whereC, ` id = $`+strconv.Itoa(i))
+		args = append(args, params.ID)
+		i++
+	}
+
+	if params.WebhookID != "" {
+		whereC = append(whereC, ` webhook_id = $`+strconv.Itoa(i))
+		args = append(args, params.WebhookID)
+		i++
+	}
+
+	if params.Status != "" {
+		whereC = append(whereC, ` status = $`+strconv.Itoa(i))
+		args = append(args, params.Status)
+		i++
+	}
+
+	if !params.RetryBefore.IsZero() {
+		whereC = append(whereC, ` next_retry <= $`+strconv.Itoa(i))
+		args = append(args, params.RetryBefore)
+		i++
+	}
+
+	if len(whereC) > 0 {
+		q += " WHERE "
+	}
+
+	q += strings.Join(whereC, " AND ")
+	if !params.RetryBefore.IsZero() {
+		q += ` ORDER BY next_retry ASC, created_at ASC `
+	} else {
+		q += ` ORDER BY created_at DESC, id DESC `
+	}
+
+	if params.Limit > 0 {
+		q += " LIMIT " + strconv.FormatUint(params.Limit, 10)
+		if params.Offset > 0 {
+			q += " OFFSET " + strconv.FormatUint(params.Offset, 10)
+		}
+	}
+
+	rows, err := d.conn(ctx).QueryContext(ctx, q, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var (
+			wd          structs.WebhookDelivery
+			e           []byte
+			deliveredAt sql.NullTime
+		)
+		if err = rows.Scan(&wd.ID, &wd.WebhookID, &wd.CreatedAt, &wd.UpdatedAt, &e, &wd.Status, &wd.Attempts, &wd.NextRetry, &wd.ResponseCode, &wd.Error, &deliveredAt); err != nil {
+			return nil, err
+		}
+		if err = json.Unmarshal(e, &wd.Event); err != nil {
+			return nil, err
+		}
+		wd.DeliveredAt = deliveredAt.Time
+		deliveries = append(deliveries, wd)
+	}
+	if err = rows.Err(); err != nil {
+		return nil, err
+	}
+
+	if len(deliveries) == 0 && params.ID != "" {
+		return nil, structs.ErrNotFound
+	}
+
+	return deliveries, nil
+}
+
+// webhookFilter returns filter of webhook as column values, NULL when not set
+func webhookFilter(wh structs.Webhook) (validatorID, address interface{}, kinds []int64) {
+	if wh.ValidatorID != nil {
+		validatorID = wh.ValidatorID.String()
+	}
+	if wh.Address != (common.Address{}) {
+		address = wh.Address.Hash().Big().String()
+	}
+	kinds = []int64{}
+	for _, k := range wh.Kinds {
+		kinds = append(kinds, int64(k))
+	}
+	return validatorID, address, kinds
+}
@@ -0,0 +1,111 @@
+package sqlite
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/google/uuid"
+
+	"github.com/figment-networks/skale-indexer/scraper/structs"
+)
+
+// accountRank orders account types the way they are declared in the postgres enum
+const accountRank = `CASE %s WHEN 'default' THEN 0 WHEN 'delegator' THEN 1 ELSE 2 END`
+
+// SaveAccount saves account
+func (d *Driver) SaveAccount(ctx context.Context, a structs.Account) error {
+	if deferWrite(ctx, func(ctx context.Context) error { return d.SaveAccount(ctx, a) }) {
+		return nil
+	}
+
+	_, err := d.conn(ctx).ExecContext(ctx, `INSERT INTO accounts ("id", "created_at", "address", "account_type")
+			VALUES (?1, ?2, ?3, ?4)
+			ON CONFLICT (address)
+			DO UPDATE SET
+			account_type = excluded.account_type
+			WHERE `+fmt.Sprintf(accountRank, "accounts.account_type")+` < `+fmt.Sprintf(accountRank, "excluded.account_type"),
+		uuid.New().String(),
+		micros(time.Now()),
+		a.Address.Hex(),
+		a.Type)
+	return err
+}
+
+// GetAccounts gets accounts
+func (d *Driver) GetAccounts(ctx context.Context, params structs.AccountParams) (accounts []structs.Account, err error) {
+	q, args := accountsQuery(params)
+	rows, err := d.conn(ctx).QueryContext(ctx, q, args...)
+	if err != nil {
+		return nil, err
+	}
+
+	defer rows.Close()
+
+	for rows.Next() {
+		a := structs.Account{}
+		var (
+			id, addr  string
+			createdAt int64
+		)
+		if err = rows.Scan(&id, &createdAt, &addr, &a.Type); err != nil {
+			return nil, err
+		}
+		if a.ID, err = uuid.Parse(id); err != nil {
+			return nil, err
+		}
+		a.CreatedAt = fromMicros(createdAt)
+		a.Address = common.HexToAddress(addr)
+
+		accounts = append(accounts, a)
+	}
+
+	return accounts, rows.Err()
+}
+
+// CountAccounts counts accounts matching params, regardless of paging
+func (d *Driver) CountAccounts(ctx context.Context, params structs.AccountParams) (count uint64, err error) {
+	params.Limit, params.Offset, params.Cursor = 0, 0, structs.KeyCursor{}
+	q, args := accountsQuery(params)
+	err = d.conn(ctx).QueryRowContext(ctx, `SELECT COUNT(*) FROM (`+q+`)`, args...).Scan(&count)
+	return count, err
+}
+
+// accountsQuery builds query of accounts for params
+func accountsQuery(params structs.AccountParams) (q string, args []interface{}) {
+	q = `SELECT id, created_at, address, account_type FROM accounts `
+	var (
+		wherec []string
+		i      = 1
+	)
+
+	if params.Address != "" {
+		wherec = append(wherec, ` address = `+param(i))
+		args = append(args, common.HexToAddress(params.Address).Hex())
+		i++
+	}
+	if params.Type != "" {
+		wherec = append(wherec, ` account_type = `+param(i))
+		args = append(args, params.Type)
+		i++
+	}
+	if !params.Cursor.IsZero() {
+		wherec = append(wherec, ` (created_at < `+param(i)+` OR (created_at = `+param(i)+` AND address > `+param(i+1)+`))`)
+		args = append(args, micros(params.Cursor.Time), common.HexToAddress(params.Cursor.Key).Hex())
+		i += 2
+	}
+	if len(args) > 0 {
+		q += ` WHERE `
+	}
+	q += strings.Join(wherec, " AND ")
+	q += ` ORDER BY created_at DESC, address`
+
+	offset := params.Offset
+	if !params.Cursor.IsZero() {
+		offset = 0
+	}
+	q += page(params.Limit, offset)
+	return q, args
+}
@@ -0,0 +1,78 @@
+package sqlite
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/figment-networks/skale-indexer/scraper/structs"
+)
+
+// SaveBlock saves block header
+func (d *Driver) SaveBlock(ctx context.Context, b structs.Block) error {
+	_, err := d.conn(ctx).ExecContext(ctx, `INSERT INTO blocks ("number", "hash", "parent_hash", "time")
+			VALUES (?1, ?2, ?3, ?4)
+			ON CONFLICT (number)
+			DO UPDATE SET
+				hash = excluded.hash,
+				parent_hash = excluded.parent_hash,
+				time = excluded.time`,
+		b.Number,
+		b.Hash.Hex(),
+		b.ParentHash.Hex(),
+		micros(b.Time))
+	return err
+}
+
+// GetBlock gets block header of given height
+func (d *Driver) GetBlock(ctx context.Context, height uint64) (b structs.Block, err error) {
+	row := d.conn(ctx).QueryRowContext(ctx, `SELECT number, hash, parent_hash, time FROM blocks WHERE number = ?1`, height)
+	b, err = scanBlock(row)
+	if err == sql.ErrNoRows {
+		return b, structs.ErrNotFound
+	}
+	return b, err
+}
+
+// GetBlockBefore gets the highest stored block below given height
+func (d *Driver) GetBlockBefore(ctx context.Context, height uint64) (b structs.Block, err error) {
+	row := d.conn(ctx).QueryRowContext(ctx, `SELECT number, hash, parent_hash, time FROM blocks WHERE number < ?1 ORDER BY number DESC LIMIT 1`, height)
+	b, err = scanBlock(row)
+	if err == sql.ErrNoRows {
+		return b, structs.ErrNotFound
+	}
+	return b, err
+}
+
+// GetBlockBounds gets the closest stored blocks produced at or before and after given time.
+// Zero value is returned for the missing bound.
+func (d *Driver) GetBlockBounds(ctx context.Context, t time.Time) (before, after structs.Block, err error) {
+	row := d.conn(ctx).QueryRowContext(ctx, `SELECT number, hash, parent_hash, time FROM blocks WHERE time <= ?1 ORDER BY time DESC, number DESC LIMIT 1`, micros(t))
+	if before, err = scanBlock(row); err != nil && err != sql.ErrNoRows {
+		return before, after, err
+	}
+
+	row = d.conn(ctx).QueryRowContext(ctx, `SELECT number, hash, parent_hash, time FROM blocks WHERE time > ?1 ORDER BY time ASC, number ASC LIMIT 1`, micros(t))
+	if after, err = scanBlock(row); err != nil && err != sql.ErrNoRows {
+		return before, after, err
+	}
+
+	return before, after, nil
+}
+
+func scanBlock(row *sql.Row) (b structs.Block, err error) {
+	var (
+		hash, parentHash string
+		t                int64
+	)
+	if err = row.Scan(&b.Number, &hash, &parentHash, &t); err != nil {
+		return b, err
+	}
+
+	b.Hash = common.HexToHash(hash)
+	b.ParentHash = common.HexToHash(parentHash)
+	b.Time = fromMicros(t)
+	return b, nil
+}
@@ -0,0 +1,283 @@
+package sqlite
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/google/uuid"
+
+	"github.com/figment-networks/skale-indexer/scraper/structs"
+)
+
+// SaveContractEvent saves contract events
+func (d *Driver) SaveContractEvent(ctx context.Context, ce structs.ContractEvent) error {
+	if deferWrite(ctx, func(ctx context.Context) error { return d.SaveContractEvent(ctx, ce) }) {
+		return nil
+	}
+
+	params, err := json.Marshal(ce.Params)
+	if err != nil {
+		return err
+	}
+
+	var (
+		bIDs   = []string{}
+		bAddrs = []string{}
+		bID1   sql.NullString
+		bID2   sql.NullString
+	)
+	for _, bid := range ce.BoundID {
+		bIDs = append(bIDs, bid.String())
+	}
+	for _, baddr := range ce.BoundAddress {
+		bAddrs = append(bAddrs, baddr.Hex())
+	}
+	if len(bIDs) > 0 {
+		bID1 = sql.NullString{String: bIDs[0], Valid: true}
+	}
+	if len(bIDs) > 1 {
+		bID2 = sql.NullString{String: bIDs[1], Valid: true}
+	}
+
+	boundIDs, err := json.Marshal(bIDs)
+	if err != nil {
+		return err
+	}
+	boundAddrs, err := json.Marshal(bAddrs)
+	if err != nil {
+		return err
+	}
+
+	_, err = d.conn(ctx).ExecContext(ctx,
+		`INSERT INTO contract_events(
+			"id",
+			"created_at",
+			"contract_name",
+			"event_name",
+			"contract_address",
+			"block_height",
+			"time",
+			"transaction_hash",
+			"params",
+			"removed",
+			"bound_type",
+			"bound_id",
+			"bound_address",
+			"bound_id_1",
+			"bound_id_2")
+		VALUES (?1, ?2, ?3, ?4, ?5, ?6, ?7, ?8, ?9, ?10, ?11, ?12, ?13, ?14, ?15)
+		ON CONFLICT (contract_address, event_name, block_height, transaction_hash, removed)
+		DO UPDATE SET
+			contract_name = excluded.contract_name,
+			time = excluded.time,
+			params = excluded.params,
+			bound_type = excluded.bound_type,
+			bound_id = excluded.bound_id,
+			bound_address = excluded.bound_address,
+			bound_id_1 = excluded.bound_id_1,
+			bound_id_2 = excluded.bound_id_2
+		`,
+		uuid.New().String(),
+		micros(time.Now()),
+		ce.ContractName,
+		ce.EventName,
+		ce.ContractAddress.Hex(),
+		ce.BlockHeight,
+		micros(ce.Time),
+		ce.TransactionHash.Hex(),
+		string(params),
+		ce.Removed,
+		ce.BoundType,
+		string(boundIDs),
+		string(boundAddrs),
+		bID1,
+		bID2,
+	)
+	return err
+}
+
+// GetContractEvents gets contract events
+func (d *Driver) GetContractEvents(ctx context.Context, params structs.EventParams) (contractEvents []structs.ContractEvent, err error) {
+	q := `SELECT id, contract_name, event_name, contract_address, block_height, time, transaction_hash, params, removed
+		FROM contract_events `
+
+	whereC, args, err := contractEventsFilter(params)
+	if err != nil {
+		return nil, err
+	}
+
+	if !params.Cursor.IsZero() {
+		i := len(args) + 1
+		whereC = append(whereC, ` (block_height, id) < (`+param(i)+`, `+param(i+1)+`)`)
+		args = append(args, params.Cursor.BlockHeight, params.Cursor.ID)
+	}
+
+	if len(whereC) > 0 {
+		q += " WHERE "
+	}
+	q += strings.Join(whereC, " AND ")
+	q += ` ORDER BY block_height DESC, id DESC `
+
+	offset := params.Offset
+	if !params.Cursor.IsZero() {
+		offset = 0
+	}
+	q += page(params.Limit, offset)
+
+	rows, err := d.conn(ctx).QueryContext(ctx, q, args...)
+	if err != nil {
+		return nil, fmt.Errorf("query error: %w", err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		e := structs.ContractEvent{}
+		var (
+			id     string
+			ca     string
+			th     string
+			t      int64
+			params string
+		)
+		if err = rows.Scan(&id, &e.ContractName, &e.EventName, &ca, &e.BlockHeight, &t, &th, &params, &e.Removed); err != nil {
+			return nil, err
+		}
+		if e.ID, err = uuid.Parse(id); err != nil {
+			return nil, err
+		}
+		e.ContractAddress = common.HexToAddress(ca)
+		e.TransactionHash = common.HexToHash(th)
+		e.Time = fromMicros(t)
+
+		a, err := structs.DecodeEventParams([]byte(params))
+		if err != nil {
+			return nil, fmt.Errorf("unmarshal error error: %w", err)
+		}
+
+		e.Params = a
+		contractEvents = append(contractEvents, e)
+	}
+
+	return contractEvents, rows.Err()
+}
+
+// CountContractEvents counts contract events matching params, regardless of paging
+func (d *Driver) CountContractEvents(ctx context.Context, params structs.EventParams) (count uint64, err error) {
+	q := `SELECT COUNT(*) FROM contract_events `
+
+	whereC, args, err := contractEventsFilter(params)
+	if err != nil {
+		return 0, err
+	}
+	if len(whereC) > 0 {
+		q += " WHERE " + strings.Join(whereC, " AND ")
+	}
+
+	err = d.conn(ctx).QueryRowContext(ctx, q, args...).Scan(&count)
+	return count, err
+}
+
+// contractEventsFilter builds conditions of contract events query for params, paging excluded
+func contractEventsFilter(params structs.EventParams) (whereC []string, args []interface{}, err error) {
+	i := 1
+
+	if !params.TimeFrom.IsZero() || !params.TimeTo.IsZero() {
+		whereC = append(whereC, ` time BETWEEN `+param(i)+` AND `+param(i+1))
+		args = append(args, micros(params.TimeFrom), micros(params.TimeTo))
+		i += 2
+	}
+
+	if params.Type != "" {
+		switch params.Type {
+		case "validator":
+			whereC = append(whereC, ` ( (bound_type = 'validator' AND bound_id_1 = `+param(i)+` ) OR
+				   ( bound_type = 'delegation' AND bound_id_2 = `+param(i)+`) ) `)
+		case "delegation":
+			whereC = append(whereC, ` bound_id_1 = `+param(i)+` AND bound_type = 'delegation' `)
+		case "node":
+			whereC = append(whereC, ` bound_id_1 = `+param(i)+` AND bound_type = 'node' `)
+		case "token":
+			whereC = append(whereC, ` bound_type = 'token' `)
+		default:
+			return nil, nil, errors.New("unknown type")
+		}
+		if params.Type != "token" {
+			args = append(args, strconv.FormatUint(params.Id, 10))
+			i++
+		}
+	}
+
+	if params.ContractName != "" {
+		whereC = append(whereC, ` contract_name = `+param(i))
+		args = append(args, params.ContractName)
+		i++
+	}
+
+	if params.EventName != "" {
+		whereC = append(whereC, ` event_name = `+param(i))
+		args = append(args, params.EventName)
+		i++
+	}
+
+	// there are no JSON functions in the bundled sqlite, params are matched in the text they are saved as.
+	// It's encoding/json output with sorted keys and no spaces, and quotes inside strings are escaped,
+	// so "key":value followed by comma or brace is found only for the key (top level or nested)
+	keys := make([]string, 0, len(params.Params))
+	for k := range params.Params {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	for _, key := range keys {
+		k, err := json.Marshal(key)
+		if err != nil {
+			return nil, nil, err
+		}
+		var or []string
+		for _, v := range structs.EventParamValues(params.Params[key]) {
+			or = append(or, ` instr(params, `+param(i)+`) > 0 OR instr(params, `+param(i+1)+`) > 0`)
+			args = append(args, string(k)+":"+string(v)+",", string(k)+":"+string(v)+"}")
+			i += 2
+		}
+		whereC = append(whereC, ` (`+strings.Join(or, " OR ")+`)`)
+	}
+
+	if params.HeightFrom > 0 {
+		whereC = append(whereC, ` block_height >= `+param(i))
+		args = append(args, params.HeightFrom)
+		i++
+	}
+
+	if params.HeightTo > 0 {
+		whereC = append(whereC, ` block_height <= `+param(i))
+		args = append(args, params.HeightTo)
+		i++
+	}
+
+	// bound addresses are saved as JSON array of checksummed addresses
+	if params.BoundAddress != (common.Address{}) {
+		whereC = append(whereC, ` instr(bound_address, `+param(i)+`) > 0`)
+		args = append(args, `"`+params.BoundAddress.Hex()+`"`)
+		i++
+	}
+
+	if params.TransactionHash != (common.Hash{}) {
+		whereC = append(whereC, ` transaction_hash = `+param(i))
+		args = append(args, params.TransactionHash.Hex())
+		i++
+	}
+
+	if params.AtHeight > 0 {
+		whereC = append(whereC, ` block_height <= `+param(i))
+		args = append(args, params.AtHeight)
+	}
+
+	return whereC, args, nil
+}
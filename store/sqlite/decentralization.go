@@ -0,0 +1,79 @@
+package sqlite
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/figment-networks/skale-indexer/scraper/structs"
+)
+
+// SaveDecentralization saves metrics of the epoch, replacing the ones computed before
+func (d *Driver) SaveDecentralization(ctx context.Context, dc structs.Decentralization) error {
+	_, err := d.conn(ctx).ExecContext(ctx, `INSERT INTO decentralization
+			("epoch", "created_at", "time", "block_height", "validators", "total_stake", "nakamoto_coefficient", "gini",
+			"top1_share", "top5_share", "top10_share", "validators_over_33", "validators_over_50", "validators_over_66")
+		VALUES (`+placeholders(1, 14)+`)
+		ON CONFLICT (epoch)
+		DO UPDATE SET
+			time = excluded.time,
+			block_height = excluded.block_height,
+			validators = excluded.validators,
+			total_stake = excluded.total_stake,
+			nakamoto_coefficient = excluded.nakamoto_coefficient,
+			gini = excluded.gini,
+			top1_share = excluded.top1_share,
+			top5_share = excluded.top5_share,
+			top10_share = excluded.top10_share,
+			validators_over_33 = excluded.validators_over_33,
+			validators_over_50 = excluded.validators_over_50,
+			validators_over_66 = excluded.validators_over_66`,
+		dc.Epoch, micros(time.Now()), micros(dc.Time), dc.BlockHeight, dc.Validators, dc.TotalStake.String(), dc.NakamotoCoefficient, dc.Gini,
+		dc.Top1Share, dc.Top5Share, dc.Top10Share, dc.ValidatorsOver33, dc.ValidatorsOver50, dc.ValidatorsOver66)
+	return err
+}
+
+// GetDecentralization gets metrics of epochs, the latest first
+func (d *Driver) GetDecentralization(ctx context.Context, params structs.DecentralizationParams) (dcs []structs.Decentralization, err error) {
+	q := `SELECT epoch, time, block_height, validators, total_stake, nakamoto_coefficient, gini,
+			top1_share, top5_share, top10_share, validators_over_33, validators_over_50, validators_over_66
+		FROM decentralization`
+	var (
+		args   []interface{}
+		wherec []string
+	)
+	if params.EpochFrom > 0 {
+		args = append(args, params.EpochFrom)
+		wherec = append(wherec, ` epoch >= `+param(len(args)))
+	}
+	if params.EpochTo > 0 {
+		args = append(args, params.EpochTo)
+		wherec = append(wherec, ` epoch <= `+param(len(args)))
+	}
+	if len(wherec) > 0 {
+		q += ` WHERE ` + strings.Join(wherec, " AND ")
+	}
+	q += ` ORDER BY epoch DESC` + page(params.Limit, params.Offset)
+
+	rows, err := d.conn(ctx).QueryContext(ctx, q, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var (
+			dc         structs.Decentralization
+			t          int64
+			totalStake string
+		)
+		if err = rows.Scan(&dc.Epoch, &t, &dc.BlockHeight, &dc.Validators, &totalStake, &dc.NakamotoCoefficient, &dc.Gini,
+			&dc.Top1Share, &dc.Top5Share, &dc.Top10Share, &dc.ValidatorsOver33, &dc.ValidatorsOver50, &dc.ValidatorsOver66); err != nil {
+			return nil, err
+		}
+		dc.Time = fromMicros(t)
+		dc.TotalStake = parseNum(totalStake)
+		dcs = append(dcs, dc)
+	}
+	return dcs, rows.Err()
+}
@@ -0,0 +1,296 @@
+package sqlite
+
+import (
+	"context"
+	"database/sql"
+	"math/big"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/google/uuid"
+
+	"github.com/figment-networks/skale-indexer/scraper/structs"
+)
+
+const delegationColumns = `d.id, d.created_at, d.delegation_id, d.holder, d.validator_id, d.block_height, d.transaction_hash, d.amount, d.delegation_period, d.created, d.started, d.finished, d.info, d.state`
+
+// SaveDelegation saves delegation
+func (d *Driver) SaveDelegation(ctx context.Context, dl structs.Delegation) error {
+	// last day of the month in which delegation period ends
+	created := dl.Created.UTC()
+	until := time.Date(created.Year(), created.Month()+time.Month(1+dl.DelegationPeriod.Uint64()), 1, 0, 0, 0, 0, time.UTC).AddDate(0, 0, -1)
+
+	_, err := d.conn(ctx).ExecContext(ctx, `INSERT INTO delegations (
+				"id", "created_at", "delegation_id", "holder", "validator_id", "block_height", "transaction_hash", "amount",
+				"delegation_period", "created", "started", "finished", "info", "state", "until")
+		VALUES (?1, ?2, ?3, ?4, ?5, ?6, ?7, ?8, ?9, ?10, ?11, ?12, ?13, ?14, ?15)
+		ON CONFLICT (delegation_id, transaction_hash)
+		DO UPDATE SET
+			holder = excluded.holder,
+			block_height = excluded.block_height,
+			validator_id = excluded.validator_id,
+			amount = excluded.amount,
+			delegation_period = excluded.delegation_period,
+			created = excluded.created,
+			started = excluded.started,
+			finished = excluded.finished,
+			info = excluded.info,
+			state = excluded.state,
+			until = excluded.until
+		`,
+		uuid.New().String(),
+		micros(time.Now()),
+		num(dl.DelegationID),
+		dl.Holder.Hex(),
+		num(dl.ValidatorID),
+		dl.BlockHeight,
+		dl.TransactionHash.Hex(),
+		num(dl.Amount),
+		num(dl.DelegationPeriod),
+		micros(dl.Created),
+		num(dl.Started),
+		num(dl.Finished),
+		dl.Info,
+		dl.State,
+		micros(until))
+
+	return err
+}
+
+// GetDelegationTimeline gets all delegation information over time
+func (d *Driver) GetDelegationTimeline(ctx context.Context, params structs.DelegationParams) (delegations []structs.Delegation, err error) {
+	whereC, args := delegationsFilter(params, true)
+
+	if !params.Cursor.IsZero() {
+		i := len(args) + 1
+		whereC = append(whereC, ` (d.block_height, d.id) < (`+param(i)+`, `+param(i+1)+`)`)
+		args = append(args, params.Cursor.BlockHeight, params.Cursor.ID)
+	}
+
+	q := `SELECT ` + delegationColumns + `, v.name
+			FROM delegations d INNER JOIN validators v ON d.validator_id = v.validator_id `
+	if len(whereC) > 0 {
+		q += " WHERE "
+	}
+	q += strings.Join(whereC, " AND ")
+	q += ` ORDER BY d.block_height DESC, d.id DESC`
+
+	offset := params.Offset
+	if !params.Cursor.IsZero() {
+		offset = 0
+	}
+	q += page(params.Limit, offset)
+
+	return d.queryDelegations(ctx, q, args)
+}
+
+// CountDelegationTimeline counts delegation changes matching params, regardless of paging
+func (d *Driver) CountDelegationTimeline(ctx context.Context, params structs.DelegationParams) (count uint64, err error) {
+	whereC, args := delegationsFilter(params, true)
+
+	q := `SELECT COUNT(*) FROM delegations d INNER JOIN validators v ON d.validator_id = v.validator_id `
+	if len(whereC) > 0 {
+		q += " WHERE " + strings.Join(whereC, " AND ")
+	}
+
+	err = d.conn(ctx).QueryRowContext(ctx, q, args...).Scan(&count)
+	return count, err
+}
+
+// GetDelegations gets the latest state of delegations
+func (d *Driver) GetDelegations(ctx context.Context, params structs.DelegationParams) (delegations []structs.Delegation, err error) {
+	whereC, args := delegationsFilter(params, true)
+
+	// there is no DISTINCT ON, the latest record of delegation is picked by its row number
+	q := `SELECT ` + delegationColumns + `, v.name, ROW_NUMBER() OVER (PARTITION BY d.delegation_id ORDER BY d.block_height DESC) AS rn
+			FROM delegations d INNER JOIN validators v ON d.validator_id = v.validator_id `
+	if len(whereC) > 0 {
+		q += " WHERE "
+	}
+	q += strings.Join(whereC, " AND ")
+
+	q = `SELECT ` + strings.Replace(delegationColumns, "d.", "l.", -1) + `, l.name FROM (` + q + `) l WHERE l.rn = 1 ORDER BY l.delegation_id DESC`
+
+	offset := params.Offset
+	if !params.KeyCursor.IsZero() {
+		offset = 0
+	}
+	q += page(params.Limit, offset)
+
+	return d.queryDelegations(ctx, q, args)
+}
+
+// CountDelegations counts the latest state of delegations matching params, regardless of paging
+func (d *Driver) CountDelegations(ctx context.Context, params structs.DelegationParams) (count uint64, err error) {
+	params.KeyCursor = structs.KeyCursor{}
+	whereC, args := delegationsFilter(params, true)
+
+	q := `SELECT COUNT(DISTINCT d.delegation_id) FROM delegations d INNER JOIN validators v ON d.validator_id = v.validator_id `
+	if len(whereC) > 0 {
+		q += " WHERE " + strings.Join(whereC, " AND ")
+	}
+
+	err = d.conn(ctx).QueryRowContext(ctx, q, args...).Scan(&count)
+	return count, err
+}
+
+// GetTypesSummaryDelegations sums up the latest state of delegations by state
+func (d *Driver) GetTypesSummaryDelegations(ctx context.Context, params structs.DelegationParams) (delegations []structs.DelegationSummary, err error) {
+	whereC, args := delegationsFilter(structs.DelegationParams{
+		ValidatorID: params.ValidatorID,
+		AtHeight:    params.AtHeight,
+		TimeAt:      params.TimeAt,
+		TimeFrom:    params.TimeFrom,
+		TimeTo:      params.TimeTo,
+	}, false)
+
+	q := `SELECT d.delegation_id, d.amount, d.state, ROW_NUMBER() OVER (PARTITION BY d.delegation_id ORDER BY d.block_height DESC) AS rn
+			FROM delegations d `
+	if len(whereC) > 0 {
+		q += " WHERE "
+	}
+	q += strings.Join(whereC, " AND ")
+
+	var rows *sql.Rows
+	rows, err = d.conn(ctx).QueryContext(ctx, `SELECT l.state, l.amount FROM (`+q+`) l WHERE l.rn = 1`, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	// amounts exceed the range of SQLite numbers, so they're summed up here
+	summaries := map[structs.DelegationState]*structs.DelegationSummary{}
+	for rows.Next() {
+		var (
+			state  structs.DelegationState
+			amount string
+		)
+		if err := rows.Scan(&state, &amount); err != nil {
+			return nil, err
+		}
+
+		sum, ok := summaries[state]
+		if !ok {
+			sum = &structs.DelegationSummary{State: state, Count: new(big.Int), Amount: new(big.Int)}
+			summaries[state] = sum
+		}
+		sum.Count.Add(sum.Count, big.NewInt(1))
+		sum.Amount.Add(sum.Amount, parseNum(amount))
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	for _, sum := range summaries {
+		delegations = append(delegations, *sum)
+	}
+	sort.Slice(delegations, func(i, j int) bool { return delegations[i].State < delegations[j].State })
+	return delegations, nil
+}
+
+// delegationsFilter builds where clause of delegations query for given params.
+// Holder and state are used only for the queries which allow them
+func delegationsFilter(params structs.DelegationParams, full bool) (whereC []string, args []interface{}) {
+	i := 1
+
+	if params.DelegationID != "" {
+		whereC = append(whereC, ` d.delegation_id = `+param(i))
+		args = append(args, params.DelegationID)
+		i++
+	}
+	if params.ValidatorID != "" {
+		whereC = append(whereC, ` d.validator_id = `+param(i))
+		args = append(args, params.ValidatorID)
+		i++
+	}
+	if params.AtHeight > 0 {
+		whereC = append(whereC, ` d.block_height <= `+param(i))
+		args = append(args, params.AtHeight)
+		i++
+	}
+	if full && params.Holder != "" {
+		whereC = append(whereC, ` d.holder = `+param(i))
+		args = append(args, common.HexToAddress(params.Holder).Hex())
+		i++
+	}
+	if full && len(params.State) > 0 {
+		whereC = append(whereC, ` d.state IN (`+placeholders(i, len(params.State))+`)`)
+		for _, s := range params.State {
+			args = append(args, s)
+		}
+		i += len(params.State)
+	}
+	if full && len(params.ValidatorIDs) > 0 {
+		whereC = append(whereC, ` d.validator_id IN (`+placeholders(i, len(params.ValidatorIDs))+`)`)
+		for _, id := range params.ValidatorIDs {
+			args = append(args, id)
+		}
+		i += len(params.ValidatorIDs)
+	}
+	if full && len(params.Holders) > 0 {
+		whereC = append(whereC, ` d.holder IN (`+placeholders(i, len(params.Holders))+`)`)
+		for _, h := range params.Holders {
+			args = append(args, common.HexToAddress(h).Hex())
+		}
+		i += len(params.Holders)
+	}
+	if full && !params.KeyCursor.IsZero() {
+		whereC = append(whereC, ` d.delegation_id < `+param(i))
+		args = append(args, params.KeyCursor.Key)
+		i++
+	}
+
+	if !params.TimeAt.IsZero() {
+		whereC = append(whereC, ` `+param(i)+` BETWEEN d.created AND d.until`)
+		args = append(args, micros(params.TimeAt))
+		i++
+	} else if !params.TimeFrom.IsZero() && !params.TimeTo.IsZero() {
+		whereC = append(whereC, ` d.created BETWEEN `+param(i)+` AND `+param(i+1))
+		args = append(args, micros(params.TimeFrom), micros(params.TimeTo))
+		i += 2
+	}
+
+	return whereC, args
+}
+
+func (d *Driver) queryDelegations(ctx context.Context, q string, args []interface{}) (delegations []structs.Delegation, err error) {
+	rows, err := d.conn(ctx).QueryContext(ctx, q, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		dlg := structs.Delegation{}
+		var (
+			id, th, holder            string
+			createdAt, created        int64
+			dlgID, vldID, dlgPeriod   string
+			amount, started, finished string
+			validatorName             sql.NullString
+		)
+
+		if err := rows.Scan(&id, &createdAt, &dlgID, &holder, &vldID, &dlg.BlockHeight, &th, &amount, &dlgPeriod, &created, &started, &finished, &dlg.Info, &dlg.State, &validatorName); err != nil {
+			return nil, err
+		}
+
+		if dlg.ID, err = uuid.Parse(id); err != nil {
+			return nil, err
+		}
+		dlg.CreatedAt = fromMicros(createdAt)
+		dlg.DelegationID = parseNum(dlgID)
+		dlg.Holder = common.HexToAddress(holder)
+		dlg.ValidatorID = parseNum(vldID)
+		dlg.TransactionHash = common.HexToHash(th)
+		dlg.Amount = parseNum(amount)
+		dlg.DelegationPeriod = parseNum(dlgPeriod)
+		dlg.Created = fromMicros(created)
+		dlg.Started = parseNum(started)
+		dlg.Finished = parseNum(finished)
+		dlg.ValidatorName = validatorName.String
+		delegations = append(delegations, dlg)
+	}
+	return delegations, rows.Err()
+}
@@ -0,0 +1,144 @@
+package sqlite
+
+import (
+	"context"
+	"encoding/json"
+	"strings"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/google/uuid"
+
+	"github.com/figment-networks/skale-indexer/scraper/structs"
+)
+
+// SaveFailedEvent saves event log which failed processing. Already stored one gets another attempt counted,
+// and its next retry is never moved earlier, so re-scraping the block doesn't reset the backoff
+func (d *Driver) SaveFailedEvent(ctx context.Context, fe structs.FailedEvent) error {
+	l, err := json.Marshal(fe.Log)
+	if err != nil {
+		return err
+	}
+
+	now := micros(time.Now())
+	_, err = d.conn(ctx).ExecContext(ctx, `INSERT INTO failed_events (
+			"id", "created_at", "updated_at", "block_height", "transaction_hash", "log_index", "contract_name", "event_name", "log", "error", "attempts", "next_retry")
+		VALUES (?1, ?2, ?2, ?3, ?4, ?5, ?6, ?7, ?8, ?9, ?10, ?11)
+		ON CONFLICT (transaction_hash, log_index)
+		DO UPDATE SET
+			updated_at = excluded.updated_at,
+			contract_name = excluded.contract_name,
+			event_name = excluded.event_name,
+			log = excluded.log,
+			error = excluded.error,
+			attempts = failed_events.attempts + 1,
+			next_retry = MAX(failed_events.next_retry, excluded.next_retry)`,
+		uuid.New().String(),
+		now,
+		fe.BlockHeight,
+		fe.TransactionHash.Hex(),
+		fe.LogIndex,
+		fe.ContractName,
+		fe.EventName,
+		string(l),
+		fe.Error,
+		fe.Attempts,
+		micros(fe.NextRetry))
+	return err
+}
+
+// GetFailedEvents gets failed events
+func (d *Driver) GetFailedEvents(ctx context.Context, params structs.FailedEventParams) (failedEvents []structs.FailedEvent, err error) {
+	q := `SELECT id, created_at, updated_at, block_height, transaction_hash, log_index, contract_name, event_name, log, error, attempts, next_retry FROM failed_events `
+
+	var (
+		args   []interface{}
+		whereC []string
+		i      = 1
+	)
+
+	if params.ID != "" {
+		whereC = append(whereC, ` id = `+param(i))
+		args = append(args, params.ID)
+		i++
+	}
+
+	if !params.RetryBefore.IsZero() {
+		whereC = append(whereC, ` next_retry <= `+param(i))
+		args = append(args, micros(params.RetryBefore))
+		i++
+	}
+
+	if params.MaxAttempts > 0 {
+		whereC = append(whereC, ` attempts < `+param(i))
+		args = append(args, params.MaxAttempts)
+		i++
+	}
+
+	if len(whereC) > 0 {
+		q += " WHERE "
+	}
+
+	q += strings.Join(whereC, " AND ")
+	q += ` ORDER BY block_height ASC, log_index ASC `
+	q += page(params.Limit, params.Offset)
+
+	rows, err := d.conn(ctx).QueryContext(ctx, q, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var (
+		createdAt, updatedAt, nextRetry int64
+		txHash, l                       string
+	)
+	for rows.Next() {
+		fe := structs.FailedEvent{}
+		if err = rows.Scan(&fe.ID, &createdAt, &updatedAt, &fe.BlockHeight, &txHash, &fe.LogIndex, &fe.ContractName, &fe.EventName, &l, &fe.Error, &fe.Attempts, &nextRetry); err != nil {
+			return nil, err
+		}
+
+		fe.CreatedAt = fromMicros(createdAt)
+		fe.UpdatedAt = fromMicros(updatedAt)
+		fe.NextRetry = fromMicros(nextRetry)
+		fe.TransactionHash = common.HexToHash(txHash)
+
+		if err = json.Unmarshal([]byte(l), &fe.Log); err != nil {
+			return nil, err
+		}
+		failedEvents = append(failedEvents, fe)
+	}
+	if err = rows.Err(); err != nil {
+		return nil, err
+	}
+
+	if len(failedEvents) == 0 && params.ID != "" {
+		return nil, structs.ErrNotFound
+	}
+
+	return failedEvents, nil
+}
+
+// DeleteFailedEvent removes failed event from the queue
+func (d *Driver) DeleteFailedEvent(ctx context.Context, id string) error {
+	res, err := d.conn(ctx).ExecContext(ctx, `DELETE FROM failed_events WHERE id = ?1`, id)
+	if err != nil {
+		return err
+	}
+
+	n, err := res.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if n == 0 {
+		return structs.ErrNotFound
+	}
+	return nil
+}
+
+// DeleteFailedEventOfLog removes failed event of the log, if there is one
+func (d *Driver) DeleteFailedEventOfLog(ctx context.Context, txHash common.Hash, logIndex uint) error {
+	_, err := d.conn(ctx).ExecContext(ctx, `DELETE FROM failed_events WHERE transaction_hash = ?1 AND log_index = ?2`, txHash.Hex(), logIndex)
+	return err
+}
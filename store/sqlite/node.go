@@ -0,0 +1,373 @@
+package sqlite
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"math/big"
+	"net"
+	"strings"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/google/uuid"
+
+	"github.com/figment-networks/skale-indexer/scraper/structs"
+)
+
+// SaveNodes saves nodes
+func (d *Driver) SaveNodes(ctx context.Context, nodes []structs.Node, removedNodeAddress common.Address) error {
+	return d.Atomic(ctx, func(ctx context.Context) error {
+		tx := d.conn(ctx)
+		for _, n := range nodes {
+			_, err := tx.ExecContext(ctx, `INSERT INTO nodes
+			("id", "created_at", "node_id", "address", "name",  "ip", "public_ip", "port", "start_block", "next_reward_date", "last_reward_date", "finish_time", "status", "validator_id", "block_height")
+			SELECT ?1, ?2, ?3, ?4, ?5, ?6, ?7, ?8, ?9, ?10, ?11, ?12, ?13, ?14, ?15
+				WHERE NOT EXISTS (SELECT 1 FROM nodes n2 WHERE n2.node_id = ?3 AND n2.block_height > ?15 LIMIT 1)
+			ON CONFLICT (node_id)
+			DO UPDATE SET
+				name = excluded.name,
+				address = excluded.address,
+				ip = excluded.ip,
+				public_ip = excluded.public_ip,
+				port = excluded.port,
+				start_block = excluded.start_block,
+				next_reward_date = excluded.next_reward_date,
+				last_reward_date = excluded.last_reward_date,
+				finish_time = excluded.finish_time,
+				status = excluded.status,
+				validator_id = excluded.validator_id,
+				block_height = excluded.block_height`,
+				uuid.New().String(),
+				micros(time.Now()),
+				num(n.NodeID),
+				n.Address.Hex(),
+				n.Name,
+				n.IP.String(),
+				n.PublicIP.String(),
+				n.Port,
+				num(n.StartBlock),
+				micros(n.NextRewardDate),
+				micros(n.LastRewardDate),
+				num(n.FinishTime),
+				n.Status.String(),
+				num(n.ValidatorID),
+				n.BlockHeight)
+			if err != nil {
+				return err
+			}
+
+			if err := d.saveNodeVersion(ctx, n); err != nil {
+				return err
+			}
+		}
+
+		// update removed node
+		if len(nodes) > 0 {
+			_, err := tx.ExecContext(ctx, `UPDATE nodes SET address = ?1 WHERE validator_id = ?2 AND address = ?3`,
+				common.Address{}.Hex(),
+				num(nodes[0].ValidatorID),
+				removedNodeAddress.Hex())
+			if err != nil || removedNodeAddress == (common.Address{}) {
+				return err
+			}
+			return d.removeNodeAddressVersion(ctx, nodes[0].ValidatorID, nodes[0].BlockHeight, removedNodeAddress)
+		}
+		return nil
+	})
+}
+
+// saveNodeVersion records node as valid from its block height until the following version, if there is any
+func (d *Driver) saveNodeVersion(ctx context.Context, n structs.Node) error {
+	tx := d.conn(ctx)
+
+	// nodes are saved again on every event of their validator, state which is already valid at the height is not a change
+	var unchanged bool
+	err := tx.QueryRowContext(ctx, `SELECT valid_from < ?2 AND address = ?3 AND name = ?4 AND ip = ?5 AND public_ip = ?6 AND port = ?7 AND start_block = ?8
+			AND next_reward_date = ?9 AND last_reward_date = ?10 AND finish_time = ?11 AND status = ?12 AND validator_id = ?13
+		FROM nodes_history
+		WHERE node_id = ?1 AND valid_from <= ?2
+		ORDER BY valid_from DESC
+		LIMIT 1`,
+		num(n.NodeID),
+		n.BlockHeight,
+		n.Address.Hex(),
+		n.Name,
+		n.IP.String(),
+		n.PublicIP.String(),
+		n.Port,
+		num(n.StartBlock),
+		micros(n.NextRewardDate),
+		micros(n.LastRewardDate),
+		num(n.FinishTime),
+		n.Status.String(),
+		num(n.ValidatorID)).Scan(&unchanged)
+	if err != nil && err != sql.ErrNoRows {
+		return err
+	}
+	if unchanged {
+		return nil
+	}
+
+	_, err = tx.ExecContext(ctx, `UPDATE nodes_history SET valid_to = ?2
+		WHERE node_id = ?1 AND valid_from < ?2 AND (valid_to IS NULL OR valid_to > ?2)`,
+		num(n.NodeID), n.BlockHeight)
+	if err != nil {
+		return err
+	}
+
+	_, err = tx.ExecContext(ctx, `INSERT INTO nodes_history
+			("id", "created_at", "node_id", "address", "name",  "ip", "public_ip", "port", "start_block", "next_reward_date", "last_reward_date", "finish_time", "status", "validator_id", "valid_from", "valid_to")
+		VALUES (?1, ?2, ?3, ?4, ?5, ?6, ?7, ?8, ?9, ?10, ?11, ?12, ?13, ?14, ?15,
+			(SELECT MIN(valid_from) FROM nodes_history WHERE node_id = ?3 AND valid_from > ?15))
+		ON CONFLICT (node_id, valid_from)
+		DO UPDATE SET
+			name = excluded.name,
+			address = excluded.address,
+			ip = excluded.ip,
+			public_ip = excluded.public_ip,
+			port = excluded.port,
+			start_block = excluded.start_block,
+			next_reward_date = excluded.next_reward_date,
+			last_reward_date = excluded.last_reward_date,
+			finish_time = excluded.finish_time,
+			status = excluded.status,
+			validator_id = excluded.validator_id`,
+		uuid.New().String(),
+		micros(time.Now()),
+		num(n.NodeID),
+		n.Address.Hex(),
+		n.Name,
+		n.IP.String(),
+		n.PublicIP.String(),
+		n.Port,
+		num(n.StartBlock),
+		micros(n.NextRewardDate),
+		micros(n.LastRewardDate),
+		num(n.FinishTime),
+		n.Status.String(),
+		num(n.ValidatorID),
+		n.BlockHeight)
+	return err
+}
+
+// removeNodeAddressVersion records that nodes of validator, which had the removed address at given height, have no address since
+func (d *Driver) removeNodeAddressVersion(ctx context.Context, validatorID *big.Int, height uint64, removed common.Address) error {
+	tx := d.conn(ctx)
+	rows, err := tx.QueryContext(ctx, `SELECT id FROM nodes_history
+		WHERE validator_id = ?1 AND address = ?3 AND valid_from < ?2 AND (valid_to IS NULL OR valid_to > ?2)`,
+		num(validatorID), height, removed.Hex())
+	if err != nil {
+		return err
+	}
+	var ids []string
+	for rows.Next() {
+		var id string
+		if err := rows.Scan(&id); err != nil {
+			rows.Close()
+			return err
+		}
+		ids = append(ids, id)
+	}
+	rows.Close()
+	if err := rows.Err(); err != nil {
+		return err
+	}
+
+	// there is no uuid generator in SQLite, new versions are inserted one by one
+	for _, id := range ids {
+		_, err = tx.ExecContext(ctx, `INSERT INTO nodes_history
+				("id", "created_at", "node_id", "address", "name",  "ip", "public_ip", "port", "start_block", "next_reward_date", "last_reward_date", "finish_time", "status", "validator_id", "valid_from", "valid_to")
+			SELECT ?1, ?2, node_id, ?3, name, ip, public_ip, port, start_block, next_reward_date, last_reward_date, finish_time, status, validator_id, ?4, valid_to
+				FROM nodes_history WHERE id = ?5`,
+			uuid.New().String(), micros(time.Now()), common.Address{}.Hex(), height, id)
+		if err != nil {
+			return err
+		}
+
+		if _, err = tx.ExecContext(ctx, `UPDATE nodes_history SET valid_to = ?1 WHERE id = ?2`, height, id); err != nil {
+			return err
+		}
+	}
+
+	_, err = tx.ExecContext(ctx, `UPDATE nodes_history SET address = ?1 WHERE validator_id = ?2 AND address = ?3 AND valid_from = ?4`,
+		common.Address{}.Hex(), num(validatorID), removed.Hex(), height)
+	return err
+}
+
+// GetNodes gets nodes
+func (d *Driver) GetNodes(ctx context.Context, params structs.NodeParams) (nodes []structs.Node, err error) {
+	q, args := nodesQuery(params)
+	rows, err := d.conn(ctx).QueryContext(ctx, q, args...)
+	if err != nil {
+		return nil, fmt.Errorf("query error: %w", err)
+	}
+
+	defer rows.Close()
+	for rows.Next() {
+		var height uint64
+		n, err := scanNode(rows, &height)
+		if err != nil {
+			return nil, err
+		}
+		n.BlockHeight = height
+		nodes = append(nodes, n)
+	}
+	return nodes, rows.Err()
+}
+
+// CountNodes counts nodes matching params, regardless of paging
+func (d *Driver) CountNodes(ctx context.Context, params structs.NodeParams) (count uint64, err error) {
+	params.Limit, params.Offset, params.Cursor = 0, 0, structs.KeyCursor{}
+	q, args := nodesQuery(params)
+	err = d.conn(ctx).QueryRowContext(ctx, `SELECT COUNT(*) FROM (`+q+`)`, args...).Scan(&count)
+	return count, err
+}
+
+// nodesQuery builds query of nodes for params
+func nodesQuery(params structs.NodeParams) (q string, args []interface{}) {
+	q = `SELECT
+			id, created_at, node_id, address, name, ip, public_ip, port, start_block, next_reward_date, last_reward_date, finish_time, status, validator_id, block_height
+		FROM `
+
+	var (
+		wherec []string
+		i      = 1
+	)
+
+	if params.AtHeight > 0 {
+		q += `(SELECT
+				id, created_at, node_id, address, name, ip, public_ip, port, start_block, next_reward_date, last_reward_date, finish_time, status, validator_id, valid_from AS block_height
+			FROM nodes_history
+			WHERE valid_from <= ?1 AND (valid_to IS NULL OR valid_to > ?1)) nodes `
+		args = append(args, params.AtHeight)
+		i++
+	} else {
+		q += `nodes `
+	}
+
+	if params.NodeID != "" {
+		wherec = append(wherec, ` node_id = `+param(i))
+		args = append(args, params.NodeID)
+		i++
+	}
+	if params.ValidatorID != "" {
+		wherec = append(wherec, ` validator_id = `+param(i))
+		args = append(args, params.ValidatorID)
+		i++
+	}
+	if params.Status != "" {
+		wherec = append(wherec, ` status = `+param(i))
+		args = append(args, params.Status)
+		i++
+	}
+	if params.Address != "" {
+		wherec = append(wherec, ` address = `+param(i))
+		args = append(args, common.HexToAddress(params.Address).Hex())
+		i++
+	}
+	if !params.Cursor.IsZero() {
+		wherec = append(wherec, ` node_id > `+param(i))
+		args = append(args, params.Cursor.Key)
+		i++
+	}
+	if len(wherec) > 0 {
+		q += ` WHERE `
+		q += strings.Join(wherec, " AND ")
+	}
+
+	q += ` ORDER BY node_id `
+
+	offset := params.Offset
+	if !params.Cursor.IsZero() {
+		offset = 0
+	}
+	q += page(params.Limit, offset)
+	return q, args
+}
+
+// GetNodeTimeline gets changes of nodes, the latest first
+func (d *Driver) GetNodeTimeline(ctx context.Context, params structs.NodeParams) (changes []structs.NodeChange, err error) {
+	q := `SELECT
+			h.id, h.created_at, h.node_id, h.address, h.name, h.ip, h.public_ip, h.port, h.start_block, h.next_reward_date, h.last_reward_date, h.finish_time, h.status, h.validator_id,
+			h.valid_from, COALESCE(h.valid_to, 0), b.time
+		FROM nodes_history h
+		LEFT JOIN blocks b ON b.number = h.valid_from`
+
+	var (
+		args   []interface{}
+		wherec []string
+		i      = 1
+	)
+	if params.NodeID != "" {
+		wherec = append(wherec, ` h.node_id = `+param(i))
+		args = append(args, params.NodeID)
+		i++
+	}
+	if params.ValidatorID != "" {
+		wherec = append(wherec, ` h.validator_id = `+param(i))
+		args = append(args, params.ValidatorID)
+		i++
+	}
+	if params.Address != "" {
+		wherec = append(wherec, ` h.address = `+param(i))
+		args = append(args, common.HexToAddress(params.Address).Hex())
+		i++
+	}
+	if len(wherec) > 0 {
+		q += ` WHERE ` + strings.Join(wherec, " AND ")
+	}
+
+	q += ` ORDER BY h.valid_from DESC, length(h.node_id), h.node_id`
+	q += page(params.Limit, params.Offset)
+
+	rows, err := d.conn(ctx).QueryContext(ctx, q, args...)
+	if err != nil {
+		return nil, fmt.Errorf("query error: %w", err)
+	}
+
+	defer rows.Close()
+	for rows.Next() {
+		var (
+			c         structs.NodeChange
+			height    uint64
+			blockTime sql.NullInt64
+		)
+		if c.Node, err = scanNode(rows, &height, &c.ValidTo, &blockTime); err != nil {
+			return nil, err
+		}
+		c.BlockHeight = height
+		if blockTime.Valid {
+			c.Time = fromMicros(blockTime.Int64)
+		}
+		changes = append(changes, c)
+	}
+	return changes, rows.Err()
+}
+
+// scanNode scans columns of node, followed by the extra ones
+func scanNode(rows *sql.Rows, extra ...interface{}) (n structs.Node, err error) {
+	var (
+		createdAt, nextRewardDate, lastRewardDate int64
+		nodeID, validatorID                       string
+		startBlock, finishTime                    string
+		address, IP, publicIP, status             string
+	)
+	dest := []interface{}{&n.ID, &createdAt, &nodeID, &address, &n.Name, &IP, &publicIP, &n.Port, &startBlock, &nextRewardDate, &lastRewardDate, &finishTime, &status, &validatorID}
+	if err = rows.Scan(append(dest, extra...)...); err != nil {
+		return n, err
+	}
+
+	n.CreatedAt = fromMicros(createdAt)
+	n.NodeID = parseNum(nodeID)
+	n.Address = common.HexToAddress(address)
+	n.IP = net.ParseIP(IP)
+	n.PublicIP = net.ParseIP(publicIP)
+	n.StartBlock = parseNum(startBlock)
+	n.NextRewardDate = fromMicros(nextRewardDate)
+	n.LastRewardDate = fromMicros(lastRewardDate)
+	n.FinishTime = parseNum(finishTime)
+	n.ValidatorID = parseNum(validatorID)
+	n.Status, _ = structs.GetTypeForNode(status)
+	return n, nil
+}
@@ -0,0 +1,77 @@
+package sqlite
+
+import (
+	"context"
+	"time"
+
+	"github.com/figment-networks/skale-indexer/scraper/structs"
+)
+
+// outboxInsertBatch keeps the number of parameters of single insert well below SQLite limit
+const outboxInsertBatch = 150
+
+// SaveOutboxMessages saves messages, numbering them in order
+func (d *Driver) SaveOutboxMessages(ctx context.Context, msgs []structs.OutboxMessage) error {
+	for len(msgs) > outboxInsertBatch {
+		if err := d.SaveOutboxMessages(ctx, msgs[:outboxInsertBatch]); err != nil {
+			return err
+		}
+		msgs = msgs[outboxInsertBatch:]
+	}
+	if len(msgs) == 0 {
+		return nil
+	}
+
+	now := micros(time.Now())
+	q := `INSERT INTO outbox ("message_id", "created_at", "topic", "key", "height", "payload") VALUES `
+	args := make([]interface{}, 0, len(msgs)*6)
+	for i, m := range msgs {
+		if i > 0 {
+			q += ", "
+		}
+		q += "(" + placeholders(i*6+1, 6) + ")"
+		args = append(args, m.MessageID, now, m.Topic, m.Key, m.Height, string(m.Payload))
+	}
+
+	_, err := d.conn(ctx).ExecContext(ctx, q, args...)
+	return err
+}
+
+// GetOutboxMessages gets messages in order they were saved in
+func (d *Driver) GetOutboxMessages(ctx context.Context, params structs.OutboxParams) (msgs []structs.OutboxMessage, err error) {
+	q := `SELECT id, message_id, created_at, topic, key, height, payload FROM outbox WHERE id > ?1 ORDER BY id ASC ` + page(params.Limit, 0)
+
+	rows, err := d.conn(ctx).QueryContext(ctx, q, params.After)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var (
+			m         structs.OutboxMessage
+			createdAt int64
+			payload   string
+		)
+		if err = rows.Scan(&m.ID, &m.MessageID, &createdAt, &m.Topic, &m.Key, &m.Height, &payload); err != nil {
+			return nil, err
+		}
+		m.CreatedAt = fromMicros(createdAt)
+		m.Payload = []byte(payload)
+		msgs = append(msgs, m)
+	}
+	return msgs, rows.Err()
+}
+
+// DeleteOutboxMessages removes published messages
+func (d *Driver) DeleteOutboxMessages(ctx context.Context, ids []uint64) error {
+	if len(ids) == 0 {
+		return nil
+	}
+	args := make([]interface{}, len(ids))
+	for i, id := range ids {
+		args[i] = id
+	}
+	_, err := d.conn(ctx).ExecContext(ctx, `DELETE FROM outbox WHERE id IN (`+placeholders(1, len(ids))+`)`, args...)
+	return err
+}
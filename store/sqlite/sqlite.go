@@ -0,0 +1,241 @@
+// Package sqlite is SQLite store driver for single node deployments.
+// Schema is created by migrations in cmd/skale-indexer-migration/migrations_sqlite.
+//
+// SQLite lacks arrays, enums and numeric types wide enough for token amounts, so big numbers
+// are stored as decimal text, addresses and hashes as hex and times as unix microseconds in UTC.
+package sqlite
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"math/big"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	_ "github.com/mattn/go-sqlite3"
+	"go.uber.org/zap"
+)
+
+// busyTimeout is how long a write waits for the one in progress, in milliseconds
+const busyTimeout = 30000
+
+// maxOpenConns limits connections, all of them may read while one of them writes
+const maxOpenConns = 8
+
+// Driver is SQLite database driver implementation
+type Driver struct {
+	db *sql.DB
+	l  *zap.Logger
+}
+
+// Open opens database file in WAL mode, so reads don't wait for the write in progress.
+// SQLite allows only one writer at a time: transactions take the write lock when they begin
+// and writes made meanwhile wait for it up to busyTimeout, instead of failing right away
+func Open(path string) (*sql.DB, error) {
+	sep := "?"
+	if strings.Contains(path, "?") {
+		sep = "&"
+	}
+	db, err := sql.Open("sqlite3", path+sep+"_journal_mode=WAL&_txlock=immediate&_busy_timeout="+strconv.Itoa(busyTimeout))
+	if err != nil {
+		return nil, err
+	}
+	db.SetMaxOpenConns(maxOpenConns)
+	return db, nil
+}
+
+// NewDriver is Driver constructor, db is expected to be opened by Open
+func NewDriver(ctx context.Context, db *sql.DB, l *zap.Logger) *Driver {
+	return &Driver{
+		db: db,
+		l:  l,
+	}
+}
+
+// queryer is a set of methods shared by *sql.DB and *sql.Tx
+type queryer interface {
+	ExecContext(ctx context.Context, query string, args ...interface{}) (sql.Result, error)
+	QueryContext(ctx context.Context, query string, args ...interface{}) (*sql.Rows, error)
+	QueryRowContext(ctx context.Context, query string, args ...interface{}) *sql.Row
+}
+
+type scopeKey struct{}
+
+// scope is database transaction carried in context by Atomic
+type scope struct {
+	tx         *sql.Tx
+	savepoints int
+}
+
+// conn returns transaction of the scope started by Atomic or database handle when there is none
+func (d *Driver) conn(ctx context.Context) queryer {
+	if s, ok := ctx.Value(scopeKey{}).(*scope); ok {
+		return s.tx
+	}
+	return d.db
+}
+
+// Atomic runs fn in a single database transaction. Every driver call made with the context given to fn
+// is part of it; it's committed when fn returns nil and rolled back otherwise.
+// Nested calls are run in savepoints, so that inner failure may be handled without discarding the outer scope.
+// Reads made within fn with context other than the given one don't see its writes, the writes wait until it completes.
+func (d *Driver) Atomic(ctx context.Context, fn func(ctx context.Context) error) (err error) {
+	if s, ok := ctx.Value(scopeKey{}).(*scope); ok {
+		return s.savepoint(ctx, fn)
+	}
+
+	tx, err := d.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+
+	defer func() {
+		if p := recover(); p != nil {
+			tx.Rollback()
+			panic(p)
+		}
+	}()
+
+	if err = fn(context.WithValue(ctx, scopeKey{}, &scope{tx: tx})); err != nil {
+		if rollbackErr := tx.Rollback(); rollbackErr != nil {
+			return fmt.Errorf("error rolling back (%s): %w", rollbackErr.Error(), err)
+		}
+		return err
+	}
+
+	return tx.Commit()
+}
+
+func (s *scope) savepoint(ctx context.Context, fn func(ctx context.Context) error) (err error) {
+	s.savepoints++
+	name := "sp_" + strconv.Itoa(s.savepoints)
+
+	if _, err = s.tx.ExecContext(ctx, "SAVEPOINT "+name); err != nil {
+		return err
+	}
+
+	defer func() {
+		if p := recover(); p != nil {
+			s.tx.ExecContext(ctx, "ROLLBACK TO SAVEPOINT "+name)
+			panic(p)
+		}
+	}()
+
+	if err = fn(ctx); err != nil {
+		if _, rollbackErr := s.tx.ExecContext(ctx, "ROLLBACK TO SAVEPOINT "+name); rollbackErr != nil {
+			return fmt.Errorf("error rolling back to savepoint (%s): %w", rollbackErr.Error(), err)
+		}
+		return err
+	}
+
+	_, err = s.tx.ExecContext(ctx, "RELEASE SAVEPOINT "+name)
+	return err
+}
+
+type bulkKey struct{}
+
+// bulk holds writes made outside of Atomic during the Bulk call, shared by all the goroutines using its context
+type bulk struct {
+	lock   sync.Mutex
+	writes []func(ctx context.Context) error
+}
+
+// deferWrite adds write to the Bulk of ctx unless it's made within Atomic, reporting whether it did
+func deferWrite(ctx context.Context, write func(ctx context.Context) error) bool {
+	bk, ok := ctx.Value(bulkKey{}).(*bulk)
+	if !ok {
+		return false
+	}
+	if _, ok := ctx.Value(scopeKey{}).(*scope); ok {
+		return false
+	}
+
+	bk.lock.Lock()
+	bk.writes = append(bk.writes, write)
+	bk.lock.Unlock()
+	return true
+}
+
+// Bulk runs fn. There is no faster loading path in SQLite than transactions the writes are already grouped in,
+// so writes made within Atomic are committed with it. Contract events, accounts and validator statistics
+// written outside of Atomic are run in a single transaction when fn succeeds and discarded when it fails
+func (d *Driver) Bulk(ctx context.Context, fn func(ctx context.Context) error) error {
+	if _, ok := ctx.Value(bulkKey{}).(*bulk); ok {
+		return fn(ctx)
+	}
+	if _, ok := ctx.Value(scopeKey{}).(*scope); ok {
+		return fn(ctx)
+	}
+
+	bk := &bulk{}
+	if err := fn(context.WithValue(ctx, bulkKey{}, bk)); err != nil {
+		return err
+	}
+
+	if len(bk.writes) == 0 {
+		return nil
+	}
+	return d.Atomic(ctx, func(ctx context.Context) error {
+		for _, write := range bk.writes {
+			if err := write(ctx); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+// param returns numbered placeholder
+func param(i int) string {
+	return "?" + strconv.Itoa(i)
+}
+
+// placeholders returns numbered placeholders for n consecutive params, separated with comma
+func placeholders(i, n int) string {
+	p := make([]string, n)
+	for j := range p {
+		p[j] = param(i + j)
+	}
+	return strings.Join(p, ", ")
+}
+
+// page returns limit and offset clause, offset being used only with limit
+func page(limit, offset uint64) (q string) {
+	if limit > 0 {
+		q += " LIMIT " + strconv.FormatUint(limit, 10)
+		if offset > 0 {
+			q += " OFFSET " + strconv.FormatUint(offset, 10)
+		}
+	}
+	return q
+}
+
+// num formats big number for storage, nil being stored as zero
+func num(i *big.Int) string {
+	if i == nil {
+		return "0"
+	}
+	return i.String()
+}
+
+// parseNum parses stored big number
+func parseNum(s string) *big.Int {
+	i, _ := new(big.Int).SetString(s, 10)
+	if i == nil {
+		return new(big.Int)
+	}
+	return i
+}
+
+// micros converts time to stored unix microseconds
+func micros(t time.Time) int64 {
+	return t.Unix()*1e6 + int64(t.Nanosecond()/1e3)
+}
+
+// fromMicros converts stored unix microseconds to time
+func fromMicros(us int64) time.Time {
+	return time.Unix(us/1e6, (us%1e6)*1e3).UTC()
+}
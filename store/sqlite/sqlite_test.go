@@ -0,0 +1,138 @@
+package sqlite
+
+import (
+	"context"
+	"io/ioutil"
+	"math/big"
+	"path/filepath"
+	"sort"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+
+	"github.com/figment-networks/skale-indexer/scraper/structs"
+	"github.com/figment-networks/skale-indexer/store"
+	"github.com/figment-networks/skale-indexer/store/storetest"
+)
+
+const migrations = "../../cmd/skale-indexer-migration/migrations_sqlite"
+
+func newDriver(t *testing.T) *Driver {
+	db, err := Open(filepath.Join(t.TempDir(), "indexer.db"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { db.Close() })
+
+	ups, err := filepath.Glob(filepath.Join(migrations, "*.up.sql"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	sort.Strings(ups)
+	for _, up := range ups {
+		q, err := ioutil.ReadFile(up)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if _, err := db.Exec(string(q)); err != nil {
+			t.Fatalf("migration %s: %v", up, err)
+		}
+	}
+
+	return NewDriver(context.Background(), db, zap.NewNop())
+}
+
+func TestDriver(t *testing.T) {
+	storetest.Run(t, func(t *testing.T) store.DBDriver {
+		return newDriver(t)
+	})
+}
+
+// TestDriverConcurrent runs the scraper writing blocks next to the workers reading and writing outside of them,
+// the way outbox relay, webhook deliveries and failed events retries do
+func TestDriverConcurrent(t *testing.T) {
+	ctx := context.Background()
+	d := newDriver(t)
+
+	const blocks = 50
+	start := time.Date(2021, time.March, 10, 12, 0, 0, 0, time.UTC)
+
+	var wg sync.WaitGroup
+	errs := make(chan error, 4*blocks)
+	done := make(chan struct{})
+
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		defer close(done)
+		for h := uint64(1); h <= blocks; h++ {
+			errs <- d.Atomic(ctx, func(ctx context.Context) error {
+				if err := d.SaveBlock(ctx, structs.Block{Number: h, Hash: common.BigToHash(new(big.Int).SetUint64(h)), Time: start.Add(time.Duration(h) * time.Second)}); err != nil {
+					return err
+				}
+				// reading with context of no scope doesn't wait for the block
+				if _, err := d.GetOutboxMessages(context.Background(), structs.OutboxParams{Limit: 10}); err != nil {
+					return err
+				}
+				return d.Atomic(ctx, func(ctx context.Context) error {
+					return d.SaveOutboxMessages(ctx, []structs.OutboxMessage{structs.NewOutboxMessage(structs.OutboxTopicSystemEvent, "key", h, "", []byte(`{}`))})
+				})
+			})
+		}
+	}()
+
+	worker := func(work func() error) {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for {
+				select {
+				case <-done:
+					return
+				default:
+				}
+				if err := work(); err != nil {
+					errs <- err
+					return
+				}
+			}
+		}()
+	}
+
+	// outbox relay
+	worker(func() error {
+		msgs, err := d.GetOutboxMessages(ctx, structs.OutboxParams{Limit: 5})
+		if err != nil || len(msgs) == 0 {
+			return err
+		}
+		ids := make([]uint64, len(msgs))
+		for i, m := range msgs {
+			ids[i] = m.ID
+		}
+		return d.DeleteOutboxMessages(ctx, ids)
+	})
+	// webhook deliveries
+	worker(func() error {
+		_, err := d.GetWebhooks(ctx, structs.WebhookParams{Active: true})
+		return err
+	})
+	// failed events retries
+	worker(func() error {
+		_, err := d.GetFailedEvents(ctx, structs.FailedEventParams{Limit: 10})
+		return err
+	})
+
+	wg.Wait()
+	close(errs)
+	for err := range errs {
+		require.NoError(t, err)
+	}
+
+	b, err := d.GetBlock(ctx, blocks)
+	require.NoError(t, err)
+	require.Equal(t, uint64(blocks), b.Number)
+}
@@ -0,0 +1,189 @@
+package sqlite
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/google/uuid"
+
+	"github.com/figment-networks/skale-indexer/scraper/structs"
+)
+
+// SaveSystemEvent saves system events
+func (d *Driver) SaveSystemEvent(ctx context.Context, se structs.SystemEvent) error {
+	_, err := d.conn(ctx).ExecContext(ctx,
+		`INSERT INTO system_events(
+			"id",
+			"height",
+			"kind",
+			"time",
+			"sender",
+			"recipient",
+			"sender_id",
+			"recipient_id",
+			"before",
+			"after",
+			"change"
+			)
+		VALUES (?1, ?2, ?3, ?4, ?5, ?6, ?7, ?8, ?9, ?10, ?11)
+		ON CONFLICT ( height, kind, sender, sender_id, recipient, recipient_id)
+		DO UPDATE SET
+			before = excluded.before,
+			after = excluded.after,
+			change = excluded.change
+		`,
+		uuid.New().String(),
+		se.Height,
+		se.Kind,
+		micros(se.Time),
+		se.Sender.Hex(),
+		se.Recipient.Hex(),
+		new(big.Int).Abs(&se.SenderID).String(),
+		new(big.Int).Abs(&se.RecipientID).String(),
+		new(big.Int).Abs(&se.Before).String(),
+		new(big.Int).Abs(&se.After).String(),
+		decimal(&se.Change),
+	)
+	return err
+}
+
+// GetSystemEvents gets contract events
+func (d *Driver) GetSystemEvents(ctx context.Context, params structs.SystemEventParams) (systemEvents []structs.SystemEvent, err error) {
+	q := `SELECT id, height, kind, time, sender, sender_id, recipient, recipient_id, before, after, change FROM system_events `
+
+	whereC, args := systemEventsFilter(params)
+
+	if !params.Cursor.IsZero() {
+		i := len(args) + 1
+		whereC = append(whereC, ` (height, id) < (`+param(i)+`, `+param(i+1)+`)`)
+		args = append(args, params.Cursor.BlockHeight, params.Cursor.ID)
+	}
+
+	if len(whereC) > 0 {
+		q += " WHERE "
+	}
+	q += strings.Join(whereC, " AND ")
+	q += ` ORDER BY height DESC, id DESC `
+
+	offset := params.Offset
+	if !params.Cursor.IsZero() {
+		offset = 0
+	}
+	q += page(params.Limit, offset)
+
+	rows, err := d.conn(ctx).QueryContext(ctx, q, args...)
+	if err != nil {
+		return nil, fmt.Errorf("query error: %w", err)
+	}
+	defer rows.Close()
+
+	var (
+		t           int64
+		sender      string
+		recipient   string
+		senderID    string
+		recipientID string
+		beforeValue string
+		afterValue  string
+		change      string
+	)
+	for rows.Next() {
+		e := structs.SystemEvent{}
+		if err = rows.Scan(&e.ID, &e.Height, &e.Kind, &t, &sender, &senderID, &recipient, &recipientID, &beforeValue, &afterValue, &change); err != nil {
+			return nil, err
+		}
+
+		e.Time = fromMicros(t)
+		e.Sender = common.HexToAddress(sender)
+		e.Recipient = common.HexToAddress(recipient)
+		e.SenderID = *parseNum(senderID)
+		e.RecipientID = *parseNum(recipientID)
+		e.Before = *parseNum(beforeValue)
+		e.After = *parseNum(afterValue)
+
+		f := new(big.Float)
+		f.SetString(change)
+		e.Change = *f
+
+		systemEvents = append(systemEvents, e)
+	}
+
+	return systemEvents, rows.Err()
+}
+
+// decimal formats float the way it'd be stored in DECIMAL(65,0) column
+func decimal(f *big.Float) string {
+	p := new(big.Float)
+	p.SetString(f.String())
+	return p.Text('f', 0)
+}
+
+// CountSystemEvents counts system events matching params, regardless of paging
+func (d *Driver) CountSystemEvents(ctx context.Context, params structs.SystemEventParams) (count uint64, err error) {
+	q := `SELECT COUNT(*) FROM system_events `
+
+	whereC, args := systemEventsFilter(params)
+	if len(whereC) > 0 {
+		q += " WHERE " + strings.Join(whereC, " AND ")
+	}
+
+	err = d.conn(ctx).QueryRowContext(ctx, q, args...).Scan(&count)
+	return count, err
+}
+
+// systemEventsFilter builds conditions of system events query for params, paging excluded
+func systemEventsFilter(params structs.SystemEventParams) (whereC []string, args []interface{}) {
+	i := 1
+
+	if params.Address != "" {
+		whereC = append(whereC, `( sender = `+param(i)+` OR recipient = `+param(i)+` )`)
+		args = append(args, common.HexToAddress(params.Address).Hex())
+		i++
+	}
+
+	if params.ID != "" {
+		whereC = append(whereC, ` id = `+param(i))
+		args = append(args, params.ID)
+		i++
+	}
+
+	if params.ValidatorID != "" {
+		whereC = append(whereC, `( sender_id = `+param(i)+` OR recipient_id = `+param(i)+` )`)
+		args = append(args, params.ValidatorID)
+		i++
+	}
+
+	if params.ReceiverID > 0 {
+		whereC = append(whereC, ` recipient_id = `+param(i))
+		args = append(args, params.ReceiverID)
+		i++
+	}
+
+	if params.SenderID > 0 {
+		whereC = append(whereC, ` sender_id = `+param(i))
+		args = append(args, params.SenderID)
+		i++
+	}
+
+	if params.Kind != "" {
+		whereC = append(whereC, ` kind = `+param(i))
+		args = append(args, params.Kind)
+		i++
+	}
+
+	if params.After > 0 {
+		whereC = append(whereC, ` height > `+param(i))
+		args = append(args, params.After)
+		i++
+	}
+
+	if params.AtHeight > 0 {
+		whereC = append(whereC, ` height <= `+param(i))
+		args = append(args, params.AtHeight)
+	}
+
+	return whereC, args
+}
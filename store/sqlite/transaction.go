@@ -0,0 +1,76 @@
+package sqlite
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/google/uuid"
+
+	"github.com/figment-networks/skale-indexer/scraper/structs"
+)
+
+// SaveTransaction saves transaction
+func (d *Driver) SaveTransaction(ctx context.Context, tx structs.Transaction) error {
+	_, err := d.conn(ctx).ExecContext(ctx, `INSERT INTO transactions (
+			"id", "created_at", "hash", "block_height", "block_hash", "tx_index", "time", "sender", "recipient",
+			"value", "nonce", "gas", "gas_price", "gas_used", "fee", "status")
+		VALUES (?1, ?2, ?3, ?4, ?5, ?6, ?7, ?8, ?9, ?10, ?11, ?12, ?13, ?14, ?15, ?16)
+		ON CONFLICT (hash)
+		DO UPDATE SET
+			block_height = excluded.block_height,
+			block_hash = excluded.block_hash,
+			tx_index = excluded.tx_index,
+			time = excluded.time,
+			gas_used = excluded.gas_used,
+			fee = excluded.fee,
+			status = excluded.status`,
+		uuid.New().String(),
+		micros(time.Now()),
+		tx.Hash.Hex(),
+		tx.BlockHeight,
+		tx.BlockHash.Hex(),
+		tx.Index,
+		micros(tx.Time),
+		tx.From.Hex(),
+		tx.To.Hex(),
+		num(tx.Value),
+		tx.Nonce,
+		tx.Gas,
+		num(tx.GasPrice),
+		tx.GasUsed,
+		num(tx.Fee),
+		tx.Status)
+	return err
+}
+
+// GetTransaction gets transaction by hash
+func (d *Driver) GetTransaction(ctx context.Context, hash common.Hash) (tx structs.Transaction, err error) {
+	var (
+		txHash, blockHash, sender, recipient string
+		value, gasPrice, fee                 string
+		t                                    int64
+	)
+
+	err = d.conn(ctx).QueryRowContext(ctx, `SELECT hash, block_height, block_hash, tx_index, time, sender, recipient, value, nonce, gas, gas_price, gas_used, fee, status
+			FROM transactions WHERE hash = ?1`, hash.Hex()).
+		Scan(&txHash, &tx.BlockHeight, &blockHash, &tx.Index, &t, &sender, &recipient, &value, &tx.Nonce, &tx.Gas, &gasPrice, &tx.GasUsed, &fee, &tx.Status)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return tx, structs.ErrNotFound
+		}
+		return tx, err
+	}
+
+	tx.Hash = common.HexToHash(txHash)
+	tx.BlockHash = common.HexToHash(blockHash)
+	tx.Time = fromMicros(t)
+	tx.From = common.HexToAddress(sender)
+	tx.To = common.HexToAddress(recipient)
+	tx.Value = parseNum(value)
+	tx.GasPrice = parseNum(gasPrice)
+	tx.Fee = parseNum(fee)
+
+	return tx, nil
+}
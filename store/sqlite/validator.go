@@ -0,0 +1,380 @@
+package sqlite
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"math/big"
+	"strings"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/google/uuid"
+
+	"github.com/figment-networks/skale-indexer/scraper/structs"
+)
+
+// validatorsOrder are expressions validators may be ordered by.
+// Big numbers are kept as text, so they're ordered by length first
+var validatorsOrder = map[string][]string{
+	"validator_id":              {"validator_id"},
+	"name":                      {"name"},
+	"fee_rate":                  {"length(fee_rate)", "fee_rate"},
+	"registration_time":         {"registration_time"},
+	"minimum_delegation_amount": {"length(minimum_delegation_amount)", "minimum_delegation_amount"},
+	"active_nodes":              {"active_nodes"},
+	"linked_nodes":              {"linked_nodes"},
+	"staked":                    {"length(staked)", "staked"},
+	"block_height":              {"block_height"},
+}
+
+// validatorsAtHeight selects versions of validators valid at height ?1, with counts taken from statistics of that time
+const validatorsAtHeight = `(SELECT
+				id,
+				created_at,
+				validator_id,
+				name,
+				validator_address,
+				requested_address,
+				description,
+				fee_rate,
+				registration_time,
+				minimum_delegation_amount,
+				accept_new_requests,
+				authorized,
+				COALESCE((SELECT amount FROM validator_statistics s
+					WHERE s.validator_id = h.validator_id AND s.statistic_type = ?2 AND s.block_height <= ?1
+					ORDER BY s.block_height DESC LIMIT 1), 0) AS active_nodes,
+				COALESCE((SELECT amount FROM validator_statistics s
+					WHERE s.validator_id = h.validator_id AND s.statistic_type = ?3 AND s.block_height <= ?1
+					ORDER BY s.block_height DESC LIMIT 1), 0) AS linked_nodes,
+				COALESCE((SELECT amount FROM validator_statistics s
+					WHERE s.validator_id = h.validator_id AND s.statistic_type = ?4 AND s.block_height <= ?1
+					ORDER BY s.block_height DESC LIMIT 1), '0') AS staked,
+				valid_from AS block_height
+			FROM validators_history h
+			WHERE valid_from <= ?1 AND (valid_to IS NULL OR valid_to > ?1)) validators`
+
+// SaveValidator saves validator
+func (d *Driver) SaveValidator(ctx context.Context, v structs.Validator) error {
+	return d.Atomic(ctx, func(ctx context.Context) error {
+		_, err := d.conn(ctx).ExecContext(ctx, `INSERT INTO validators (
+			"id",
+			"created_at",
+			"validator_id",
+			"name",
+			"validator_address",
+			"requested_address",
+			"description",
+			"fee_rate",
+			"registration_time",
+			"minimum_delegation_amount",
+			"accept_new_requests",
+			"authorized",
+			"active_nodes",
+			"linked_nodes",
+			"staked",
+			"block_height")
+		SELECT ?1, ?2, ?3, ?4, ?5, ?6, ?7, ?8, ?9, ?10, ?11, ?12, ?13, ?14, ?15, ?16
+			WHERE NOT EXISTS (SELECT 1 FROM validators v2 WHERE v2.validator_id = ?3 AND v2.block_height > ?16 LIMIT 1)
+		ON CONFLICT (validator_id)
+		DO UPDATE SET
+			name = excluded.name,
+			validator_address = excluded.validator_address,
+			requested_address = excluded.requested_address,
+			description = excluded.description,
+			fee_rate = excluded.fee_rate,
+			registration_time = excluded.registration_time,
+			minimum_delegation_amount = excluded.minimum_delegation_amount,
+			accept_new_requests = excluded.accept_new_requests,
+			authorized = excluded.authorized,
+			block_height = excluded.block_height
+		`,
+			uuid.New().String(),
+			micros(time.Now()),
+			num(v.ValidatorID),
+			v.Name,
+			v.ValidatorAddress.Hex(),
+			v.RequestedAddress.Hex(),
+			v.Description,
+			num(v.FeeRate),
+			micros(v.RegistrationTime),
+			num(v.MinimumDelegationAmount),
+			v.AcceptNewRequests,
+			v.Authorized,
+			v.ActiveNodes,
+			v.LinkedNodes,
+			num(v.Staked),
+			v.BlockHeight)
+		if err != nil {
+			return err
+		}
+
+		return d.saveValidatorVersion(ctx, v)
+	})
+}
+
+// saveValidatorVersion records validator as valid from its block height until the following version, if there is any
+func (d *Driver) saveValidatorVersion(ctx context.Context, v structs.Validator) error {
+	tx := d.conn(ctx)
+	_, err := tx.ExecContext(ctx, `UPDATE validators_history SET valid_to = ?2
+		WHERE validator_id = ?1 AND valid_from < ?2 AND (valid_to IS NULL OR valid_to > ?2)`,
+		num(v.ValidatorID), v.BlockHeight)
+	if err != nil {
+		return err
+	}
+
+	_, err = tx.ExecContext(ctx, `INSERT INTO validators_history (
+			"id",
+			"created_at",
+			"validator_id",
+			"name",
+			"validator_address",
+			"requested_address",
+			"description",
+			"fee_rate",
+			"registration_time",
+			"minimum_delegation_amount",
+			"accept_new_requests",
+			"authorized",
+			"valid_from",
+			"valid_to")
+		VALUES (?1, ?2, ?3, ?4, ?5, ?6, ?7, ?8, ?9, ?10, ?11, ?12, ?13,
+			(SELECT MIN(valid_from) FROM validators_history WHERE validator_id = ?3 AND valid_from > ?13))
+		ON CONFLICT (validator_id, valid_from)
+		DO UPDATE SET
+			name = excluded.name,
+			validator_address = excluded.validator_address,
+			requested_address = excluded.requested_address,
+			description = excluded.description,
+			fee_rate = excluded.fee_rate,
+			registration_time = excluded.registration_time,
+			minimum_delegation_amount = excluded.minimum_delegation_amount,
+			accept_new_requests = excluded.accept_new_requests,
+			authorized = excluded.authorized`,
+		uuid.New().String(),
+		micros(time.Now()),
+		num(v.ValidatorID),
+		v.Name,
+		v.ValidatorAddress.Hex(),
+		v.RequestedAddress.Hex(),
+		v.Description,
+		num(v.FeeRate),
+		micros(v.RegistrationTime),
+		num(v.MinimumDelegationAmount),
+		v.AcceptNewRequests,
+		v.Authorized,
+		v.BlockHeight)
+	return err
+}
+
+// GetValidators gets validators by params
+func (d *Driver) GetValidators(ctx context.Context, params structs.ValidatorParams) (validators []structs.Validator, err error) {
+	q, args, err := validatorsQuery(params)
+	if err != nil {
+		return nil, err
+	}
+
+	var rows *sql.Rows
+	rows, err = d.conn(ctx).QueryContext(ctx, q, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		vld := structs.Validator{}
+		var (
+			createdAt, registrationTime        int64
+			vldID, feeRate, mnmDlgAmount       string
+			staked                             string
+			validatorAddress, requestedAddress string
+			name, description                  sql.NullString
+		)
+		err = rows.Scan(&vld.ID,
+			&createdAt,
+			&vldID,
+			&name,
+			&validatorAddress,
+			&requestedAddress,
+			&description,
+			&feeRate,
+			&registrationTime,
+			&mnmDlgAmount,
+			&vld.AcceptNewRequests,
+			&vld.Authorized,
+			&vld.ActiveNodes,
+			&vld.LinkedNodes,
+			&staked,
+			&vld.BlockHeight)
+		if err != nil {
+			return nil, err
+		}
+		vld.CreatedAt = fromMicros(createdAt)
+		vld.ValidatorID = parseNum(vldID)
+		vld.Name = name.String
+		vld.Description = description.String
+		vld.ValidatorAddress = common.HexToAddress(validatorAddress)
+		vld.RequestedAddress = common.HexToAddress(requestedAddress)
+		vld.FeeRate = parseNum(feeRate)
+		vld.RegistrationTime = fromMicros(registrationTime)
+		vld.MinimumDelegationAmount = parseNum(mnmDlgAmount)
+		vld.Staked = parseNum(staked)
+		validators = append(validators, vld)
+	}
+	return validators, rows.Err()
+}
+
+// CountValidators counts validators matching params, regardless of paging
+func (d *Driver) CountValidators(ctx context.Context, params structs.ValidatorParams) (count uint64, err error) {
+	params.Limit, params.Offset, params.Cursor = 0, 0, structs.KeyCursor{}
+	q, args, err := validatorsQuery(params)
+	if err != nil {
+		return 0, err
+	}
+	err = d.conn(ctx).QueryRowContext(ctx, `SELECT COUNT(*) FROM (`+q+`)`, args...).Scan(&count)
+	return count, err
+}
+
+// validatorsQuery builds query of validators for params
+func validatorsQuery(params structs.ValidatorParams) (q string, args []interface{}, err error) {
+	q = `SELECT
+				id,
+				created_at,
+				validator_id,
+				name,
+				validator_address,
+				requested_address,
+				description,
+				fee_rate,
+				registration_time,
+				minimum_delegation_amount,
+				accept_new_requests,
+				authorized,
+				active_nodes,
+				linked_nodes,
+				staked,
+				block_height
+			FROM `
+
+	var (
+		whereC []string
+		i      = 1
+	)
+
+	if params.AtHeight > 0 {
+		q += validatorsAtHeight
+		args = append(args, params.AtHeight, structs.ValidatorStatisticsTypeActiveNodes, structs.ValidatorStatisticsTypeLinkedNodes, structs.ValidatorStatisticsTypeTotalStake)
+		i += 4
+	} else {
+		q += `validators`
+	}
+
+	if params.ValidatorID != "" {
+		whereC = append(whereC, ` validator_id = `+param(i))
+		args = append(args, params.ValidatorID)
+		i++
+	}
+
+	if !params.TimeFrom.IsZero() && !params.TimeTo.IsZero() {
+		whereC = append(whereC, ` registration_time BETWEEN `+param(i)+` AND `+param(i+1))
+		args = append(args, micros(params.TimeFrom), micros(params.TimeTo))
+		i += 2
+	}
+
+	if params.Authorized > 0 {
+		whereC = append(whereC, ` authorized = `+param(i))
+		args = append(args, params.Authorized == structs.StateTrue)
+		i++
+	}
+
+	if params.Address != "" {
+		whereC = append(whereC, ` validator_address = `+param(i))
+		args = append(args, common.HexToAddress(params.Address).Hex())
+		i++
+	}
+
+	if !params.Cursor.IsZero() {
+		if params.OrderBy != "" {
+			return "", nil, structs.ErrCursorOrder
+		}
+		whereC = append(whereC, ` validator_id > `+param(i))
+		args = append(args, params.Cursor.Key)
+		i++
+	}
+
+	if len(whereC) > 0 {
+		q += ` WHERE `
+	}
+	q += strings.Join(whereC, " AND ")
+
+	column := params.OrderBy
+	if column == "" {
+		column = "validator_id"
+	}
+	order, err := orderBy(validatorsOrder, column, params.OrderDirection)
+	if err != nil {
+		return "", nil, err
+	}
+	q += ` ORDER BY ` + order
+
+	offset := params.Offset
+	if !params.Cursor.IsZero() {
+		offset = 0
+	}
+	q += page(params.Limit, offset)
+	return q, args, nil
+}
+
+// UpdateCountsOfValidator updates linked ,active  node count as well as total stake information of validator
+func (d *Driver) UpdateCountsOfValidator(ctx context.Context, validatorID *big.Int) error {
+	if deferWrite(ctx, func(ctx context.Context) error { return d.UpdateCountsOfValidator(ctx, validatorID) }) {
+		return nil
+	}
+
+	_, err := d.conn(ctx).ExecContext(ctx, `UPDATE validators
+						SET
+							active_nodes = COALESCE((SELECT amount
+								 FROM validator_statistics
+								 WHERE validator_id = ?1 AND statistic_type = ?2
+								 ORDER BY block_height DESC LIMIT 1 ), 0),
+							linked_nodes = COALESCE((SELECT amount
+								 FROM validator_statistics
+								 WHERE validator_id = ?1 AND statistic_type = ?3
+								 ORDER BY block_height DESC LIMIT 1 ), 0),
+							staked = COALESCE((SELECT amount
+								FROM validator_statistics
+								WHERE validator_id = ?1 AND statistic_type = ?4
+								ORDER BY block_height DESC LIMIT 1 ), '0')
+						WHERE validator_id = ?1`,
+		validatorID.String(),
+		structs.ValidatorStatisticsTypeActiveNodes,
+		structs.ValidatorStatisticsTypeLinkedNodes,
+		structs.ValidatorStatisticsTypeTotalStake)
+
+	return err
+}
+
+// orderBy builds order clause from the whitelisted columns
+func orderBy(columns map[string][]string, column, direction string) (string, error) {
+	column = strings.TrimSpace(column)
+	exprs, ok := columns[column]
+	if !ok {
+		return "", fmt.Errorf("unknown order column: %s", column)
+	}
+
+	direction = strings.ToUpper(strings.TrimSpace(direction))
+	switch direction {
+	case "":
+		direction = "ASC"
+	case "ASC", "DESC":
+	default:
+		return "", fmt.Errorf("unknown order direction: %s", direction)
+	}
+
+	order := make([]string, len(exprs))
+	for i, e := range exprs {
+		order[i] = e + " " + direction
+	}
+	return strings.Join(order, ", "), nil
+}
@@ -0,0 +1,94 @@
+package sqlite
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/google/uuid"
+
+	"github.com/figment-networks/skale-indexer/scraper/structs"
+)
+
+// SaveValidatorAddress saves change of validator addresses, unless they are the same as in the previous change
+func (d *Driver) SaveValidatorAddress(ctx context.Context, va structs.ValidatorAddress) error {
+	_, err := d.conn(ctx).ExecContext(ctx, `INSERT INTO validator_addresses
+			("id", "created_at", "validator_id", "address", "requested_address", "block_height", "time", "transaction_hash", "event_name")
+		SELECT ?1, ?2, ?3, ?4, ?5, ?6, ?7, ?8, ?9
+			WHERE NOT EXISTS (SELECT 1 FROM
+				(SELECT address, requested_address FROM validator_addresses WHERE validator_id = ?3 AND block_height < ?6 ORDER BY block_height DESC LIMIT 1) p
+				WHERE p.address = ?4 AND p.requested_address = ?5)
+		ON CONFLICT (validator_id, block_height)
+		DO UPDATE SET
+			address = excluded.address,
+			requested_address = excluded.requested_address,
+			time = excluded.time,
+			transaction_hash = excluded.transaction_hash,
+			event_name = excluded.event_name`,
+		uuid.New().String(),
+		micros(time.Now()),
+		num(va.ValidatorID),
+		va.Address.Hex(),
+		va.RequestedAddress.Hex(),
+		va.BlockHeight,
+		micros(va.Time),
+		va.TransactionHash.Hex(),
+		va.EventName)
+	return err
+}
+
+// GetValidatorAddresses gets changes of validator addresses, the latest first
+func (d *Driver) GetValidatorAddresses(ctx context.Context, params structs.ValidatorAddressParams) (addresses []structs.ValidatorAddress, err error) {
+	q := `SELECT id, created_at, validator_id, address, requested_address, block_height, time, transaction_hash, event_name
+		FROM validator_addresses`
+
+	var (
+		args   []interface{}
+		wherec []string
+		i      = 1
+	)
+	if params.ValidatorID != "" {
+		wherec = append(wherec, ` validator_id = `+param(i))
+		args = append(args, params.ValidatorID)
+		i++
+	}
+	if params.Address != "" {
+		wherec = append(wherec, ` (address = `+param(i)+` OR requested_address = `+param(i)+`)`)
+		args = append(args, common.HexToAddress(params.Address).Hex())
+		i++
+	}
+	if len(wherec) > 0 {
+		q += ` WHERE ` + strings.Join(wherec, " AND ")
+	}
+
+	q += ` ORDER BY block_height DESC, validator_id`
+	q += page(params.Limit, params.Offset)
+
+	rows, err := d.conn(ctx).QueryContext(ctx, q, args...)
+	if err != nil {
+		return nil, fmt.Errorf("query error: %w", err)
+	}
+
+	defer rows.Close()
+	for rows.Next() {
+		var (
+			va                         structs.ValidatorAddress
+			createdAt, blockTime       int64
+			validatorID                string
+			address, requested, txHash string
+		)
+		if err := rows.Scan(&va.ID, &createdAt, &validatorID, &address, &requested, &va.BlockHeight, &blockTime, &txHash, &va.EventName); err != nil {
+			return nil, err
+		}
+		va.CreatedAt = fromMicros(createdAt)
+		va.ValidatorID = parseNum(validatorID)
+		va.Address = common.HexToAddress(address)
+		va.RequestedAddress = common.HexToAddress(requested)
+		va.Time = fromMicros(blockTime)
+		va.TransactionHash = common.HexToHash(txHash)
+		addresses = append(addresses, va)
+	}
+	return addresses, rows.Err()
+}
@@ -0,0 +1,163 @@
+package sqlite
+
+import (
+	"context"
+	"database/sql"
+	"math/big"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/figment-networks/skale-indexer/scraper/structs"
+)
+
+func (d *Driver) SaveValidatorStatistic(ctx context.Context, validatorID *big.Int, blockHeight uint64, blockTime time.Time, statisticsType structs.StatisticTypeVS, amount *big.Int) (err error) {
+	if deferWrite(ctx, func(ctx context.Context) error {
+		return d.SaveValidatorStatistic(ctx, validatorID, blockHeight, blockTime, statisticsType, amount)
+	}) {
+		return nil
+	}
+
+	// Update value in validator_statistics unless the value already exists
+	_, err = d.conn(ctx).ExecContext(ctx, `
+	INSERT INTO validator_statistics (id, created_at, validator_id, block_height, time, statistic_type, amount)
+		SELECT ?1, ?2, ?3, ?4, ?5, ?6, ?7 WHERE ?7 NOT IN ( SELECT amount FROM validator_statistics WHERE validator_id = ?3 AND statistic_type = ?6 AND block_height < ?4 ORDER BY block_height DESC LIMIT 1)
+		ON CONFLICT (validator_id, block_height, statistic_type)
+		DO UPDATE SET amount = excluded.amount;`,
+		uuid.New().String(), micros(time.Now()), validatorID.String(), blockHeight, micros(blockTime), statisticsType, amount.String())
+	return err
+}
+
+func (d *Driver) GetValidatorStatistics(ctx context.Context, params structs.ValidatorStatisticsParams) (validatorStatistics []structs.ValidatorStatistics, err error) {
+	// there is no DISTINCT ON, the latest statistic is picked by its row number
+	q := `SELECT
+			id, created_at, validator_id, amount, block_height, time, statistic_type,
+			ROW_NUMBER() OVER (PARTITION BY validator_id, statistic_type ORDER BY block_height DESC) AS rn
+			FROM validator_statistics `
+	var (
+		args   []interface{}
+		wherec []string
+		i      = 1
+	)
+	if params.ValidatorID != "" {
+		wherec = append(wherec, ` validator_id = `+param(i))
+		args = append(args, params.ValidatorID)
+		i++
+	}
+	if params.Type > 0 {
+		wherec = append(wherec, ` statistic_type = `+param(i))
+		args = append(args, params.Type)
+		i++
+	}
+	if params.AtHeight > 0 {
+		wherec = append(wherec, ` block_height <= `+param(i))
+		args = append(args, params.AtHeight)
+		i++
+	}
+	if !params.TimeFrom.IsZero() && !params.TimeTo.IsZero() {
+		wherec = append(wherec, ` time BETWEEN `+param(i)+` AND `+param(i+1))
+		args = append(args, micros(params.TimeFrom), micros(params.TimeTo))
+		i += 2
+	}
+	if len(args) > 0 {
+		q += ` WHERE `
+	}
+	q += strings.Join(wherec, " AND ")
+
+	q = `SELECT id, created_at, validator_id, amount, block_height, time, statistic_type FROM (` + q + `)
+			WHERE rn = 1 ORDER BY validator_id ASC, statistic_type`
+	q += page(params.Limit, params.Offset)
+
+	return d.queryValidatorStatistics(ctx, q, args...)
+}
+
+func (d *Driver) GetValidatorStatisticsTimeline(ctx context.Context, params structs.ValidatorStatisticsParams) (validatorStatistics []structs.ValidatorStatistics, err error) {
+	return d.queryValidatorStatistics(ctx,
+		`SELECT id, created_at, validator_id, amount, block_height, time, statistic_type
+			FROM validator_statistics
+			WHERE
+				validator_id = ?1 AND statistic_type = ?2 AND time BETWEEN ?3 AND ?4
+			ORDER BY block_height DESC`, params.ValidatorID, params.Type, micros(params.TimeFrom), micros(params.TimeTo))
+}
+
+// GetValidatorStatisticsDaily gets the last value of statistic in every day (UTC) it changed within the time range, from the rollup table,
+// with the last value before the range of every validator
+func (d *Driver) GetValidatorStatisticsDaily(ctx context.Context, params structs.ValidatorStatisticsParams) (validatorStatistics []structs.ValidatorStatistics, err error) {
+	args := []interface{}{params.Type, micros(startOfDay(params.TimeFrom)), micros(startOfDay(params.TimeTo))}
+	var byValidator string
+	if params.ValidatorID != "" {
+		byValidator = ` AND validator_id = ?4`
+		args = append(args, params.ValidatorID)
+	}
+
+	var rows *sql.Rows
+	rows, err = d.conn(ctx).QueryContext(ctx,
+		`SELECT validator_id, amount, block_height, day, statistic_type FROM (
+			SELECT validator_id, amount, block_height, day, statistic_type
+				FROM validator_statistics_daily
+				WHERE statistic_type = ?1 AND day BETWEEN ?2 AND ?3`+byValidator+`
+			UNION ALL
+			SELECT validator_id, amount, block_height, day, statistic_type FROM (
+				SELECT validator_id, amount, block_height, day, statistic_type,
+					ROW_NUMBER() OVER (PARTITION BY validator_id ORDER BY day DESC) AS rn
+				FROM validator_statistics_daily
+				WHERE statistic_type = ?1 AND day < ?2`+byValidator+`)
+			WHERE rn = 1)
+		ORDER BY validator_id ASC, day ASC`, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var (
+		day           int64
+		vldID, amount string
+	)
+
+	for rows.Next() {
+		vs := structs.ValidatorStatistics{}
+		if err = rows.Scan(&vldID, &amount, &vs.BlockHeight, &day, &vs.Type); err != nil {
+			return nil, err
+		}
+		vs.Time = fromMicros(day)
+		vs.ValidatorID = parseNum(vldID)
+		vs.Amount = parseNum(amount)
+		validatorStatistics = append(validatorStatistics, vs)
+	}
+	return validatorStatistics, rows.Err()
+}
+
+// startOfDay is the beginning of the day (UTC) of the time
+func startOfDay(t time.Time) time.Time {
+	t = t.UTC()
+	return time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.UTC)
+}
+
+func (d *Driver) queryValidatorStatistics(ctx context.Context, q string, args ...interface{}) (validatorStatistics []structs.ValidatorStatistics, err error) {
+	var rows *sql.Rows
+	rows, err = d.conn(ctx).QueryContext(ctx, q, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var (
+		createdAt, t  int64
+		vldID, amount string
+	)
+
+	for rows.Next() {
+		vs := structs.ValidatorStatistics{}
+		err = rows.Scan(&vs.ID, &createdAt, &vldID, &amount, &vs.BlockHeight, &t, &vs.Type)
+		if err != nil {
+			return nil, err
+		}
+		vs.CreatedAt = fromMicros(createdAt)
+		vs.Time = fromMicros(t)
+		vs.ValidatorID = parseNum(vldID)
+		vs.Amount = parseNum(amount)
+		validatorStatistics = append(validatorStatistics, vs)
+	}
+	return validatorStatistics, rows.Err()
+}
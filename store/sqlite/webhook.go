@@ -0,0 +1,317 @@
+package sqlite
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"math/big"
+	"strings"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/google/uuid"
+
+	"github.com/figment-networks/skale-indexer/scraper/structs"
+)
+
+// CreateWebhook saves new webhook
+func (d *Driver) CreateWebhook(ctx context.Context, wh structs.Webhook) (id string, err error) {
+	validatorID, address, kinds, err := webhookFilter(wh)
+	if err != nil {
+		return "", err
+	}
+
+	id = uuid.New().String()
+	_, err = d.conn(ctx).ExecContext(ctx, `INSERT INTO webhooks (
+			"id", "created_at", "updated_at", "url", "secret", "kinds", "validator_id", "address", "active")
+		VALUES (?1, ?2, ?2, ?3, ?4, ?5, ?6, ?7, ?8)`,
+		id,
+		micros(time.Now()),
+		wh.URL,
+		wh.Secret,
+		kinds,
+		validatorID,
+		address,
+		wh.Active)
+	return id, err
+}
+
+// UpdateWebhook updates url, secret, filter and activity of webhook
+func (d *Driver) UpdateWebhook(ctx context.Context, wh structs.Webhook) error {
+	validatorID, address, kinds, err := webhookFilter(wh)
+	if err != nil {
+		return err
+	}
+
+	res, err := d.conn(ctx).ExecContext(ctx, `UPDATE webhooks
+		SET updated_at = ?2, url = ?3, secret = ?4, kinds = ?5, validator_id = ?6, address = ?7, active = ?8
+		WHERE id = ?1`,
+		wh.ID,
+		micros(time.Now()),
+		wh.URL,
+		wh.Secret,
+		kinds,
+		validatorID,
+		address,
+		wh.Active)
+	if err != nil {
+		return err
+	}
+
+	n, err := res.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if n == 0 {
+		return structs.ErrNotFound
+	}
+	return nil
+}
+
+// GetWebhooks gets webhooks, the oldest first
+func (d *Driver) GetWebhooks(ctx context.Context, params structs.WebhookParams) (webhooks []structs.Webhook, err error) {
+	q := `SELECT id, created_at, updated_at, url, secret, kinds, validator_id, address, active FROM webhooks `
+
+	var (
+		args   []interface{}
+		whereC []string
+		i      = 1
+	)
+
+	if params.ID != "" {
+		whereC = append(whereC, ` id = `+param(i))
+		args = append(args, params.ID)
+		i++
+	}
+
+	if params.Active {
+		whereC = append(whereC, ` active = 1`)
+	}
+
+	if len(whereC) > 0 {
+		q += " WHERE "
+	}
+
+	q += strings.Join(whereC, " AND ")
+	q += ` ORDER BY created_at ASC, id ASC `
+	q += page(params.Limit, params.Offset)
+
+	rows, err := d.conn(ctx).QueryContext(ctx, q, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var (
+			wh                   structs.Webhook
+			createdAt, updatedAt int64
+			kinds                string
+			validatorID, address sql.NullString
+		)
+		if err = rows.Scan(&wh.ID, &createdAt, &updatedAt, &wh.URL, &wh.Secret, &kinds, &validatorID, &address, &wh.Active); err != nil {
+			return nil, err
+		}
+
+		wh.CreatedAt = fromMicros(createdAt)
+		wh.UpdatedAt = fromMicros(updatedAt)
+		if err = json.Unmarshal([]byte(kinds), &wh.Kinds); err != nil {
+			return nil, err
+		}
+		if validatorID.Valid {
+			wh.ValidatorID, _ = new(big.Int).SetString(validatorID.String, 10)
+		}
+		if address.Valid {
+			wh.Address = common.HexToAddress(address.String)
+		}
+		webhooks = append(webhooks, wh)
+	}
+	if err = rows.Err(); err != nil {
+		return nil, err
+	}
+
+	if len(webhooks) == 0 && params.ID != "" {
+		return nil, structs.ErrNotFound
+	}
+
+	return webhooks, nil
+}
+
+// DeleteWebhook removes webhook together with its deliveries
+func (d *Driver) DeleteWebhook(ctx context.Context, id string) error {
+	return d.Atomic(ctx, func(ctx context.Context) error {
+		if _, err := d.conn(ctx).ExecContext(ctx, `DELETE FROM webhook_deliveries WHERE webhook_id = ?1`, id); err != nil {
+			return err
+		}
+
+		res, err := d.conn(ctx).ExecContext(ctx, `DELETE FROM webhooks WHERE id = ?1`, id)
+		if err != nil {
+			return err
+		}
+
+		n, err := res.RowsAffected()
+		if err != nil {
+			return err
+		}
+		if n == 0 {
+			return structs.ErrNotFound
+		}
+		return nil
+	})
+}
+
+// CreateWebhookDelivery queues delivery, unless the event is already queued for the webhook
+func (d *Driver) CreateWebhookDelivery(ctx context.Context, wd structs.WebhookDelivery) error {
+	e, err := json.Marshal(&wd.Event)
+	if err != nil {
+		return err
+	}
+
+	_, err = d.conn(ctx).ExecContext(ctx, `INSERT INTO webhook_deliveries (
+			"id", "webhook_id", "created_at", "updated_at", "event_key", "event", "status", "attempts", "next_retry", "response_code", "error", "delivered_at")
+		VALUES (?1, ?2, ?3, ?3, ?4, ?5, ?6, ?7, ?8, ?9, ?10, 0)
+		ON CONFLICT (webhook_id, event_key) DO NOTHING`,
+		uuid.New().String(),
+		wd.WebhookID,
+		micros(time.Now()),
+		wd.Event.Key(),
+		string(e),
+		wd.Status,
+		wd.Attempts,
+		micros(wd.NextRetry),
+		wd.ResponseCode,
+		wd.Error)
+	return err
+}
+
+// UpdateWebhookDelivery records the outcome of delivery attempt
+func (d *Driver) UpdateWebhookDelivery(ctx context.Context, wd structs.WebhookDelivery) error {
+	var deliveredAt int64
+	if !wd.DeliveredAt.IsZero() {
+		deliveredAt = micros(wd.DeliveredAt)
+	}
+
+	res, err := d.conn(ctx).ExecContext(ctx, `UPDATE webhook_deliveries
+		SET updated_at = ?2, status = ?3, attempts = ?4, next_retry = ?5, response_code = ?6, error = ?7, delivered_at = ?8
+		WHERE id = ?1`,
+		wd.ID,
+		micros(time.Now()),
+		wd.Status,
+		wd.Attempts,
+		micros(wd.NextRetry),
+		wd.ResponseCode,
+		wd.Error,
+		deliveredAt)
+	if err != nil {
+		return err
+	}
+
+	n, err := res.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if n == 0 {
+		return structs.ErrNotFound
+	}
+	return nil
+}
+
+// GetWebhookDeliveries gets deliveries, the earliest due first when they're listed for retry, the latest created first otherwise
+func (d *Driver) GetWebhookDeliveries(ctx context.Context, params structs.WebhookDeliveryParams) (deliveries []structs.WebhookDelivery, err error) {
+	q := `SELECT id, webhook_id, created_at, updated_at, event, status, attempts, next_retry, response_code, error, delivered_at FROM webhook_deliveries `
+
+	var (
+		args   []interface{}
+		whereC []string
+		i      = 1
+	)
+
+	if params.ID != "" {
+		whereC = append(whereC, ` id = `+param(i))
+		args = append(args, params.ID)
+		i++
+	}
+
+	if params.WebhookID != "" {
+		whereC = append(whereC, ` webhook_id = `+param(i))
+		args = append(args, params.WebhookID)
+		i++
+	}
+
+	if params.Status != "" {
+		whereC = append(whereC, ` status = `+param(i))
+		args = append(args, params.Status)
+		i++
+	}
+
+	if !params.RetryBefore.IsZero() {
+		whereC = append(whereC, ` next_retry <= `+param(i))
+		args = append(args, micros(params.RetryBefore))
+		i++
+	}
+
+	if len(whereC) > 0 {
+		q += " WHERE "
+	}
+
+	q += strings.Join(whereC, " AND ")
+	if !params.RetryBefore.IsZero() {
+		q += ` ORDER BY next_retry ASC, created_at ASC `
+	} else {
+		q += ` ORDER BY created_at DESC, id DESC `
+	}
+	q += page(params.Limit, params.Offset)
+
+	rows, err := d.conn(ctx).QueryContext(ctx, q, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var (
+			wd                                           structs.WebhookDelivery
+			createdAt, updatedAt, nextRetry, deliveredAt int64
+			e                                            string
+		)
+		if err = rows.Scan(&wd.ID, &wd.WebhookID, &createdAt, &updatedAt, &e, &wd.Status, &wd.Attempts, &nextRetry, &wd.ResponseCode, &wd.Error, &deliveredAt); err != nil {
+			return nil, err
+		}
+
+		wd.CreatedAt = fromMicros(createdAt)
+		wd.UpdatedAt = fromMicros(updatedAt)
+		wd.NextRetry = fromMicros(nextRetry)
+		if deliveredAt > 0 {
+			wd.DeliveredAt = fromMicros(deliveredAt)
+		}
+		if err = json.Unmarshal([]byte(e), &wd.Event); err != nil {
+			return nil, err
+		}
+		deliveries = append(deliveries, wd)
+	}
+	if err = rows.Err(); err != nil {
+		return nil, err
+	}
+
+	if len(deliveries) == 0 && params.ID != "" {
+		return nil, structs.ErrNotFound
+	}
+
+	return deliveries, nil
+}
+
+// webhookFilter returns filter of webhook as column values, NULL when not set
+func webhookFilter(wh structs.Webhook) (validatorID, address interface{}, kinds string, err error) {
+	if wh.ValidatorID != nil {
+		validatorID = wh.ValidatorID.String()
+	}
+	if wh.Address != (common.Address{}) {
+		address = wh.Address.Hex()
+	}
+	k := wh.Kinds
+	if k == nil {
+		k = []structs.SysEvtType{}
+	}
+	b, err := json.Marshal(k)
+	return validatorID, address, string(b), err
+}
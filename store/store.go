@@ -16,31 +16,56 @@ type DBDriver interface {
 	ContractEventStore
 	SystemEventStore
 	SkaleStore
+	BlockStore
+	TransactionStore
+	FailedEventStore
+	WebhookStore
+	OutboxStore
+	DecentralizationStore
+	AtomicStore
+	BulkStore
 }
 
 type DataStore interface {
 	ContractEventStore
 	SystemEventStore
 	SkaleStore
+	BlockStore
+	TransactionStore
+	FailedEventStore
+	WebhookStore
+	OutboxStore
+	DecentralizationStore
+	AtomicStore
+	BulkStore
 }
 
 type SkaleStore interface {
 	SaveNodes(ctx context.Context, nodes []structs.Node, removedNodeAddress common.Address) error
 	GetNodes(ctx context.Context, params structs.NodeParams) (nodes []structs.Node, err error)
+	CountNodes(ctx context.Context, params structs.NodeParams) (count uint64, err error)
+	GetNodeTimeline(ctx context.Context, params structs.NodeParams) (changes []structs.NodeChange, err error)
 
 	SaveValidator(ctx context.Context, validator structs.Validator) error
 	GetValidators(ctx context.Context, params structs.ValidatorParams) (validators []structs.Validator, err error)
+	CountValidators(ctx context.Context, params structs.ValidatorParams) (count uint64, err error)
+	SaveValidatorAddress(ctx context.Context, va structs.ValidatorAddress) error
+	GetValidatorAddresses(ctx context.Context, params structs.ValidatorAddressParams) (addresses []structs.ValidatorAddress, err error)
 
 	SaveDelegation(ctx context.Context, delegation structs.Delegation) error
 	GetDelegations(ctx context.Context, params structs.DelegationParams) (delegations []structs.Delegation, err error)
+	CountDelegations(ctx context.Context, params structs.DelegationParams) (count uint64, err error)
 	GetDelegationTimeline(ctx context.Context, params structs.DelegationParams) (delegations []structs.Delegation, err error)
+	CountDelegationTimeline(ctx context.Context, params structs.DelegationParams) (count uint64, err error)
 
 	SaveAccount(ctx context.Context, account structs.Account) error
 	GetAccounts(ctx context.Context, params structs.AccountParams) (accounts []structs.Account, err error)
+	CountAccounts(ctx context.Context, params structs.AccountParams) (count uint64, err error)
 
 	SaveValidatorStatistic(ctx context.Context, validatorID *big.Int, blockHeight uint64, blockTime time.Time, statisticsType structs.StatisticTypeVS, amount *big.Int) (err error)
 	GetValidatorStatistics(ctx context.Context, params structs.ValidatorStatisticsParams) (validatorStatistics []structs.ValidatorStatistics, err error)
 	GetValidatorStatisticsTimeline(ctx context.Context, params structs.ValidatorStatisticsParams) (validatorStatistics []structs.ValidatorStatistics, err error)
+	GetValidatorStatisticsDaily(ctx context.Context, params structs.ValidatorStatisticsParams) (validatorStatistics []structs.ValidatorStatistics, err error)
 
 	GetTypesSummaryDelegations(ctx context.Context, params structs.DelegationParams) (delegations []structs.DelegationSummary, err error)
 
@@ -50,11 +75,74 @@ type SkaleStore interface {
 type ContractEventStore interface {
 	SaveContractEvent(ctx context.Context, contractEvent structs.ContractEvent) error
 	GetContractEvents(ctx context.Context, params structs.EventParams) (contractEvents []structs.ContractEvent, err error)
+	CountContractEvents(ctx context.Context, params structs.EventParams) (count uint64, err error)
 }
 
 type SystemEventStore interface {
 	SaveSystemEvent(ctx context.Context, event structs.SystemEvent) error
 	GetSystemEvents(ctx context.Context, params structs.SystemEventParams) (events []structs.SystemEvent, err error)
+	CountSystemEvents(ctx context.Context, params structs.SystemEventParams) (count uint64, err error)
+}
+
+type BlockStore interface {
+	SaveBlock(ctx context.Context, block structs.Block) error
+	GetBlock(ctx context.Context, height uint64) (block structs.Block, err error)
+	GetBlockBefore(ctx context.Context, height uint64) (block structs.Block, err error)
+	GetBlockBounds(ctx context.Context, t time.Time) (before, after structs.Block, err error)
+}
+
+type TransactionStore interface {
+	SaveTransaction(ctx context.Context, tx structs.Transaction) error
+	GetTransaction(ctx context.Context, hash common.Hash) (tx structs.Transaction, err error)
+}
+
+// AtomicStore runs store operations as a single unit of work.
+// Every call made with the context given to fn commits or rolls back together
+type AtomicStore interface {
+	Atomic(ctx context.Context, fn func(ctx context.Context) error) error
+}
+
+// BulkStore buffers high-volume writes made with the context given to fn. Writes of Atomic scopes which succeed
+// are kept when fn fails, the rest are loaded at once only when fn succeeds.
+// Writes made within fn may not be visible for reads outside of Atomic until Bulk returns
+type BulkStore interface {
+	Bulk(ctx context.Context, fn func(ctx context.Context) error) error
+}
+
+type FailedEventStore interface {
+	SaveFailedEvent(ctx context.Context, fe structs.FailedEvent) error
+	GetFailedEvents(ctx context.Context, params structs.FailedEventParams) (failedEvents []structs.FailedEvent, err error)
+	DeleteFailedEvent(ctx context.Context, id string) error
+	DeleteFailedEventOfLog(ctx context.Context, txHash common.Hash, logIndex uint) error
+}
+
+// WebhookStore keeps webhooks and the queue of their deliveries. Deliveries are removed together with their webhook
+type WebhookStore interface {
+	CreateWebhook(ctx context.Context, wh structs.Webhook) (id string, err error)
+	UpdateWebhook(ctx context.Context, wh structs.Webhook) error
+	GetWebhooks(ctx context.Context, params structs.WebhookParams) (webhooks []structs.Webhook, err error)
+	DeleteWebhook(ctx context.Context, id string) error
+
+	// CreateWebhookDelivery queues delivery, unless the event is already queued for the webhook
+	CreateWebhookDelivery(ctx context.Context, d structs.WebhookDelivery) error
+	UpdateWebhookDelivery(ctx context.Context, d structs.WebhookDelivery) error
+	GetWebhookDeliveries(ctx context.Context, params structs.WebhookDeliveryParams) (deliveries []structs.WebhookDelivery, err error)
+}
+
+// OutboxStore keeps messages saved in the same unit of work as records they carry, until they're published
+type OutboxStore interface {
+	SaveOutboxMessages(ctx context.Context, msgs []structs.OutboxMessage) error
+	// GetOutboxMessages gets messages in order they were saved in
+	GetOutboxMessages(ctx context.Context, params structs.OutboxParams) (msgs []structs.OutboxMessage, err error)
+	DeleteOutboxMessages(ctx context.Context, ids []uint64) error
+}
+
+// DecentralizationStore keeps stake decentralisation metrics per epoch
+type DecentralizationStore interface {
+	// SaveDecentralization saves metrics of the epoch, replacing the ones computed before
+	SaveDecentralization(ctx context.Context, dc structs.Decentralization) error
+	// GetDecentralization gets metrics of epochs, the latest first
+	GetDecentralization(ctx context.Context, params structs.DecentralizationParams) (dcs []structs.Decentralization, err error)
 }
 
 type Store struct {
@@ -75,6 +163,10 @@ func (s *Store) GetAccounts(ctx context.Context, params structs.AccountParams) (
 	return s.driver.GetAccounts(ctx, params)
 }
 
+func (s *Store) CountAccounts(ctx context.Context, params structs.AccountParams) (count uint64, err error) {
+	return s.driver.CountAccounts(ctx, params)
+}
+
 func (s *Store) SaveNodes(ctx context.Context, nodes []structs.Node, removedNodeAddress common.Address) error {
 	return s.driver.SaveNodes(ctx, nodes, removedNodeAddress)
 }
@@ -83,6 +175,14 @@ func (s *Store) GetNodes(ctx context.Context, params structs.NodeParams) (nodes
 	return s.driver.GetNodes(ctx, params)
 }
 
+func (s *Store) CountNodes(ctx context.Context, params structs.NodeParams) (count uint64, err error) {
+	return s.driver.CountNodes(ctx, params)
+}
+
+func (s *Store) GetNodeTimeline(ctx context.Context, params structs.NodeParams) (changes []structs.NodeChange, err error) {
+	return s.driver.GetNodeTimeline(ctx, params)
+}
+
 func (s *Store) SaveValidator(ctx context.Context, validator structs.Validator) error {
 	return s.driver.SaveValidator(ctx, validator)
 }
@@ -91,6 +191,18 @@ func (s *Store) GetValidators(ctx context.Context, params structs.ValidatorParam
 	return s.driver.GetValidators(ctx, params)
 }
 
+func (s *Store) CountValidators(ctx context.Context, params structs.ValidatorParams) (count uint64, err error) {
+	return s.driver.CountValidators(ctx, params)
+}
+
+func (s *Store) SaveValidatorAddress(ctx context.Context, va structs.ValidatorAddress) error {
+	return s.driver.SaveValidatorAddress(ctx, va)
+}
+
+func (s *Store) GetValidatorAddresses(ctx context.Context, params structs.ValidatorAddressParams) (addresses []structs.ValidatorAddress, err error) {
+	return s.driver.GetValidatorAddresses(ctx, params)
+}
+
 func (s *Store) SaveDelegation(ctx context.Context, delegation structs.Delegation) error {
 	return s.driver.SaveDelegation(ctx, delegation)
 }
@@ -99,10 +211,18 @@ func (s *Store) GetDelegations(ctx context.Context, params structs.DelegationPar
 	return s.driver.GetDelegations(ctx, params)
 }
 
+func (s *Store) CountDelegations(ctx context.Context, params structs.DelegationParams) (count uint64, err error) {
+	return s.driver.CountDelegations(ctx, params)
+}
+
 func (s *Store) GetDelegationTimeline(ctx context.Context, params structs.DelegationParams) (delegations []structs.Delegation, err error) {
 	return s.driver.GetDelegationTimeline(ctx, params)
 }
 
+func (s *Store) CountDelegationTimeline(ctx context.Context, params structs.DelegationParams) (count uint64, err error) {
+	return s.driver.CountDelegationTimeline(ctx, params)
+}
+
 func (s *Store) GetValidatorStatistics(ctx context.Context, params structs.ValidatorStatisticsParams) (validatorStatistics []structs.ValidatorStatistics, err error) {
 	return s.driver.GetValidatorStatistics(ctx, params)
 }
@@ -111,6 +231,10 @@ func (s *Store) GetValidatorStatisticsTimeline(ctx context.Context, params struc
 	return s.driver.GetValidatorStatisticsTimeline(ctx, params)
 }
 
+func (s *Store) GetValidatorStatisticsDaily(ctx context.Context, params structs.ValidatorStatisticsParams) (validatorStatistics []structs.ValidatorStatistics, err error) {
+	return s.driver.GetValidatorStatisticsDaily(ctx, params)
+}
+
 func (s *Store) SaveValidatorStatistic(ctx context.Context, validatorID *big.Int, blockHeight uint64, blockTime time.Time, statisticsType structs.StatisticTypeVS, amount *big.Int) (err error) {
 	return s.driver.SaveValidatorStatistic(ctx, validatorID, blockHeight, blockTime, statisticsType, amount)
 }
@@ -134,6 +258,10 @@ func (s *Store) GetContractEvents(ctx context.Context, params structs.EventParam
 	return s.driver.GetContractEvents(ctx, params)
 }
 
+func (s *Store) CountContractEvents(ctx context.Context, params structs.EventParams) (count uint64, err error) {
+	return s.driver.CountContractEvents(ctx, params)
+}
+
 // System events
 
 func (s *Store) SaveSystemEvent(ctx context.Context, event structs.SystemEvent) error {
@@ -143,3 +271,117 @@ func (s *Store) SaveSystemEvent(ctx context.Context, event structs.SystemEvent)
 func (s *Store) GetSystemEvents(ctx context.Context, params structs.SystemEventParams) (event []structs.SystemEvent, err error) {
 	return s.driver.GetSystemEvents(ctx, params)
 }
+
+func (s *Store) CountSystemEvents(ctx context.Context, params structs.SystemEventParams) (count uint64, err error) {
+	return s.driver.CountSystemEvents(ctx, params)
+}
+
+// Blocks
+
+func (s *Store) SaveBlock(ctx context.Context, block structs.Block) error {
+	return s.driver.SaveBlock(ctx, block)
+}
+
+func (s *Store) GetBlock(ctx context.Context, height uint64) (block structs.Block, err error) {
+	return s.driver.GetBlock(ctx, height)
+}
+
+func (s *Store) GetBlockBefore(ctx context.Context, height uint64) (block structs.Block, err error) {
+	return s.driver.GetBlockBefore(ctx, height)
+}
+
+func (s *Store) GetBlockBounds(ctx context.Context, t time.Time) (before, after structs.Block, err error) {
+	return s.driver.GetBlockBounds(ctx, t)
+}
+
+// Transactions
+
+func (s *Store) SaveTransaction(ctx context.Context, tx structs.Transaction) error {
+	return s.driver.SaveTransaction(ctx, tx)
+}
+
+func (s *Store) GetTransaction(ctx context.Context, hash common.Hash) (tx structs.Transaction, err error) {
+	return s.driver.GetTransaction(ctx, hash)
+}
+
+// Unit of work
+
+func (s *Store) Atomic(ctx context.Context, fn func(ctx context.Context) error) error {
+	return s.driver.Atomic(ctx, fn)
+}
+
+func (s *Store) Bulk(ctx context.Context, fn func(ctx context.Context) error) error {
+	return s.driver.Bulk(ctx, fn)
+}
+
+// Failed Events
+
+func (s *Store) SaveFailedEvent(ctx context.Context, fe structs.FailedEvent) error {
+	return s.driver.SaveFailedEvent(ctx, fe)
+}
+
+func (s *Store) GetFailedEvents(ctx context.Context, params structs.FailedEventParams) (failedEvents []structs.FailedEvent, err error) {
+	return s.driver.GetFailedEvents(ctx, params)
+}
+
+func (s *Store) DeleteFailedEvent(ctx context.Context, id string) error {
+	return s.driver.DeleteFailedEvent(ctx, id)
+}
+
+func (s *Store) DeleteFailedEventOfLog(ctx context.Context, txHash common.Hash, logIndex uint) error {
+	return s.driver.DeleteFailedEventOfLog(ctx, txHash, logIndex)
+}
+
+// Webhooks
+
+func (s *Store) CreateWebhook(ctx context.Context, wh structs.Webhook) (id string, err error) {
+	return s.driver.CreateWebhook(ctx, wh)
+}
+
+func (s *Store) UpdateWebhook(ctx context.Context, wh structs.Webhook) error {
+	return s.driver.UpdateWebhook(ctx, wh)
+}
+
+func (s *Store) GetWebhooks(ctx context.Context, params structs.WebhookParams) (webhooks []structs.Webhook, err error) {
+	return s.driver.GetWebhooks(ctx, params)
+}
+
+func (s *Store) DeleteWebhook(ctx context.Context, id string) error {
+	return s.driver.DeleteWebhook(ctx, id)
+}
+
+func (s *Store) CreateWebhookDelivery(ctx context.Context, d structs.WebhookDelivery) error {
+	return s.driver.CreateWebhookDelivery(ctx, d)
+}
+
+func (s *Store) UpdateWebhookDelivery(ctx context.Context, d structs.WebhookDelivery) error {
+	return s.driver.UpdateWebhookDelivery(ctx, d)
+}
+
+func (s *Store) GetWebhookDeliveries(ctx context.Context, params structs.WebhookDeliveryParams) (deliveries []structs.WebhookDelivery, err error) {
+	return s.driver.GetWebhookDeliveries(ctx, params)
+}
+
+// Outbox
+
+func (s *Store) SaveOutboxMessages(ctx context.Context, msgs []structs.OutboxMessage) error {
+	return s.driver.SaveOutboxMessages(ctx, msgs)
+}
+
+func (s *Store) GetOutboxMessages(ctx context.Context, params structs.OutboxParams) (msgs []structs.OutboxMessage, err error) {
+	return s.driver.GetOutboxMessages(ctx, params)
+}
+
+func (s *Store) DeleteOutboxMessages(ctx context.Context, ids []uint64) error {
+	return s.driver.DeleteOutboxMessages(ctx, ids)
+}
+
+// Decentralization
+
+func (s *Store) SaveDecentralization(ctx context.Context, dc structs.Decentralization) error {
+	return s.driver.SaveDecentralization(ctx, dc)
+}
+
+func (s *Store) GetDecentralization(ctx context.Context, params structs.DecentralizationParams) (dcs []structs.Decentralization, err error) {
+	return s.driver.GetDecentralization(ctx, params)
+}
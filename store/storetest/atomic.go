@@ -0,0 +1,143 @@
+package storetest
+
+import (
+	"context"
+	"errors"
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/figment-networks/skale-indexer/scraper/structs"
+	"github.com/figment-networks/skale-indexer/store"
+)
+
+var errTest = errors.New("test error")
+
+func testAtomic(t *testing.T, d store.DBDriver) {
+	ctx := context.Background()
+
+	err := d.Atomic(ctx, func(ctx context.Context) error {
+		require.NoError(t, d.SaveBlock(ctx, structs.Block{Number: 1, Time: at(1)}))
+		// writes are visible within the scope
+		_, err := d.GetBlock(ctx, 1)
+		require.NoError(t, err)
+		return errTest
+	})
+	require.ErrorIs(t, err, errTest)
+	_, err = d.GetBlock(ctx, 1)
+	require.ErrorIs(t, err, structs.ErrNotFound)
+
+	err = d.Atomic(ctx, func(ctx context.Context) error {
+		require.NoError(t, d.SaveBlock(ctx, structs.Block{Number: 1, Time: at(1)}))
+		// failed nested call is rolled back alone
+		nestedErr := d.Atomic(ctx, func(ctx context.Context) error {
+			require.NoError(t, d.SaveBlock(ctx, structs.Block{Number: 2, Time: at(2)}))
+			return errTest
+		})
+		require.ErrorIs(t, nestedErr, errTest)
+		return d.Atomic(ctx, func(ctx context.Context) error {
+			return d.SaveBlock(ctx, structs.Block{Number: 3, Time: at(3)})
+		})
+	})
+	require.NoError(t, err)
+
+	_, err = d.GetBlock(ctx, 1)
+	require.NoError(t, err)
+	_, err = d.GetBlock(ctx, 2)
+	require.ErrorIs(t, err, structs.ErrNotFound)
+	_, err = d.GetBlock(ctx, 3)
+	require.NoError(t, err)
+
+	require.Panics(t, func() {
+		d.Atomic(ctx, func(ctx context.Context) error {
+			require.NoError(t, d.SaveBlock(ctx, structs.Block{Number: 4, Time: at(4)}))
+			panic("test panic")
+		})
+	})
+	_, err = d.GetBlock(ctx, 4)
+	require.ErrorIs(t, err, structs.ErrNotFound)
+}
+
+func testBulk(t *testing.T, d store.DBDriver) {
+	ctx := context.Background()
+
+	require.NoError(t, d.SaveValidator(ctx, validator(1, "first", addrA, 1, true, 0)))
+
+	err := d.Bulk(ctx, func(ctx context.Context) error {
+		for h := uint64(10); h < 15; h++ {
+			if err := d.SaveContractEvent(ctx, contractEvent(h, "validator", 1)); err != nil {
+				return err
+			}
+			if err := d.SaveValidatorStatistic(ctx, big.NewInt(1), h, at(int(h)), structs.ValidatorStatisticsTypeTotalStake, big.NewInt(int64(h/2))); err != nil {
+				return err
+			}
+			if err := d.SaveAccount(ctx, structs.Account{Address: addrB, Type: structs.AccountTypeDelegator}); err != nil {
+				return err
+			}
+			if err := d.UpdateCountsOfValidator(ctx, big.NewInt(1)); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	require.NoError(t, err)
+
+	ces, err := d.GetContractEvents(ctx, structs.EventParams{})
+	require.NoError(t, err)
+	require.Equal(t, []uint64{14, 13, 12, 11, 10}, contractEventHeights(ces))
+
+	// unchanged values are skipped the same as when saved one by one
+	timeline, err := d.GetValidatorStatisticsTimeline(ctx, structs.ValidatorStatisticsParams{
+		ValidatorID: "1",
+		Type:        structs.ValidatorStatisticsTypeTotalStake,
+		TimeFrom:    at(0),
+		TimeTo:      at(20),
+	})
+	require.NoError(t, err)
+	require.Len(t, timeline, 3)
+	require.Equal(t, []uint64{14, 12, 10}, []uint64{timeline[0].BlockHeight, timeline[1].BlockHeight, timeline[2].BlockHeight})
+
+	accounts, err := d.GetAccounts(ctx, structs.AccountParams{})
+	require.NoError(t, err)
+	require.Len(t, accounts, 1)
+
+	validators, err := d.GetValidators(ctx, structs.ValidatorParams{ValidatorID: "1"})
+	require.NoError(t, err)
+	requireBig(t, 7, validators[0].Staked)
+
+	// failed bulk is discarded, except for the scopes committed within it
+	err = d.Bulk(ctx, func(ctx context.Context) error {
+		require.NoError(t, d.Atomic(ctx, func(ctx context.Context) error {
+			return d.SaveContractEvent(ctx, contractEvent(20, "validator", 1))
+		}))
+		require.NoError(t, d.SaveContractEvent(ctx, contractEvent(21, "validator", 1)))
+		return errTest
+	})
+	require.ErrorIs(t, err, errTest)
+	ces, err = d.GetContractEvents(ctx, structs.EventParams{})
+	require.NoError(t, err)
+	require.Equal(t, []uint64{20, 14, 13, 12, 11, 10}, contractEventHeights(ces))
+
+	// scope rejected within bulk is undone alone, its buffered writes included
+	err = d.Bulk(ctx, func(ctx context.Context) error {
+		require.ErrorIs(t, d.Atomic(ctx, func(ctx context.Context) error {
+			if err := d.SaveBlock(ctx, structs.Block{Number: 30, Time: at(30)}); err != nil {
+				return err
+			}
+			if err := d.SaveContractEvent(ctx, contractEvent(30, "validator", 1)); err != nil {
+				return err
+			}
+			return errTest
+		}), errTest)
+		return d.Atomic(ctx, func(ctx context.Context) error {
+			return d.SaveContractEvent(ctx, contractEvent(31, "validator", 1))
+		})
+	})
+	require.NoError(t, err)
+	ces, err = d.GetContractEvents(ctx, structs.EventParams{})
+	require.NoError(t, err)
+	require.Equal(t, []uint64{31, 20, 14, 13, 12, 11, 10}, contractEventHeights(ces))
+	_, err = d.GetBlock(ctx, 30)
+	require.ErrorIs(t, err, structs.ErrNotFound)
+}
@@ -0,0 +1,124 @@
+package storetest
+
+import (
+	"context"
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/figment-networks/skale-indexer/scraper/structs"
+	"github.com/figment-networks/skale-indexer/store"
+)
+
+func testBlocks(t *testing.T, d store.DBDriver) {
+	ctx := context.Background()
+
+	for _, b := range []structs.Block{
+		{Number: 1, Hash: hash(101), ParentHash: hash(100), Time: at(0)},
+		{Number: 2, Hash: hash(102), ParentHash: hash(101), Time: at(1)},
+		{Number: 3, Hash: hash(103), ParentHash: hash(102), Time: at(1)},
+		{Number: 4, Hash: hash(104), ParentHash: hash(103), Time: at(3)},
+	} {
+		require.NoError(t, d.SaveBlock(ctx, b))
+	}
+
+	b, err := d.GetBlock(ctx, 2)
+	require.NoError(t, err)
+	require.Equal(t, uint64(2), b.Number)
+	require.Equal(t, hash(102), b.Hash)
+	require.Equal(t, hash(101), b.ParentHash)
+	requireTime(t, at(1), b.Time)
+
+	_, err = d.GetBlock(ctx, 5)
+	require.ErrorIs(t, err, structs.ErrNotFound)
+
+	b, err = d.GetBlockBefore(ctx, 10)
+	require.NoError(t, err)
+	require.Equal(t, uint64(4), b.Number)
+	require.Equal(t, hash(104), b.Hash)
+
+	b, err = d.GetBlockBefore(ctx, 3)
+	require.NoError(t, err)
+	require.Equal(t, uint64(2), b.Number)
+
+	_, err = d.GetBlockBefore(ctx, 1)
+	require.ErrorIs(t, err, structs.ErrNotFound)
+
+	before, after, err := d.GetBlockBounds(ctx, at(1))
+	require.NoError(t, err)
+	require.Equal(t, uint64(3), before.Number)
+	require.Equal(t, uint64(4), after.Number)
+
+	before, after, err = d.GetBlockBounds(ctx, at(-1))
+	require.NoError(t, err)
+	require.Equal(t, structs.Block{}, before)
+	require.Equal(t, uint64(1), after.Number)
+
+	before, after, err = d.GetBlockBounds(ctx, at(5))
+	require.NoError(t, err)
+	require.Equal(t, uint64(4), before.Number)
+	require.Equal(t, structs.Block{}, after)
+
+	// upsert
+	require.NoError(t, d.SaveBlock(ctx, structs.Block{Number: 2, Hash: hash(202), ParentHash: hash(101), Time: at(2)}))
+	b, err = d.GetBlock(ctx, 2)
+	require.NoError(t, err)
+	require.Equal(t, hash(202), b.Hash)
+	requireTime(t, at(2), b.Time)
+}
+
+func testTransactions(t *testing.T, d store.DBDriver) {
+	ctx := context.Background()
+
+	_, err := d.GetTransaction(ctx, hash(1))
+	require.ErrorIs(t, err, structs.ErrNotFound)
+
+	tx := structs.Transaction{
+		Hash:        hash(1),
+		BlockHeight: 10,
+		BlockHash:   hash(10),
+		Index:       2,
+		Time:        at(10),
+		From:        addrA,
+		To:          addrB,
+		Value:       big.NewInt(100),
+		Nonce:       3,
+		Gas:         21000,
+		GasPrice:    big.NewInt(20),
+		GasUsed:     20000,
+		Fee:         big.NewInt(400000),
+		Status:      1,
+	}
+	require.NoError(t, d.SaveTransaction(ctx, tx))
+
+	got, err := d.GetTransaction(ctx, hash(1))
+	require.NoError(t, err)
+	require.Equal(t, tx.Hash, got.Hash)
+	require.Equal(t, tx.BlockHeight, got.BlockHeight)
+	require.Equal(t, tx.BlockHash, got.BlockHash)
+	require.Equal(t, tx.Index, got.Index)
+	require.Equal(t, tx.From, got.From)
+	require.Equal(t, tx.To, got.To)
+	require.Equal(t, tx.Nonce, got.Nonce)
+	require.Equal(t, tx.Gas, got.Gas)
+	require.Equal(t, tx.GasUsed, got.GasUsed)
+	require.Equal(t, tx.Status, got.Status)
+	requireBig(t, 100, got.Value)
+	requireBig(t, 20, got.GasPrice)
+	requireBig(t, 400000, got.Fee)
+	requireTime(t, at(10), got.Time)
+
+	// inclusion is updated, what was sent stays the same
+	moved := tx
+	moved.BlockHeight = 11
+	moved.Value = big.NewInt(1)
+	moved.Status = 0
+	require.NoError(t, d.SaveTransaction(ctx, moved))
+
+	got, err = d.GetTransaction(ctx, hash(1))
+	require.NoError(t, err)
+	require.Equal(t, uint64(11), got.BlockHeight)
+	require.Equal(t, uint64(0), got.Status)
+	requireBig(t, 100, got.Value)
+}
@@ -0,0 +1,65 @@
+package storetest
+
+import (
+	"context"
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/figment-networks/skale-indexer/scraper/structs"
+	"github.com/figment-networks/skale-indexer/store"
+)
+
+func testDecentralization(t *testing.T, d store.DBDriver) {
+	ctx := context.Background()
+
+	metrics := func(epoch uint64, stake int64) structs.Decentralization {
+		return structs.Decentralization{
+			Epoch:               epoch,
+			Time:                at(int(epoch)),
+			BlockHeight:         epoch * 10,
+			Validators:          3,
+			TotalStake:          big.NewInt(stake),
+			NakamotoCoefficient: 1,
+			Gini:                0.25,
+			Top1Share:           0.5,
+			Top5Share:           1,
+			Top10Share:          1,
+			ValidatorsOver33:    1,
+			ValidatorsOver50:    2,
+			ValidatorsOver66:    2,
+		}
+	}
+	epochs := func(dcs []structs.Decentralization) (e []uint64) {
+		for _, dc := range dcs {
+			e = append(e, dc.Epoch)
+		}
+		return e
+	}
+
+	for _, epoch := range []uint64{10, 11, 12} {
+		require.NoError(t, d.SaveDecentralization(ctx, metrics(epoch, 1000)))
+	}
+	// metrics computed again replace the previous ones
+	require.NoError(t, d.SaveDecentralization(ctx, metrics(11, 2000)))
+
+	dcs, err := d.GetDecentralization(ctx, structs.DecentralizationParams{})
+	require.NoError(t, err)
+	require.Equal(t, []uint64{12, 11, 10}, epochs(dcs))
+	requireBig(t, 2000, dcs[1].TotalStake)
+	requireTime(t, at(11), dcs[1].Time)
+	require.Equal(t, uint64(110), dcs[1].BlockHeight)
+	require.Equal(t, uint64(3), dcs[1].Validators)
+	require.Equal(t, 0.25, dcs[1].Gini)
+	require.Equal(t, 0.5, dcs[1].Top1Share)
+	require.Equal(t, uint64(2), dcs[1].ValidatorsOver66)
+
+	dcs, err = d.GetDecentralization(ctx, structs.DecentralizationParams{EpochFrom: 11, EpochTo: 11})
+	require.NoError(t, err)
+	require.Equal(t, []uint64{11}, epochs(dcs))
+
+	dcs, err = d.GetDecentralization(ctx, structs.DecentralizationParams{Limit: 1, Offset: 1})
+	require.NoError(t, err)
+	require.Equal(t, []uint64{11}, epochs(dcs))
+}
@@ -0,0 +1,327 @@
+package storetest
+
+import (
+	"context"
+	"encoding/json"
+	"math/big"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+
+	"github.com/figment-networks/skale-indexer/scraper/structs"
+	"github.com/figment-networks/skale-indexer/store"
+)
+
+func contractEvent(height uint64, boundType string, boundIDs ...int64) structs.ContractEvent {
+	ce := structs.ContractEvent{
+		ContractName:    "delegation_controller",
+		EventName:       "DelegationProposed",
+		ContractAddress: addrA,
+		BlockHeight:     height,
+		Time:            at(int(height)),
+		TransactionHash: hash(height),
+		Params:          map[string]interface{}{"height": height},
+		BoundType:       boundType,
+		BoundAddress:    []common.Address{addrB},
+	}
+	for _, id := range boundIDs {
+		ce.BoundID = append(ce.BoundID, *big.NewInt(id))
+	}
+	return ce
+}
+
+func contractEventHeights(ces []structs.ContractEvent) (heights []uint64) {
+	for _, ce := range ces {
+		heights = append(heights, ce.BlockHeight)
+	}
+	return heights
+}
+
+func testContractEvents(t *testing.T, d store.DBDriver) {
+	ctx := context.Background()
+
+	for _, ce := range []structs.ContractEvent{
+		contractEvent(10, "validator", 1),
+		contractEvent(11, "delegation", 5, 1),
+		contractEvent(12, "node", 7),
+		contractEvent(13, "token"),
+		contractEvent(14, "delegation", 6, 2),
+	} {
+		require.NoError(t, d.SaveContractEvent(ctx, ce))
+	}
+
+	tests := []struct {
+		name    string
+		params  structs.EventParams
+		heights []uint64
+	}{
+		{"all, latest first", structs.EventParams{}, []uint64{14, 13, 12, 11, 10}},
+		{"validator with its delegations", structs.EventParams{Type: "validator", Id: 1}, []uint64{11, 10}},
+		{"delegation", structs.EventParams{Type: "delegation", Id: 5}, []uint64{11}},
+		{"node", structs.EventParams{Type: "node", Id: 7}, []uint64{12}},
+		{"token", structs.EventParams{Type: "token"}, []uint64{13}},
+		{"transaction", structs.EventParams{TransactionHash: hash(12)}, []uint64{12}},
+		{"time range", structs.EventParams{TimeFrom: at(11), TimeTo: at(12)}, []uint64{12, 11}},
+		{"limit and offset", structs.EventParams{Limit: 2, Offset: 1}, []uint64{13, 12}},
+		{"offset without limit is ignored", structs.EventParams{Offset: 3}, []uint64{14, 13, 12, 11, 10}},
+	}
+	for _, tt := range tests {
+		ces, err := d.GetContractEvents(ctx, tt.params)
+		require.NoError(t, err, tt.name)
+		require.Equal(t, tt.heights, contractEventHeights(ces), tt.name)
+	}
+
+	_, err := d.GetContractEvents(ctx, structs.EventParams{Type: "unknown"})
+	require.Error(t, err)
+
+	ces, err := d.GetContractEvents(ctx, structs.EventParams{TransactionHash: hash(10)})
+	require.NoError(t, err)
+	require.Len(t, ces, 1)
+	got := ces[0]
+	require.NotEqual(t, uuid.Nil, got.ID)
+	require.Equal(t, "delegation_controller", got.ContractName)
+	require.Equal(t, "DelegationProposed", got.EventName)
+	require.Equal(t, addrA, got.ContractAddress)
+	require.Equal(t, hash(10), got.TransactionHash)
+	requireTime(t, at(10), got.Time)
+	// params are returned as decoded from json, numbers kept exact
+	require.Equal(t, map[string]interface{}{"height": json.Number("10")}, got.Params)
+
+	// upsert
+	updated := contractEvent(10, "validator", 1)
+	updated.Params = map[string]interface{}{"height": "updated"}
+	require.NoError(t, d.SaveContractEvent(ctx, updated))
+
+	ces, err = d.GetContractEvents(ctx, structs.EventParams{})
+	require.NoError(t, err)
+	require.Len(t, ces, 5)
+
+	ces, err = d.GetContractEvents(ctx, structs.EventParams{TransactionHash: hash(10)})
+	require.NoError(t, err)
+	require.Len(t, ces, 1)
+	require.Equal(t, got.ID, ces[0].ID)
+	require.Equal(t, "updated", ces[0].Params["height"])
+}
+
+func testContractEventSearch(t *testing.T, d store.DBDriver) {
+	ctx := context.Background()
+
+	amount, _ := new(big.Int).SetString("25000000000000000000000", 10)
+	proposed := contractEvent(10, "delegation", 5, 1)
+	proposed.Params = map[string]interface{}{"holder": addrB, "amount": amount, "delegationId": big.NewInt(5), "description": "first"}
+	accepted := contractEvent(11, "delegation", 5, 1)
+	accepted.EventName = "DelegationAccepted"
+	accepted.Params = map[string]interface{}{"delegationId": big.NewInt(5)}
+	registered := contractEvent(12, "validator", 1)
+	registered.ContractName = "validator_service"
+	registered.EventName = "ValidatorRegistered"
+	registered.Params = map[string]interface{}{"validatorId": big.NewInt(1), "trusted": true, "name": "5"}
+	other := contractEvent(13, "delegation", 6, 2)
+	other.BoundAddress = []common.Address{addrB, addrC}
+	other.Params = map[string]interface{}{"holder": addrC, "amount": big.NewInt(2), "delegationId": big.NewInt(6), "description": "0xAbC"}
+	for _, ce := range []structs.ContractEvent{proposed, accepted, registered, other} {
+		require.NoError(t, d.SaveContractEvent(ctx, ce))
+	}
+
+	tests := []struct {
+		name    string
+		params  structs.EventParams
+		heights []uint64
+	}{
+		{"contract name", structs.EventParams{ContractName: "validator_service"}, []uint64{12}},
+		{"event name", structs.EventParams{EventName: "DelegationProposed"}, []uint64{13, 10}},
+		{"contract and event name", structs.EventParams{ContractName: "delegation_controller", EventName: "DelegationAccepted"}, []uint64{11}},
+		{"checksummed address param", structs.EventParams{Params: map[string]string{"holder": addrB.Hex()}}, []uint64{10}},
+		{"lower case address param", structs.EventParams{Params: map[string]string{"holder": strings.ToLower(addrC.Hex())}}, []uint64{13}},
+		{"number param", structs.EventParams{Params: map[string]string{"delegationId": "5"}}, []uint64{11, 10}},
+		{"number param is not prefix", structs.EventParams{Params: map[string]string{"amount": "25"}}, nil},
+		{"big number param", structs.EventParams{Params: map[string]string{"amount": amount.String()}}, []uint64{10}},
+		{"boolean param", structs.EventParams{Params: map[string]string{"trusted": "true"}}, []uint64{12}},
+		{"string param looking like number", structs.EventParams{Params: map[string]string{"name": "5"}}, []uint64{12}},
+		{"string param looking like hex", structs.EventParams{Params: map[string]string{"description": "0xAbC"}}, []uint64{13}},
+		{"params together", structs.EventParams{Params: map[string]string{"delegationId": "5", "description": "first"}}, []uint64{10}},
+		{"missing param", structs.EventParams{Params: map[string]string{"validatorId": "5"}}, nil},
+		{"block range", structs.EventParams{HeightFrom: 11, HeightTo: 12}, []uint64{12, 11}},
+		{"open block range", structs.EventParams{HeightFrom: 12}, []uint64{13, 12}},
+		{"params and block range", structs.EventParams{EventName: "DelegationProposed", Params: map[string]string{"delegationId": "6"}, HeightTo: 13}, []uint64{13}},
+		{"bound address", structs.EventParams{BoundAddress: addrB}, []uint64{13, 12, 11, 10}},
+		{"one of bound addresses", structs.EventParams{BoundAddress: addrC}, []uint64{13}},
+		{"address not bound", structs.EventParams{BoundAddress: addrA}, nil},
+	}
+	for _, tt := range tests {
+		ces, err := d.GetContractEvents(ctx, tt.params)
+		require.NoError(t, err, tt.name)
+		require.Equal(t, tt.heights, contractEventHeights(ces), tt.name)
+
+		count, err := d.CountContractEvents(ctx, tt.params)
+		require.NoError(t, err, tt.name)
+		require.Equal(t, uint64(len(tt.heights)), count, tt.name)
+	}
+}
+
+func systemEvent(height uint64, kind structs.SysEvtType, sender, recipient common.Address, senderID, recipientID int64) structs.SystemEvent {
+	se := structs.SystemEvent{
+		Height:    height,
+		Time:      at(int(height)),
+		Kind:      kind,
+		Sender:    sender,
+		Recipient: recipient,
+	}
+	se.SenderID.SetInt64(senderID)
+	se.RecipientID.SetInt64(recipientID)
+	se.Before.SetInt64(100)
+	se.After.SetInt64(150)
+	se.Change.SetInt64(50)
+	return se
+}
+
+func systemEventHeights(ses []structs.SystemEvent) (heights []uint64) {
+	for _, se := range ses {
+		heights = append(heights, se.Height)
+	}
+	return heights
+}
+
+func testSystemEvents(t *testing.T, d store.DBDriver) {
+	ctx := context.Background()
+
+	for _, se := range []structs.SystemEvent{
+		systemEvent(10, structs.SysEvtTypeNewDelegation, addrA, addrB, 0, 1),
+		systemEvent(11, structs.SysEvtTypeDelegationAccepted, addrB, addrA, 1, 0),
+		systemEvent(12, structs.SysEvtTypeFeeChanged, addrC, addrC, 2, 2),
+	} {
+		require.NoError(t, d.SaveSystemEvent(ctx, se))
+	}
+
+	all, err := d.GetSystemEvents(ctx, structs.SystemEventParams{})
+	require.NoError(t, err)
+	require.Equal(t, []uint64{12, 11, 10}, systemEventHeights(all))
+
+	first := all[2]
+	require.NotEmpty(t, first.ID)
+	require.Equal(t, structs.SysEvtTypeNewDelegation, first.Kind)
+	require.Equal(t, addrA, first.Sender)
+	require.Equal(t, addrB, first.Recipient)
+	requireBig(t, 0, &first.SenderID)
+	requireBig(t, 1, &first.RecipientID)
+	requireBig(t, 100, &first.Before)
+	requireBig(t, 150, &first.After)
+	require.Equal(t, 0, first.Change.Cmp(big.NewFloat(50)))
+	requireTime(t, at(10), first.Time)
+
+	tests := []struct {
+		name    string
+		params  structs.SystemEventParams
+		heights []uint64
+	}{
+		{"address", structs.SystemEventParams{Address: addrA.Hex()}, []uint64{11, 10}},
+		{"id", structs.SystemEventParams{ID: first.ID}, []uint64{10}},
+		{"validator", structs.SystemEventParams{ValidatorID: "1"}, []uint64{11, 10}},
+		{"receiver", structs.SystemEventParams{ReceiverID: 1}, []uint64{10}},
+		{"sender", structs.SystemEventParams{SenderID: 2}, []uint64{12}},
+		{"kind", structs.SystemEventParams{Kind: strconv.FormatUint(uint64(structs.SysEvtTypeFeeChanged), 10)}, []uint64{12}},
+		{"after", structs.SystemEventParams{After: 10}, []uint64{12, 11}},
+		{"limit and offset", structs.SystemEventParams{Limit: 1, Offset: 1}, []uint64{11}},
+	}
+	for _, tt := range tests {
+		ses, err := d.GetSystemEvents(ctx, tt.params)
+		require.NoError(t, err, tt.name)
+		require.Equal(t, tt.heights, systemEventHeights(ses), tt.name)
+	}
+
+	// upsert
+	updated := systemEvent(10, structs.SysEvtTypeNewDelegation, addrA, addrB, 0, 1)
+	updated.After.SetInt64(200)
+	require.NoError(t, d.SaveSystemEvent(ctx, updated))
+
+	ses, err := d.GetSystemEvents(ctx, structs.SystemEventParams{ID: first.ID})
+	require.NoError(t, err)
+	require.Len(t, ses, 1)
+	requireBig(t, 200, &ses[0].After)
+
+	all, err = d.GetSystemEvents(ctx, structs.SystemEventParams{})
+	require.NoError(t, err)
+	require.Len(t, all, 3)
+}
+
+func testFailedEvents(t *testing.T, d store.DBDriver) {
+	ctx := context.Background()
+
+	fe := func(height uint64, logIndex uint, attempts uint64, nextRetry time.Time) structs.FailedEvent {
+		return structs.FailedEvent{
+			BlockHeight:     height,
+			TransactionHash: hash(height),
+			LogIndex:        logIndex,
+			ContractName:    "validator_service",
+			EventName:       "ValidatorRegistered",
+			Log:             types.Log{Address: addrA, BlockNumber: height, TxHash: hash(height), Index: logIndex, Data: []byte{1, 2}, Topics: []common.Hash{hash(1)}},
+			Error:           "error",
+			Attempts:        attempts,
+			NextRetry:       nextRetry,
+		}
+	}
+
+	require.NoError(t, d.SaveFailedEvent(ctx, fe(11, 0, 1, at(10))))
+	require.NoError(t, d.SaveFailedEvent(ctx, fe(10, 2, 1, at(20))))
+	require.NoError(t, d.SaveFailedEvent(ctx, fe(10, 1, 5, at(5))))
+
+	all, err := d.GetFailedEvents(ctx, structs.FailedEventParams{})
+	require.NoError(t, err)
+	require.Len(t, all, 3)
+	require.Equal(t, []uint{1, 2, 0}, []uint{all[0].LogIndex, all[1].LogIndex, all[2].LogIndex})
+
+	got := all[2]
+	require.NotEmpty(t, got.ID)
+	require.Equal(t, uint64(11), got.BlockHeight)
+	require.Equal(t, hash(11), got.TransactionHash)
+	require.Equal(t, "validator_service", got.ContractName)
+	require.Equal(t, "ValidatorRegistered", got.EventName)
+	require.Equal(t, "error", got.Error)
+	require.Equal(t, addrA, got.Log.Address)
+	require.Equal(t, []byte{1, 2}, got.Log.Data)
+	requireTime(t, at(10), got.NextRetry)
+
+	due, err := d.GetFailedEvents(ctx, structs.FailedEventParams{RetryBefore: at(10), MaxAttempts: 3})
+	require.NoError(t, err)
+	require.Len(t, due, 1)
+	require.Equal(t, got.ID, due[0].ID)
+
+	paged, err := d.GetFailedEvents(ctx, structs.FailedEventParams{Limit: 1, Offset: 1})
+	require.NoError(t, err)
+	require.Len(t, paged, 1)
+	require.Equal(t, uint(2), paged[0].LogIndex)
+
+	// upsert
+	require.NoError(t, d.SaveFailedEvent(ctx, fe(11, 0, 2, at(30))))
+	byID, err := d.GetFailedEvents(ctx, structs.FailedEventParams{ID: got.ID})
+	require.NoError(t, err)
+	require.Len(t, byID, 1)
+	require.Equal(t, uint64(2), byID[0].Attempts)
+	requireTime(t, at(30), byID[0].NextRetry)
+
+	// failing once again when the block is re-scraped counts the attempt, without moving the retry earlier
+	require.NoError(t, d.SaveFailedEvent(ctx, fe(11, 0, 1, at(15))))
+	byID, err = d.GetFailedEvents(ctx, structs.FailedEventParams{ID: got.ID})
+	require.NoError(t, err)
+	require.Equal(t, uint64(3), byID[0].Attempts)
+	requireTime(t, at(30), byID[0].NextRetry)
+
+	require.NoError(t, d.DeleteFailedEvent(ctx, got.ID))
+	require.ErrorIs(t, d.DeleteFailedEvent(ctx, got.ID), structs.ErrNotFound)
+	_, err = d.GetFailedEvents(ctx, structs.FailedEventParams{ID: got.ID})
+	require.ErrorIs(t, err, structs.ErrNotFound)
+
+	// by log, once it's processed when the block is re-scraped
+	require.NoError(t, d.DeleteFailedEventOfLog(ctx, hash(10), 2))
+	require.NoError(t, d.DeleteFailedEventOfLog(ctx, hash(10), 2))
+	all, err = d.GetFailedEvents(ctx, structs.FailedEventParams{})
+	require.NoError(t, err)
+	require.Len(t, all, 1)
+	require.Equal(t, uint(1), all[0].LogIndex)
+}
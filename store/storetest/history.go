@@ -0,0 +1,207 @@
+package storetest
+
+import (
+	"context"
+	"math/big"
+	"net"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/require"
+
+	"github.com/figment-networks/skale-indexer/scraper/structs"
+	"github.com/figment-networks/skale-indexer/store"
+)
+
+func testValidatorsAtHeight(t *testing.T, d store.DBDriver) {
+	ctx := context.Background()
+
+	feeChanged := validator(1, "first", addrA, 20, true, 0)
+	feeChanged.FeeRate = big.NewInt(25)
+	mdrChanged := validator(1, "first", addrA, 30, true, 0)
+	mdrChanged.FeeRate = big.NewInt(25)
+	mdrChanged.MinimumDelegationAmount = big.NewInt(2000)
+
+	require.NoError(t, d.SaveValidator(ctx, validator(1, "first", addrA, 10, true, 0)))
+	require.NoError(t, d.SaveValidator(ctx, mdrChanged))
+	// version saved out of order is placed between the existing ones
+	require.NoError(t, d.SaveValidator(ctx, feeChanged))
+	require.NoError(t, d.SaveValidator(ctx, validator(2, "second", addrB, 25, false, 10)))
+	require.NoError(t, d.SaveValidatorStatistic(ctx, big.NewInt(1), 15, at(15), structs.ValidatorStatisticsTypeTotalStake, big.NewInt(300)))
+	require.NoError(t, d.SaveValidatorStatistic(ctx, big.NewInt(1), 28, at(28), structs.ValidatorStatisticsTypeTotalStake, big.NewInt(700)))
+
+	tests := []struct {
+		height uint64
+		ids    []int64
+		fee    int64
+		mdr    int64
+		staked int64
+	}{
+		{10, []int64{1}, 10, 1000, 0},
+		{19, []int64{1}, 10, 1000, 300},
+		{20, []int64{1}, 25, 1000, 300},
+		{29, []int64{1, 2}, 25, 1000, 700},
+		{100, []int64{1, 2}, 25, 2000, 700},
+	}
+	for _, tt := range tests {
+		validators, err := d.GetValidators(ctx, structs.ValidatorParams{AtHeight: tt.height})
+		require.NoError(t, err, tt.height)
+		require.Equal(t, tt.ids, validatorIDs(validators), tt.height)
+		requireBig(t, tt.fee, validators[0].FeeRate)
+		requireBig(t, tt.mdr, validators[0].MinimumDelegationAmount)
+		requireBig(t, tt.staked, validators[0].Staked)
+	}
+
+	validators, err := d.GetValidators(ctx, structs.ValidatorParams{AtHeight: 9})
+	require.NoError(t, err)
+	require.Empty(t, validators)
+
+	// filters apply to the version at height
+	validators, err = d.GetValidators(ctx, structs.ValidatorParams{AtHeight: 29, ValidatorID: "2"})
+	require.NoError(t, err)
+	require.Equal(t, []int64{2}, validatorIDs(validators))
+	require.Equal(t, uint64(25), validators[0].BlockHeight)
+}
+
+func testNodesAtHeight(t *testing.T, d store.DBDriver) {
+	ctx := context.Background()
+
+	require.NoError(t, d.SaveNodes(ctx, []structs.Node{
+		node(1, 1, addrA, 10, structs.NodeStatusActive),
+		node(2, 1, addrB, 10, structs.NodeStatusActive),
+	}, common.Address{}))
+	require.NoError(t, d.SaveNodes(ctx, []structs.Node{node(1, 1, addrA, 20, structs.NodeStatusInMaintenance)}, common.Address{}))
+	require.NoError(t, d.SaveNodes(ctx, []structs.Node{node(2, 1, addrB, 30, structs.NodeStatusLeft)}, addrA))
+
+	tests := []struct {
+		name      string
+		params    structs.NodeParams
+		ids       []int64
+		addresses []common.Address
+	}{
+		{"before", structs.NodeParams{AtHeight: 9}, nil, nil},
+		{"first", structs.NodeParams{AtHeight: 10}, []int64{1, 2}, []common.Address{addrA, addrB}},
+		{"status", structs.NodeParams{AtHeight: 25, Status: "In_Maintenance"}, []int64{1}, []common.Address{addrA}},
+		{"address removed", structs.NodeParams{AtHeight: 30}, []int64{1, 2}, []common.Address{{}, addrB}},
+		{"address", structs.NodeParams{AtHeight: 15, Address: addrA.Hex()}, []int64{1}, []common.Address{addrA}},
+	}
+	for _, tt := range tests {
+		nodes, err := d.GetNodes(ctx, tt.params)
+		require.NoError(t, err, tt.name)
+		require.Equal(t, tt.ids, nodeIDs(nodes), tt.name)
+		var addresses []common.Address
+		for _, n := range nodes {
+			addresses = append(addresses, n.Address)
+		}
+		require.Equal(t, tt.addresses, addresses, tt.name)
+	}
+
+	nodes, err := d.GetNodes(ctx, structs.NodeParams{AtHeight: 25, NodeID: "1"})
+	require.NoError(t, err)
+	require.Equal(t, structs.NodeStatusInMaintenance, nodes[0].Status)
+	require.Equal(t, uint64(20), nodes[0].BlockHeight)
+}
+
+func testNodeTimeline(t *testing.T, d store.DBDriver) {
+	ctx := context.Background()
+
+	for _, height := range []uint64{10, 20, 30} {
+		require.NoError(t, d.SaveBlock(ctx, structs.Block{Number: height, Hash: hash(height), Time: at(int(height))}))
+	}
+	require.NoError(t, d.SaveNodes(ctx, []structs.Node{
+		node(1, 1, addrA, 10, structs.NodeStatusActive),
+		node(2, 1, addrB, 10, structs.NodeStatusActive),
+	}, common.Address{}))
+	// node saved again with its validator is not changed
+	require.NoError(t, d.SaveNodes(ctx, []structs.Node{
+		node(1, 1, addrA, 20, structs.NodeStatusInMaintenance),
+		node(2, 1, addrB, 20, structs.NodeStatusActive),
+	}, common.Address{}))
+	moved := node(1, 1, addrA, 25, structs.NodeStatusInMaintenance)
+	moved.IP = net.ParseIP("10.0.0.2")
+	require.NoError(t, d.SaveNodes(ctx, []structs.Node{moved}, common.Address{}))
+	require.NoError(t, d.SaveNodes(ctx, []structs.Node{node(1, 1, addrA, 30, structs.NodeStatusActive)}, common.Address{}))
+
+	timeline, err := d.GetNodeTimeline(ctx, structs.NodeParams{NodeID: "1"})
+	require.NoError(t, err)
+	var heights, validTo []uint64
+	for _, c := range timeline {
+		heights = append(heights, c.BlockHeight)
+		validTo = append(validTo, c.ValidTo)
+	}
+	require.Equal(t, []uint64{30, 25, 20, 10}, heights)
+	require.Equal(t, []uint64{0, 30, 25, 20}, validTo)
+	require.Equal(t, structs.NodeStatusInMaintenance, timeline[1].Status)
+	require.True(t, net.ParseIP("10.0.0.2").Equal(timeline[1].IP))
+	require.True(t, timeline[1].Time.IsZero())
+	requireTime(t, at(20), timeline[2].Time)
+	require.NotEmpty(t, timeline[3].ID)
+	requireBig(t, 1, timeline[3].ValidatorID)
+
+	timeline, err = d.GetNodeTimeline(ctx, structs.NodeParams{ValidatorID: "1"})
+	require.NoError(t, err)
+	require.Len(t, timeline, 5)
+	require.Equal(t, []int64{1, 2}, nodeIDs([]structs.Node{timeline[3].Node, timeline[4].Node}))
+
+	timeline, err = d.GetNodeTimeline(ctx, structs.NodeParams{ValidatorID: "1", Limit: 2, Offset: 1})
+	require.NoError(t, err)
+	require.Len(t, timeline, 2)
+	require.Equal(t, uint64(25), timeline[0].BlockHeight)
+
+	timeline, err = d.GetNodeTimeline(ctx, structs.NodeParams{Address: addrB.Hex()})
+	require.NoError(t, err)
+	require.Len(t, timeline, 1)
+	requireBig(t, 2, timeline[0].NodeID)
+
+	timeline, err = d.GetNodeTimeline(ctx, structs.NodeParams{ValidatorID: "2"})
+	require.NoError(t, err)
+	require.Empty(t, timeline)
+}
+
+func testRecordsAtHeight(t *testing.T, d store.DBDriver) {
+	ctx := context.Background()
+
+	require.NoError(t, d.SaveValidator(ctx, validator(1, "first", addrA, 10, true, 0)))
+	require.NoError(t, d.SaveDelegation(ctx, delegation(1, 1, addrA, 10, structs.DelegationStatePROPOSED, 100, 3)))
+	require.NoError(t, d.SaveDelegation(ctx, delegation(1, 1, addrA, 20, structs.DelegationStateACCEPTED, 100, 3)))
+	require.NoError(t, d.SaveDelegation(ctx, delegation(2, 1, addrB, 30, structs.DelegationStatePROPOSED, 50, 3)))
+
+	delegations, err := d.GetDelegations(ctx, structs.DelegationParams{AtHeight: 15})
+	require.NoError(t, err)
+	require.Equal(t, []int64{1}, delegationIDs(delegations))
+	require.Equal(t, structs.DelegationStatePROPOSED, delegations[0].State)
+
+	summary, err := d.GetTypesSummaryDelegations(ctx, structs.DelegationParams{AtHeight: 25})
+	require.NoError(t, err)
+	require.Len(t, summary, 1)
+	require.Equal(t, structs.DelegationStateACCEPTED, summary[0].State)
+	requireBig(t, 100, summary[0].Amount)
+
+	timeline, err := d.GetDelegationTimeline(ctx, structs.DelegationParams{DelegationID: "1", AtHeight: 15})
+	require.NoError(t, err)
+	require.Len(t, timeline, 1)
+
+	require.NoError(t, d.SaveValidatorStatistic(ctx, big.NewInt(1), 10, at(10), structs.ValidatorStatisticsTypeFee, big.NewInt(10)))
+	require.NoError(t, d.SaveValidatorStatistic(ctx, big.NewInt(1), 20, at(20), structs.ValidatorStatisticsTypeFee, big.NewInt(20)))
+	statistics, err := d.GetValidatorStatistics(ctx, structs.ValidatorStatisticsParams{ValidatorID: "1", AtHeight: 15})
+	require.NoError(t, err)
+	require.Len(t, statistics, 1)
+	requireBig(t, 10, statistics[0].Amount)
+
+	for _, ce := range []structs.ContractEvent{contractEvent(10, "validator", 1), contractEvent(20, "validator", 1)} {
+		require.NoError(t, d.SaveContractEvent(ctx, ce))
+	}
+	events, err := d.GetContractEvents(ctx, structs.EventParams{AtHeight: 15})
+	require.NoError(t, err)
+	require.Equal(t, []uint64{10}, contractEventHeights(events))
+
+	for _, se := range []structs.SystemEvent{
+		systemEvent(10, structs.SysEvtTypeNewDelegation, addrA, addrB, 0, 1),
+		systemEvent(20, structs.SysEvtTypeDelegationAccepted, addrB, addrA, 1, 0),
+	} {
+		require.NoError(t, d.SaveSystemEvent(ctx, se))
+	}
+	systemEvents, err := d.GetSystemEvents(ctx, structs.SystemEventParams{AtHeight: 15})
+	require.NoError(t, err)
+	require.Equal(t, []uint64{10}, systemEventHeights(systemEvents))
+}
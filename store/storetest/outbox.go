@@ -0,0 +1,73 @@
+package storetest
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/figment-networks/skale-indexer/scraper/structs"
+	"github.com/figment-networks/skale-indexer/store"
+)
+
+func testOutbox(t *testing.T, d store.DBDriver) {
+	ctx := context.Background()
+
+	msg := func(key string, height uint64) structs.OutboxMessage {
+		return structs.OutboxMessage{
+			Topic:   structs.OutboxTopicSystemEvent,
+			Key:     key,
+			Height:  height,
+			Payload: json.RawMessage(`{"height":` + key + `}`),
+		}
+	}
+	keys := func(msgs []structs.OutboxMessage) (k []string) {
+		for _, m := range msgs {
+			k = append(k, m.Key)
+		}
+		return k
+	}
+
+	require.NoError(t, d.SaveOutboxMessages(ctx, nil))
+	require.NoError(t, d.SaveOutboxMessages(ctx, []structs.OutboxMessage{msg("1", 10), msg("2", 10)}))
+
+	// messages are saved with the records they carry
+	err := d.Atomic(ctx, func(ctx context.Context) error {
+		require.NoError(t, d.SaveOutboxMessages(ctx, []structs.OutboxMessage{msg("3", 11)}))
+		return errTest
+	})
+	require.ErrorIs(t, err, errTest)
+
+	var many []structs.OutboxMessage
+	for i := 0; i < 1200; i++ {
+		many = append(many, msg("4", 12))
+	}
+	require.NoError(t, d.Atomic(ctx, func(ctx context.Context) error {
+		return d.SaveOutboxMessages(ctx, many)
+	}))
+
+	all, err := d.GetOutboxMessages(ctx, structs.OutboxParams{})
+	require.NoError(t, err)
+	require.Len(t, all, 1202)
+	require.Equal(t, []string{"1", "2", "4"}, keys(all[:3]))
+	for i := 1; i < len(all); i++ {
+		require.Greater(t, all[i].ID, all[i-1].ID)
+	}
+
+	first := all[0]
+	require.Equal(t, structs.OutboxTopicSystemEvent, first.Topic)
+	require.Equal(t, uint64(10), first.Height)
+	require.JSONEq(t, `{"height":1}`, string(first.Payload))
+	require.False(t, first.CreatedAt.IsZero())
+
+	batch, err := d.GetOutboxMessages(ctx, structs.OutboxParams{After: first.ID, Limit: 2})
+	require.NoError(t, err)
+	require.Equal(t, []uint64{all[1].ID, all[2].ID}, []uint64{batch[0].ID, batch[1].ID})
+
+	require.NoError(t, d.DeleteOutboxMessages(ctx, nil))
+	require.NoError(t, d.DeleteOutboxMessages(ctx, []uint64{first.ID, all[2].ID}))
+	left, err := d.GetOutboxMessages(ctx, structs.OutboxParams{Limit: 2})
+	require.NoError(t, err)
+	require.Equal(t, []uint64{all[1].ID, all[3].ID}, []uint64{left[0].ID, left[1].ID})
+}
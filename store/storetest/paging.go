@@ -0,0 +1,229 @@
+package storetest
+
+import (
+	"context"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/require"
+
+	"github.com/figment-networks/skale-indexer/scraper/structs"
+	"github.com/figment-networks/skale-indexer/store"
+)
+
+// walk requests pages of given size following the cursor of the last record, until an incomplete page comes
+func walk(t *testing.T, size int, get func(c structs.Cursor) []structs.Cursor) (pages [][]structs.Cursor) {
+	var c structs.Cursor
+	for i := 0; i < 10; i++ {
+		p := get(c)
+		pages = append(pages, p)
+		if len(p) < size {
+			return pages
+		}
+		c = p[len(p)-1]
+	}
+	t.Fatal("cursor paging does not end")
+	return nil
+}
+
+// requireLatestFirst checks records are listed once and ordered by height and id, the latest first
+func requireLatestFirst(t *testing.T, pages [][]structs.Cursor, n int) {
+	var all []structs.Cursor
+	for _, p := range pages {
+		all = append(all, p...)
+	}
+	require.Len(t, all, n)
+
+	seen := map[string]bool{}
+	for i, c := range all {
+		require.False(t, seen[c.ID], "record listed twice")
+		seen[c.ID] = true
+		if i > 0 {
+			prev := all[i-1]
+			require.True(t, prev.BlockHeight > c.BlockHeight || (prev.BlockHeight == c.BlockHeight && prev.ID > c.ID), "records out of order")
+		}
+	}
+}
+
+func testCursorPaging(t *testing.T, d store.DBDriver) {
+	ctx := context.Background()
+
+	for i, height := range []uint64{10, 11, 11, 11, 12} {
+		ce := contractEvent(height, "validator", 1)
+		ce.TransactionHash = hash(uint64(100 + i))
+		require.NoError(t, d.SaveContractEvent(ctx, ce))
+	}
+
+	events := func(c structs.Cursor) (page []structs.Cursor) {
+		ces, err := d.GetContractEvents(ctx, structs.EventParams{Type: "validator", Id: 1, Limit: 2, Cursor: c})
+		require.NoError(t, err)
+		for _, ce := range ces {
+			page = append(page, structs.Cursor{BlockHeight: ce.BlockHeight, ID: ce.ID.String()})
+		}
+		return page
+	}
+	// offset is ignored with cursor
+	ces, err := d.GetContractEvents(ctx, structs.EventParams{Limit: 2, Offset: 3, Cursor: structs.Cursor{BlockHeight: 100, ID: "ffffffff-ffff-ffff-ffff-ffffffffffff"}})
+	require.NoError(t, err)
+	require.Equal(t, []uint64{12, 11}, contractEventHeights(ces))
+
+	pages := walk(t, 2, events)
+	require.Len(t, pages, 3)
+	requireLatestFirst(t, pages, 5)
+
+	// record added meanwhile does not shift the following page
+	require.NoError(t, d.SaveContractEvent(ctx, contractEvent(13, "validator", 1)))
+	require.Equal(t, pages[1], events(pages[0][1]))
+
+	count, err := d.CountContractEvents(ctx, structs.EventParams{Type: "validator", Id: 1, Limit: 1})
+	require.NoError(t, err)
+	require.Equal(t, uint64(6), count)
+	count, err = d.CountContractEvents(ctx, structs.EventParams{AtHeight: 11})
+	require.NoError(t, err)
+	require.Equal(t, uint64(4), count)
+	_, err = d.CountContractEvents(ctx, structs.EventParams{Type: "unknown"})
+	require.Error(t, err)
+
+	for i, height := range []uint64{10, 11, 11, 12} {
+		require.NoError(t, d.SaveSystemEvent(ctx, systemEvent(height, structs.SysEvtType(i+1), addrA, addrB, 1, 2)))
+	}
+	pages = walk(t, 3, func(c structs.Cursor) (page []structs.Cursor) {
+		ses, err := d.GetSystemEvents(ctx, structs.SystemEventParams{ValidatorID: "1", Limit: 3, Cursor: c})
+		require.NoError(t, err)
+		for _, se := range ses {
+			page = append(page, structs.Cursor{BlockHeight: se.Height, ID: se.ID})
+		}
+		return page
+	})
+	require.Len(t, pages, 2)
+	requireLatestFirst(t, pages, 4)
+
+	count, err = d.CountSystemEvents(ctx, structs.SystemEventParams{Address: addrB.Hex()})
+	require.NoError(t, err)
+	require.Equal(t, uint64(4), count)
+
+	require.NoError(t, d.SaveValidator(ctx, validator(1, "first", addrA, 1, true, 0)))
+	for i, height := range []uint64{10, 10, 11, 12} {
+		dl := delegation(int64(i+1), 1, addrA, height, structs.DelegationStatePROPOSED, 100, 3)
+		require.NoError(t, d.SaveDelegation(ctx, dl))
+	}
+	pages = walk(t, 1, func(c structs.Cursor) (page []structs.Cursor) {
+		dls, err := d.GetDelegationTimeline(ctx, structs.DelegationParams{ValidatorID: "1", Limit: 1, Cursor: c})
+		require.NoError(t, err)
+		for _, dl := range dls {
+			page = append(page, structs.Cursor{BlockHeight: dl.BlockHeight, ID: dl.ID.String()})
+		}
+		return page
+	})
+	require.Len(t, pages, 5)
+	requireLatestFirst(t, pages, 4)
+
+	count, err = d.CountDelegationTimeline(ctx, structs.DelegationParams{ValidatorID: "1", Offset: 2})
+	require.NoError(t, err)
+	require.Equal(t, uint64(4), count)
+	count, err = d.CountDelegationTimeline(ctx, structs.DelegationParams{Holder: addrB.Hex()})
+	require.NoError(t, err)
+	require.Equal(t, uint64(0), count)
+}
+
+// walkKeys requests pages of given size following the key cursor of the last record, until an incomplete page comes
+func walkKeys(t *testing.T, size int, get func(c structs.KeyCursor) []structs.KeyCursor) (keys []string) {
+	var c structs.KeyCursor
+	for i := 0; i < 10; i++ {
+		p := get(c)
+		for _, k := range p {
+			keys = append(keys, k.Key)
+		}
+		if len(p) < size {
+			return keys
+		}
+		c = p[len(p)-1]
+	}
+	t.Fatal("cursor paging does not end")
+	return nil
+}
+
+func testKeyCursorPaging(t *testing.T, d store.DBDriver) {
+	ctx := context.Background()
+
+	for i, addr := range []common.Address{addrA, addrB, addrC} {
+		require.NoError(t, d.SaveValidator(ctx, validator(int64(i+1), "validator", addr, 10, true, 0)))
+	}
+	// offset is ignored with cursor
+	vs, err := d.GetValidators(ctx, structs.ValidatorParams{Limit: 2, Offset: 1, Cursor: structs.KeyCursor{Key: "1"}})
+	require.NoError(t, err)
+	require.Len(t, vs, 2)
+	require.Equal(t, int64(2), vs[0].ValidatorID.Int64())
+
+	keys := walkKeys(t, 2, func(c structs.KeyCursor) (page []structs.KeyCursor) {
+		vs, err := d.GetValidators(ctx, structs.ValidatorParams{Limit: 2, Cursor: c})
+		require.NoError(t, err)
+		for _, v := range vs {
+			page = append(page, structs.KeyCursor{Key: v.ValidatorID.String()})
+		}
+		return page
+	})
+	require.Equal(t, []string{"1", "2", "3"}, keys)
+	_, err = d.GetValidators(ctx, structs.ValidatorParams{OrderBy: "name", Cursor: structs.KeyCursor{Key: "1"}})
+	require.ErrorIs(t, err, structs.ErrCursorOrder)
+	count, err := d.CountValidators(ctx, structs.ValidatorParams{Limit: 1, Cursor: structs.KeyCursor{Key: "2"}})
+	require.NoError(t, err)
+	require.Equal(t, uint64(3), count)
+
+	require.NoError(t, d.SaveNodes(ctx, []structs.Node{
+		node(3, 1, addrC, 10, structs.NodeStatusActive),
+		node(1, 1, addrA, 10, structs.NodeStatusActive),
+		node(2, 2, addrB, 10, structs.NodeStatusActive),
+	}, common.Address{}))
+	keys = walkKeys(t, 2, func(c structs.KeyCursor) (page []structs.KeyCursor) {
+		nodes, err := d.GetNodes(ctx, structs.NodeParams{Limit: 2, Cursor: c})
+		require.NoError(t, err)
+		for _, n := range nodes {
+			page = append(page, structs.KeyCursor{Key: n.NodeID.String()})
+		}
+		return page
+	})
+	require.Equal(t, []string{"1", "2", "3"}, keys)
+	count, err = d.CountNodes(ctx, structs.NodeParams{ValidatorID: "1", Limit: 1})
+	require.NoError(t, err)
+	require.Equal(t, uint64(2), count)
+
+	for _, addr := range []common.Address{addrA, addrB, addrC} {
+		require.NoError(t, d.SaveAccount(ctx, structs.Account{Address: addr, Type: structs.AccountTypeDelegator}))
+	}
+	all, err := d.GetAccounts(ctx, structs.AccountParams{})
+	require.NoError(t, err)
+	keys = walkKeys(t, 2, func(c structs.KeyCursor) (page []structs.KeyCursor) {
+		accounts, err := d.GetAccounts(ctx, structs.AccountParams{Limit: 2, Cursor: c})
+		require.NoError(t, err)
+		for _, a := range accounts {
+			page = append(page, structs.KeyCursor{Key: a.Address.Hex(), Time: a.CreatedAt})
+		}
+		return page
+	})
+	require.Len(t, keys, 3)
+	for i, a := range all {
+		require.Equal(t, a.Address.Hex(), keys[i], "accounts are paged in the order they are listed")
+	}
+	count, err = d.CountAccounts(ctx, structs.AccountParams{Type: string(structs.AccountTypeDelegator)})
+	require.NoError(t, err)
+	require.Equal(t, uint64(3), count)
+
+	for i, height := range []uint64{10, 11, 12} {
+		require.NoError(t, d.SaveDelegation(ctx, delegation(int64(i+1), 1, addrA, height, structs.DelegationStatePROPOSED, 100, 3)))
+	}
+	// the latest state of delegation is listed once
+	require.NoError(t, d.SaveDelegation(ctx, delegation(2, 1, addrA, 13, structs.DelegationStateDELEGATED, 100, 3)))
+	keys = walkKeys(t, 2, func(c structs.KeyCursor) (page []structs.KeyCursor) {
+		dls, err := d.GetDelegations(ctx, structs.DelegationParams{ValidatorID: "1", Limit: 2, KeyCursor: c})
+		require.NoError(t, err)
+		for _, dl := range dls {
+			page = append(page, structs.KeyCursor{Key: dl.DelegationID.String()})
+		}
+		return page
+	})
+	require.Equal(t, []string{"3", "2", "1"}, keys)
+	count, err = d.CountDelegations(ctx, structs.DelegationParams{ValidatorID: "1", Limit: 1})
+	require.NoError(t, err)
+	require.Equal(t, uint64(3), count)
+}
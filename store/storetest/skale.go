@@ -0,0 +1,547 @@
+package storetest
+
+import (
+	"context"
+	"math/big"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+
+	"github.com/figment-networks/skale-indexer/scraper/structs"
+	"github.com/figment-networks/skale-indexer/store"
+)
+
+func node(id, validatorID int64, address common.Address, height uint64, status structs.NodeStatus) structs.Node {
+	return structs.Node{
+		NodeID:         big.NewInt(id),
+		Address:        address,
+		Name:           "node",
+		IP:             net.ParseIP("10.0.0.1"),
+		PublicIP:       net.ParseIP("192.168.0.1"),
+		Port:           10000,
+		StartBlock:     big.NewInt(5),
+		NextRewardDate: at(60),
+		LastRewardDate: at(0),
+		FinishTime:     big.NewInt(0),
+		Status:         status,
+		ValidatorID:    big.NewInt(validatorID),
+		BlockHeight:    height,
+	}
+}
+
+func nodeIDs(nodes []structs.Node) (ids []int64) {
+	for _, n := range nodes {
+		ids = append(ids, n.NodeID.Int64())
+	}
+	return ids
+}
+
+func testNodes(t *testing.T, d store.DBDriver) {
+	ctx := context.Background()
+
+	require.NoError(t, d.SaveNodes(ctx, []structs.Node{
+		node(2, 1, addrB, 10, structs.NodeStatusLeaving),
+		node(1, 1, addrA, 10, structs.NodeStatusActive),
+	}, common.Address{}))
+	require.NoError(t, d.SaveNodes(ctx, []structs.Node{node(3, 2, addrC, 10, structs.NodeStatusActive)}, common.Address{}))
+
+	tests := []struct {
+		name   string
+		params structs.NodeParams
+		ids    []int64
+	}{
+		{"all", structs.NodeParams{}, []int64{1, 2, 3}},
+		{"node", structs.NodeParams{NodeID: "2"}, []int64{2}},
+		{"validator", structs.NodeParams{ValidatorID: "1"}, []int64{1, 2}},
+		{"status", structs.NodeParams{Status: "Leaving"}, []int64{2}},
+		{"address", structs.NodeParams{Address: addrC.Hex()}, []int64{3}},
+		{"limit and offset", structs.NodeParams{Limit: 1, Offset: 1}, []int64{2}},
+	}
+	for _, tt := range tests {
+		nodes, err := d.GetNodes(ctx, tt.params)
+		require.NoError(t, err, tt.name)
+		require.Equal(t, tt.ids, nodeIDs(nodes), tt.name)
+	}
+
+	nodes, err := d.GetNodes(ctx, structs.NodeParams{NodeID: "1"})
+	require.NoError(t, err)
+	require.Len(t, nodes, 1)
+	got := nodes[0]
+	require.NotEmpty(t, got.ID)
+	require.False(t, got.CreatedAt.IsZero())
+	require.Equal(t, addrA, got.Address)
+	require.Equal(t, "node", got.Name)
+	require.True(t, net.ParseIP("10.0.0.1").Equal(got.IP))
+	require.True(t, net.ParseIP("192.168.0.1").Equal(got.PublicIP))
+	require.Equal(t, uint16(10000), got.Port)
+	requireBig(t, 5, got.StartBlock)
+	requireBig(t, 0, got.FinishTime)
+	requireBig(t, 1, got.ValidatorID)
+	requireTime(t, at(60), got.NextRewardDate)
+	requireTime(t, at(0), got.LastRewardDate)
+	require.Equal(t, structs.NodeStatusActive, got.Status)
+
+	// older state does not overwrite newer one
+	stale := node(1, 1, addrA, 9, structs.NodeStatusLeft)
+	stale.Name = "stale"
+	require.NoError(t, d.SaveNodes(ctx, []structs.Node{stale}, common.Address{}))
+	nodes, err = d.GetNodes(ctx, structs.NodeParams{NodeID: "1"})
+	require.NoError(t, err)
+	require.Equal(t, "node", nodes[0].Name)
+
+	newer := node(1, 1, addrA, 11, structs.NodeStatusInMaintenance)
+	newer.Name = "newer"
+	require.NoError(t, d.SaveNodes(ctx, []structs.Node{newer}, common.Address{}))
+	nodes, err = d.GetNodes(ctx, structs.NodeParams{NodeID: "1"})
+	require.NoError(t, err)
+	require.Equal(t, got.ID, nodes[0].ID)
+	require.Equal(t, "newer", nodes[0].Name)
+	require.Equal(t, structs.NodeStatusInMaintenance, nodes[0].Status)
+
+	// address of removed node is cleared
+	require.NoError(t, d.SaveNodes(ctx, []structs.Node{node(2, 1, addrB, 12, structs.NodeStatusLeft)}, addrA))
+	nodes, err = d.GetNodes(ctx, structs.NodeParams{ValidatorID: "1"})
+	require.NoError(t, err)
+	require.Equal(t, common.Address{}, nodes[0].Address)
+	require.Equal(t, addrB, nodes[1].Address)
+}
+
+func validator(id int64, name string, address common.Address, height uint64, authorized bool, registered int) structs.Validator {
+	return structs.Validator{
+		ValidatorID:             big.NewInt(id),
+		Name:                    name,
+		ValidatorAddress:        address,
+		RequestedAddress:        addrC,
+		Description:             "description",
+		FeeRate:                 big.NewInt(10),
+		RegistrationTime:        at(registered),
+		MinimumDelegationAmount: big.NewInt(1000),
+		AcceptNewRequests:       true,
+		Authorized:              authorized,
+		BlockHeight:             height,
+	}
+}
+
+func validatorIDs(validators []structs.Validator) (ids []int64) {
+	for _, v := range validators {
+		ids = append(ids, v.ValidatorID.Int64())
+	}
+	return ids
+}
+
+func testValidators(t *testing.T, d store.DBDriver) {
+	ctx := context.Background()
+
+	require.NoError(t, d.SaveValidator(ctx, validator(2, "second", addrB, 10, false, 10)))
+	require.NoError(t, d.SaveValidator(ctx, validator(1, "first", addrA, 10, true, 0)))
+
+	tests := []struct {
+		name   string
+		params structs.ValidatorParams
+		ids    []int64
+	}{
+		{"all", structs.ValidatorParams{}, []int64{1, 2}},
+		{"validator", structs.ValidatorParams{ValidatorID: "2"}, []int64{2}},
+		{"authorized", structs.ValidatorParams{Authorized: structs.StateTrue}, []int64{1}},
+		{"not authorized", structs.ValidatorParams{Authorized: structs.StateFalse}, []int64{2}},
+		{"address", structs.ValidatorParams{Address: addrB.Hex()}, []int64{2}},
+		{"registration time", structs.ValidatorParams{TimeFrom: at(5), TimeTo: at(15)}, []int64{2}},
+		{"ordered", structs.ValidatorParams{OrderBy: "validator_id", OrderDirection: "DESC"}, []int64{2, 1}},
+		{"limit and offset", structs.ValidatorParams{Limit: 1, Offset: 1}, []int64{2}},
+	}
+	for _, tt := range tests {
+		validators, err := d.GetValidators(ctx, tt.params)
+		require.NoError(t, err, tt.name)
+		require.Equal(t, tt.ids, validatorIDs(validators), tt.name)
+	}
+
+	validators, err := d.GetValidators(ctx, structs.ValidatorParams{ValidatorID: "1"})
+	require.NoError(t, err)
+	require.Len(t, validators, 1)
+	got := validators[0]
+	require.NotEmpty(t, got.ID)
+	require.False(t, got.CreatedAt.IsZero())
+	require.Equal(t, "first", got.Name)
+	require.Equal(t, addrA, got.ValidatorAddress)
+	require.Equal(t, addrC, got.RequestedAddress)
+	require.Equal(t, "description", got.Description)
+	requireBig(t, 10, got.FeeRate)
+	requireBig(t, 1000, got.MinimumDelegationAmount)
+	requireBig(t, 0, got.Staked)
+	requireTime(t, at(0), got.RegistrationTime)
+	require.True(t, got.AcceptNewRequests)
+	require.True(t, got.Authorized)
+	require.Equal(t, uint64(10), got.BlockHeight)
+
+	// older state does not overwrite newer one
+	require.NoError(t, d.SaveValidator(ctx, validator(1, "stale", addrA, 9, true, 0)))
+	validators, err = d.GetValidators(ctx, structs.ValidatorParams{ValidatorID: "1"})
+	require.NoError(t, err)
+	require.Equal(t, "first", validators[0].Name)
+
+	// counts come from statistics and are kept on update
+	require.NoError(t, d.SaveValidatorStatistic(ctx, big.NewInt(1), 11, at(11), structs.ValidatorStatisticsTypeActiveNodes, big.NewInt(2)))
+	require.NoError(t, d.SaveValidatorStatistic(ctx, big.NewInt(1), 11, at(11), structs.ValidatorStatisticsTypeLinkedNodes, big.NewInt(3)))
+	require.NoError(t, d.SaveValidatorStatistic(ctx, big.NewInt(1), 11, at(11), structs.ValidatorStatisticsTypeTotalStake, big.NewInt(500)))
+	require.NoError(t, d.UpdateCountsOfValidator(ctx, big.NewInt(1)))
+	require.NoError(t, d.SaveValidator(ctx, validator(1, "renamed", addrA, 12, true, 0)))
+
+	validators, err = d.GetValidators(ctx, structs.ValidatorParams{ValidatorID: "1"})
+	require.NoError(t, err)
+	require.Equal(t, got.ID, validators[0].ID)
+	require.Equal(t, "renamed", validators[0].Name)
+	require.Equal(t, uint(2), validators[0].ActiveNodes)
+	require.Equal(t, uint(3), validators[0].LinkedNodes)
+	requireBig(t, 500, validators[0].Staked)
+}
+
+func validatorAddress(validatorID int64, address, requested common.Address, height uint64) structs.ValidatorAddress {
+	return structs.ValidatorAddress{
+		ValidatorID:      big.NewInt(validatorID),
+		Address:          address,
+		RequestedAddress: requested,
+		BlockHeight:      height,
+		Time:             at(int(height)),
+		TransactionHash:  hash(height),
+		EventName:        "ValidatorAddressChanged",
+	}
+}
+
+func testValidatorAddresses(t *testing.T, d store.DBDriver) {
+	ctx := context.Background()
+
+	require.NoError(t, d.SaveValidatorAddress(ctx, validatorAddress(1, addrA, common.Address{}, 10)))
+	// the same addresses found again are not a change
+	require.NoError(t, d.SaveValidatorAddress(ctx, validatorAddress(1, addrA, common.Address{}, 15)))
+	require.NoError(t, d.SaveValidatorAddress(ctx, validatorAddress(1, addrA, addrB, 20)))
+	require.NoError(t, d.SaveValidatorAddress(ctx, validatorAddress(1, addrB, common.Address{}, 30)))
+	require.NoError(t, d.SaveValidatorAddress(ctx, validatorAddress(2, addrC, common.Address{}, 25)))
+	// change of the same height is overwritten
+	synced := validatorAddress(2, addrC, addrA, 25)
+	synced.TransactionHash, synced.EventName = common.Hash{}, ""
+	require.NoError(t, d.SaveValidatorAddress(ctx, synced))
+
+	addresses, err := d.GetValidatorAddresses(ctx, structs.ValidatorAddressParams{ValidatorID: "1"})
+	require.NoError(t, err)
+	var heights []uint64
+	for _, a := range addresses {
+		heights = append(heights, a.BlockHeight)
+	}
+	require.Equal(t, []uint64{30, 20, 10}, heights)
+	require.Equal(t, addrA, addresses[1].Address)
+	require.Equal(t, addrB, addresses[1].RequestedAddress)
+	require.Equal(t, hash(20), addresses[1].TransactionHash)
+	require.Equal(t, "ValidatorAddressChanged", addresses[1].EventName)
+	requireTime(t, at(20), addresses[1].Time)
+	requireBig(t, 1, addresses[1].ValidatorID)
+	require.NotEmpty(t, addresses[1].ID)
+
+	addresses, err = d.GetValidatorAddresses(ctx, structs.ValidatorAddressParams{ValidatorID: "2"})
+	require.NoError(t, err)
+	require.Len(t, addresses, 1)
+	require.Equal(t, addrA, addresses[0].RequestedAddress)
+	require.Equal(t, common.Hash{}, addresses[0].TransactionHash)
+	require.Empty(t, addresses[0].EventName)
+
+	addresses, err = d.GetValidatorAddresses(ctx, structs.ValidatorAddressParams{Address: addrA.Hex()})
+	require.NoError(t, err)
+	require.Len(t, addresses, 3)
+	require.Equal(t, uint64(25), addresses[0].BlockHeight)
+
+	addresses, err = d.GetValidatorAddresses(ctx, structs.ValidatorAddressParams{Limit: 2, Offset: 1})
+	require.NoError(t, err)
+	require.Len(t, addresses, 2)
+	require.Equal(t, uint64(25), addresses[0].BlockHeight)
+	require.Equal(t, uint64(20), addresses[1].BlockHeight)
+}
+
+func delegation(id, validatorID int64, holder common.Address, height uint64, state structs.DelegationState, amount int64, period int64) structs.Delegation {
+	return structs.Delegation{
+		DelegationID:     big.NewInt(id),
+		Holder:           holder,
+		ValidatorID:      big.NewInt(validatorID),
+		BlockHeight:      height,
+		TransactionHash:  hash(height),
+		Amount:           big.NewInt(amount),
+		DelegationPeriod: big.NewInt(period),
+		Created:          base,
+		Started:          big.NewInt(0),
+		Finished:         big.NewInt(0),
+		Info:             "info",
+		State:            state,
+	}
+}
+
+func delegationIDs(delegations []structs.Delegation) (ids []int64) {
+	for _, dl := range delegations {
+		ids = append(ids, dl.DelegationID.Int64())
+	}
+	return ids
+}
+
+func testDelegations(t *testing.T, d store.DBDriver) {
+	ctx := context.Background()
+
+	require.NoError(t, d.SaveValidator(ctx, validator(1, "first", addrA, 1, true, 0)))
+	require.NoError(t, d.SaveValidator(ctx, validator(2, "second", addrB, 1, true, 0)))
+
+	for _, dl := range []structs.Delegation{
+		delegation(1, 1, addrA, 10, structs.DelegationStatePROPOSED, 100, 3),
+		delegation(1, 1, addrA, 12, structs.DelegationStateACCEPTED, 100, 3),
+		delegation(2, 2, addrB, 11, structs.DelegationStateDELEGATED, 200, 2),
+		// validator is not stored, so it's left out of everything but summary
+		delegation(3, 3, addrC, 13, structs.DelegationStateDELEGATED, 300, 2),
+	} {
+		require.NoError(t, d.SaveDelegation(ctx, dl))
+	}
+
+	tests := []struct {
+		name   string
+		params structs.DelegationParams
+		ids    []int64
+	}{
+		{"all, latest state", structs.DelegationParams{}, []int64{2, 1}},
+		{"delegation", structs.DelegationParams{DelegationID: "1"}, []int64{1}},
+		{"validator", structs.DelegationParams{ValidatorID: "2"}, []int64{2}},
+		{"holder", structs.DelegationParams{Holder: addrA.Hex()}, []int64{1}},
+		{"any of validators", structs.DelegationParams{ValidatorIDs: []string{"1", "2", "3"}}, []int64{2, 1}},
+		{"any of holders", structs.DelegationParams{Holders: []string{addrB.Hex(), addrC.Hex()}}, []int64{2}},
+		{"states", structs.DelegationParams{State: []structs.DelegationState{structs.DelegationStateACCEPTED, structs.DelegationStateDELEGATED}}, []int64{2, 1}},
+		// created in March with 3 months period, it's active until the end of June
+		{"active at the end of period", structs.DelegationParams{TimeAt: base.AddDate(0, 3, 19)}, []int64{1}},
+		{"active before created", structs.DelegationParams{TimeAt: at(-1)}, nil},
+		{"created", structs.DelegationParams{TimeFrom: at(-1), TimeTo: at(1)}, []int64{2, 1}},
+		{"limit and offset", structs.DelegationParams{Limit: 1, Offset: 1}, []int64{1}},
+	}
+	for _, tt := range tests {
+		delegations, err := d.GetDelegations(ctx, tt.params)
+		require.NoError(t, err, tt.name)
+		require.Equal(t, tt.ids, delegationIDs(delegations), tt.name)
+	}
+
+	delegations, err := d.GetDelegations(ctx, structs.DelegationParams{DelegationID: "1"})
+	require.NoError(t, err)
+	require.Len(t, delegations, 1)
+	got := delegations[0]
+	require.NotEqual(t, uuid.Nil, got.ID)
+	require.Equal(t, structs.DelegationStateACCEPTED, got.State)
+	require.Equal(t, uint64(12), got.BlockHeight)
+	require.Equal(t, hash(12), got.TransactionHash)
+	require.Equal(t, addrA, got.Holder)
+	require.Equal(t, "first", got.ValidatorName)
+	require.Equal(t, "info", got.Info)
+	requireBig(t, 1, got.ValidatorID)
+	requireBig(t, 100, got.Amount)
+	requireBig(t, 3, got.DelegationPeriod)
+	requireBig(t, 0, got.Started)
+	requireBig(t, 0, got.Finished)
+	requireTime(t, base, got.Created)
+
+	timeline, err := d.GetDelegationTimeline(ctx, structs.DelegationParams{ValidatorID: "1"})
+	require.NoError(t, err)
+	require.Len(t, timeline, 2)
+	require.Equal(t, uint64(12), timeline[0].BlockHeight)
+	require.Equal(t, uint64(10), timeline[1].BlockHeight)
+	require.Equal(t, "first", timeline[1].ValidatorName)
+
+	timeline, err = d.GetDelegationTimeline(ctx, structs.DelegationParams{State: []structs.DelegationState{structs.DelegationStatePROPOSED}})
+	require.NoError(t, err)
+	require.Len(t, timeline, 1)
+	require.Equal(t, uint64(10), timeline[0].BlockHeight)
+
+	// upsert
+	updated := delegation(2, 2, addrB, 11, structs.DelegationStateDELEGATED, 250, 2)
+	require.NoError(t, d.SaveDelegation(ctx, updated))
+	timeline, err = d.GetDelegationTimeline(ctx, structs.DelegationParams{DelegationID: "2"})
+	require.NoError(t, err)
+	require.Len(t, timeline, 1)
+	requireBig(t, 250, timeline[0].Amount)
+
+	summary, err := d.GetTypesSummaryDelegations(ctx, structs.DelegationParams{})
+	require.NoError(t, err)
+	require.Len(t, summary, 2)
+	byState := map[structs.DelegationState]structs.DelegationSummary{}
+	for _, s := range summary {
+		byState[s.State] = s
+	}
+	requireBig(t, 1, byState[structs.DelegationStateACCEPTED].Count)
+	requireBig(t, 100, byState[structs.DelegationStateACCEPTED].Amount)
+	requireBig(t, 2, byState[structs.DelegationStateDELEGATED].Count)
+	requireBig(t, 550, byState[structs.DelegationStateDELEGATED].Amount)
+
+	summary, err = d.GetTypesSummaryDelegations(ctx, structs.DelegationParams{ValidatorID: "2"})
+	require.NoError(t, err)
+	require.Len(t, summary, 1)
+	require.Equal(t, structs.DelegationStateDELEGATED, summary[0].State)
+	requireBig(t, 1, summary[0].Count)
+	requireBig(t, 250, summary[0].Amount)
+}
+
+func testAccounts(t *testing.T, d store.DBDriver) {
+	ctx := context.Background()
+
+	require.NoError(t, d.SaveAccount(ctx, structs.Account{Address: addrA, Type: structs.AccountTypeDefault}))
+	require.NoError(t, d.SaveAccount(ctx, structs.Account{Address: addrB, Type: structs.AccountTypeValidator}))
+	// type is only ever upgraded
+	require.NoError(t, d.SaveAccount(ctx, structs.Account{Address: addrA, Type: structs.AccountTypeDelegator}))
+	require.NoError(t, d.SaveAccount(ctx, structs.Account{Address: addrB, Type: structs.AccountTypeDelegator}))
+	require.Error(t, d.SaveAccount(ctx, structs.Account{Address: addrC, Type: structs.AccountType("unknown")}))
+
+	accounts, err := d.GetAccounts(ctx, structs.AccountParams{})
+	require.NoError(t, err)
+	require.Len(t, accounts, 2)
+
+	accounts, err = d.GetAccounts(ctx, structs.AccountParams{Address: addrA.Hex()})
+	require.NoError(t, err)
+	require.Len(t, accounts, 1)
+	require.NotEqual(t, uuid.Nil, accounts[0].ID)
+	require.False(t, accounts[0].CreatedAt.IsZero())
+	require.Equal(t, addrA, accounts[0].Address)
+	require.Equal(t, structs.AccountTypeDelegator, accounts[0].Type)
+
+	accounts, err = d.GetAccounts(ctx, structs.AccountParams{Type: string(structs.AccountTypeValidator)})
+	require.NoError(t, err)
+	require.Len(t, accounts, 1)
+	require.Equal(t, addrB, accounts[0].Address)
+
+	accounts, err = d.GetAccounts(ctx, structs.AccountParams{Limit: 1, Offset: 1})
+	require.NoError(t, err)
+	require.Len(t, accounts, 1)
+}
+
+func testValidatorStatistics(t *testing.T, d store.DBDriver) {
+	ctx := context.Background()
+
+	save := func(validatorID int64, height uint64, statType structs.StatisticTypeVS, amount int64) {
+		t.Helper()
+		require.NoError(t, d.SaveValidatorStatistic(ctx, big.NewInt(validatorID), height, at(int(height)), statType, big.NewInt(amount)))
+	}
+	save(1, 10, structs.ValidatorStatisticsTypeFee, 10)
+	// unchanged value is not stored
+	save(1, 11, structs.ValidatorStatisticsTypeFee, 10)
+	save(1, 12, structs.ValidatorStatisticsTypeFee, 20)
+	save(1, 10, structs.ValidatorStatisticsTypeMDR, 5)
+	save(2, 10, structs.ValidatorStatisticsTypeFee, 30)
+
+	timeline, err := d.GetValidatorStatisticsTimeline(ctx, structs.ValidatorStatisticsParams{
+		ValidatorID: "1",
+		Type:        structs.ValidatorStatisticsTypeFee,
+		TimeFrom:    at(0),
+		TimeTo:      at(20),
+	})
+	require.NoError(t, err)
+	require.Len(t, timeline, 2)
+	require.Equal(t, uint64(12), timeline[0].BlockHeight)
+	requireBig(t, 20, timeline[0].Amount)
+	require.Equal(t, uint64(10), timeline[1].BlockHeight)
+	requireBig(t, 10, timeline[1].Amount)
+	require.NotEmpty(t, timeline[1].ID)
+	requireBig(t, 1, timeline[1].ValidatorID)
+	require.Equal(t, structs.ValidatorStatisticsTypeFee, timeline[1].Type)
+	requireTime(t, at(10), timeline[1].Time)
+
+	type latest struct {
+		validatorID int64
+		statType    structs.StatisticTypeVS
+		amount      int64
+	}
+	tests := []struct {
+		name   string
+		params structs.ValidatorStatisticsParams
+		latest []latest
+	}{
+		{"all", structs.ValidatorStatisticsParams{}, []latest{
+			{1, structs.ValidatorStatisticsTypeMDR, 5},
+			{1, structs.ValidatorStatisticsTypeFee, 20},
+			{2, structs.ValidatorStatisticsTypeFee, 30},
+		}},
+		{"validator and type", structs.ValidatorStatisticsParams{ValidatorID: "1", Type: structs.ValidatorStatisticsTypeFee}, []latest{
+			{1, structs.ValidatorStatisticsTypeFee, 20},
+		}},
+		{"time range", structs.ValidatorStatisticsParams{ValidatorID: "1", TimeFrom: at(0), TimeTo: at(11)}, []latest{
+			{1, structs.ValidatorStatisticsTypeMDR, 5},
+			{1, structs.ValidatorStatisticsTypeFee, 10},
+		}},
+		{"limit and offset", structs.ValidatorStatisticsParams{Limit: 1, Offset: 2}, []latest{
+			{2, structs.ValidatorStatisticsTypeFee, 30},
+		}},
+	}
+	for _, tt := range tests {
+		stats, err := d.GetValidatorStatistics(ctx, tt.params)
+		require.NoError(t, err, tt.name)
+		var got []latest
+		for _, s := range stats {
+			got = append(got, latest{s.ValidatorID.Int64(), s.Type, s.Amount.Int64()})
+		}
+		require.Equal(t, tt.latest, got, tt.name)
+	}
+
+	// upsert
+	save(1, 12, structs.ValidatorStatisticsTypeFee, 25)
+	stats, err := d.GetValidatorStatistics(ctx, structs.ValidatorStatisticsParams{ValidatorID: "1", Type: structs.ValidatorStatisticsTypeFee})
+	require.NoError(t, err)
+	require.Len(t, stats, 1)
+	requireBig(t, 25, stats[0].Amount)
+}
+
+func testValidatorStatisticsDaily(t *testing.T, d store.DBDriver) {
+	ctx := context.Background()
+
+	day := func(n int) time.Time { return base.AddDate(0, 0, n) }
+	save := func(validatorID int64, height uint64, blockTime time.Time, statType structs.StatisticTypeVS, amount int64) {
+		t.Helper()
+		require.NoError(t, d.SaveValidatorStatistic(ctx, big.NewInt(validatorID), height, blockTime, statType, big.NewInt(amount)))
+	}
+	save(1, 10, day(0), structs.ValidatorStatisticsTypeTotalStake, 100)
+	save(1, 11, day(0).Add(time.Hour), structs.ValidatorStatisticsTypeTotalStake, 150)
+	save(1, 10, day(0), structs.ValidatorStatisticsTypeFee, 1)
+	save(2, 12, day(1), structs.ValidatorStatisticsTypeTotalStake, 50)
+	save(1, 20, day(2), structs.ValidatorStatisticsTypeTotalStake, 200)
+	save(1, 30, day(5), structs.ValidatorStatisticsTypeTotalStake, 300)
+
+	type point struct {
+		validatorID int64
+		day         time.Time
+		height      uint64
+		amount      int64
+	}
+	midnight := func(n int) time.Time {
+		t := day(n)
+		return time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.UTC)
+	}
+	daily := func(params structs.ValidatorStatisticsParams) (got []point) {
+		t.Helper()
+		stats, err := d.GetValidatorStatisticsDaily(ctx, params)
+		require.NoError(t, err)
+		for _, s := range stats {
+			require.Equal(t, structs.ValidatorStatisticsTypeTotalStake, s.Type)
+			got = append(got, point{s.ValidatorID.Int64(), s.Time.UTC(), s.BlockHeight, s.Amount.Int64()})
+		}
+		return got
+	}
+
+	// the last value of every day, with the last one before the range
+	params := structs.ValidatorStatisticsParams{Type: structs.ValidatorStatisticsTypeTotalStake, TimeFrom: day(2).Add(3 * time.Hour), TimeTo: day(4)}
+	require.Equal(t, []point{
+		{1, midnight(0), 11, 150},
+		{1, midnight(2), 20, 200},
+		{2, midnight(1), 12, 50},
+	}, daily(params))
+
+	require.Equal(t, []point{
+		{2, midnight(1), 12, 50},
+	}, daily(structs.ValidatorStatisticsParams{ValidatorID: "2", Type: structs.ValidatorStatisticsTypeTotalStake, TimeFrom: day(0), TimeTo: day(5)}))
+
+	// upsert updates the rollup, earlier height of the same day doesn't override it
+	save(1, 20, day(2), structs.ValidatorStatisticsTypeTotalStake, 250)
+	save(1, 19, day(2), structs.ValidatorStatisticsTypeTotalStake, 180)
+	params.ValidatorID = "1"
+	require.Equal(t, []point{
+		{1, midnight(0), 11, 150},
+		{1, midnight(2), 20, 250},
+	}, daily(params))
+}
@@ -0,0 +1,85 @@
+// Package storetest is the conformance suite of store drivers.
+// Every driver has to pass it, so whatever is built on top of the store behaves the same regardless of the database used.
+package storetest
+
+import (
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/require"
+
+	"github.com/figment-networks/skale-indexer/store"
+)
+
+// NewDriver returns driver with empty storage, used by the single test
+type NewDriver func(t *testing.T) store.DBDriver
+
+// Run runs the conformance suite against driver
+func Run(t *testing.T, newDriver NewDriver) {
+	tests := []struct {
+		name string
+		fn   func(t *testing.T, d store.DBDriver)
+	}{
+		{"ContractEvents", testContractEvents},
+		{"ContractEventSearch", testContractEventSearch},
+		{"SystemEvents", testSystemEvents},
+		{"FailedEvents", testFailedEvents},
+		{"Webhooks", testWebhooks},
+		{"Outbox", testOutbox},
+		{"Decentralization", testDecentralization},
+		{"Nodes", testNodes},
+		{"Validators", testValidators},
+		{"ValidatorAddresses", testValidatorAddresses},
+		{"Delegations", testDelegations},
+		{"Accounts", testAccounts},
+		{"ValidatorStatistics", testValidatorStatistics},
+		{"ValidatorStatisticsDaily", testValidatorStatisticsDaily},
+		{"Blocks", testBlocks},
+		{"Transactions", testTransactions},
+		{"Atomic", testAtomic},
+		{"Bulk", testBulk},
+		{"ValidatorsAtHeight", testValidatorsAtHeight},
+		{"NodesAtHeight", testNodesAtHeight},
+		{"NodeTimeline", testNodeTimeline},
+		{"RecordsAtHeight", testRecordsAtHeight},
+		{"CursorPaging", testCursorPaging},
+		{"KeyCursorPaging", testKeyCursorPaging},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			tt.fn(t, newDriver(t))
+		})
+	}
+}
+
+var (
+	// base is the time all test records are relative to
+	base = time.Date(2021, time.March, 10, 12, 0, 0, 0, time.UTC)
+
+	addrA = common.HexToAddress("0x06dD71dAb27C1A3e0B172d53735f00Bf1a66Eb79")
+	addrB = common.HexToAddress("0x1f42f0B1Ae2aA1D04D4b7C62C2A8a4a8C06D0a6b")
+	addrC = common.HexToAddress("0x5a6a3E5B1fD2F2e11Ae6D0e1dB0e0c6b1F7A8f24")
+)
+
+func at(minutes int) time.Time {
+	return base.Add(time.Duration(minutes) * time.Minute)
+}
+
+func hash(i uint64) common.Hash {
+	return common.BigToHash(new(big.Int).SetUint64(i))
+}
+
+func requireTime(t *testing.T, expected, actual time.Time) {
+	t.Helper()
+	require.True(t, expected.Equal(actual), "expected time %s, got %s", expected, actual)
+}
+
+func requireBig(t *testing.T, expected int64, actual *big.Int) {
+	t.Helper()
+	require.NotNil(t, actual)
+	require.Equal(t, 0, big.NewInt(expected).Cmp(actual), "expected %d, got %s", expected, actual)
+}
@@ -0,0 +1,142 @@
+package storetest
+
+import (
+	"context"
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/require"
+
+	"github.com/figment-networks/skale-indexer/scraper/structs"
+	"github.com/figment-networks/skale-indexer/store"
+)
+
+const missingID = "00000000-0000-0000-0000-000000000000"
+
+func testWebhooks(t *testing.T, d store.DBDriver) {
+	ctx := context.Background()
+
+	filtered := structs.Webhook{
+		URL:         "https://partner.example/hook",
+		Secret:      "secret",
+		Kinds:       []structs.SysEvtType{structs.SysEvtTypeNewDelegation, structs.SysEvtTypeSlashed},
+		ValidatorID: big.NewInt(2),
+		Address:     addrA,
+		Active:      true,
+	}
+	id, err := d.CreateWebhook(ctx, filtered)
+	require.NoError(t, err)
+	require.NotEmpty(t, id)
+
+	otherID, err := d.CreateWebhook(ctx, structs.Webhook{URL: "https://other.example/hook", Secret: "other"})
+	require.NoError(t, err)
+
+	all, err := d.GetWebhooks(ctx, structs.WebhookParams{})
+	require.NoError(t, err)
+	require.ElementsMatch(t, []string{id, otherID}, []string{all[0].ID, all[1].ID})
+
+	byID, err := d.GetWebhooks(ctx, structs.WebhookParams{ID: id})
+	require.NoError(t, err)
+	require.Len(t, byID, 1)
+	got := byID[0]
+	require.Equal(t, filtered.URL, got.URL)
+	require.Equal(t, filtered.Secret, got.Secret)
+	require.Equal(t, filtered.Kinds, got.Kinds)
+	requireBig(t, 2, got.ValidatorID)
+	require.Equal(t, addrA, got.Address)
+	require.True(t, got.Active)
+	require.False(t, got.CreatedAt.IsZero())
+
+	other, err := d.GetWebhooks(ctx, structs.WebhookParams{ID: otherID})
+	require.NoError(t, err)
+	require.Empty(t, other[0].Kinds)
+	require.Nil(t, other[0].ValidatorID)
+	require.Equal(t, common.Address{}, other[0].Address)
+
+	active, err := d.GetWebhooks(ctx, structs.WebhookParams{Active: true})
+	require.NoError(t, err)
+	require.Len(t, active, 1)
+	require.Equal(t, id, active[0].ID)
+
+	// update
+	got.Kinds, got.ValidatorID, got.Address, got.Active = nil, nil, common.Address{}, false
+	got.URL = "https://partner.example/v2/hook"
+	require.NoError(t, d.UpdateWebhook(ctx, got))
+	byID, err = d.GetWebhooks(ctx, structs.WebhookParams{ID: id})
+	require.NoError(t, err)
+	require.Equal(t, "https://partner.example/v2/hook", byID[0].URL)
+	require.Empty(t, byID[0].Kinds)
+	require.Nil(t, byID[0].ValidatorID)
+	require.False(t, byID[0].Active)
+	require.ErrorIs(t, d.UpdateWebhook(ctx, structs.Webhook{ID: missingID}), structs.ErrNotFound)
+
+	// deliveries
+	delivery := func(webhookID string, height uint64, attempts uint64, nextRetry int) structs.WebhookDelivery {
+		return structs.WebhookDelivery{
+			WebhookID: webhookID,
+			Event:     systemEvent(height, structs.SysEvtTypeNewDelegation, addrA, addrB, 0, 2),
+			Status:    structs.WebhookDeliveryPending,
+			Attempts:  attempts,
+			NextRetry: at(nextRetry),
+		}
+	}
+	require.NoError(t, d.CreateWebhookDelivery(ctx, delivery(id, 10, 0, 20)))
+	require.NoError(t, d.CreateWebhookDelivery(ctx, delivery(id, 11, 2, 5)))
+	require.NoError(t, d.CreateWebhookDelivery(ctx, delivery(otherID, 10, 0, 10)))
+	// the same event is queued once per webhook
+	require.NoError(t, d.CreateWebhookDelivery(ctx, delivery(id, 10, 0, 1)))
+
+	deliveries, err := d.GetWebhookDeliveries(ctx, structs.WebhookDeliveryParams{WebhookID: id})
+	require.NoError(t, err)
+	require.Len(t, deliveries, 2)
+
+	due, err := d.GetWebhookDeliveries(ctx, structs.WebhookDeliveryParams{Status: structs.WebhookDeliveryPending, RetryBefore: at(20)})
+	require.NoError(t, err)
+	require.Len(t, due, 3)
+	require.Equal(t, []uint64{11, 10, 10}, []uint64{due[0].Event.Height, due[1].Event.Height, due[2].Event.Height})
+	require.Equal(t, otherID, due[1].WebhookID)
+
+	first := due[0]
+	require.NotEmpty(t, first.ID)
+	require.Equal(t, id, first.WebhookID)
+	require.Equal(t, uint64(2), first.Attempts)
+	requireTime(t, at(5), first.NextRetry)
+	require.True(t, first.DeliveredAt.IsZero())
+	require.Equal(t, addrA, first.Event.Sender)
+	requireBig(t, 2, &first.Event.RecipientID)
+	requireBig(t, 150, &first.Event.After)
+	requireTime(t, at(11), first.Event.Time)
+
+	paged, err := d.GetWebhookDeliveries(ctx, structs.WebhookDeliveryParams{RetryBefore: at(20), Limit: 1, Offset: 1})
+	require.NoError(t, err)
+	require.Len(t, paged, 1)
+	require.Equal(t, otherID, paged[0].WebhookID)
+
+	first.Status, first.Attempts, first.ResponseCode, first.DeliveredAt = structs.WebhookDeliveryDelivered, 3, 200, at(30)
+	require.NoError(t, d.UpdateWebhookDelivery(ctx, first))
+	updated, err := d.GetWebhookDeliveries(ctx, structs.WebhookDeliveryParams{ID: first.ID})
+	require.NoError(t, err)
+	require.Len(t, updated, 1)
+	require.Equal(t, structs.WebhookDeliveryDelivered, updated[0].Status)
+	require.Equal(t, uint64(3), updated[0].Attempts)
+	require.Equal(t, 200, updated[0].ResponseCode)
+	requireTime(t, at(30), updated[0].DeliveredAt)
+	require.ErrorIs(t, d.UpdateWebhookDelivery(ctx, structs.WebhookDelivery{ID: missingID}), structs.ErrNotFound)
+
+	due, err = d.GetWebhookDeliveries(ctx, structs.WebhookDeliveryParams{Status: structs.WebhookDeliveryPending, RetryBefore: at(15)})
+	require.NoError(t, err)
+	require.Len(t, due, 1)
+	require.Equal(t, otherID, due[0].WebhookID)
+
+	// deliveries go together with webhook
+	require.NoError(t, d.DeleteWebhook(ctx, id))
+	require.ErrorIs(t, d.DeleteWebhook(ctx, id), structs.ErrNotFound)
+	_, err = d.GetWebhooks(ctx, structs.WebhookParams{ID: id})
+	require.ErrorIs(t, err, structs.ErrNotFound)
+	_, err = d.GetWebhookDeliveries(ctx, structs.WebhookDeliveryParams{ID: first.ID})
+	require.ErrorIs(t, err, structs.ErrNotFound)
+	deliveries, err = d.GetWebhookDeliveries(ctx, structs.WebhookDeliveryParams{})
+	require.NoError(t, err)
+	require.Len(t, deliveries, 1)
+}